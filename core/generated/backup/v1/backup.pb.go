@@ -0,0 +1,753 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: backup/v1/backup.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetConfigRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type Config struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// compose file of the stack
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Enabled  bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// cron expression
+	Schedule string `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// stop the running containers of the stack while archiving
+	StopServices bool `protobuf:"varint,4,opt,name=stopServices,proto3" json:"stopServices,omitempty"`
+	Volumes      bool `protobuf:"varint,5,opt,name=volumes,proto3" json:"volumes,omitempty"`
+	BindMounts   bool `protobuf:"varint,6,opt,name=bindMounts,proto3" json:"bindMounts,omitempty"`
+	// host and alias archives are written to over sftp,
+	// leave destHost empty to write to destPath on the machine running dockman
+	DestHost  string `protobuf:"bytes,7,opt,name=destHost,proto3" json:"destHost,omitempty"`
+	DestAlias string `protobuf:"bytes,8,opt,name=destAlias,proto3" json:"destAlias,omitempty"`
+	DestPath  string `protobuf:"bytes,9,opt,name=destPath,proto3" json:"destPath,omitempty"`
+	// successful backups kept, 0 keeps all
+	Retention     uint32 `protobuf:"varint,10,opt,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_backup_v1_backup_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Config) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Config) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Config) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *Config) GetStopServices() bool {
+	if x != nil {
+		return x.StopServices
+	}
+	return false
+}
+
+func (x *Config) GetVolumes() bool {
+	if x != nil {
+		return x.Volumes
+	}
+	return false
+}
+
+func (x *Config) GetBindMounts() bool {
+	if x != nil {
+		return x.BindMounts
+	}
+	return false
+}
+
+func (x *Config) GetDestHost() string {
+	if x != nil {
+		return x.DestHost
+	}
+	return ""
+}
+
+func (x *Config) GetDestAlias() string {
+	if x != nil {
+		return x.DestAlias
+	}
+	return ""
+}
+
+func (x *Config) GetDestPath() string {
+	if x != nil {
+		return x.DestPath
+	}
+	return ""
+}
+
+func (x *Config) GetRetention() uint32 {
+	if x != nil {
+		return x.Retention
+	}
+	return 0
+}
+
+type SaveConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveConfigResponse) Reset() {
+	*x = SaveConfigResponse{}
+	mi := &file_backup_v1_backup_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConfigResponse) ProtoMessage() {}
+
+func (x *SaveConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConfigResponse.ProtoReflect.Descriptor instead.
+func (*SaveConfigResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{2}
+}
+
+type RunBackupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunBackupRequest) Reset() {
+	*x = RunBackupRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunBackupRequest) ProtoMessage() {}
+
+func (x *RunBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunBackupRequest.ProtoReflect.Descriptor instead.
+func (*RunBackupRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RunBackupRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type JobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobResponse) Reset() {
+	*x = JobResponse{}
+	mi := &file_backup_v1_backup_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResponse) ProtoMessage() {}
+
+func (x *JobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResponse.ProtoReflect.Descriptor instead.
+func (*JobResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type ListRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunsRequest) Reset() {
+	*x = ListRunsRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunsRequest) ProtoMessage() {}
+
+func (x *ListRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListRunsRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListRunsRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type ListRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*Run                 `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunsResponse) Reset() {
+	*x = ListRunsResponse{}
+	mi := &file_backup_v1_backup_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunsResponse) ProtoMessage() {}
+
+func (x *ListRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListRunsResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListRunsResponse) GetRuns() []*Run {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type Run struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// unix seconds
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// running, success, failed
+	State     string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Error     string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	DestHost  string `protobuf:"bytes,5,opt,name=destHost,proto3" json:"destHost,omitempty"`
+	DestAlias string `protobuf:"bytes,6,opt,name=destAlias,proto3" json:"destAlias,omitempty"`
+	// directory of the archives in the destination
+	Dir           string     `protobuf:"bytes,7,opt,name=dir,proto3" json:"dir,omitempty"`
+	Archives      []*Archive `protobuf:"bytes,8,rep,name=archives,proto3" json:"archives,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Run) Reset() {
+	*x = Run{}
+	mi := &file_backup_v1_backup_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Run) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Run) ProtoMessage() {}
+
+func (x *Run) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Run.ProtoReflect.Descriptor instead.
+func (*Run) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Run) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Run) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Run) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Run) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Run) GetDestHost() string {
+	if x != nil {
+		return x.DestHost
+	}
+	return ""
+}
+
+func (x *Run) GetDestAlias() string {
+	if x != nil {
+		return x.DestAlias
+	}
+	return ""
+}
+
+func (x *Run) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *Run) GetArchives() []*Archive {
+	if x != nil {
+		return x.Archives
+	}
+	return nil
+}
+
+type Archive struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// volume, bind
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// volume name or host path of the bind mount
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// bytes
+	Size          int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Archive) Reset() {
+	*x = Archive{}
+	mi := &file_backup_v1_backup_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Archive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Archive) ProtoMessage() {}
+
+func (x *Archive) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Archive.ProtoReflect.Descriptor instead.
+func (*Archive) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Archive) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Archive) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Archive) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Archive) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type RestoreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	RunId uint32                 `protobuf:"varint,1,opt,name=runId,proto3" json:"runId,omitempty"`
+	// sources to restore, empty restores every archive of the run
+	Sources       []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreRequest) GetRunId() uint32 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *RestoreRequest) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+var File_backup_v1_backup_proto protoreflect.FileDescriptor
+
+const file_backup_v1_backup_proto_rawDesc = "" +
+	"\n" +
+	"\x16backup/v1/backup.proto\x12\tbackup.v1\".\n" +
+	"\x10GetConfigRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\"\xac\x02\n" +
+	"\x06Config\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bschedule\x18\x03 \x01(\tR\bschedule\x12\"\n" +
+	"\fstopServices\x18\x04 \x01(\bR\fstopServices\x12\x18\n" +
+	"\avolumes\x18\x05 \x01(\bR\avolumes\x12\x1e\n" +
+	"\n" +
+	"bindMounts\x18\x06 \x01(\bR\n" +
+	"bindMounts\x12\x1a\n" +
+	"\bdestHost\x18\a \x01(\tR\bdestHost\x12\x1c\n" +
+	"\tdestAlias\x18\b \x01(\tR\tdestAlias\x12\x1a\n" +
+	"\bdestPath\x18\t \x01(\tR\bdestPath\x12\x1c\n" +
+	"\tretention\x18\n" +
+	" \x01(\rR\tretention\"\x14\n" +
+	"\x12SaveConfigResponse\".\n" +
+	"\x10RunBackupRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\"#\n" +
+	"\vJobResponse\x12\x14\n" +
+	"\x05jobId\x18\x01 \x01(\tR\x05jobId\"-\n" +
+	"\x0fListRunsRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\"6\n" +
+	"\x10ListRunsResponse\x12\"\n" +
+	"\x04runs\x18\x01 \x03(\v2\x0e.backup.v1.RunR\x04runs\"\xd1\x01\n" +
+	"\x03Run\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\x03R\x04time\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1a\n" +
+	"\bdestHost\x18\x05 \x01(\tR\bdestHost\x12\x1c\n" +
+	"\tdestAlias\x18\x06 \x01(\tR\tdestAlias\x12\x10\n" +
+	"\x03dir\x18\a \x01(\tR\x03dir\x12.\n" +
+	"\barchives\x18\b \x03(\v2\x12.backup.v1.ArchiveR\barchives\"]\n" +
+	"\aArchive\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\"@\n" +
+	"\x0eRestoreRequest\x12\x14\n" +
+	"\x05runId\x18\x01 \x01(\rR\x05runId\x12\x18\n" +
+	"\asources\x18\x02 \x03(\tR\asources2\xdb\x02\n" +
+	"\rBackupService\x12=\n" +
+	"\tGetConfig\x12\x1b.backup.v1.GetConfigRequest\x1a\x11.backup.v1.Config\"\x00\x12@\n" +
+	"\n" +
+	"SaveConfig\x12\x11.backup.v1.Config\x1a\x1d.backup.v1.SaveConfigResponse\"\x00\x12B\n" +
+	"\tRunBackup\x12\x1b.backup.v1.RunBackupRequest\x1a\x16.backup.v1.JobResponse\"\x00\x12E\n" +
+	"\bListRuns\x12\x1a.backup.v1.ListRunsRequest\x1a\x1b.backup.v1.ListRunsResponse\"\x00\x12>\n" +
+	"\aRestore\x12\x19.backup.v1.RestoreRequest\x1a\x16.backup.v1.JobResponse\"\x00B\x8f\x01\n" +
+	"\rcom.backup.v1B\vBackupProtoP\x01Z,github.com/RA341/dockman/generated/backup/v1\xa2\x02\x03BXX\xaa\x02\tBackup.V1\xca\x02\tBackup\\V1\xe2\x02\x15Backup\\V1\\GPBMetadata\xea\x02\n" +
+	"Backup::V1b\x06proto3"
+
+var (
+	file_backup_v1_backup_proto_rawDescOnce sync.Once
+	file_backup_v1_backup_proto_rawDescData []byte
+)
+
+func file_backup_v1_backup_proto_rawDescGZIP() []byte {
+	file_backup_v1_backup_proto_rawDescOnce.Do(func() {
+		file_backup_v1_backup_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backup_v1_backup_proto_rawDesc), len(file_backup_v1_backup_proto_rawDesc)))
+	})
+	return file_backup_v1_backup_proto_rawDescData
+}
+
+var file_backup_v1_backup_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_backup_v1_backup_proto_goTypes = []any{
+	(*GetConfigRequest)(nil),   // 0: backup.v1.GetConfigRequest
+	(*Config)(nil),             // 1: backup.v1.Config
+	(*SaveConfigResponse)(nil), // 2: backup.v1.SaveConfigResponse
+	(*RunBackupRequest)(nil),   // 3: backup.v1.RunBackupRequest
+	(*JobResponse)(nil),        // 4: backup.v1.JobResponse
+	(*ListRunsRequest)(nil),    // 5: backup.v1.ListRunsRequest
+	(*ListRunsResponse)(nil),   // 6: backup.v1.ListRunsResponse
+	(*Run)(nil),                // 7: backup.v1.Run
+	(*Archive)(nil),            // 8: backup.v1.Archive
+	(*RestoreRequest)(nil),     // 9: backup.v1.RestoreRequest
+}
+var file_backup_v1_backup_proto_depIdxs = []int32{
+	7, // 0: backup.v1.ListRunsResponse.runs:type_name -> backup.v1.Run
+	8, // 1: backup.v1.Run.archives:type_name -> backup.v1.Archive
+	0, // 2: backup.v1.BackupService.GetConfig:input_type -> backup.v1.GetConfigRequest
+	1, // 3: backup.v1.BackupService.SaveConfig:input_type -> backup.v1.Config
+	3, // 4: backup.v1.BackupService.RunBackup:input_type -> backup.v1.RunBackupRequest
+	5, // 5: backup.v1.BackupService.ListRuns:input_type -> backup.v1.ListRunsRequest
+	9, // 6: backup.v1.BackupService.Restore:input_type -> backup.v1.RestoreRequest
+	1, // 7: backup.v1.BackupService.GetConfig:output_type -> backup.v1.Config
+	2, // 8: backup.v1.BackupService.SaveConfig:output_type -> backup.v1.SaveConfigResponse
+	4, // 9: backup.v1.BackupService.RunBackup:output_type -> backup.v1.JobResponse
+	6, // 10: backup.v1.BackupService.ListRuns:output_type -> backup.v1.ListRunsResponse
+	4, // 11: backup.v1.BackupService.Restore:output_type -> backup.v1.JobResponse
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_backup_v1_backup_proto_init() }
+func file_backup_v1_backup_proto_init() {
+	if File_backup_v1_backup_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backup_v1_backup_proto_rawDesc), len(file_backup_v1_backup_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backup_v1_backup_proto_goTypes,
+		DependencyIndexes: file_backup_v1_backup_proto_depIdxs,
+		MessageInfos:      file_backup_v1_backup_proto_msgTypes,
+	}.Build()
+	File_backup_v1_backup_proto = out.File
+	file_backup_v1_backup_proto_goTypes = nil
+	file_backup_v1_backup_proto_depIdxs = nil
+}
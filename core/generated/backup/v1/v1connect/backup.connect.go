@@ -0,0 +1,227 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: backup/v1/backup.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/RA341/dockman/generated/backup/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BackupServiceName is the fully-qualified name of the BackupService service.
+	BackupServiceName = "backup.v1.BackupService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BackupServiceGetConfigProcedure is the fully-qualified name of the BackupService's GetConfig RPC.
+	BackupServiceGetConfigProcedure = "/backup.v1.BackupService/GetConfig"
+	// BackupServiceSaveConfigProcedure is the fully-qualified name of the BackupService's SaveConfig
+	// RPC.
+	BackupServiceSaveConfigProcedure = "/backup.v1.BackupService/SaveConfig"
+	// BackupServiceRunBackupProcedure is the fully-qualified name of the BackupService's RunBackup RPC.
+	BackupServiceRunBackupProcedure = "/backup.v1.BackupService/RunBackup"
+	// BackupServiceListRunsProcedure is the fully-qualified name of the BackupService's ListRuns RPC.
+	BackupServiceListRunsProcedure = "/backup.v1.BackupService/ListRuns"
+	// BackupServiceRestoreProcedure is the fully-qualified name of the BackupService's Restore RPC.
+	BackupServiceRestoreProcedure = "/backup.v1.BackupService/Restore"
+)
+
+// BackupServiceClient is a client for the backup.v1.BackupService service.
+type BackupServiceClient interface {
+	GetConfig(context.Context, *connect.Request[v1.GetConfigRequest]) (*connect.Response[v1.Config], error)
+	SaveConfig(context.Context, *connect.Request[v1.Config]) (*connect.Response[v1.SaveConfigResponse], error)
+	// RunBackup starts a backup job, follow it through the jobs service
+	RunBackup(context.Context, *connect.Request[v1.RunBackupRequest]) (*connect.Response[v1.JobResponse], error)
+	ListRuns(context.Context, *connect.Request[v1.ListRunsRequest]) (*connect.Response[v1.ListRunsResponse], error)
+	// Restore starts a job extracting the archives of a run back into
+	// their volumes and bind mounts, replacing what is there
+	Restore(context.Context, *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.JobResponse], error)
+}
+
+// NewBackupServiceClient constructs a client for the backup.v1.BackupService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBackupServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BackupServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	backupServiceMethods := v1.File_backup_v1_backup_proto.Services().ByName("BackupService").Methods()
+	return &backupServiceClient{
+		getConfig: connect.NewClient[v1.GetConfigRequest, v1.Config](
+			httpClient,
+			baseURL+BackupServiceGetConfigProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("GetConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		saveConfig: connect.NewClient[v1.Config, v1.SaveConfigResponse](
+			httpClient,
+			baseURL+BackupServiceSaveConfigProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("SaveConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		runBackup: connect.NewClient[v1.RunBackupRequest, v1.JobResponse](
+			httpClient,
+			baseURL+BackupServiceRunBackupProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("RunBackup")),
+			connect.WithClientOptions(opts...),
+		),
+		listRuns: connect.NewClient[v1.ListRunsRequest, v1.ListRunsResponse](
+			httpClient,
+			baseURL+BackupServiceListRunsProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("ListRuns")),
+			connect.WithClientOptions(opts...),
+		),
+		restore: connect.NewClient[v1.RestoreRequest, v1.JobResponse](
+			httpClient,
+			baseURL+BackupServiceRestoreProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("Restore")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// backupServiceClient implements BackupServiceClient.
+type backupServiceClient struct {
+	getConfig  *connect.Client[v1.GetConfigRequest, v1.Config]
+	saveConfig *connect.Client[v1.Config, v1.SaveConfigResponse]
+	runBackup  *connect.Client[v1.RunBackupRequest, v1.JobResponse]
+	listRuns   *connect.Client[v1.ListRunsRequest, v1.ListRunsResponse]
+	restore    *connect.Client[v1.RestoreRequest, v1.JobResponse]
+}
+
+// GetConfig calls backup.v1.BackupService.GetConfig.
+func (c *backupServiceClient) GetConfig(ctx context.Context, req *connect.Request[v1.GetConfigRequest]) (*connect.Response[v1.Config], error) {
+	return c.getConfig.CallUnary(ctx, req)
+}
+
+// SaveConfig calls backup.v1.BackupService.SaveConfig.
+func (c *backupServiceClient) SaveConfig(ctx context.Context, req *connect.Request[v1.Config]) (*connect.Response[v1.SaveConfigResponse], error) {
+	return c.saveConfig.CallUnary(ctx, req)
+}
+
+// RunBackup calls backup.v1.BackupService.RunBackup.
+func (c *backupServiceClient) RunBackup(ctx context.Context, req *connect.Request[v1.RunBackupRequest]) (*connect.Response[v1.JobResponse], error) {
+	return c.runBackup.CallUnary(ctx, req)
+}
+
+// ListRuns calls backup.v1.BackupService.ListRuns.
+func (c *backupServiceClient) ListRuns(ctx context.Context, req *connect.Request[v1.ListRunsRequest]) (*connect.Response[v1.ListRunsResponse], error) {
+	return c.listRuns.CallUnary(ctx, req)
+}
+
+// Restore calls backup.v1.BackupService.Restore.
+func (c *backupServiceClient) Restore(ctx context.Context, req *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.JobResponse], error) {
+	return c.restore.CallUnary(ctx, req)
+}
+
+// BackupServiceHandler is an implementation of the backup.v1.BackupService service.
+type BackupServiceHandler interface {
+	GetConfig(context.Context, *connect.Request[v1.GetConfigRequest]) (*connect.Response[v1.Config], error)
+	SaveConfig(context.Context, *connect.Request[v1.Config]) (*connect.Response[v1.SaveConfigResponse], error)
+	// RunBackup starts a backup job, follow it through the jobs service
+	RunBackup(context.Context, *connect.Request[v1.RunBackupRequest]) (*connect.Response[v1.JobResponse], error)
+	ListRuns(context.Context, *connect.Request[v1.ListRunsRequest]) (*connect.Response[v1.ListRunsResponse], error)
+	// Restore starts a job extracting the archives of a run back into
+	// their volumes and bind mounts, replacing what is there
+	Restore(context.Context, *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.JobResponse], error)
+}
+
+// NewBackupServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBackupServiceHandler(svc BackupServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	backupServiceMethods := v1.File_backup_v1_backup_proto.Services().ByName("BackupService").Methods()
+	backupServiceGetConfigHandler := connect.NewUnaryHandler(
+		BackupServiceGetConfigProcedure,
+		svc.GetConfig,
+		connect.WithSchema(backupServiceMethods.ByName("GetConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceSaveConfigHandler := connect.NewUnaryHandler(
+		BackupServiceSaveConfigProcedure,
+		svc.SaveConfig,
+		connect.WithSchema(backupServiceMethods.ByName("SaveConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceRunBackupHandler := connect.NewUnaryHandler(
+		BackupServiceRunBackupProcedure,
+		svc.RunBackup,
+		connect.WithSchema(backupServiceMethods.ByName("RunBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceListRunsHandler := connect.NewUnaryHandler(
+		BackupServiceListRunsProcedure,
+		svc.ListRuns,
+		connect.WithSchema(backupServiceMethods.ByName("ListRuns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceRestoreHandler := connect.NewUnaryHandler(
+		BackupServiceRestoreProcedure,
+		svc.Restore,
+		connect.WithSchema(backupServiceMethods.ByName("Restore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/backup.v1.BackupService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BackupServiceGetConfigProcedure:
+			backupServiceGetConfigHandler.ServeHTTP(w, r)
+		case BackupServiceSaveConfigProcedure:
+			backupServiceSaveConfigHandler.ServeHTTP(w, r)
+		case BackupServiceRunBackupProcedure:
+			backupServiceRunBackupHandler.ServeHTTP(w, r)
+		case BackupServiceListRunsProcedure:
+			backupServiceListRunsHandler.ServeHTTP(w, r)
+		case BackupServiceRestoreProcedure:
+			backupServiceRestoreHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBackupServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBackupServiceHandler struct{}
+
+func (UnimplementedBackupServiceHandler) GetConfig(context.Context, *connect.Request[v1.GetConfigRequest]) (*connect.Response[v1.Config], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.GetConfig is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) SaveConfig(context.Context, *connect.Request[v1.Config]) (*connect.Response[v1.SaveConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.SaveConfig is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) RunBackup(context.Context, *connect.Request[v1.RunBackupRequest]) (*connect.Response[v1.JobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.RunBackup is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) ListRuns(context.Context, *connect.Request[v1.ListRunsRequest]) (*connect.Response[v1.ListRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.ListRuns is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) Restore(context.Context, *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.JobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.Restore is not implemented"))
+}
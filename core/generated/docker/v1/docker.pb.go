@@ -1333,8 +1333,10 @@ func (x *ImageLayer) GetTotalSizeAtLayer() string {
 }
 
 type ComposeValidateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Errs          []string               `protobuf:"bytes,1,rep,name=errs,proto3" json:"errs,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// messages of all diagnostics, kept for older clients
+	Errs          []string             `protobuf:"bytes,1,rep,name=errs,proto3" json:"errs,omitempty"`
+	Diagnostics   []*ComposeDiagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1376,6 +1378,99 @@ func (x *ComposeValidateResponse) GetErrs() []string {
 	return nil
 }
 
+func (x *ComposeValidateResponse) GetDiagnostics() []*ComposeDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type ComposeDiagnostic struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	File  string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// 1-based, 0 if unknown
+	Line   int32 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Column int32 `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	// error or warning
+	Severity      string `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Rule          string `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message       string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComposeDiagnostic) Reset() {
+	*x = ComposeDiagnostic{}
+	mi := &file_docker_v1_docker_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComposeDiagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComposeDiagnostic) ProtoMessage() {}
+
+func (x *ComposeDiagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComposeDiagnostic.ProtoReflect.Descriptor instead.
+func (*ComposeDiagnostic) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ComposeDiagnostic) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ComposeDiagnostic) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ComposeDiagnostic) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *ComposeDiagnostic) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ComposeDiagnostic) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *ComposeDiagnostic) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // forwards commands from user to a running session
 type ContainerExecCmdInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1387,7 +1482,7 @@ type ContainerExecCmdInput struct {
 
 func (x *ContainerExecCmdInput) Reset() {
 	*x = ContainerExecCmdInput{}
-	mi := &file_docker_v1_docker_proto_msgTypes[20]
+	mi := &file_docker_v1_docker_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1399,7 +1494,7 @@ func (x *ContainerExecCmdInput) String() string {
 func (*ContainerExecCmdInput) ProtoMessage() {}
 
 func (x *ContainerExecCmdInput) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[20]
+	mi := &file_docker_v1_docker_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1412,7 +1507,7 @@ func (x *ContainerExecCmdInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerExecCmdInput.ProtoReflect.Descriptor instead.
 func (*ContainerExecCmdInput) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{20}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ContainerExecCmdInput) GetUserCmd() string {
@@ -1440,7 +1535,7 @@ type ContainerExecRequest struct {
 
 func (x *ContainerExecRequest) Reset() {
 	*x = ContainerExecRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[21]
+	mi := &file_docker_v1_docker_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1452,7 +1547,7 @@ func (x *ContainerExecRequest) String() string {
 func (*ContainerExecRequest) ProtoMessage() {}
 
 func (x *ContainerExecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[21]
+	mi := &file_docker_v1_docker_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1465,7 +1560,7 @@ func (x *ContainerExecRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerExecRequest.ProtoReflect.Descriptor instead.
 func (*ContainerExecRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{21}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ContainerExecRequest) GetContainerID() string {
@@ -1502,7 +1597,7 @@ type Image struct {
 
 func (x *Image) Reset() {
 	*x = Image{}
-	mi := &file_docker_v1_docker_proto_msgTypes[22]
+	mi := &file_docker_v1_docker_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1514,7 +1609,7 @@ func (x *Image) String() string {
 func (*Image) ProtoMessage() {}
 
 func (x *Image) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[22]
+	mi := &file_docker_v1_docker_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1527,7 +1622,7 @@ func (x *Image) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Image.ProtoReflect.Descriptor instead.
 func (*Image) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{22}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Image) GetContainers() int64 {
@@ -1618,7 +1713,7 @@ type ManifestSummary struct {
 
 func (x *ManifestSummary) Reset() {
 	*x = ManifestSummary{}
-	mi := &file_docker_v1_docker_proto_msgTypes[23]
+	mi := &file_docker_v1_docker_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1630,7 +1725,7 @@ func (x *ManifestSummary) String() string {
 func (*ManifestSummary) ProtoMessage() {}
 
 func (x *ManifestSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[23]
+	mi := &file_docker_v1_docker_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1643,7 +1738,7 @@ func (x *ManifestSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManifestSummary.ProtoReflect.Descriptor instead.
 func (*ManifestSummary) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{23}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ManifestSummary) GetDigest() string {
@@ -1675,7 +1770,7 @@ type ListImagesRequest struct {
 
 func (x *ListImagesRequest) Reset() {
 	*x = ListImagesRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[24]
+	mi := &file_docker_v1_docker_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1687,7 +1782,7 @@ func (x *ListImagesRequest) String() string {
 func (*ListImagesRequest) ProtoMessage() {}
 
 func (x *ListImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[24]
+	mi := &file_docker_v1_docker_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,7 +1795,7 @@ func (x *ListImagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListImagesRequest.ProtoReflect.Descriptor instead.
 func (*ListImagesRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{24}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{25}
 }
 
 type ListImagesResponse struct {
@@ -1715,7 +1810,7 @@ type ListImagesResponse struct {
 
 func (x *ListImagesResponse) Reset() {
 	*x = ListImagesResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[25]
+	mi := &file_docker_v1_docker_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1727,7 +1822,7 @@ func (x *ListImagesResponse) String() string {
 func (*ListImagesResponse) ProtoMessage() {}
 
 func (x *ListImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[25]
+	mi := &file_docker_v1_docker_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,7 +1835,7 @@ func (x *ListImagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListImagesResponse.ProtoReflect.Descriptor instead.
 func (*ListImagesResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{25}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListImagesResponse) GetTotalDiskUsage() int64 {
@@ -1781,7 +1876,7 @@ type RemoveImageRequest struct {
 
 func (x *RemoveImageRequest) Reset() {
 	*x = RemoveImageRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[26]
+	mi := &file_docker_v1_docker_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1793,7 +1888,7 @@ func (x *RemoveImageRequest) String() string {
 func (*RemoveImageRequest) ProtoMessage() {}
 
 func (x *RemoveImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[26]
+	mi := &file_docker_v1_docker_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1806,7 +1901,7 @@ func (x *RemoveImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveImageRequest.ProtoReflect.Descriptor instead.
 func (*RemoveImageRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{26}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *RemoveImageRequest) GetHost() string {
@@ -1831,7 +1926,7 @@ type RemoveImageResponse struct {
 
 func (x *RemoveImageResponse) Reset() {
 	*x = RemoveImageResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[27]
+	mi := &file_docker_v1_docker_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1843,7 +1938,7 @@ func (x *RemoveImageResponse) String() string {
 func (*RemoveImageResponse) ProtoMessage() {}
 
 func (x *RemoveImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[27]
+	mi := &file_docker_v1_docker_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1856,7 +1951,7 @@ func (x *RemoveImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveImageResponse.ProtoReflect.Descriptor instead.
 func (*RemoveImageResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{27}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{28}
 }
 
 type ImagePruneResponse struct {
@@ -1869,7 +1964,7 @@ type ImagePruneResponse struct {
 
 func (x *ImagePruneResponse) Reset() {
 	*x = ImagePruneResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[28]
+	mi := &file_docker_v1_docker_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1881,7 +1976,7 @@ func (x *ImagePruneResponse) String() string {
 func (*ImagePruneResponse) ProtoMessage() {}
 
 func (x *ImagePruneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[28]
+	mi := &file_docker_v1_docker_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,7 +1989,7 @@ func (x *ImagePruneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImagePruneResponse.ProtoReflect.Descriptor instead.
 func (*ImagePruneResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{28}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ImagePruneResponse) GetSpaceReclaimed() uint64 {
@@ -1921,7 +2016,7 @@ type ImagePruneRequest struct {
 
 func (x *ImagePruneRequest) Reset() {
 	*x = ImagePruneRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[29]
+	mi := &file_docker_v1_docker_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1933,7 +2028,7 @@ func (x *ImagePruneRequest) String() string {
 func (*ImagePruneRequest) ProtoMessage() {}
 
 func (x *ImagePruneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[29]
+	mi := &file_docker_v1_docker_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1946,7 +2041,7 @@ func (x *ImagePruneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImagePruneRequest.ProtoReflect.Descriptor instead.
 func (*ImagePruneRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{29}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ImagePruneRequest) GetHost() string {
@@ -1973,7 +2068,7 @@ type ImagesDeleted struct {
 
 func (x *ImagesDeleted) Reset() {
 	*x = ImagesDeleted{}
-	mi := &file_docker_v1_docker_proto_msgTypes[30]
+	mi := &file_docker_v1_docker_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1985,7 +2080,7 @@ func (x *ImagesDeleted) String() string {
 func (*ImagesDeleted) ProtoMessage() {}
 
 func (x *ImagesDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[30]
+	mi := &file_docker_v1_docker_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,7 +2093,7 @@ func (x *ImagesDeleted) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImagesDeleted.ProtoReflect.Descriptor instead.
 func (*ImagesDeleted) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{30}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ImagesDeleted) GetDeleted() string {
@@ -2032,7 +2127,7 @@ type Volume struct {
 
 func (x *Volume) Reset() {
 	*x = Volume{}
-	mi := &file_docker_v1_docker_proto_msgTypes[31]
+	mi := &file_docker_v1_docker_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2044,7 +2139,7 @@ func (x *Volume) String() string {
 func (*Volume) ProtoMessage() {}
 
 func (x *Volume) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[31]
+	mi := &file_docker_v1_docker_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,7 +2152,7 @@ func (x *Volume) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Volume.ProtoReflect.Descriptor instead.
 func (*Volume) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{31}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *Volume) GetName() string {
@@ -2124,7 +2219,7 @@ type ListVolumesRequest struct {
 
 func (x *ListVolumesRequest) Reset() {
 	*x = ListVolumesRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[32]
+	mi := &file_docker_v1_docker_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2136,7 +2231,7 @@ func (x *ListVolumesRequest) String() string {
 func (*ListVolumesRequest) ProtoMessage() {}
 
 func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[32]
+	mi := &file_docker_v1_docker_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2149,7 +2244,7 @@ func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
 func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{32}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{33}
 }
 
 type ListVolumesResponse struct {
@@ -2161,7 +2256,7 @@ type ListVolumesResponse struct {
 
 func (x *ListVolumesResponse) Reset() {
 	*x = ListVolumesResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[33]
+	mi := &file_docker_v1_docker_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2173,7 +2268,7 @@ func (x *ListVolumesResponse) String() string {
 func (*ListVolumesResponse) ProtoMessage() {}
 
 func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[33]
+	mi := &file_docker_v1_docker_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2186,7 +2281,7 @@ func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
 func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{33}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListVolumesResponse) GetVolumes() []*Volume {
@@ -2197,14 +2292,22 @@ func (x *ListVolumesResponse) GetVolumes() []*Volume {
 }
 
 type CreateVolumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// generated by docker if empty
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// local if empty
+	Driver string `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	// e.g. type=nfs, o=addr=10.0.0.2,rw and device=:/export
+	// for an nfs share with the local driver
+	DriverOpts    map[string]string `protobuf:"bytes,3,rep,name=driverOpts,proto3" json:"driverOpts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels        map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateVolumeRequest) Reset() {
 	*x = CreateVolumeRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[34]
+	mi := &file_docker_v1_docker_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2216,7 +2319,7 @@ func (x *CreateVolumeRequest) String() string {
 func (*CreateVolumeRequest) ProtoMessage() {}
 
 func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[34]
+	mi := &file_docker_v1_docker_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2229,18 +2332,47 @@ func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
 func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{34}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateVolumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetDriverOpts() map[string]string {
+	if x != nil {
+		return x.DriverOpts
+	}
+	return nil
+}
+
+func (x *CreateVolumeRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
 }
 
 type CreateVolumeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateVolumeResponse) Reset() {
 	*x = CreateVolumeResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[35]
+	mi := &file_docker_v1_docker_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2252,7 +2384,7 @@ func (x *CreateVolumeResponse) String() string {
 func (*CreateVolumeResponse) ProtoMessage() {}
 
 func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[35]
+	mi := &file_docker_v1_docker_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2265,7 +2397,14 @@ func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
 func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{35}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
 }
 
 type DeleteVolumeRequest struct {
@@ -2280,7 +2419,7 @@ type DeleteVolumeRequest struct {
 
 func (x *DeleteVolumeRequest) Reset() {
 	*x = DeleteVolumeRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[36]
+	mi := &file_docker_v1_docker_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2292,7 +2431,7 @@ func (x *DeleteVolumeRequest) String() string {
 func (*DeleteVolumeRequest) ProtoMessage() {}
 
 func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[36]
+	mi := &file_docker_v1_docker_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2305,7 +2444,7 @@ func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{36}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *DeleteVolumeRequest) GetHost() string {
@@ -2344,7 +2483,7 @@ type DeleteVolumeResponse struct {
 
 func (x *DeleteVolumeResponse) Reset() {
 	*x = DeleteVolumeResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[37]
+	mi := &file_docker_v1_docker_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2356,7 +2495,7 @@ func (x *DeleteVolumeResponse) String() string {
 func (*DeleteVolumeResponse) ProtoMessage() {}
 
 func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[37]
+	mi := &file_docker_v1_docker_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,43 +2508,32 @@ func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{37}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{38}
 }
 
-// Network-related messages
-type Network struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Subnet         string                 `protobuf:"bytes,3,opt,name=subnet,proto3" json:"subnet,omitempty"`
-	Scope          string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
-	Driver         string                 `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`
-	EnableIpv4     bool                   `protobuf:"varint,6,opt,name=enable_ipv4,json=enableIpv4,proto3" json:"enable_ipv4,omitempty"`
-	EnableIpv6     bool                   `protobuf:"varint,7,opt,name=enable_ipv6,json=enableIpv6,proto3" json:"enable_ipv6,omitempty"`
-	Internal       bool                   `protobuf:"varint,9,opt,name=internal,proto3" json:"internal,omitempty"`
-	Attachable     bool                   `protobuf:"varint,10,opt,name=attachable,proto3" json:"attachable,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	ComposeProject string                 `protobuf:"bytes,12,opt,name=composeProject,proto3" json:"composeProject,omitempty"`
-	ContainerIds   []string               `protobuf:"bytes,13,rep,name=containerIds,proto3" json:"containerIds,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type VolumeCloneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Network) Reset() {
-	*x = Network{}
-	mi := &file_docker_v1_docker_proto_msgTypes[38]
+func (x *VolumeCloneRequest) Reset() {
+	*x = VolumeCloneRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Network) String() string {
+func (x *VolumeCloneRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Network) ProtoMessage() {}
+func (*VolumeCloneRequest) ProtoMessage() {}
 
-func (x *Network) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[38]
+func (x *VolumeCloneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2416,37 +2544,243 @@ func (x *Network) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Network.ProtoReflect.Descriptor instead.
-func (*Network) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use VolumeCloneRequest.ProtoReflect.Descriptor instead.
+func (*VolumeCloneRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Network) GetName() string {
+func (x *VolumeCloneRequest) GetSource() string {
 	if x != nil {
-		return x.Name
+		return x.Source
 	}
 	return ""
 }
 
-func (x *Network) GetId() string {
+func (x *VolumeCloneRequest) GetTarget() string {
 	if x != nil {
-		return x.Id
+		return x.Target
 	}
 	return ""
 }
 
-func (x *Network) GetSubnet() string {
-	if x != nil {
-		return x.Subnet
-	}
-	return ""
+type VolumeCloneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Network) GetScope() string {
-	if x != nil {
-		return x.Scope
-	}
-	return ""
+func (x *VolumeCloneResponse) Reset() {
+	*x = VolumeCloneResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeCloneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeCloneResponse) ProtoMessage() {}
+
+func (x *VolumeCloneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeCloneResponse.ProtoReflect.Descriptor instead.
+func (*VolumeCloneResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{40}
+}
+
+type VolumeMigrateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Source string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// connected host the volume is copied to
+	DestHost string `protobuf:"bytes,2,opt,name=destHost,proto3" json:"destHost,omitempty"`
+	// name on the destination, the source name if empty
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// remove the source volume once copied
+	RemoveSource  bool `protobuf:"varint,4,opt,name=removeSource,proto3" json:"removeSource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeMigrateRequest) Reset() {
+	*x = VolumeMigrateRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeMigrateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeMigrateRequest) ProtoMessage() {}
+
+func (x *VolumeMigrateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeMigrateRequest.ProtoReflect.Descriptor instead.
+func (*VolumeMigrateRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VolumeMigrateRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *VolumeMigrateRequest) GetDestHost() string {
+	if x != nil {
+		return x.DestHost
+	}
+	return ""
+}
+
+func (x *VolumeMigrateRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *VolumeMigrateRequest) GetRemoveSource() bool {
+	if x != nil {
+		return x.RemoveSource
+	}
+	return false
+}
+
+type VolumeMigrateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeMigrateResponse) Reset() {
+	*x = VolumeMigrateResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeMigrateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeMigrateResponse) ProtoMessage() {}
+
+func (x *VolumeMigrateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeMigrateResponse.ProtoReflect.Descriptor instead.
+func (*VolumeMigrateResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{42}
+}
+
+// Network-related messages
+type Network struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Subnet         string                 `protobuf:"bytes,3,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Scope          string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	Driver         string                 `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`
+	EnableIpv4     bool                   `protobuf:"varint,6,opt,name=enable_ipv4,json=enableIpv4,proto3" json:"enable_ipv4,omitempty"`
+	EnableIpv6     bool                   `protobuf:"varint,7,opt,name=enable_ipv6,json=enableIpv6,proto3" json:"enable_ipv6,omitempty"`
+	Internal       bool                   `protobuf:"varint,9,opt,name=internal,proto3" json:"internal,omitempty"`
+	Attachable     bool                   `protobuf:"varint,10,opt,name=attachable,proto3" json:"attachable,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ComposeProject string                 `protobuf:"bytes,12,opt,name=composeProject,proto3" json:"composeProject,omitempty"`
+	ContainerIds   []string               `protobuf:"bytes,13,rep,name=containerIds,proto3" json:"containerIds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Network) Reset() {
+	*x = Network{}
+	mi := &file_docker_v1_docker_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Network) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Network) ProtoMessage() {}
+
+func (x *Network) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Network.ProtoReflect.Descriptor instead.
+func (*Network) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Network) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Network) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Network) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *Network) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
 }
 
 func (x *Network) GetDriver() string {
@@ -2513,7 +2847,7 @@ type ListNetworksRequest struct {
 
 func (x *ListNetworksRequest) Reset() {
 	*x = ListNetworksRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[39]
+	mi := &file_docker_v1_docker_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2525,7 +2859,7 @@ func (x *ListNetworksRequest) String() string {
 func (*ListNetworksRequest) ProtoMessage() {}
 
 func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[39]
+	mi := &file_docker_v1_docker_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,7 +2872,7 @@ func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNetworksRequest.ProtoReflect.Descriptor instead.
 func (*ListNetworksRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{39}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{44}
 }
 
 type ListNetworksResponse struct {
@@ -2550,7 +2884,7 @@ type ListNetworksResponse struct {
 
 func (x *ListNetworksResponse) Reset() {
 	*x = ListNetworksResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[40]
+	mi := &file_docker_v1_docker_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2562,7 +2896,7 @@ func (x *ListNetworksResponse) String() string {
 func (*ListNetworksResponse) ProtoMessage() {}
 
 func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[40]
+	mi := &file_docker_v1_docker_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2575,7 +2909,7 @@ func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNetworksResponse.ProtoReflect.Descriptor instead.
 func (*ListNetworksResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{40}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ListNetworksResponse) GetNetworks() []*Network {
@@ -2586,14 +2920,26 @@ func (x *ListNetworksResponse) GetNetworks() []*Network {
 }
 
 type CreateNetworkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// bridge, macvlan, ipvlan, overlay or a plugin, bridge if empty
+	Driver string `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	// driver options, e.g. parent=eth0 for macvlan and ipvlan
+	Options map[string]string `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels  map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// docker picks a subnet if empty
+	Subnets []*NetworkSubnet `protobuf:"bytes,5,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	// implied by an ipv6 subnet
+	EnableIpv6    bool `protobuf:"varint,6,opt,name=enableIpv6,proto3" json:"enableIpv6,omitempty"`
+	Internal      bool `protobuf:"varint,7,opt,name=internal,proto3" json:"internal,omitempty"`
+	Attachable    bool `protobuf:"varint,8,opt,name=attachable,proto3" json:"attachable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateNetworkRequest) Reset() {
 	*x = CreateNetworkRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[41]
+	mi := &file_docker_v1_docker_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2605,7 +2951,7 @@ func (x *CreateNetworkRequest) String() string {
 func (*CreateNetworkRequest) ProtoMessage() {}
 
 func (x *CreateNetworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[41]
+	mi := &file_docker_v1_docker_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,30 +2964,91 @@ func (x *CreateNetworkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNetworkRequest.ProtoReflect.Descriptor instead.
 func (*CreateNetworkRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{41}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{46}
 }
 
-type CreateNetworkResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *CreateNetworkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateNetworkRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateNetworkRequest) GetSubnets() []*NetworkSubnet {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+func (x *CreateNetworkRequest) GetEnableIpv6() bool {
+	if x != nil {
+		return x.EnableIpv6
+	}
+	return false
+}
+
+func (x *CreateNetworkRequest) GetInternal() bool {
+	if x != nil {
+		return x.Internal
+	}
+	return false
+}
+
+func (x *CreateNetworkRequest) GetAttachable() bool {
+	if x != nil {
+		return x.Attachable
+	}
+	return false
+}
+
+type NetworkSubnet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CIDR, e.g. 172.30.0.0/16
+	Subnet  string `protobuf:"bytes,1,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Gateway string `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	// CIDR within subnet containers are assigned addresses from
+	IpRange       string `protobuf:"bytes,3,opt,name=ipRange,proto3" json:"ipRange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNetworkResponse) Reset() {
-	*x = CreateNetworkResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[42]
+func (x *NetworkSubnet) Reset() {
+	*x = NetworkSubnet{}
+	mi := &file_docker_v1_docker_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNetworkResponse) String() string {
+func (x *NetworkSubnet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNetworkResponse) ProtoMessage() {}
+func (*NetworkSubnet) ProtoMessage() {}
 
-func (x *CreateNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[42]
+func (x *NetworkSubnet) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2652,9 +3059,292 @@ func (x *CreateNetworkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNetworkResponse.ProtoReflect.Descriptor instead.
-func (*CreateNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use NetworkSubnet.ProtoReflect.Descriptor instead.
+func (*NetworkSubnet) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *NetworkSubnet) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *NetworkSubnet) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *NetworkSubnet) GetIpRange() string {
+	if x != nil {
+		return x.IpRange
+	}
+	return ""
+}
+
+type CreateNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNetworkResponse) Reset() {
+	*x = CreateNetworkResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNetworkResponse) ProtoMessage() {}
+
+func (x *CreateNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNetworkResponse.ProtoReflect.Descriptor instead.
+func (*CreateNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateNetworkResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateNetworkResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type NetworkConnectRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId   string                 `protobuf:"bytes,1,opt,name=networkId,proto3" json:"networkId,omitempty"`
+	ContainerId string                 `protobuf:"bytes,2,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	// extra dns names of the container on the network
+	Aliases []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// static addresses, only on networks with a user defined subnet
+	Ipv4          string `protobuf:"bytes,4,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Ipv6          string `protobuf:"bytes,5,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkConnectRequest) Reset() {
+	*x = NetworkConnectRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkConnectRequest) ProtoMessage() {}
+
+func (x *NetworkConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkConnectRequest.ProtoReflect.Descriptor instead.
+func (*NetworkConnectRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NetworkConnectRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *NetworkConnectRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *NetworkConnectRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *NetworkConnectRequest) GetIpv4() string {
+	if x != nil {
+		return x.Ipv4
+	}
+	return ""
+}
+
+func (x *NetworkConnectRequest) GetIpv6() string {
+	if x != nil {
+		return x.Ipv6
+	}
+	return ""
+}
+
+type NetworkConnectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkConnectResponse) Reset() {
+	*x = NetworkConnectResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkConnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkConnectResponse) ProtoMessage() {}
+
+func (x *NetworkConnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkConnectResponse.ProtoReflect.Descriptor instead.
+func (*NetworkConnectResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{50}
+}
+
+type NetworkDisconnectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=networkId,proto3" json:"networkId,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,2,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkDisconnectRequest) Reset() {
+	*x = NetworkDisconnectRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkDisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkDisconnectRequest) ProtoMessage() {}
+
+func (x *NetworkDisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkDisconnectRequest.ProtoReflect.Descriptor instead.
+func (*NetworkDisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *NetworkDisconnectRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *NetworkDisconnectRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *NetworkDisconnectRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type NetworkDisconnectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkDisconnectResponse) Reset() {
+	*x = NetworkDisconnectResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkDisconnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkDisconnectResponse) ProtoMessage() {}
+
+func (x *NetworkDisconnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkDisconnectResponse.ProtoReflect.Descriptor instead.
+func (*NetworkDisconnectResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{52}
 }
 
 type DeleteNetworkRequest struct {
@@ -2667,7 +3357,7 @@ type DeleteNetworkRequest struct {
 
 func (x *DeleteNetworkRequest) Reset() {
 	*x = DeleteNetworkRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[43]
+	mi := &file_docker_v1_docker_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2679,7 +3369,7 @@ func (x *DeleteNetworkRequest) String() string {
 func (*DeleteNetworkRequest) ProtoMessage() {}
 
 func (x *DeleteNetworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[43]
+	mi := &file_docker_v1_docker_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2692,7 +3382,7 @@ func (x *DeleteNetworkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNetworkRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNetworkRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{43}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *DeleteNetworkRequest) GetNetworkIds() []string {
@@ -2717,7 +3407,7 @@ type DeleteNetworkResponse struct {
 
 func (x *DeleteNetworkResponse) Reset() {
 	*x = DeleteNetworkResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[44]
+	mi := &file_docker_v1_docker_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2729,7 +3419,7 @@ func (x *DeleteNetworkResponse) String() string {
 func (*DeleteNetworkResponse) ProtoMessage() {}
 
 func (x *DeleteNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[44]
+	mi := &file_docker_v1_docker_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2742,7 +3432,7 @@ func (x *DeleteNetworkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNetworkResponse.ProtoReflect.Descriptor instead.
 func (*DeleteNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{44}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{54}
 }
 
 type ContainerLogsRequest struct {
@@ -2754,7 +3444,7 @@ type ContainerLogsRequest struct {
 
 func (x *ContainerLogsRequest) Reset() {
 	*x = ContainerLogsRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[45]
+	mi := &file_docker_v1_docker_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2766,7 +3456,7 @@ func (x *ContainerLogsRequest) String() string {
 func (*ContainerLogsRequest) ProtoMessage() {}
 
 func (x *ContainerLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[45]
+	mi := &file_docker_v1_docker_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,7 +3469,7 @@ func (x *ContainerLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerLogsRequest.ProtoReflect.Descriptor instead.
 func (*ContainerLogsRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{45}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ContainerLogsRequest) GetContainerID() string {
@@ -2790,15 +3480,18 @@ func (x *ContainerLogsRequest) GetContainerID() string {
 }
 
 type LogsMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// set on the first message of a compose operation,
+	// used to reattach to the job with JobAttach
+	JobId         string `protobuf:"bytes,2,opt,name=jobId,proto3" json:"jobId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LogsMessage) Reset() {
 	*x = LogsMessage{}
-	mi := &file_docker_v1_docker_proto_msgTypes[46]
+	mi := &file_docker_v1_docker_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2809,8 +3502,545 @@ func (x *LogsMessage) String() string {
 
 func (*LogsMessage) ProtoMessage() {}
 
-func (x *LogsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[46]
+func (x *LogsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsMessage.ProtoReflect.Descriptor instead.
+func (*LogsMessage) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LogsMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogsMessage) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type StatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        *SystemInfo            `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	Containers    []*ContainerStats      `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *StatsResponse) GetSystem() *SystemInfo {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetContainers() []*ContainerStats {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type StatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	File          *ComposeFile           `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	SortBy        SORT_FIELD             `protobuf:"varint,2,opt,name=sortBy,proto3,enum=docker.v1.SORT_FIELD" json:"sortBy,omitempty"`
+	Order         ORDER                  `protobuf:"varint,3,opt,name=order,proto3,enum=docker.v1.ORDER" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *StatsRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *StatsRequest) GetFile() *ComposeFile {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *StatsRequest) GetSortBy() SORT_FIELD {
+	if x != nil {
+		return x.SortBy
+	}
+	return SORT_FIELD_NAME
+}
+
+func (x *StatsRequest) GetOrder() ORDER {
+	if x != nil {
+		return x.Order
+	}
+	return ORDER_DSC
+}
+
+type SystemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CPU           float64                `protobuf:"fixed64,1,opt,name=CPU,proto3" json:"CPU,omitempty"` // represents float64
+	MemInBytes    uint64                 `protobuf:"varint,2,opt,name=memInBytes,proto3" json:"memInBytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemInfo) Reset() {
+	*x = SystemInfo{}
+	mi := &file_docker_v1_docker_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInfo) ProtoMessage() {}
+
+func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
+func (*SystemInfo) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SystemInfo) GetCPU() float64 {
+	if x != nil {
+		return x.CPU
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetMemInBytes() uint64 {
+	if x != nil {
+		return x.MemInBytes
+	}
+	return 0
+}
+
+type ListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCount   map[string]int32       `protobuf:"bytes,1,rep,name=statusCount,proto3" json:"statusCount,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	List          []*ContainerList       `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListResponse) GetStatusCount() map[string]int32 {
+	if x != nil {
+		return x.StatusCount
+	}
+	return nil
+}
+
+func (x *ListResponse) GetList() []*ContainerList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type ContainerList struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ImageID   string                 `protobuf:"bytes,2,opt,name=imageID,proto3" json:"imageID,omitempty"`
+	ImageName string                 `protobuf:"bytes,3,opt,name=imageName,proto3" json:"imageName,omitempty"`
+	State     string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Health    string                 `protobuf:"bytes,13,opt,name=health,proto3" json:"health,omitempty"`
+	Name      string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Created   string                 `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Ports     []*Port                `protobuf:"bytes,7,rep,name=ports,proto3" json:"ports,omitempty"`
+	// name to use in selecting service in docker compose
+	ServiceName     string   `protobuf:"bytes,8,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	ServicePath     string   `protobuf:"bytes,9,opt,name=servicePath,proto3" json:"servicePath,omitempty"`
+	StackName       string   `protobuf:"bytes,10,opt,name=stackName,proto3" json:"stackName,omitempty"`
+	UpdateAvailable string   `protobuf:"bytes,11,opt,name=updateAvailable,proto3" json:"updateAvailable,omitempty"`
+	IPAddress       []string `protobuf:"bytes,12,rep,name=IPAddress,proto3" json:"IPAddress,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ContainerList) Reset() {
+	*x = ContainerList{}
+	mi := &file_docker_v1_docker_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerList) ProtoMessage() {}
+
+func (x *ContainerList) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerList.ProtoReflect.Descriptor instead.
+func (*ContainerList) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ContainerList) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContainerList) GetImageID() string {
+	if x != nil {
+		return x.ImageID
+	}
+	return ""
+}
+
+func (x *ContainerList) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *ContainerList) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ContainerList) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+func (x *ContainerList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerList) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *ContainerList) GetPorts() []*Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ContainerList) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ContainerList) GetServicePath() string {
+	if x != nil {
+		return x.ServicePath
+	}
+	return ""
+}
+
+func (x *ContainerList) GetStackName() string {
+	if x != nil {
+		return x.StackName
+	}
+	return ""
+}
+
+func (x *ContainerList) GetUpdateAvailable() string {
+	if x != nil {
+		return x.UpdateAvailable
+	}
+	return ""
+}
+
+func (x *ContainerList) GetIPAddress() []string {
+	if x != nil {
+		return x.IPAddress
+	}
+	return nil
+}
+
+// ContainerInfo holds metrics for a single Docker container.
+type ContainerStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the container.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the container.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// CPU usage as a percentage.
+	CpuUsage float64 `protobuf:"fixed64,3,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
+	// Current memory usage in bytes.
+	MemoryUsage uint64 `protobuf:"varint,4,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
+	// Maximum memory limit in bytes.
+	MemoryLimit uint64 `protobuf:"varint,5,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	// Total bytes received over the network.
+	NetworkRx uint64 `protobuf:"varint,6,opt,name=network_rx,json=networkRx,proto3" json:"network_rx,omitempty"`
+	// Total bytes sent over the network.
+	NetworkTx uint64 `protobuf:"varint,7,opt,name=network_tx,json=networkTx,proto3" json:"network_tx,omitempty"`
+	// Total bytes read from block devices.
+	BlockRead uint64 `protobuf:"varint,8,opt,name=block_read,json=blockRead,proto3" json:"block_read,omitempty"`
+	// Total bytes written to block devices.
+	BlockWrite    uint64 `protobuf:"varint,9,opt,name=block_write,json=blockWrite,proto3" json:"block_write,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerStats) Reset() {
+	*x = ContainerStats{}
+	mi := &file_docker_v1_docker_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStats) ProtoMessage() {}
+
+func (x *ContainerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStats.ProtoReflect.Descriptor instead.
+func (*ContainerStats) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ContainerStats) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContainerStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerStats) GetCpuUsage() float64 {
+	if x != nil {
+		return x.CpuUsage
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetMemoryUsage() uint64 {
+	if x != nil {
+		return x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetMemoryLimit() uint64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetNetworkRx() uint64 {
+	if x != nil {
+		return x.NetworkRx
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetNetworkTx() uint64 {
+	if x != nil {
+		return x.NetworkTx
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetBlockRead() uint64 {
+	if x != nil {
+		return x.BlockRead
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetBlockWrite() uint64 {
+	if x != nil {
+		return x.BlockWrite
+	}
+	return 0
+}
+
+type Port struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Public        int32                  `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+	Private       int32                  `protobuf:"varint,2,opt,name=private,proto3" json:"private,omitempty"`
+	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Port) Reset() {
+	*x = Port{}
+	mi := &file_docker_v1_docker_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Port) ProtoMessage() {}
+
+func (x *Port) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2821,41 +4051,60 @@ func (x *LogsMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsMessage.ProtoReflect.Descriptor instead.
-func (*LogsMessage) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use Port.ProtoReflect.Descriptor instead.
+func (*Port) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *LogsMessage) GetMessage() string {
+func (x *Port) GetPublic() int32 {
 	if x != nil {
-		return x.Message
+		return x.Public
+	}
+	return 0
+}
+
+func (x *Port) GetPrivate() int32 {
+	if x != nil {
+		return x.Private
+	}
+	return 0
+}
+
+func (x *Port) GetHost() string {
+	if x != nil {
+		return x.Host
 	}
 	return ""
 }
 
-type StatsResponse struct {
+func (x *Port) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	System        *SystemInfo            `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
-	Containers    []*ContainerStats      `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatsResponse) Reset() {
-	*x = StatsResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[47]
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_docker_v1_docker_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsResponse) String() string {
+func (x *Empty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsResponse) ProtoMessage() {}
+func (*Empty) ProtoMessage() {}
 
-func (x *StatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[47]
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2866,50 +4115,33 @@ func (x *StatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
-func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *StatsResponse) GetSystem() *SystemInfo {
-	if x != nil {
-		return x.System
-	}
-	return nil
-}
-
-func (x *StatsResponse) GetContainers() []*ContainerStats {
-	if x != nil {
-		return x.Containers
-	}
-	return nil
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{64}
 }
 
-type StatsRequest struct {
+type ContainerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
-	File          *ComposeFile           `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	SortBy        SORT_FIELD             `protobuf:"varint,2,opt,name=sortBy,proto3,enum=docker.v1.SORT_FIELD" json:"sortBy,omitempty"`
-	Order         ORDER                  `protobuf:"varint,3,opt,name=order,proto3,enum=docker.v1.ORDER" json:"order,omitempty"`
+	ContainerIds  []string               `protobuf:"bytes,1,rep,name=containerIds,proto3" json:"containerIds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatsRequest) Reset() {
-	*x = StatsRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[48]
+func (x *ContainerRequest) Reset() {
+	*x = ContainerRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsRequest) String() string {
+func (x *ContainerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsRequest) ProtoMessage() {}
+func (*ContainerRequest) ProtoMessage() {}
 
-func (x *StatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[48]
+func (x *ContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2920,62 +4152,41 @@ func (x *StatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
-func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *StatsRequest) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
+// Deprecated: Use ContainerRequest.ProtoReflect.Descriptor instead.
+func (*ContainerRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *StatsRequest) GetFile() *ComposeFile {
+func (x *ContainerRequest) GetContainerIds() []string {
 	if x != nil {
-		return x.File
+		return x.ContainerIds
 	}
 	return nil
 }
 
-func (x *StatsRequest) GetSortBy() SORT_FIELD {
-	if x != nil {
-		return x.SortBy
-	}
-	return SORT_FIELD_NAME
-}
-
-func (x *StatsRequest) GetOrder() ORDER {
-	if x != nil {
-		return x.Order
-	}
-	return ORDER_DSC
-}
-
-type SystemInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CPU           float64                `protobuf:"fixed64,1,opt,name=CPU,proto3" json:"CPU,omitempty"` // represents float64
-	MemInBytes    uint64                 `protobuf:"varint,2,opt,name=memInBytes,proto3" json:"memInBytes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ComposeFile struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Filename         string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	SelectedServices []string               `protobuf:"bytes,3,rep,name=selectedServices,proto3" json:"selectedServices,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SystemInfo) Reset() {
-	*x = SystemInfo{}
-	mi := &file_docker_v1_docker_proto_msgTypes[49]
+func (x *ComposeFile) Reset() {
+	*x = ComposeFile{}
+	mi := &file_docker_v1_docker_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SystemInfo) String() string {
+func (x *ComposeFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemInfo) ProtoMessage() {}
+func (*ComposeFile) ProtoMessage() {}
 
-func (x *SystemInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[49]
+func (x *ComposeFile) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2986,48 +4197,52 @@ func (x *SystemInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
-func (*SystemInfo) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ComposeFile.ProtoReflect.Descriptor instead.
+func (*ComposeFile) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *SystemInfo) GetCPU() float64 {
+func (x *ComposeFile) GetFilename() string {
 	if x != nil {
-		return x.CPU
+		return x.Filename
 	}
-	return 0
+	return ""
 }
 
-func (x *SystemInfo) GetMemInBytes() uint64 {
+func (x *ComposeFile) GetSelectedServices() []string {
 	if x != nil {
-		return x.MemInBytes
+		return x.SelectedServices
 	}
-	return 0
+	return nil
 }
 
-type ListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusCount   map[string]int32       `protobuf:"bytes,1,rep,name=statusCount,proto3" json:"statusCount,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	List          []*ContainerList       `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+type ComposeResolveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// fully interpolated config, secret values are masked
+	Config    string             `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Variables []*ComposeVariable `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	Warnings  []string           `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// set if the config could not be resolved
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResponse) Reset() {
-	*x = ListResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[50]
+func (x *ComposeResolveResponse) Reset() {
+	*x = ComposeResolveResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResponse) String() string {
+func (x *ComposeResolveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResponse) ProtoMessage() {}
+func (*ComposeResolveResponse) ProtoMessage() {}
 
-func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[50]
+func (x *ComposeResolveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3038,60 +4253,69 @@ func (x *ListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
-func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ComposeResolveResponse.ProtoReflect.Descriptor instead.
+func (*ComposeResolveResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ListResponse) GetStatusCount() map[string]int32 {
+func (x *ComposeResolveResponse) GetConfig() string {
 	if x != nil {
-		return x.StatusCount
+		return x.Config
+	}
+	return ""
+}
+
+func (x *ComposeResolveResponse) GetVariables() []*ComposeVariable {
+	if x != nil {
+		return x.Variables
 	}
 	return nil
 }
 
-func (x *ListResponse) GetList() []*ContainerList {
+func (x *ComposeResolveResponse) GetWarnings() []string {
 	if x != nil {
-		return x.List
+		return x.Warnings
 	}
 	return nil
 }
 
-type ContainerList struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ImageID   string                 `protobuf:"bytes,2,opt,name=imageID,proto3" json:"imageID,omitempty"`
-	ImageName string                 `protobuf:"bytes,3,opt,name=imageName,proto3" json:"imageName,omitempty"`
-	State     string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	Health    string                 `protobuf:"bytes,13,opt,name=health,proto3" json:"health,omitempty"`
-	Name      string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Created   string                 `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
-	Ports     []*Port                `protobuf:"bytes,7,rep,name=ports,proto3" json:"ports,omitempty"`
-	// name to use in selecting service in docker compose
-	ServiceName     string   `protobuf:"bytes,8,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
-	ServicePath     string   `protobuf:"bytes,9,opt,name=servicePath,proto3" json:"servicePath,omitempty"`
-	StackName       string   `protobuf:"bytes,10,opt,name=stackName,proto3" json:"stackName,omitempty"`
-	UpdateAvailable string   `protobuf:"bytes,11,opt,name=updateAvailable,proto3" json:"updateAvailable,omitempty"`
-	IPAddress       []string `protobuf:"bytes,12,rep,name=IPAddress,proto3" json:"IPAddress,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ComposeResolveResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *ContainerList) Reset() {
-	*x = ContainerList{}
-	mi := &file_docker_v1_docker_proto_msgTypes[51]
+type ComposeVariable struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// masked if secret is set
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// env file the value was loaded from
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	DefaultValue  string `protobuf:"bytes,4,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
+	Defined       bool   `protobuf:"varint,5,opt,name=defined,proto3" json:"defined,omitempty"`
+	Required      bool   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Secret        bool   `protobuf:"varint,7,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComposeVariable) Reset() {
+	*x = ComposeVariable{}
+	mi := &file_docker_v1_docker_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerList) String() string {
+func (x *ComposeVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerList) ProtoMessage() {}
+func (*ComposeVariable) ProtoMessage() {}
 
-func (x *ContainerList) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[51]
+func (x *ComposeVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3102,142 +4326,158 @@ func (x *ContainerList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerList.ProtoReflect.Descriptor instead.
-func (*ContainerList) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ComposeVariable.ProtoReflect.Descriptor instead.
+func (*ComposeVariable) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ContainerList) GetId() string {
+func (x *ComposeVariable) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ContainerList) GetImageID() string {
+func (x *ComposeVariable) GetValue() string {
 	if x != nil {
-		return x.ImageID
+		return x.Value
 	}
 	return ""
 }
 
-func (x *ContainerList) GetImageName() string {
+func (x *ComposeVariable) GetSource() string {
 	if x != nil {
-		return x.ImageName
+		return x.Source
 	}
 	return ""
 }
 
-func (x *ContainerList) GetState() string {
+func (x *ComposeVariable) GetDefaultValue() string {
 	if x != nil {
-		return x.State
+		return x.DefaultValue
 	}
 	return ""
 }
 
-func (x *ContainerList) GetHealth() string {
+func (x *ComposeVariable) GetDefined() bool {
 	if x != nil {
-		return x.Health
+		return x.Defined
 	}
-	return ""
+	return false
 }
 
-func (x *ContainerList) GetName() string {
+func (x *ComposeVariable) GetRequired() bool {
 	if x != nil {
-		return x.Name
+		return x.Required
 	}
-	return ""
+	return false
 }
 
-func (x *ContainerList) GetCreated() string {
+func (x *ComposeVariable) GetSecret() bool {
 	if x != nil {
-		return x.Created
+		return x.Secret
 	}
-	return ""
+	return false
 }
 
-func (x *ContainerList) GetPorts() []*Port {
-	if x != nil {
-		return x.Ports
-	}
-	return nil
+type ComposeManyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// select all compose files under a folder e.g. compose/media
+	Folder string `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	// select all stacks with this tag in dockman.yml
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// max stacks running at the same time, defaults to 1
+	Parallelism   int32 `protobuf:"varint,3,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	StopOnFailure bool  `protobuf:"varint,4,opt,name=stopOnFailure,proto3" json:"stopOnFailure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComposeManyRequest) Reset() {
+	*x = ComposeManyRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComposeManyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ContainerList) GetServiceName() string {
+func (*ComposeManyRequest) ProtoMessage() {}
+
+func (x *ComposeManyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[69]
 	if x != nil {
-		return x.ServiceName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ContainerList) GetServicePath() string {
+// Deprecated: Use ComposeManyRequest.ProtoReflect.Descriptor instead.
+func (*ComposeManyRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ComposeManyRequest) GetFolder() string {
 	if x != nil {
-		return x.ServicePath
+		return x.Folder
 	}
 	return ""
 }
 
-func (x *ContainerList) GetStackName() string {
+func (x *ComposeManyRequest) GetTag() string {
 	if x != nil {
-		return x.StackName
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *ContainerList) GetUpdateAvailable() string {
+func (x *ComposeManyRequest) GetParallelism() int32 {
 	if x != nil {
-		return x.UpdateAvailable
+		return x.Parallelism
 	}
-	return ""
+	return 0
 }
 
-func (x *ContainerList) GetIPAddress() []string {
+func (x *ComposeManyRequest) GetStopOnFailure() bool {
 	if x != nil {
-		return x.IPAddress
+		return x.StopOnFailure
 	}
-	return nil
+	return false
 }
 
-// ContainerInfo holds metrics for a single Docker container.
-type ContainerStats struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier of the container.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Name of the container.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// CPU usage as a percentage.
-	CpuUsage float64 `protobuf:"fixed64,3,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
-	// Current memory usage in bytes.
-	MemoryUsage uint64 `protobuf:"varint,4,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
-	// Maximum memory limit in bytes.
-	MemoryLimit uint64 `protobuf:"varint,5,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
-	// Total bytes received over the network.
-	NetworkRx uint64 `protobuf:"varint,6,opt,name=network_rx,json=networkRx,proto3" json:"network_rx,omitempty"`
-	// Total bytes sent over the network.
-	NetworkTx uint64 `protobuf:"varint,7,opt,name=network_tx,json=networkTx,proto3" json:"network_tx,omitempty"`
-	// Total bytes read from block devices.
-	BlockRead uint64 `protobuf:"varint,8,opt,name=block_read,json=blockRead,proto3" json:"block_read,omitempty"`
-	// Total bytes written to block devices.
-	BlockWrite    uint64 `protobuf:"varint,9,opt,name=block_write,json=blockWrite,proto3" json:"block_write,omitempty"`
+type ComposeManyProgress struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Filename string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// pending, running, succeeded, failed, skipped
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// set once the stack is running
+	JobId         string `protobuf:"bytes,3,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerStats) Reset() {
-	*x = ContainerStats{}
-	mi := &file_docker_v1_docker_proto_msgTypes[52]
+func (x *ComposeManyProgress) Reset() {
+	*x = ComposeManyProgress{}
+	mi := &file_docker_v1_docker_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerStats) String() string {
+func (x *ComposeManyProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerStats) ProtoMessage() {}
+func (*ComposeManyProgress) ProtoMessage() {}
 
-func (x *ContainerStats) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[52]
+func (x *ComposeManyProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3248,99 +4488,150 @@ func (x *ContainerStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerStats.ProtoReflect.Descriptor instead.
-func (*ContainerStats) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ComposeManyProgress.ProtoReflect.Descriptor instead.
+func (*ComposeManyProgress) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ContainerStats) GetId() string {
+func (x *ComposeManyProgress) GetFilename() string {
 	if x != nil {
-		return x.Id
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *ContainerStats) GetName() string {
+func (x *ComposeManyProgress) GetState() string {
 	if x != nil {
-		return x.Name
+		return x.State
 	}
 	return ""
 }
 
-func (x *ContainerStats) GetCpuUsage() float64 {
+func (x *ComposeManyProgress) GetJobId() string {
 	if x != nil {
-		return x.CpuUsage
+		return x.JobId
 	}
-	return 0
+	return ""
 }
 
-func (x *ContainerStats) GetMemoryUsage() uint64 {
+func (x *ComposeManyProgress) GetError() string {
 	if x != nil {
-		return x.MemoryUsage
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *ContainerStats) GetMemoryLimit() uint64 {
-	if x != nil {
-		return x.MemoryLimit
-	}
-	return 0
+type JobListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerStats) GetNetworkRx() uint64 {
-	if x != nil {
-		return x.NetworkRx
-	}
-	return 0
+func (x *JobListRequest) Reset() {
+	*x = JobListRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerStats) GetNetworkTx() uint64 {
+func (x *JobListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobListRequest) ProtoMessage() {}
+
+func (x *JobListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[71]
 	if x != nil {
-		return x.NetworkTx
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ContainerStats) GetBlockRead() uint64 {
+// Deprecated: Use JobListRequest.ProtoReflect.Descriptor instead.
+func (*JobListRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{71}
+}
+
+type JobListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobListResponse) Reset() {
+	*x = JobListResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobListResponse) ProtoMessage() {}
+
+func (x *JobListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[72]
 	if x != nil {
-		return x.BlockRead
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ContainerStats) GetBlockWrite() uint64 {
+// Deprecated: Use JobListResponse.ProtoReflect.Descriptor instead.
+func (*JobListResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *JobListResponse) GetJobs() []*Job {
 	if x != nil {
-		return x.BlockWrite
+		return x.Jobs
 	}
-	return 0
+	return nil
 }
 
-type Port struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Public        int32                  `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
-	Private       int32                  `protobuf:"varint,2,opt,name=private,proto3" json:"private,omitempty"`
-	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+type Job struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filename string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Action   string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// running, succeeded, failed, cancelled
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// unix seconds
+	StartedAt int64 `protobuf:"varint,6,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	// unix seconds, 0 while the job is running
+	EndedAt       int64 `protobuf:"varint,7,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Port) Reset() {
-	*x = Port{}
-	mi := &file_docker_v1_docker_proto_msgTypes[53]
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_docker_v1_docker_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Port) String() string {
+func (x *Job) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Port) ProtoMessage() {}
+func (*Job) ProtoMessage() {}
 
-func (x *Port) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[53]
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3351,60 +4642,84 @@ func (x *Port) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Port.ProtoReflect.Descriptor instead.
-func (*Port) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *Port) GetPublic() int32 {
+func (x *Job) GetId() string {
 	if x != nil {
-		return x.Public
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *Port) GetPrivate() int32 {
+func (x *Job) GetFilename() string {
 	if x != nil {
-		return x.Private
+		return x.Filename
 	}
-	return 0
+	return ""
 }
 
-func (x *Port) GetHost() string {
+func (x *Job) GetAction() string {
 	if x != nil {
-		return x.Host
+		return x.Action
 	}
 	return ""
 }
 
-func (x *Port) GetType() string {
+func (x *Job) GetStatus() string {
 	if x != nil {
-		return x.Type
+		return x.Status
 	}
 	return ""
 }
 
-type Empty struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *Job) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Job) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Job) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+type JobAttachRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId string                 `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	// replay the full output instead of only the most recent
+	FromStart     bool `protobuf:"varint,2,opt,name=fromStart,proto3" json:"fromStart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
-	mi := &file_docker_v1_docker_proto_msgTypes[54]
+func (x *JobAttachRequest) Reset() {
+	*x = JobAttachRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Empty) String() string {
+func (x *JobAttachRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*JobAttachRequest) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[54]
+func (x *JobAttachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3415,33 +4730,47 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use JobAttachRequest.ProtoReflect.Descriptor instead.
+func (*JobAttachRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{74}
 }
 
-type ContainerRequest struct {
+func (x *JobAttachRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobAttachRequest) GetFromStart() bool {
+	if x != nil {
+		return x.FromStart
+	}
+	return false
+}
+
+type JobCancelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerIds  []string               `protobuf:"bytes,1,rep,name=containerIds,proto3" json:"containerIds,omitempty"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerRequest) Reset() {
-	*x = ContainerRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[55]
+func (x *JobCancelRequest) Reset() {
+	*x = JobCancelRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerRequest) String() string {
+func (x *JobCancelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerRequest) ProtoMessage() {}
+func (*JobCancelRequest) ProtoMessage() {}
 
-func (x *ContainerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[55]
+func (x *JobCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3452,41 +4781,39 @@ func (x *ContainerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerRequest.ProtoReflect.Descriptor instead.
-func (*ContainerRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use JobCancelRequest.ProtoReflect.Descriptor instead.
+func (*JobCancelRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ContainerRequest) GetContainerIds() []string {
+func (x *JobCancelRequest) GetJobId() string {
 	if x != nil {
-		return x.ContainerIds
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-type ComposeFile struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Filename         string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	SelectedServices []string               `protobuf:"bytes,3,rep,name=selectedServices,proto3" json:"selectedServices,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type JobCancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ComposeFile) Reset() {
-	*x = ComposeFile{}
-	mi := &file_docker_v1_docker_proto_msgTypes[56]
+func (x *JobCancelResponse) Reset() {
+	*x = JobCancelResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComposeFile) String() string {
+func (x *JobCancelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComposeFile) ProtoMessage() {}
+func (*JobCancelResponse) ProtoMessage() {}
 
-func (x *ComposeFile) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[56]
+func (x *JobCancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3497,23 +4824,9 @@ func (x *ComposeFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComposeFile.ProtoReflect.Descriptor instead.
-func (*ComposeFile) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{56}
-}
-
-func (x *ComposeFile) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
-}
-
-func (x *ComposeFile) GetSelectedServices() []string {
-	if x != nil {
-		return x.SelectedServices
-	}
-	return nil
+// Deprecated: Use JobCancelResponse.ProtoReflect.Descriptor instead.
+func (*JobCancelResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{76}
 }
 
 var File_docker_v1_docker_proto protoreflect.FileDescriptor
@@ -3622,9 +4935,17 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\aLayerId\x18\x03 \x01(\tR\aLayerId\x12\x10\n" +
 	"\x03cmd\x18\x01 \x01(\tR\x03cmd\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\tR\x04size\x12*\n" +
-	"\x10totalSizeAtLayer\x18\x04 \x01(\tR\x10totalSizeAtLayer\"-\n" +
+	"\x10totalSizeAtLayer\x18\x04 \x01(\tR\x10totalSizeAtLayer\"m\n" +
 	"\x17ComposeValidateResponse\x12\x12\n" +
-	"\x04errs\x18\x01 \x03(\tR\x04errs\"S\n" +
+	"\x04errs\x18\x01 \x03(\tR\x04errs\x12>\n" +
+	"\vdiagnostics\x18\x02 \x03(\v2\x1c.docker.v1.ComposeDiagnosticR\vdiagnostics\"\x9d\x01\n" +
+	"\x11ComposeDiagnostic\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06column\x18\x03 \x01(\x05R\x06column\x12\x1a\n" +
+	"\bseverity\x18\x04 \x01(\tR\bseverity\x12\x12\n" +
+	"\x04rule\x18\x05 \x01(\tR\x04rule\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"S\n" +
 	"\x15ContainerExecCmdInput\x12\x18\n" +
 	"\auserCmd\x18\x01 \x01(\tR\auserCmd\x12 \n" +
 	"\vcontainerID\x18\x02 \x01(\tR\vcontainerID\"R\n" +
@@ -3687,15 +5008,38 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\x12composeProjectName\x18\b \x01(\tR\x12composeProjectName\"\x14\n" +
 	"\x12ListVolumesRequest\"B\n" +
 	"\x13ListVolumesResponse\x12+\n" +
-	"\avolumes\x18\x01 \x03(\v2\x11.docker.v1.VolumeR\avolumes\"\x15\n" +
-	"\x13CreateVolumeRequest\"\x16\n" +
-	"\x14CreateVolumeResponse\"s\n" +
+	"\avolumes\x18\x01 \x03(\v2\x11.docker.v1.VolumeR\avolumes\"\xcf\x02\n" +
+	"\x13CreateVolumeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12N\n" +
+	"\n" +
+	"driverOpts\x18\x03 \x03(\v2..docker.v1.CreateVolumeRequest.DriverOptsEntryR\n" +
+	"driverOpts\x12B\n" +
+	"\x06labels\x18\x04 \x03(\v2*.docker.v1.CreateVolumeRequest.LabelsEntryR\x06labels\x1a=\n" +
+	"\x0fDriverOptsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"A\n" +
+	"\x14CreateVolumeResponse\x12)\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.docker.v1.VolumeR\x06volume\"s\n" +
 	"\x13DeleteVolumeRequest\x12\x12\n" +
 	"\x04host\x18\x04 \x01(\tR\x04host\x12\x1c\n" +
 	"\tvolumeIds\x18\x01 \x03(\tR\tvolumeIds\x12\x12\n" +
 	"\x04anon\x18\x02 \x01(\bR\x04anon\x12\x16\n" +
 	"\x06unused\x18\x03 \x01(\bR\x06unused\"\x16\n" +
-	"\x14DeleteVolumeResponse\"\xdb\x02\n" +
+	"\x14DeleteVolumeResponse\"D\n" +
+	"\x12VolumeCloneRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"\x15\n" +
+	"\x13VolumeCloneResponse\"\x86\x01\n" +
+	"\x14VolumeMigrateRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x1a\n" +
+	"\bdestHost\x18\x02 \x01(\tR\bdestHost\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\"\n" +
+	"\fremoveSource\x18\x04 \x01(\bR\fremoveSource\"\x17\n" +
+	"\x15VolumeMigrateResponse\"\xdb\x02\n" +
 	"\aNetwork\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
@@ -3716,9 +5060,45 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\fcontainerIds\x18\r \x03(\tR\fcontainerIds\"\x15\n" +
 	"\x13ListNetworksRequest\"F\n" +
 	"\x14ListNetworksResponse\x12.\n" +
-	"\bnetworks\x18\x01 \x03(\v2\x12.docker.v1.NetworkR\bnetworks\"\x16\n" +
-	"\x14CreateNetworkRequest\"\x17\n" +
-	"\x15CreateNetworkResponse\"L\n" +
+	"\bnetworks\x18\x01 \x03(\v2\x12.docker.v1.NetworkR\bnetworks\"\xd6\x03\n" +
+	"\x14CreateNetworkRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12F\n" +
+	"\aoptions\x18\x03 \x03(\v2,.docker.v1.CreateNetworkRequest.OptionsEntryR\aoptions\x12C\n" +
+	"\x06labels\x18\x04 \x03(\v2+.docker.v1.CreateNetworkRequest.LabelsEntryR\x06labels\x122\n" +
+	"\asubnets\x18\x05 \x03(\v2\x18.docker.v1.NetworkSubnetR\asubnets\x12\x1e\n" +
+	"\n" +
+	"enableIpv6\x18\x06 \x01(\bR\n" +
+	"enableIpv6\x12\x1a\n" +
+	"\binternal\x18\a \x01(\bR\binternal\x12\x1e\n" +
+	"\n" +
+	"attachable\x18\b \x01(\bR\n" +
+	"attachable\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"[\n" +
+	"\rNetworkSubnet\x12\x16\n" +
+	"\x06subnet\x18\x01 \x01(\tR\x06subnet\x12\x18\n" +
+	"\agateway\x18\x02 \x01(\tR\agateway\x12\x18\n" +
+	"\aipRange\x18\x03 \x01(\tR\aipRange\"C\n" +
+	"\x15CreateNetworkResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\x99\x01\n" +
+	"\x15NetworkConnectRequest\x12\x1c\n" +
+	"\tnetworkId\x18\x01 \x01(\tR\tnetworkId\x12 \n" +
+	"\vcontainerId\x18\x02 \x01(\tR\vcontainerId\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\x12\x12\n" +
+	"\x04ipv4\x18\x04 \x01(\tR\x04ipv4\x12\x12\n" +
+	"\x04ipv6\x18\x05 \x01(\tR\x04ipv6\"\x18\n" +
+	"\x16NetworkConnectResponse\"p\n" +
+	"\x18NetworkDisconnectRequest\x12\x1c\n" +
+	"\tnetworkId\x18\x01 \x01(\tR\tnetworkId\x12 \n" +
+	"\vcontainerId\x18\x02 \x01(\tR\vcontainerId\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\x1b\n" +
+	"\x19NetworkDisconnectResponse\"L\n" +
 	"\x14DeleteNetworkRequest\x12\x1e\n" +
 	"\n" +
 	"networkIds\x18\x03 \x03(\tR\n" +
@@ -3726,9 +5106,10 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\x05prune\x18\x02 \x01(\bR\x05prune\"\x17\n" +
 	"\x15DeleteNetworkResponse\"8\n" +
 	"\x14ContainerLogsRequest\x12 \n" +
-	"\vcontainerID\x18\x01 \x01(\tR\vcontainerID\"'\n" +
+	"\vcontainerID\x18\x01 \x01(\tR\vcontainerID\"=\n" +
 	"\vLogsMessage\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"y\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
+	"\x05jobId\x18\x02 \x01(\tR\x05jobId\"y\n" +
 	"\rStatsResponse\x12-\n" +
 	"\x06system\x18\x01 \x01(\v2\x15.docker.v1.SystemInfoR\x06system\x129\n" +
 	"\n" +
@@ -3790,7 +5171,47 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\fcontainerIds\x18\x01 \x03(\tR\fcontainerIds\"U\n" +
 	"\vComposeFile\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12*\n" +
-	"\x10selectedServices\x18\x03 \x03(\tR\x10selectedServices*`\n" +
+	"\x10selectedServices\x18\x03 \x03(\tR\x10selectedServices\"\x9c\x01\n" +
+	"\x16ComposeResolveResponse\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x128\n" +
+	"\tvariables\x18\x02 \x03(\v2\x1a.docker.v1.ComposeVariableR\tvariables\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xc5\x01\n" +
+	"\x0fComposeVariable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\"\n" +
+	"\fdefaultValue\x18\x04 \x01(\tR\fdefaultValue\x12\x18\n" +
+	"\adefined\x18\x05 \x01(\bR\adefined\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x16\n" +
+	"\x06secret\x18\a \x01(\bR\x06secret\"\x86\x01\n" +
+	"\x12ComposeManyRequest\x12\x16\n" +
+	"\x06folder\x18\x01 \x01(\tR\x06folder\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12 \n" +
+	"\vparallelism\x18\x03 \x01(\x05R\vparallelism\x12$\n" +
+	"\rstopOnFailure\x18\x04 \x01(\bR\rstopOnFailure\"s\n" +
+	"\x13ComposeManyProgress\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
+	"\x05jobId\x18\x03 \x01(\tR\x05jobId\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x10\n" +
+	"\x0eJobListRequest\"5\n" +
+	"\x0fJobListResponse\x12\"\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x0e.docker.v1.JobR\x04jobs\"\xaf\x01\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1c\n" +
+	"\tstartedAt\x18\x06 \x01(\x03R\tstartedAt\x12\x18\n" +
+	"\aendedAt\x18\a \x01(\x03R\aendedAt\"F\n" +
+	"\x10JobAttachRequest\x12\x14\n" +
+	"\x05jobId\x18\x01 \x01(\tR\x05jobId\x12\x1c\n" +
+	"\tfromStart\x18\x02 \x01(\bR\tfromStart\"(\n" +
+	"\x10JobCancelRequest\x12\x14\n" +
+	"\x05jobId\x18\x01 \x01(\tR\x05jobId\"\x13\n" +
+	"\x11JobCancelResponse*`\n" +
 	"\n" +
 	"SORT_FIELD\x12\b\n" +
 	"\x04NAME\x10\x00\x12\a\n" +
@@ -3806,7 +5227,7 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\x06DISK_W\x10\x06*\x19\n" +
 	"\x05ORDER\x12\a\n" +
 	"\x03DSC\x10\x00\x12\a\n" +
-	"\x03ASC\x10\x012\xa2\x12\n" +
+	"\x03ASC\x10\x012\xf9\x19\n" +
 	"\rDockerService\x12G\n" +
 	"\x0eContainerStart\x12\x1b.docker.v1.ContainerRequest\x1a\x16.docker.v1.LogsMessage\"\x00\x12F\n" +
 	"\rContainerStop\x12\x1b.docker.v1.ContainerRequest\x1a\x16.docker.v1.LogsMessage\"\x00\x12H\n" +
@@ -3825,8 +5246,15 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\x0eComposeRestart\x12\x16.docker.v1.ComposeFile\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12C\n" +
 	"\rComposeUpdate\x12\x16.docker.v1.ComposeFile\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12@\n" +
 	"\vComposeList\x12\x16.docker.v1.ComposeFile\x1a\x17.docker.v1.ListResponse\"\x00\x12O\n" +
-	"\x0fComposeValidate\x12\x16.docker.v1.ComposeFile\x1a\".docker.v1.ComposeValidateResponse\"\x00\x12`\n" +
-	"\x11ComposeFileStatus\x12#.docker.v1.ComposeFileStatusRequest\x1a$.docker.v1.ComposeFileStatusResponse\"\x00\x12J\n" +
+	"\x0fComposeValidate\x12\x16.docker.v1.ComposeFile\x1a\".docker.v1.ComposeValidateResponse\"\x00\x12M\n" +
+	"\x0eComposeResolve\x12\x16.docker.v1.ComposeFile\x1a!.docker.v1.ComposeResolveResponse\"\x00\x12`\n" +
+	"\x11ComposeFileStatus\x12#.docker.v1.ComposeFileStatusRequest\x1a$.docker.v1.ComposeFileStatusResponse\"\x00\x12R\n" +
+	"\rComposeUpMany\x12\x1d.docker.v1.ComposeManyRequest\x1a\x1e.docker.v1.ComposeManyProgress\"\x000\x01\x12V\n" +
+	"\x11ComposeUpdateMany\x12\x1d.docker.v1.ComposeManyRequest\x1a\x1e.docker.v1.ComposeManyProgress\"\x000\x01\x12T\n" +
+	"\x0fComposeDownMany\x12\x1d.docker.v1.ComposeManyRequest\x1a\x1e.docker.v1.ComposeManyProgress\"\x000\x01\x12B\n" +
+	"\aJobList\x12\x19.docker.v1.JobListRequest\x1a\x1a.docker.v1.JobListResponse\"\x00\x12D\n" +
+	"\tJobAttach\x12\x1b.docker.v1.JobAttachRequest\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12H\n" +
+	"\tJobCancel\x12\x1b.docker.v1.JobCancelRequest\x1a\x1c.docker.v1.JobCancelResponse\"\x00\x12J\n" +
 	"\tImageList\x12\x1c.docker.v1.ListImagesRequest\x1a\x1d.docker.v1.ListImagesResponse\"\x00\x12N\n" +
 	"\vImageRemove\x12\x1d.docker.v1.RemoveImageRequest\x1a\x1e.docker.v1.RemoveImageResponse\"\x00\x12Q\n" +
 	"\x10ImagePruneUnused\x12\x1c.docker.v1.ImagePruneRequest\x1a\x1d.docker.v1.ImagePruneResponse\"\x00\x12Q\n" +
@@ -3834,11 +5262,16 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\n" +
 	"VolumeList\x12\x1d.docker.v1.ListVolumesRequest\x1a\x1e.docker.v1.ListVolumesResponse\"\x00\x12Q\n" +
 	"\fVolumeCreate\x12\x1e.docker.v1.CreateVolumeRequest\x1a\x1f.docker.v1.CreateVolumeResponse\"\x00\x12Q\n" +
-	"\fVolumeDelete\x12\x1e.docker.v1.DeleteVolumeRequest\x1a\x1f.docker.v1.DeleteVolumeResponse\"\x00\x12P\n" +
+	"\fVolumeDelete\x12\x1e.docker.v1.DeleteVolumeRequest\x1a\x1f.docker.v1.DeleteVolumeResponse\"\x00\x12N\n" +
+	"\vVolumeClone\x12\x1d.docker.v1.VolumeCloneRequest\x1a\x1e.docker.v1.VolumeCloneResponse\"\x00\x12O\n" +
+	"\fVolumeRename\x12\x1d.docker.v1.VolumeCloneRequest\x1a\x1e.docker.v1.VolumeCloneResponse\"\x00\x12T\n" +
+	"\rVolumeMigrate\x12\x1f.docker.v1.VolumeMigrateRequest\x1a .docker.v1.VolumeMigrateResponse\"\x00\x12P\n" +
 	"\vNetworkList\x12\x1e.docker.v1.ListNetworksRequest\x1a\x1f.docker.v1.ListNetworksResponse\"\x00\x12T\n" +
 	"\rNetworkCreate\x12\x1f.docker.v1.CreateNetworkRequest\x1a .docker.v1.CreateNetworkResponse\"\x00\x12T\n" +
 	"\rNetworkDelete\x12\x1f.docker.v1.DeleteNetworkRequest\x1a .docker.v1.DeleteNetworkResponse\"\x00\x12W\n" +
-	"\x0eNetworkInspect\x12 .docker.v1.NetworkInspectRequest\x1a!.docker.v1.NetworkInspectResponse\"\x00B\x8f\x01\n" +
+	"\x0eNetworkInspect\x12 .docker.v1.NetworkInspectRequest\x1a!.docker.v1.NetworkInspectResponse\"\x00\x12W\n" +
+	"\x0eNetworkConnect\x12 .docker.v1.NetworkConnectRequest\x1a!.docker.v1.NetworkConnectResponse\"\x00\x12`\n" +
+	"\x11NetworkDisconnect\x12#.docker.v1.NetworkDisconnectRequest\x1a$.docker.v1.NetworkDisconnectResponse\"\x00B\x8f\x01\n" +
 	"\rcom.docker.v1B\vDockerProtoP\x01Z,github.com/RA341/dockman/generated/docker/v1\xa2\x02\x03DXX\xaa\x02\tDocker.V1\xca\x02\tDocker\\V1\xe2\x02\x15Docker\\V1\\GPBMetadata\xea\x02\n" +
 	"Docker::V1b\x06proto3"
 
@@ -3855,7 +5288,7 @@ func file_docker_v1_docker_proto_rawDescGZIP() []byte {
 }
 
 var file_docker_v1_docker_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_docker_v1_docker_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_docker_v1_docker_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_docker_v1_docker_proto_goTypes = []any{
 	(SORT_FIELD)(0),                   // 0: docker.v1.SORT_FIELD
 	(ORDER)(0),                        // 1: docker.v1.ORDER
@@ -3879,140 +5312,197 @@ var file_docker_v1_docker_proto_goTypes = []any{
 	(*ImageInspect)(nil),              // 19: docker.v1.ImageInspect
 	(*ImageLayer)(nil),                // 20: docker.v1.ImageLayer
 	(*ComposeValidateResponse)(nil),   // 21: docker.v1.ComposeValidateResponse
-	(*ContainerExecCmdInput)(nil),     // 22: docker.v1.ContainerExecCmdInput
-	(*ContainerExecRequest)(nil),      // 23: docker.v1.ContainerExecRequest
-	(*Image)(nil),                     // 24: docker.v1.Image
-	(*ManifestSummary)(nil),           // 25: docker.v1.ManifestSummary
-	(*ListImagesRequest)(nil),         // 26: docker.v1.ListImagesRequest
-	(*ListImagesResponse)(nil),        // 27: docker.v1.ListImagesResponse
-	(*RemoveImageRequest)(nil),        // 28: docker.v1.RemoveImageRequest
-	(*RemoveImageResponse)(nil),       // 29: docker.v1.RemoveImageResponse
-	(*ImagePruneResponse)(nil),        // 30: docker.v1.ImagePruneResponse
-	(*ImagePruneRequest)(nil),         // 31: docker.v1.ImagePruneRequest
-	(*ImagesDeleted)(nil),             // 32: docker.v1.ImagesDeleted
-	(*Volume)(nil),                    // 33: docker.v1.Volume
-	(*ListVolumesRequest)(nil),        // 34: docker.v1.ListVolumesRequest
-	(*ListVolumesResponse)(nil),       // 35: docker.v1.ListVolumesResponse
-	(*CreateVolumeRequest)(nil),       // 36: docker.v1.CreateVolumeRequest
-	(*CreateVolumeResponse)(nil),      // 37: docker.v1.CreateVolumeResponse
-	(*DeleteVolumeRequest)(nil),       // 38: docker.v1.DeleteVolumeRequest
-	(*DeleteVolumeResponse)(nil),      // 39: docker.v1.DeleteVolumeResponse
-	(*Network)(nil),                   // 40: docker.v1.Network
-	(*ListNetworksRequest)(nil),       // 41: docker.v1.ListNetworksRequest
-	(*ListNetworksResponse)(nil),      // 42: docker.v1.ListNetworksResponse
-	(*CreateNetworkRequest)(nil),      // 43: docker.v1.CreateNetworkRequest
-	(*CreateNetworkResponse)(nil),     // 44: docker.v1.CreateNetworkResponse
-	(*DeleteNetworkRequest)(nil),      // 45: docker.v1.DeleteNetworkRequest
-	(*DeleteNetworkResponse)(nil),     // 46: docker.v1.DeleteNetworkResponse
-	(*ContainerLogsRequest)(nil),      // 47: docker.v1.ContainerLogsRequest
-	(*LogsMessage)(nil),               // 48: docker.v1.LogsMessage
-	(*StatsResponse)(nil),             // 49: docker.v1.StatsResponse
-	(*StatsRequest)(nil),              // 50: docker.v1.StatsRequest
-	(*SystemInfo)(nil),                // 51: docker.v1.SystemInfo
-	(*ListResponse)(nil),              // 52: docker.v1.ListResponse
-	(*ContainerList)(nil),             // 53: docker.v1.ContainerList
-	(*ContainerStats)(nil),            // 54: docker.v1.ContainerStats
-	(*Port)(nil),                      // 55: docker.v1.Port
-	(*Empty)(nil),                     // 56: docker.v1.Empty
-	(*ContainerRequest)(nil),          // 57: docker.v1.ContainerRequest
-	(*ComposeFile)(nil),               // 58: docker.v1.ComposeFile
-	nil,                               // 59: docker.v1.ComposeFileStatusResponse.StatusEntry
-	nil,                               // 60: docker.v1.ContainerConfig.LabelsEntry
-	nil,                               // 61: docker.v1.Image.LabelsEntry
-	nil,                               // 62: docker.v1.ListResponse.StatusCountEntry
+	(*ComposeDiagnostic)(nil),         // 22: docker.v1.ComposeDiagnostic
+	(*ContainerExecCmdInput)(nil),     // 23: docker.v1.ContainerExecCmdInput
+	(*ContainerExecRequest)(nil),      // 24: docker.v1.ContainerExecRequest
+	(*Image)(nil),                     // 25: docker.v1.Image
+	(*ManifestSummary)(nil),           // 26: docker.v1.ManifestSummary
+	(*ListImagesRequest)(nil),         // 27: docker.v1.ListImagesRequest
+	(*ListImagesResponse)(nil),        // 28: docker.v1.ListImagesResponse
+	(*RemoveImageRequest)(nil),        // 29: docker.v1.RemoveImageRequest
+	(*RemoveImageResponse)(nil),       // 30: docker.v1.RemoveImageResponse
+	(*ImagePruneResponse)(nil),        // 31: docker.v1.ImagePruneResponse
+	(*ImagePruneRequest)(nil),         // 32: docker.v1.ImagePruneRequest
+	(*ImagesDeleted)(nil),             // 33: docker.v1.ImagesDeleted
+	(*Volume)(nil),                    // 34: docker.v1.Volume
+	(*ListVolumesRequest)(nil),        // 35: docker.v1.ListVolumesRequest
+	(*ListVolumesResponse)(nil),       // 36: docker.v1.ListVolumesResponse
+	(*CreateVolumeRequest)(nil),       // 37: docker.v1.CreateVolumeRequest
+	(*CreateVolumeResponse)(nil),      // 38: docker.v1.CreateVolumeResponse
+	(*DeleteVolumeRequest)(nil),       // 39: docker.v1.DeleteVolumeRequest
+	(*DeleteVolumeResponse)(nil),      // 40: docker.v1.DeleteVolumeResponse
+	(*VolumeCloneRequest)(nil),        // 41: docker.v1.VolumeCloneRequest
+	(*VolumeCloneResponse)(nil),       // 42: docker.v1.VolumeCloneResponse
+	(*VolumeMigrateRequest)(nil),      // 43: docker.v1.VolumeMigrateRequest
+	(*VolumeMigrateResponse)(nil),     // 44: docker.v1.VolumeMigrateResponse
+	(*Network)(nil),                   // 45: docker.v1.Network
+	(*ListNetworksRequest)(nil),       // 46: docker.v1.ListNetworksRequest
+	(*ListNetworksResponse)(nil),      // 47: docker.v1.ListNetworksResponse
+	(*CreateNetworkRequest)(nil),      // 48: docker.v1.CreateNetworkRequest
+	(*NetworkSubnet)(nil),             // 49: docker.v1.NetworkSubnet
+	(*CreateNetworkResponse)(nil),     // 50: docker.v1.CreateNetworkResponse
+	(*NetworkConnectRequest)(nil),     // 51: docker.v1.NetworkConnectRequest
+	(*NetworkConnectResponse)(nil),    // 52: docker.v1.NetworkConnectResponse
+	(*NetworkDisconnectRequest)(nil),  // 53: docker.v1.NetworkDisconnectRequest
+	(*NetworkDisconnectResponse)(nil), // 54: docker.v1.NetworkDisconnectResponse
+	(*DeleteNetworkRequest)(nil),      // 55: docker.v1.DeleteNetworkRequest
+	(*DeleteNetworkResponse)(nil),     // 56: docker.v1.DeleteNetworkResponse
+	(*ContainerLogsRequest)(nil),      // 57: docker.v1.ContainerLogsRequest
+	(*LogsMessage)(nil),               // 58: docker.v1.LogsMessage
+	(*StatsResponse)(nil),             // 59: docker.v1.StatsResponse
+	(*StatsRequest)(nil),              // 60: docker.v1.StatsRequest
+	(*SystemInfo)(nil),                // 61: docker.v1.SystemInfo
+	(*ListResponse)(nil),              // 62: docker.v1.ListResponse
+	(*ContainerList)(nil),             // 63: docker.v1.ContainerList
+	(*ContainerStats)(nil),            // 64: docker.v1.ContainerStats
+	(*Port)(nil),                      // 65: docker.v1.Port
+	(*Empty)(nil),                     // 66: docker.v1.Empty
+	(*ContainerRequest)(nil),          // 67: docker.v1.ContainerRequest
+	(*ComposeFile)(nil),               // 68: docker.v1.ComposeFile
+	(*ComposeResolveResponse)(nil),    // 69: docker.v1.ComposeResolveResponse
+	(*ComposeVariable)(nil),           // 70: docker.v1.ComposeVariable
+	(*ComposeManyRequest)(nil),        // 71: docker.v1.ComposeManyRequest
+	(*ComposeManyProgress)(nil),       // 72: docker.v1.ComposeManyProgress
+	(*JobListRequest)(nil),            // 73: docker.v1.JobListRequest
+	(*JobListResponse)(nil),           // 74: docker.v1.JobListResponse
+	(*Job)(nil),                       // 75: docker.v1.Job
+	(*JobAttachRequest)(nil),          // 76: docker.v1.JobAttachRequest
+	(*JobCancelRequest)(nil),          // 77: docker.v1.JobCancelRequest
+	(*JobCancelResponse)(nil),         // 78: docker.v1.JobCancelResponse
+	nil,                               // 79: docker.v1.ComposeFileStatusResponse.StatusEntry
+	nil,                               // 80: docker.v1.ContainerConfig.LabelsEntry
+	nil,                               // 81: docker.v1.Image.LabelsEntry
+	nil,                               // 82: docker.v1.CreateVolumeRequest.DriverOptsEntry
+	nil,                               // 83: docker.v1.CreateVolumeRequest.LabelsEntry
+	nil,                               // 84: docker.v1.CreateNetworkRequest.OptionsEntry
+	nil,                               // 85: docker.v1.CreateNetworkRequest.LabelsEntry
+	nil,                               // 86: docker.v1.ListResponse.StatusCountEntry
 }
 var file_docker_v1_docker_proto_depIdxs = []int32{
-	59, // 0: docker.v1.ComposeFileStatusResponse.status:type_name -> docker.v1.ComposeFileStatusResponse.StatusEntry
+	79, // 0: docker.v1.ComposeFileStatusResponse.status:type_name -> docker.v1.ComposeFileStatusResponse.StatusEntry
 	8,  // 1: docker.v1.ContainerTopResponse.top:type_name -> docker.v1.Top
 	7,  // 2: docker.v1.Top.proc:type_name -> docker.v1.Process
 	11, // 3: docker.v1.ContainerInspectMessage.mounts:type_name -> docker.v1.ContainerMount
 	10, // 4: docker.v1.ContainerInspectMessage.config:type_name -> docker.v1.ContainerConfig
-	60, // 5: docker.v1.ContainerConfig.Labels:type_name -> docker.v1.ContainerConfig.LabelsEntry
+	80, // 5: docker.v1.ContainerConfig.Labels:type_name -> docker.v1.ContainerConfig.LabelsEntry
 	15, // 6: docker.v1.NetworkInspectResponse.inspect:type_name -> docker.v1.NetworkInspectInfo
-	40, // 7: docker.v1.NetworkInspectInfo.net:type_name -> docker.v1.Network
+	45, // 7: docker.v1.NetworkInspectInfo.net:type_name -> docker.v1.Network
 	16, // 8: docker.v1.NetworkInspectInfo.container:type_name -> docker.v1.NetworkContainerInspect
 	19, // 9: docker.v1.ImageInspectResponse.inspect:type_name -> docker.v1.ImageInspect
 	20, // 10: docker.v1.ImageInspect.layers:type_name -> docker.v1.ImageLayer
-	61, // 11: docker.v1.Image.labels:type_name -> docker.v1.Image.LabelsEntry
-	25, // 12: docker.v1.Image.manifests:type_name -> docker.v1.ManifestSummary
-	24, // 13: docker.v1.ListImagesResponse.images:type_name -> docker.v1.Image
-	32, // 14: docker.v1.ImagePruneResponse.deleted:type_name -> docker.v1.ImagesDeleted
-	33, // 15: docker.v1.ListVolumesResponse.volumes:type_name -> docker.v1.Volume
-	40, // 16: docker.v1.ListNetworksResponse.networks:type_name -> docker.v1.Network
-	51, // 17: docker.v1.StatsResponse.system:type_name -> docker.v1.SystemInfo
-	54, // 18: docker.v1.StatsResponse.containers:type_name -> docker.v1.ContainerStats
-	58, // 19: docker.v1.StatsRequest.file:type_name -> docker.v1.ComposeFile
-	0,  // 20: docker.v1.StatsRequest.sortBy:type_name -> docker.v1.SORT_FIELD
-	1,  // 21: docker.v1.StatsRequest.order:type_name -> docker.v1.ORDER
-	62, // 22: docker.v1.ListResponse.statusCount:type_name -> docker.v1.ListResponse.StatusCountEntry
-	53, // 23: docker.v1.ListResponse.list:type_name -> docker.v1.ContainerList
-	55, // 24: docker.v1.ContainerList.ports:type_name -> docker.v1.Port
-	3,  // 25: docker.v1.ComposeFileStatusResponse.StatusEntry.value:type_name -> docker.v1.Status
-	57, // 26: docker.v1.DockerService.ContainerStart:input_type -> docker.v1.ContainerRequest
-	57, // 27: docker.v1.DockerService.ContainerStop:input_type -> docker.v1.ContainerRequest
-	57, // 28: docker.v1.DockerService.ContainerRemove:input_type -> docker.v1.ContainerRequest
-	57, // 29: docker.v1.DockerService.ContainerRestart:input_type -> docker.v1.ContainerRequest
-	57, // 30: docker.v1.DockerService.ContainerUpdate:input_type -> docker.v1.ContainerRequest
-	5,  // 31: docker.v1.DockerService.ContainerTop:input_type -> docker.v1.ContainerTopRequest
-	12, // 32: docker.v1.DockerService.ContainerList:input_type -> docker.v1.ContainerListRequest
-	50, // 33: docker.v1.DockerService.ContainerStats:input_type -> docker.v1.StatsRequest
-	47, // 34: docker.v1.DockerService.ContainerLogs:input_type -> docker.v1.ContainerLogsRequest
-	47, // 35: docker.v1.DockerService.ContainerInspect:input_type -> docker.v1.ContainerLogsRequest
-	58, // 36: docker.v1.DockerService.ComposeUp:input_type -> docker.v1.ComposeFile
-	58, // 37: docker.v1.DockerService.ComposeDown:input_type -> docker.v1.ComposeFile
-	58, // 38: docker.v1.DockerService.ComposeStart:input_type -> docker.v1.ComposeFile
-	58, // 39: docker.v1.DockerService.ComposeStop:input_type -> docker.v1.ComposeFile
-	58, // 40: docker.v1.DockerService.ComposeRestart:input_type -> docker.v1.ComposeFile
-	58, // 41: docker.v1.DockerService.ComposeUpdate:input_type -> docker.v1.ComposeFile
-	58, // 42: docker.v1.DockerService.ComposeList:input_type -> docker.v1.ComposeFile
-	58, // 43: docker.v1.DockerService.ComposeValidate:input_type -> docker.v1.ComposeFile
-	2,  // 44: docker.v1.DockerService.ComposeFileStatus:input_type -> docker.v1.ComposeFileStatusRequest
-	26, // 45: docker.v1.DockerService.ImageList:input_type -> docker.v1.ListImagesRequest
-	28, // 46: docker.v1.DockerService.ImageRemove:input_type -> docker.v1.RemoveImageRequest
-	31, // 47: docker.v1.DockerService.ImagePruneUnused:input_type -> docker.v1.ImagePruneRequest
-	17, // 48: docker.v1.DockerService.ImageInspect:input_type -> docker.v1.ImageInspectRequest
-	34, // 49: docker.v1.DockerService.VolumeList:input_type -> docker.v1.ListVolumesRequest
-	36, // 50: docker.v1.DockerService.VolumeCreate:input_type -> docker.v1.CreateVolumeRequest
-	38, // 51: docker.v1.DockerService.VolumeDelete:input_type -> docker.v1.DeleteVolumeRequest
-	41, // 52: docker.v1.DockerService.NetworkList:input_type -> docker.v1.ListNetworksRequest
-	43, // 53: docker.v1.DockerService.NetworkCreate:input_type -> docker.v1.CreateNetworkRequest
-	45, // 54: docker.v1.DockerService.NetworkDelete:input_type -> docker.v1.DeleteNetworkRequest
-	13, // 55: docker.v1.DockerService.NetworkInspect:input_type -> docker.v1.NetworkInspectRequest
-	48, // 56: docker.v1.DockerService.ContainerStart:output_type -> docker.v1.LogsMessage
-	48, // 57: docker.v1.DockerService.ContainerStop:output_type -> docker.v1.LogsMessage
-	48, // 58: docker.v1.DockerService.ContainerRemove:output_type -> docker.v1.LogsMessage
-	48, // 59: docker.v1.DockerService.ContainerRestart:output_type -> docker.v1.LogsMessage
-	56, // 60: docker.v1.DockerService.ContainerUpdate:output_type -> docker.v1.Empty
-	6,  // 61: docker.v1.DockerService.ContainerTop:output_type -> docker.v1.ContainerTopResponse
-	52, // 62: docker.v1.DockerService.ContainerList:output_type -> docker.v1.ListResponse
-	49, // 63: docker.v1.DockerService.ContainerStats:output_type -> docker.v1.StatsResponse
-	48, // 64: docker.v1.DockerService.ContainerLogs:output_type -> docker.v1.LogsMessage
-	9,  // 65: docker.v1.DockerService.ContainerInspect:output_type -> docker.v1.ContainerInspectMessage
-	48, // 66: docker.v1.DockerService.ComposeUp:output_type -> docker.v1.LogsMessage
-	48, // 67: docker.v1.DockerService.ComposeDown:output_type -> docker.v1.LogsMessage
-	48, // 68: docker.v1.DockerService.ComposeStart:output_type -> docker.v1.LogsMessage
-	48, // 69: docker.v1.DockerService.ComposeStop:output_type -> docker.v1.LogsMessage
-	48, // 70: docker.v1.DockerService.ComposeRestart:output_type -> docker.v1.LogsMessage
-	48, // 71: docker.v1.DockerService.ComposeUpdate:output_type -> docker.v1.LogsMessage
-	52, // 72: docker.v1.DockerService.ComposeList:output_type -> docker.v1.ListResponse
-	21, // 73: docker.v1.DockerService.ComposeValidate:output_type -> docker.v1.ComposeValidateResponse
-	4,  // 74: docker.v1.DockerService.ComposeFileStatus:output_type -> docker.v1.ComposeFileStatusResponse
-	27, // 75: docker.v1.DockerService.ImageList:output_type -> docker.v1.ListImagesResponse
-	29, // 76: docker.v1.DockerService.ImageRemove:output_type -> docker.v1.RemoveImageResponse
-	30, // 77: docker.v1.DockerService.ImagePruneUnused:output_type -> docker.v1.ImagePruneResponse
-	18, // 78: docker.v1.DockerService.ImageInspect:output_type -> docker.v1.ImageInspectResponse
-	35, // 79: docker.v1.DockerService.VolumeList:output_type -> docker.v1.ListVolumesResponse
-	37, // 80: docker.v1.DockerService.VolumeCreate:output_type -> docker.v1.CreateVolumeResponse
-	39, // 81: docker.v1.DockerService.VolumeDelete:output_type -> docker.v1.DeleteVolumeResponse
-	42, // 82: docker.v1.DockerService.NetworkList:output_type -> docker.v1.ListNetworksResponse
-	44, // 83: docker.v1.DockerService.NetworkCreate:output_type -> docker.v1.CreateNetworkResponse
-	46, // 84: docker.v1.DockerService.NetworkDelete:output_type -> docker.v1.DeleteNetworkResponse
-	14, // 85: docker.v1.DockerService.NetworkInspect:output_type -> docker.v1.NetworkInspectResponse
-	56, // [56:86] is the sub-list for method output_type
-	26, // [26:56] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	22, // 11: docker.v1.ComposeValidateResponse.diagnostics:type_name -> docker.v1.ComposeDiagnostic
+	81, // 12: docker.v1.Image.labels:type_name -> docker.v1.Image.LabelsEntry
+	26, // 13: docker.v1.Image.manifests:type_name -> docker.v1.ManifestSummary
+	25, // 14: docker.v1.ListImagesResponse.images:type_name -> docker.v1.Image
+	33, // 15: docker.v1.ImagePruneResponse.deleted:type_name -> docker.v1.ImagesDeleted
+	34, // 16: docker.v1.ListVolumesResponse.volumes:type_name -> docker.v1.Volume
+	82, // 17: docker.v1.CreateVolumeRequest.driverOpts:type_name -> docker.v1.CreateVolumeRequest.DriverOptsEntry
+	83, // 18: docker.v1.CreateVolumeRequest.labels:type_name -> docker.v1.CreateVolumeRequest.LabelsEntry
+	34, // 19: docker.v1.CreateVolumeResponse.volume:type_name -> docker.v1.Volume
+	45, // 20: docker.v1.ListNetworksResponse.networks:type_name -> docker.v1.Network
+	84, // 21: docker.v1.CreateNetworkRequest.options:type_name -> docker.v1.CreateNetworkRequest.OptionsEntry
+	85, // 22: docker.v1.CreateNetworkRequest.labels:type_name -> docker.v1.CreateNetworkRequest.LabelsEntry
+	49, // 23: docker.v1.CreateNetworkRequest.subnets:type_name -> docker.v1.NetworkSubnet
+	61, // 24: docker.v1.StatsResponse.system:type_name -> docker.v1.SystemInfo
+	64, // 25: docker.v1.StatsResponse.containers:type_name -> docker.v1.ContainerStats
+	68, // 26: docker.v1.StatsRequest.file:type_name -> docker.v1.ComposeFile
+	0,  // 27: docker.v1.StatsRequest.sortBy:type_name -> docker.v1.SORT_FIELD
+	1,  // 28: docker.v1.StatsRequest.order:type_name -> docker.v1.ORDER
+	86, // 29: docker.v1.ListResponse.statusCount:type_name -> docker.v1.ListResponse.StatusCountEntry
+	63, // 30: docker.v1.ListResponse.list:type_name -> docker.v1.ContainerList
+	65, // 31: docker.v1.ContainerList.ports:type_name -> docker.v1.Port
+	70, // 32: docker.v1.ComposeResolveResponse.variables:type_name -> docker.v1.ComposeVariable
+	75, // 33: docker.v1.JobListResponse.jobs:type_name -> docker.v1.Job
+	3,  // 34: docker.v1.ComposeFileStatusResponse.StatusEntry.value:type_name -> docker.v1.Status
+	67, // 35: docker.v1.DockerService.ContainerStart:input_type -> docker.v1.ContainerRequest
+	67, // 36: docker.v1.DockerService.ContainerStop:input_type -> docker.v1.ContainerRequest
+	67, // 37: docker.v1.DockerService.ContainerRemove:input_type -> docker.v1.ContainerRequest
+	67, // 38: docker.v1.DockerService.ContainerRestart:input_type -> docker.v1.ContainerRequest
+	67, // 39: docker.v1.DockerService.ContainerUpdate:input_type -> docker.v1.ContainerRequest
+	5,  // 40: docker.v1.DockerService.ContainerTop:input_type -> docker.v1.ContainerTopRequest
+	12, // 41: docker.v1.DockerService.ContainerList:input_type -> docker.v1.ContainerListRequest
+	60, // 42: docker.v1.DockerService.ContainerStats:input_type -> docker.v1.StatsRequest
+	57, // 43: docker.v1.DockerService.ContainerLogs:input_type -> docker.v1.ContainerLogsRequest
+	57, // 44: docker.v1.DockerService.ContainerInspect:input_type -> docker.v1.ContainerLogsRequest
+	68, // 45: docker.v1.DockerService.ComposeUp:input_type -> docker.v1.ComposeFile
+	68, // 46: docker.v1.DockerService.ComposeDown:input_type -> docker.v1.ComposeFile
+	68, // 47: docker.v1.DockerService.ComposeStart:input_type -> docker.v1.ComposeFile
+	68, // 48: docker.v1.DockerService.ComposeStop:input_type -> docker.v1.ComposeFile
+	68, // 49: docker.v1.DockerService.ComposeRestart:input_type -> docker.v1.ComposeFile
+	68, // 50: docker.v1.DockerService.ComposeUpdate:input_type -> docker.v1.ComposeFile
+	68, // 51: docker.v1.DockerService.ComposeList:input_type -> docker.v1.ComposeFile
+	68, // 52: docker.v1.DockerService.ComposeValidate:input_type -> docker.v1.ComposeFile
+	68, // 53: docker.v1.DockerService.ComposeResolve:input_type -> docker.v1.ComposeFile
+	2,  // 54: docker.v1.DockerService.ComposeFileStatus:input_type -> docker.v1.ComposeFileStatusRequest
+	71, // 55: docker.v1.DockerService.ComposeUpMany:input_type -> docker.v1.ComposeManyRequest
+	71, // 56: docker.v1.DockerService.ComposeUpdateMany:input_type -> docker.v1.ComposeManyRequest
+	71, // 57: docker.v1.DockerService.ComposeDownMany:input_type -> docker.v1.ComposeManyRequest
+	73, // 58: docker.v1.DockerService.JobList:input_type -> docker.v1.JobListRequest
+	76, // 59: docker.v1.DockerService.JobAttach:input_type -> docker.v1.JobAttachRequest
+	77, // 60: docker.v1.DockerService.JobCancel:input_type -> docker.v1.JobCancelRequest
+	27, // 61: docker.v1.DockerService.ImageList:input_type -> docker.v1.ListImagesRequest
+	29, // 62: docker.v1.DockerService.ImageRemove:input_type -> docker.v1.RemoveImageRequest
+	32, // 63: docker.v1.DockerService.ImagePruneUnused:input_type -> docker.v1.ImagePruneRequest
+	17, // 64: docker.v1.DockerService.ImageInspect:input_type -> docker.v1.ImageInspectRequest
+	35, // 65: docker.v1.DockerService.VolumeList:input_type -> docker.v1.ListVolumesRequest
+	37, // 66: docker.v1.DockerService.VolumeCreate:input_type -> docker.v1.CreateVolumeRequest
+	39, // 67: docker.v1.DockerService.VolumeDelete:input_type -> docker.v1.DeleteVolumeRequest
+	41, // 68: docker.v1.DockerService.VolumeClone:input_type -> docker.v1.VolumeCloneRequest
+	41, // 69: docker.v1.DockerService.VolumeRename:input_type -> docker.v1.VolumeCloneRequest
+	43, // 70: docker.v1.DockerService.VolumeMigrate:input_type -> docker.v1.VolumeMigrateRequest
+	46, // 71: docker.v1.DockerService.NetworkList:input_type -> docker.v1.ListNetworksRequest
+	48, // 72: docker.v1.DockerService.NetworkCreate:input_type -> docker.v1.CreateNetworkRequest
+	55, // 73: docker.v1.DockerService.NetworkDelete:input_type -> docker.v1.DeleteNetworkRequest
+	13, // 74: docker.v1.DockerService.NetworkInspect:input_type -> docker.v1.NetworkInspectRequest
+	51, // 75: docker.v1.DockerService.NetworkConnect:input_type -> docker.v1.NetworkConnectRequest
+	53, // 76: docker.v1.DockerService.NetworkDisconnect:input_type -> docker.v1.NetworkDisconnectRequest
+	58, // 77: docker.v1.DockerService.ContainerStart:output_type -> docker.v1.LogsMessage
+	58, // 78: docker.v1.DockerService.ContainerStop:output_type -> docker.v1.LogsMessage
+	58, // 79: docker.v1.DockerService.ContainerRemove:output_type -> docker.v1.LogsMessage
+	58, // 80: docker.v1.DockerService.ContainerRestart:output_type -> docker.v1.LogsMessage
+	66, // 81: docker.v1.DockerService.ContainerUpdate:output_type -> docker.v1.Empty
+	6,  // 82: docker.v1.DockerService.ContainerTop:output_type -> docker.v1.ContainerTopResponse
+	62, // 83: docker.v1.DockerService.ContainerList:output_type -> docker.v1.ListResponse
+	59, // 84: docker.v1.DockerService.ContainerStats:output_type -> docker.v1.StatsResponse
+	58, // 85: docker.v1.DockerService.ContainerLogs:output_type -> docker.v1.LogsMessage
+	9,  // 86: docker.v1.DockerService.ContainerInspect:output_type -> docker.v1.ContainerInspectMessage
+	58, // 87: docker.v1.DockerService.ComposeUp:output_type -> docker.v1.LogsMessage
+	58, // 88: docker.v1.DockerService.ComposeDown:output_type -> docker.v1.LogsMessage
+	58, // 89: docker.v1.DockerService.ComposeStart:output_type -> docker.v1.LogsMessage
+	58, // 90: docker.v1.DockerService.ComposeStop:output_type -> docker.v1.LogsMessage
+	58, // 91: docker.v1.DockerService.ComposeRestart:output_type -> docker.v1.LogsMessage
+	58, // 92: docker.v1.DockerService.ComposeUpdate:output_type -> docker.v1.LogsMessage
+	62, // 93: docker.v1.DockerService.ComposeList:output_type -> docker.v1.ListResponse
+	21, // 94: docker.v1.DockerService.ComposeValidate:output_type -> docker.v1.ComposeValidateResponse
+	69, // 95: docker.v1.DockerService.ComposeResolve:output_type -> docker.v1.ComposeResolveResponse
+	4,  // 96: docker.v1.DockerService.ComposeFileStatus:output_type -> docker.v1.ComposeFileStatusResponse
+	72, // 97: docker.v1.DockerService.ComposeUpMany:output_type -> docker.v1.ComposeManyProgress
+	72, // 98: docker.v1.DockerService.ComposeUpdateMany:output_type -> docker.v1.ComposeManyProgress
+	72, // 99: docker.v1.DockerService.ComposeDownMany:output_type -> docker.v1.ComposeManyProgress
+	74, // 100: docker.v1.DockerService.JobList:output_type -> docker.v1.JobListResponse
+	58, // 101: docker.v1.DockerService.JobAttach:output_type -> docker.v1.LogsMessage
+	78, // 102: docker.v1.DockerService.JobCancel:output_type -> docker.v1.JobCancelResponse
+	28, // 103: docker.v1.DockerService.ImageList:output_type -> docker.v1.ListImagesResponse
+	30, // 104: docker.v1.DockerService.ImageRemove:output_type -> docker.v1.RemoveImageResponse
+	31, // 105: docker.v1.DockerService.ImagePruneUnused:output_type -> docker.v1.ImagePruneResponse
+	18, // 106: docker.v1.DockerService.ImageInspect:output_type -> docker.v1.ImageInspectResponse
+	36, // 107: docker.v1.DockerService.VolumeList:output_type -> docker.v1.ListVolumesResponse
+	38, // 108: docker.v1.DockerService.VolumeCreate:output_type -> docker.v1.CreateVolumeResponse
+	40, // 109: docker.v1.DockerService.VolumeDelete:output_type -> docker.v1.DeleteVolumeResponse
+	42, // 110: docker.v1.DockerService.VolumeClone:output_type -> docker.v1.VolumeCloneResponse
+	42, // 111: docker.v1.DockerService.VolumeRename:output_type -> docker.v1.VolumeCloneResponse
+	44, // 112: docker.v1.DockerService.VolumeMigrate:output_type -> docker.v1.VolumeMigrateResponse
+	47, // 113: docker.v1.DockerService.NetworkList:output_type -> docker.v1.ListNetworksResponse
+	50, // 114: docker.v1.DockerService.NetworkCreate:output_type -> docker.v1.CreateNetworkResponse
+	56, // 115: docker.v1.DockerService.NetworkDelete:output_type -> docker.v1.DeleteNetworkResponse
+	14, // 116: docker.v1.DockerService.NetworkInspect:output_type -> docker.v1.NetworkInspectResponse
+	52, // 117: docker.v1.DockerService.NetworkConnect:output_type -> docker.v1.NetworkConnectResponse
+	54, // 118: docker.v1.DockerService.NetworkDisconnect:output_type -> docker.v1.NetworkDisconnectResponse
+	77, // [77:119] is the sub-list for method output_type
+	35, // [35:77] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_docker_v1_docker_proto_init() }
@@ -4026,7 +5516,7 @@ func file_docker_v1_docker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_docker_v1_docker_proto_rawDesc), len(file_docker_v1_docker_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   61,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
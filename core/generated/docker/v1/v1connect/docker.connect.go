@@ -86,9 +86,27 @@ const (
 	// DockerServiceComposeValidateProcedure is the fully-qualified name of the DockerService's
 	// ComposeValidate RPC.
 	DockerServiceComposeValidateProcedure = "/docker.v1.DockerService/ComposeValidate"
+	// DockerServiceComposeResolveProcedure is the fully-qualified name of the DockerService's
+	// ComposeResolve RPC.
+	DockerServiceComposeResolveProcedure = "/docker.v1.DockerService/ComposeResolve"
 	// DockerServiceComposeFileStatusProcedure is the fully-qualified name of the DockerService's
 	// ComposeFileStatus RPC.
 	DockerServiceComposeFileStatusProcedure = "/docker.v1.DockerService/ComposeFileStatus"
+	// DockerServiceComposeUpManyProcedure is the fully-qualified name of the DockerService's
+	// ComposeUpMany RPC.
+	DockerServiceComposeUpManyProcedure = "/docker.v1.DockerService/ComposeUpMany"
+	// DockerServiceComposeUpdateManyProcedure is the fully-qualified name of the DockerService's
+	// ComposeUpdateMany RPC.
+	DockerServiceComposeUpdateManyProcedure = "/docker.v1.DockerService/ComposeUpdateMany"
+	// DockerServiceComposeDownManyProcedure is the fully-qualified name of the DockerService's
+	// ComposeDownMany RPC.
+	DockerServiceComposeDownManyProcedure = "/docker.v1.DockerService/ComposeDownMany"
+	// DockerServiceJobListProcedure is the fully-qualified name of the DockerService's JobList RPC.
+	DockerServiceJobListProcedure = "/docker.v1.DockerService/JobList"
+	// DockerServiceJobAttachProcedure is the fully-qualified name of the DockerService's JobAttach RPC.
+	DockerServiceJobAttachProcedure = "/docker.v1.DockerService/JobAttach"
+	// DockerServiceJobCancelProcedure is the fully-qualified name of the DockerService's JobCancel RPC.
+	DockerServiceJobCancelProcedure = "/docker.v1.DockerService/JobCancel"
 	// DockerServiceImageListProcedure is the fully-qualified name of the DockerService's ImageList RPC.
 	DockerServiceImageListProcedure = "/docker.v1.DockerService/ImageList"
 	// DockerServiceImageRemoveProcedure is the fully-qualified name of the DockerService's ImageRemove
@@ -109,6 +127,15 @@ const (
 	// DockerServiceVolumeDeleteProcedure is the fully-qualified name of the DockerService's
 	// VolumeDelete RPC.
 	DockerServiceVolumeDeleteProcedure = "/docker.v1.DockerService/VolumeDelete"
+	// DockerServiceVolumeCloneProcedure is the fully-qualified name of the DockerService's VolumeClone
+	// RPC.
+	DockerServiceVolumeCloneProcedure = "/docker.v1.DockerService/VolumeClone"
+	// DockerServiceVolumeRenameProcedure is the fully-qualified name of the DockerService's
+	// VolumeRename RPC.
+	DockerServiceVolumeRenameProcedure = "/docker.v1.DockerService/VolumeRename"
+	// DockerServiceVolumeMigrateProcedure is the fully-qualified name of the DockerService's
+	// VolumeMigrate RPC.
+	DockerServiceVolumeMigrateProcedure = "/docker.v1.DockerService/VolumeMigrate"
 	// DockerServiceNetworkListProcedure is the fully-qualified name of the DockerService's NetworkList
 	// RPC.
 	DockerServiceNetworkListProcedure = "/docker.v1.DockerService/NetworkList"
@@ -121,6 +148,12 @@ const (
 	// DockerServiceNetworkInspectProcedure is the fully-qualified name of the DockerService's
 	// NetworkInspect RPC.
 	DockerServiceNetworkInspectProcedure = "/docker.v1.DockerService/NetworkInspect"
+	// DockerServiceNetworkConnectProcedure is the fully-qualified name of the DockerService's
+	// NetworkConnect RPC.
+	DockerServiceNetworkConnectProcedure = "/docker.v1.DockerService/NetworkConnect"
+	// DockerServiceNetworkDisconnectProcedure is the fully-qualified name of the DockerService's
+	// NetworkDisconnect RPC.
+	DockerServiceNetworkDisconnectProcedure = "/docker.v1.DockerService/NetworkDisconnect"
 )
 
 // DockerServiceClient is a client for the docker.v1.DockerService service.
@@ -145,7 +178,15 @@ type DockerServiceClient interface {
 	ComposeUpdate(context.Context, *connect.Request[v1.ComposeFile]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
 	ComposeList(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ListResponse], error)
 	ComposeValidate(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeValidateResponse], error)
+	ComposeResolve(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeResolveResponse], error)
 	ComposeFileStatus(context.Context, *connect.Request[v1.ComposeFileStatusRequest]) (*connect.Response[v1.ComposeFileStatusResponse], error)
+	ComposeUpMany(context.Context, *connect.Request[v1.ComposeManyRequest]) (*connect.ServerStreamForClient[v1.ComposeManyProgress], error)
+	ComposeUpdateMany(context.Context, *connect.Request[v1.ComposeManyRequest]) (*connect.ServerStreamForClient[v1.ComposeManyProgress], error)
+	ComposeDownMany(context.Context, *connect.Request[v1.ComposeManyRequest]) (*connect.ServerStreamForClient[v1.ComposeManyProgress], error)
+	// compose jobs
+	JobList(context.Context, *connect.Request[v1.JobListRequest]) (*connect.Response[v1.JobListResponse], error)
+	JobAttach(context.Context, *connect.Request[v1.JobAttachRequest]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
+	JobCancel(context.Context, *connect.Request[v1.JobCancelRequest]) (*connect.Response[v1.JobCancelResponse], error)
 	// images
 	ImageList(context.Context, *connect.Request[v1.ListImagesRequest]) (*connect.Response[v1.ListImagesResponse], error)
 	ImageRemove(context.Context, *connect.Request[v1.RemoveImageRequest]) (*connect.Response[v1.RemoveImageResponse], error)
@@ -155,11 +196,18 @@ type DockerServiceClient interface {
 	VolumeList(context.Context, *connect.Request[v1.ListVolumesRequest]) (*connect.Response[v1.ListVolumesResponse], error)
 	VolumeCreate(context.Context, *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error)
 	VolumeDelete(context.Context, *connect.Request[v1.DeleteVolumeRequest]) (*connect.Response[v1.DeleteVolumeResponse], error)
+	VolumeClone(context.Context, *connect.Request[v1.VolumeCloneRequest]) (*connect.Response[v1.VolumeCloneResponse], error)
+	// VolumeRename copies the volume to a new name and removes the old one
+	VolumeRename(context.Context, *connect.Request[v1.VolumeCloneRequest]) (*connect.Response[v1.VolumeCloneResponse], error)
+	// VolumeMigrate copies a volume to another connected host
+	VolumeMigrate(context.Context, *connect.Request[v1.VolumeMigrateRequest]) (*connect.Response[v1.VolumeMigrateResponse], error)
 	// networks
 	NetworkList(context.Context, *connect.Request[v1.ListNetworksRequest]) (*connect.Response[v1.ListNetworksResponse], error)
 	NetworkCreate(context.Context, *connect.Request[v1.CreateNetworkRequest]) (*connect.Response[v1.CreateNetworkResponse], error)
 	NetworkDelete(context.Context, *connect.Request[v1.DeleteNetworkRequest]) (*connect.Response[v1.DeleteNetworkResponse], error)
 	NetworkInspect(context.Context, *connect.Request[v1.NetworkInspectRequest]) (*connect.Response[v1.NetworkInspectResponse], error)
+	NetworkConnect(context.Context, *connect.Request[v1.NetworkConnectRequest]) (*connect.Response[v1.NetworkConnectResponse], error)
+	NetworkDisconnect(context.Context, *connect.Request[v1.NetworkDisconnectRequest]) (*connect.Response[v1.NetworkDisconnectResponse], error)
 }
 
 // NewDockerServiceClient constructs a client for the docker.v1.DockerService service. By default,
@@ -281,12 +329,54 @@ func NewDockerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(dockerServiceMethods.ByName("ComposeValidate")),
 			connect.WithClientOptions(opts...),
 		),
+		composeResolve: connect.NewClient[v1.ComposeFile, v1.ComposeResolveResponse](
+			httpClient,
+			baseURL+DockerServiceComposeResolveProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeResolve")),
+			connect.WithClientOptions(opts...),
+		),
 		composeFileStatus: connect.NewClient[v1.ComposeFileStatusRequest, v1.ComposeFileStatusResponse](
 			httpClient,
 			baseURL+DockerServiceComposeFileStatusProcedure,
 			connect.WithSchema(dockerServiceMethods.ByName("ComposeFileStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		composeUpMany: connect.NewClient[v1.ComposeManyRequest, v1.ComposeManyProgress](
+			httpClient,
+			baseURL+DockerServiceComposeUpManyProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeUpMany")),
+			connect.WithClientOptions(opts...),
+		),
+		composeUpdateMany: connect.NewClient[v1.ComposeManyRequest, v1.ComposeManyProgress](
+			httpClient,
+			baseURL+DockerServiceComposeUpdateManyProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeUpdateMany")),
+			connect.WithClientOptions(opts...),
+		),
+		composeDownMany: connect.NewClient[v1.ComposeManyRequest, v1.ComposeManyProgress](
+			httpClient,
+			baseURL+DockerServiceComposeDownManyProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeDownMany")),
+			connect.WithClientOptions(opts...),
+		),
+		jobList: connect.NewClient[v1.JobListRequest, v1.JobListResponse](
+			httpClient,
+			baseURL+DockerServiceJobListProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("JobList")),
+			connect.WithClientOptions(opts...),
+		),
+		jobAttach: connect.NewClient[v1.JobAttachRequest, v1.LogsMessage](
+			httpClient,
+			baseURL+DockerServiceJobAttachProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("JobAttach")),
+			connect.WithClientOptions(opts...),
+		),
+		jobCancel: connect.NewClient[v1.JobCancelRequest, v1.JobCancelResponse](
+			httpClient,
+			baseURL+DockerServiceJobCancelProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("JobCancel")),
+			connect.WithClientOptions(opts...),
+		),
 		imageList: connect.NewClient[v1.ListImagesRequest, v1.ListImagesResponse](
 			httpClient,
 			baseURL+DockerServiceImageListProcedure,
@@ -329,6 +419,24 @@ func NewDockerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(dockerServiceMethods.ByName("VolumeDelete")),
 			connect.WithClientOptions(opts...),
 		),
+		volumeClone: connect.NewClient[v1.VolumeCloneRequest, v1.VolumeCloneResponse](
+			httpClient,
+			baseURL+DockerServiceVolumeCloneProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("VolumeClone")),
+			connect.WithClientOptions(opts...),
+		),
+		volumeRename: connect.NewClient[v1.VolumeCloneRequest, v1.VolumeCloneResponse](
+			httpClient,
+			baseURL+DockerServiceVolumeRenameProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("VolumeRename")),
+			connect.WithClientOptions(opts...),
+		),
+		volumeMigrate: connect.NewClient[v1.VolumeMigrateRequest, v1.VolumeMigrateResponse](
+			httpClient,
+			baseURL+DockerServiceVolumeMigrateProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("VolumeMigrate")),
+			connect.WithClientOptions(opts...),
+		),
 		networkList: connect.NewClient[v1.ListNetworksRequest, v1.ListNetworksResponse](
 			httpClient,
 			baseURL+DockerServiceNetworkListProcedure,
@@ -353,6 +461,18 @@ func NewDockerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(dockerServiceMethods.ByName("NetworkInspect")),
 			connect.WithClientOptions(opts...),
 		),
+		networkConnect: connect.NewClient[v1.NetworkConnectRequest, v1.NetworkConnectResponse](
+			httpClient,
+			baseURL+DockerServiceNetworkConnectProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("NetworkConnect")),
+			connect.WithClientOptions(opts...),
+		),
+		networkDisconnect: connect.NewClient[v1.NetworkDisconnectRequest, v1.NetworkDisconnectResponse](
+			httpClient,
+			baseURL+DockerServiceNetworkDisconnectProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("NetworkDisconnect")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -376,7 +496,14 @@ type dockerServiceClient struct {
 	composeUpdate     *connect.Client[v1.ComposeFile, v1.LogsMessage]
 	composeList       *connect.Client[v1.ComposeFile, v1.ListResponse]
 	composeValidate   *connect.Client[v1.ComposeFile, v1.ComposeValidateResponse]
+	composeResolve    *connect.Client[v1.ComposeFile, v1.ComposeResolveResponse]
 	composeFileStatus *connect.Client[v1.ComposeFileStatusRequest, v1.ComposeFileStatusResponse]
+	composeUpMany     *connect.Client[v1.ComposeManyRequest, v1.ComposeManyProgress]
+	composeUpdateMany *connect.Client[v1.ComposeManyRequest, v1.ComposeManyProgress]
+	composeDownMany   *connect.Client[v1.ComposeManyRequest, v1.ComposeManyProgress]
+	jobList           *connect.Client[v1.JobListRequest, v1.JobListResponse]
+	jobAttach         *connect.Client[v1.JobAttachRequest, v1.LogsMessage]
+	jobCancel         *connect.Client[v1.JobCancelRequest, v1.JobCancelResponse]
 	imageList         *connect.Client[v1.ListImagesRequest, v1.ListImagesResponse]
 	imageRemove       *connect.Client[v1.RemoveImageRequest, v1.RemoveImageResponse]
 	imagePruneUnused  *connect.Client[v1.ImagePruneRequest, v1.ImagePruneResponse]
@@ -384,10 +511,15 @@ type dockerServiceClient struct {
 	volumeList        *connect.Client[v1.ListVolumesRequest, v1.ListVolumesResponse]
 	volumeCreate      *connect.Client[v1.CreateVolumeRequest, v1.CreateVolumeResponse]
 	volumeDelete      *connect.Client[v1.DeleteVolumeRequest, v1.DeleteVolumeResponse]
+	volumeClone       *connect.Client[v1.VolumeCloneRequest, v1.VolumeCloneResponse]
+	volumeRename      *connect.Client[v1.VolumeCloneRequest, v1.VolumeCloneResponse]
+	volumeMigrate     *connect.Client[v1.VolumeMigrateRequest, v1.VolumeMigrateResponse]
 	networkList       *connect.Client[v1.ListNetworksRequest, v1.ListNetworksResponse]
 	networkCreate     *connect.Client[v1.CreateNetworkRequest, v1.CreateNetworkResponse]
 	networkDelete     *connect.Client[v1.DeleteNetworkRequest, v1.DeleteNetworkResponse]
 	networkInspect    *connect.Client[v1.NetworkInspectRequest, v1.NetworkInspectResponse]
+	networkConnect    *connect.Client[v1.NetworkConnectRequest, v1.NetworkConnectResponse]
+	networkDisconnect *connect.Client[v1.NetworkDisconnectRequest, v1.NetworkDisconnectResponse]
 }
 
 // ContainerStart calls docker.v1.DockerService.ContainerStart.
@@ -480,11 +612,46 @@ func (c *dockerServiceClient) ComposeValidate(ctx context.Context, req *connect.
 	return c.composeValidate.CallUnary(ctx, req)
 }
 
+// ComposeResolve calls docker.v1.DockerService.ComposeResolve.
+func (c *dockerServiceClient) ComposeResolve(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeResolveResponse], error) {
+	return c.composeResolve.CallUnary(ctx, req)
+}
+
 // ComposeFileStatus calls docker.v1.DockerService.ComposeFileStatus.
 func (c *dockerServiceClient) ComposeFileStatus(ctx context.Context, req *connect.Request[v1.ComposeFileStatusRequest]) (*connect.Response[v1.ComposeFileStatusResponse], error) {
 	return c.composeFileStatus.CallUnary(ctx, req)
 }
 
+// ComposeUpMany calls docker.v1.DockerService.ComposeUpMany.
+func (c *dockerServiceClient) ComposeUpMany(ctx context.Context, req *connect.Request[v1.ComposeManyRequest]) (*connect.ServerStreamForClient[v1.ComposeManyProgress], error) {
+	return c.composeUpMany.CallServerStream(ctx, req)
+}
+
+// ComposeUpdateMany calls docker.v1.DockerService.ComposeUpdateMany.
+func (c *dockerServiceClient) ComposeUpdateMany(ctx context.Context, req *connect.Request[v1.ComposeManyRequest]) (*connect.ServerStreamForClient[v1.ComposeManyProgress], error) {
+	return c.composeUpdateMany.CallServerStream(ctx, req)
+}
+
+// ComposeDownMany calls docker.v1.DockerService.ComposeDownMany.
+func (c *dockerServiceClient) ComposeDownMany(ctx context.Context, req *connect.Request[v1.ComposeManyRequest]) (*connect.ServerStreamForClient[v1.ComposeManyProgress], error) {
+	return c.composeDownMany.CallServerStream(ctx, req)
+}
+
+// JobList calls docker.v1.DockerService.JobList.
+func (c *dockerServiceClient) JobList(ctx context.Context, req *connect.Request[v1.JobListRequest]) (*connect.Response[v1.JobListResponse], error) {
+	return c.jobList.CallUnary(ctx, req)
+}
+
+// JobAttach calls docker.v1.DockerService.JobAttach.
+func (c *dockerServiceClient) JobAttach(ctx context.Context, req *connect.Request[v1.JobAttachRequest]) (*connect.ServerStreamForClient[v1.LogsMessage], error) {
+	return c.jobAttach.CallServerStream(ctx, req)
+}
+
+// JobCancel calls docker.v1.DockerService.JobCancel.
+func (c *dockerServiceClient) JobCancel(ctx context.Context, req *connect.Request[v1.JobCancelRequest]) (*connect.Response[v1.JobCancelResponse], error) {
+	return c.jobCancel.CallUnary(ctx, req)
+}
+
 // ImageList calls docker.v1.DockerService.ImageList.
 func (c *dockerServiceClient) ImageList(ctx context.Context, req *connect.Request[v1.ListImagesRequest]) (*connect.Response[v1.ListImagesResponse], error) {
 	return c.imageList.CallUnary(ctx, req)
@@ -520,6 +687,21 @@ func (c *dockerServiceClient) VolumeDelete(ctx context.Context, req *connect.Req
 	return c.volumeDelete.CallUnary(ctx, req)
 }
 
+// VolumeClone calls docker.v1.DockerService.VolumeClone.
+func (c *dockerServiceClient) VolumeClone(ctx context.Context, req *connect.Request[v1.VolumeCloneRequest]) (*connect.Response[v1.VolumeCloneResponse], error) {
+	return c.volumeClone.CallUnary(ctx, req)
+}
+
+// VolumeRename calls docker.v1.DockerService.VolumeRename.
+func (c *dockerServiceClient) VolumeRename(ctx context.Context, req *connect.Request[v1.VolumeCloneRequest]) (*connect.Response[v1.VolumeCloneResponse], error) {
+	return c.volumeRename.CallUnary(ctx, req)
+}
+
+// VolumeMigrate calls docker.v1.DockerService.VolumeMigrate.
+func (c *dockerServiceClient) VolumeMigrate(ctx context.Context, req *connect.Request[v1.VolumeMigrateRequest]) (*connect.Response[v1.VolumeMigrateResponse], error) {
+	return c.volumeMigrate.CallUnary(ctx, req)
+}
+
 // NetworkList calls docker.v1.DockerService.NetworkList.
 func (c *dockerServiceClient) NetworkList(ctx context.Context, req *connect.Request[v1.ListNetworksRequest]) (*connect.Response[v1.ListNetworksResponse], error) {
 	return c.networkList.CallUnary(ctx, req)
@@ -540,6 +722,16 @@ func (c *dockerServiceClient) NetworkInspect(ctx context.Context, req *connect.R
 	return c.networkInspect.CallUnary(ctx, req)
 }
 
+// NetworkConnect calls docker.v1.DockerService.NetworkConnect.
+func (c *dockerServiceClient) NetworkConnect(ctx context.Context, req *connect.Request[v1.NetworkConnectRequest]) (*connect.Response[v1.NetworkConnectResponse], error) {
+	return c.networkConnect.CallUnary(ctx, req)
+}
+
+// NetworkDisconnect calls docker.v1.DockerService.NetworkDisconnect.
+func (c *dockerServiceClient) NetworkDisconnect(ctx context.Context, req *connect.Request[v1.NetworkDisconnectRequest]) (*connect.Response[v1.NetworkDisconnectResponse], error) {
+	return c.networkDisconnect.CallUnary(ctx, req)
+}
+
 // DockerServiceHandler is an implementation of the docker.v1.DockerService service.
 type DockerServiceHandler interface {
 	// container
@@ -562,7 +754,15 @@ type DockerServiceHandler interface {
 	ComposeUpdate(context.Context, *connect.Request[v1.ComposeFile], *connect.ServerStream[v1.LogsMessage]) error
 	ComposeList(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ListResponse], error)
 	ComposeValidate(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeValidateResponse], error)
+	ComposeResolve(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeResolveResponse], error)
 	ComposeFileStatus(context.Context, *connect.Request[v1.ComposeFileStatusRequest]) (*connect.Response[v1.ComposeFileStatusResponse], error)
+	ComposeUpMany(context.Context, *connect.Request[v1.ComposeManyRequest], *connect.ServerStream[v1.ComposeManyProgress]) error
+	ComposeUpdateMany(context.Context, *connect.Request[v1.ComposeManyRequest], *connect.ServerStream[v1.ComposeManyProgress]) error
+	ComposeDownMany(context.Context, *connect.Request[v1.ComposeManyRequest], *connect.ServerStream[v1.ComposeManyProgress]) error
+	// compose jobs
+	JobList(context.Context, *connect.Request[v1.JobListRequest]) (*connect.Response[v1.JobListResponse], error)
+	JobAttach(context.Context, *connect.Request[v1.JobAttachRequest], *connect.ServerStream[v1.LogsMessage]) error
+	JobCancel(context.Context, *connect.Request[v1.JobCancelRequest]) (*connect.Response[v1.JobCancelResponse], error)
 	// images
 	ImageList(context.Context, *connect.Request[v1.ListImagesRequest]) (*connect.Response[v1.ListImagesResponse], error)
 	ImageRemove(context.Context, *connect.Request[v1.RemoveImageRequest]) (*connect.Response[v1.RemoveImageResponse], error)
@@ -572,11 +772,18 @@ type DockerServiceHandler interface {
 	VolumeList(context.Context, *connect.Request[v1.ListVolumesRequest]) (*connect.Response[v1.ListVolumesResponse], error)
 	VolumeCreate(context.Context, *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error)
 	VolumeDelete(context.Context, *connect.Request[v1.DeleteVolumeRequest]) (*connect.Response[v1.DeleteVolumeResponse], error)
+	VolumeClone(context.Context, *connect.Request[v1.VolumeCloneRequest]) (*connect.Response[v1.VolumeCloneResponse], error)
+	// VolumeRename copies the volume to a new name and removes the old one
+	VolumeRename(context.Context, *connect.Request[v1.VolumeCloneRequest]) (*connect.Response[v1.VolumeCloneResponse], error)
+	// VolumeMigrate copies a volume to another connected host
+	VolumeMigrate(context.Context, *connect.Request[v1.VolumeMigrateRequest]) (*connect.Response[v1.VolumeMigrateResponse], error)
 	// networks
 	NetworkList(context.Context, *connect.Request[v1.ListNetworksRequest]) (*connect.Response[v1.ListNetworksResponse], error)
 	NetworkCreate(context.Context, *connect.Request[v1.CreateNetworkRequest]) (*connect.Response[v1.CreateNetworkResponse], error)
 	NetworkDelete(context.Context, *connect.Request[v1.DeleteNetworkRequest]) (*connect.Response[v1.DeleteNetworkResponse], error)
 	NetworkInspect(context.Context, *connect.Request[v1.NetworkInspectRequest]) (*connect.Response[v1.NetworkInspectResponse], error)
+	NetworkConnect(context.Context, *connect.Request[v1.NetworkConnectRequest]) (*connect.Response[v1.NetworkConnectResponse], error)
+	NetworkDisconnect(context.Context, *connect.Request[v1.NetworkDisconnectRequest]) (*connect.Response[v1.NetworkDisconnectResponse], error)
 }
 
 // NewDockerServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -694,12 +901,54 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(dockerServiceMethods.ByName("ComposeValidate")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dockerServiceComposeResolveHandler := connect.NewUnaryHandler(
+		DockerServiceComposeResolveProcedure,
+		svc.ComposeResolve,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeResolve")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dockerServiceComposeFileStatusHandler := connect.NewUnaryHandler(
 		DockerServiceComposeFileStatusProcedure,
 		svc.ComposeFileStatus,
 		connect.WithSchema(dockerServiceMethods.ByName("ComposeFileStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dockerServiceComposeUpManyHandler := connect.NewServerStreamHandler(
+		DockerServiceComposeUpManyProcedure,
+		svc.ComposeUpMany,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeUpMany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceComposeUpdateManyHandler := connect.NewServerStreamHandler(
+		DockerServiceComposeUpdateManyProcedure,
+		svc.ComposeUpdateMany,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeUpdateMany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceComposeDownManyHandler := connect.NewServerStreamHandler(
+		DockerServiceComposeDownManyProcedure,
+		svc.ComposeDownMany,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeDownMany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceJobListHandler := connect.NewUnaryHandler(
+		DockerServiceJobListProcedure,
+		svc.JobList,
+		connect.WithSchema(dockerServiceMethods.ByName("JobList")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceJobAttachHandler := connect.NewServerStreamHandler(
+		DockerServiceJobAttachProcedure,
+		svc.JobAttach,
+		connect.WithSchema(dockerServiceMethods.ByName("JobAttach")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceJobCancelHandler := connect.NewUnaryHandler(
+		DockerServiceJobCancelProcedure,
+		svc.JobCancel,
+		connect.WithSchema(dockerServiceMethods.ByName("JobCancel")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dockerServiceImageListHandler := connect.NewUnaryHandler(
 		DockerServiceImageListProcedure,
 		svc.ImageList,
@@ -742,6 +991,24 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(dockerServiceMethods.ByName("VolumeDelete")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dockerServiceVolumeCloneHandler := connect.NewUnaryHandler(
+		DockerServiceVolumeCloneProcedure,
+		svc.VolumeClone,
+		connect.WithSchema(dockerServiceMethods.ByName("VolumeClone")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceVolumeRenameHandler := connect.NewUnaryHandler(
+		DockerServiceVolumeRenameProcedure,
+		svc.VolumeRename,
+		connect.WithSchema(dockerServiceMethods.ByName("VolumeRename")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceVolumeMigrateHandler := connect.NewUnaryHandler(
+		DockerServiceVolumeMigrateProcedure,
+		svc.VolumeMigrate,
+		connect.WithSchema(dockerServiceMethods.ByName("VolumeMigrate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dockerServiceNetworkListHandler := connect.NewUnaryHandler(
 		DockerServiceNetworkListProcedure,
 		svc.NetworkList,
@@ -766,6 +1033,18 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(dockerServiceMethods.ByName("NetworkInspect")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dockerServiceNetworkConnectHandler := connect.NewUnaryHandler(
+		DockerServiceNetworkConnectProcedure,
+		svc.NetworkConnect,
+		connect.WithSchema(dockerServiceMethods.ByName("NetworkConnect")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceNetworkDisconnectHandler := connect.NewUnaryHandler(
+		DockerServiceNetworkDisconnectProcedure,
+		svc.NetworkDisconnect,
+		connect.WithSchema(dockerServiceMethods.ByName("NetworkDisconnect")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/docker.v1.DockerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DockerServiceContainerStartProcedure:
@@ -804,8 +1083,22 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 			dockerServiceComposeListHandler.ServeHTTP(w, r)
 		case DockerServiceComposeValidateProcedure:
 			dockerServiceComposeValidateHandler.ServeHTTP(w, r)
+		case DockerServiceComposeResolveProcedure:
+			dockerServiceComposeResolveHandler.ServeHTTP(w, r)
 		case DockerServiceComposeFileStatusProcedure:
 			dockerServiceComposeFileStatusHandler.ServeHTTP(w, r)
+		case DockerServiceComposeUpManyProcedure:
+			dockerServiceComposeUpManyHandler.ServeHTTP(w, r)
+		case DockerServiceComposeUpdateManyProcedure:
+			dockerServiceComposeUpdateManyHandler.ServeHTTP(w, r)
+		case DockerServiceComposeDownManyProcedure:
+			dockerServiceComposeDownManyHandler.ServeHTTP(w, r)
+		case DockerServiceJobListProcedure:
+			dockerServiceJobListHandler.ServeHTTP(w, r)
+		case DockerServiceJobAttachProcedure:
+			dockerServiceJobAttachHandler.ServeHTTP(w, r)
+		case DockerServiceJobCancelProcedure:
+			dockerServiceJobCancelHandler.ServeHTTP(w, r)
 		case DockerServiceImageListProcedure:
 			dockerServiceImageListHandler.ServeHTTP(w, r)
 		case DockerServiceImageRemoveProcedure:
@@ -820,6 +1113,12 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 			dockerServiceVolumeCreateHandler.ServeHTTP(w, r)
 		case DockerServiceVolumeDeleteProcedure:
 			dockerServiceVolumeDeleteHandler.ServeHTTP(w, r)
+		case DockerServiceVolumeCloneProcedure:
+			dockerServiceVolumeCloneHandler.ServeHTTP(w, r)
+		case DockerServiceVolumeRenameProcedure:
+			dockerServiceVolumeRenameHandler.ServeHTTP(w, r)
+		case DockerServiceVolumeMigrateProcedure:
+			dockerServiceVolumeMigrateHandler.ServeHTTP(w, r)
 		case DockerServiceNetworkListProcedure:
 			dockerServiceNetworkListHandler.ServeHTTP(w, r)
 		case DockerServiceNetworkCreateProcedure:
@@ -828,6 +1127,10 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 			dockerServiceNetworkDeleteHandler.ServeHTTP(w, r)
 		case DockerServiceNetworkInspectProcedure:
 			dockerServiceNetworkInspectHandler.ServeHTTP(w, r)
+		case DockerServiceNetworkConnectProcedure:
+			dockerServiceNetworkConnectHandler.ServeHTTP(w, r)
+		case DockerServiceNetworkDisconnectProcedure:
+			dockerServiceNetworkDisconnectHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -909,10 +1212,38 @@ func (UnimplementedDockerServiceHandler) ComposeValidate(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeValidate is not implemented"))
 }
 
+func (UnimplementedDockerServiceHandler) ComposeResolve(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeResolveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeResolve is not implemented"))
+}
+
 func (UnimplementedDockerServiceHandler) ComposeFileStatus(context.Context, *connect.Request[v1.ComposeFileStatusRequest]) (*connect.Response[v1.ComposeFileStatusResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeFileStatus is not implemented"))
 }
 
+func (UnimplementedDockerServiceHandler) ComposeUpMany(context.Context, *connect.Request[v1.ComposeManyRequest], *connect.ServerStream[v1.ComposeManyProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeUpMany is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ComposeUpdateMany(context.Context, *connect.Request[v1.ComposeManyRequest], *connect.ServerStream[v1.ComposeManyProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeUpdateMany is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ComposeDownMany(context.Context, *connect.Request[v1.ComposeManyRequest], *connect.ServerStream[v1.ComposeManyProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeDownMany is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) JobList(context.Context, *connect.Request[v1.JobListRequest]) (*connect.Response[v1.JobListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.JobList is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) JobAttach(context.Context, *connect.Request[v1.JobAttachRequest], *connect.ServerStream[v1.LogsMessage]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.JobAttach is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) JobCancel(context.Context, *connect.Request[v1.JobCancelRequest]) (*connect.Response[v1.JobCancelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.JobCancel is not implemented"))
+}
+
 func (UnimplementedDockerServiceHandler) ImageList(context.Context, *connect.Request[v1.ListImagesRequest]) (*connect.Response[v1.ListImagesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ImageList is not implemented"))
 }
@@ -941,6 +1272,18 @@ func (UnimplementedDockerServiceHandler) VolumeDelete(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.VolumeDelete is not implemented"))
 }
 
+func (UnimplementedDockerServiceHandler) VolumeClone(context.Context, *connect.Request[v1.VolumeCloneRequest]) (*connect.Response[v1.VolumeCloneResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.VolumeClone is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) VolumeRename(context.Context, *connect.Request[v1.VolumeCloneRequest]) (*connect.Response[v1.VolumeCloneResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.VolumeRename is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) VolumeMigrate(context.Context, *connect.Request[v1.VolumeMigrateRequest]) (*connect.Response[v1.VolumeMigrateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.VolumeMigrate is not implemented"))
+}
+
 func (UnimplementedDockerServiceHandler) NetworkList(context.Context, *connect.Request[v1.ListNetworksRequest]) (*connect.Response[v1.ListNetworksResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.NetworkList is not implemented"))
 }
@@ -956,3 +1299,11 @@ func (UnimplementedDockerServiceHandler) NetworkDelete(context.Context, *connect
 func (UnimplementedDockerServiceHandler) NetworkInspect(context.Context, *connect.Request[v1.NetworkInspectRequest]) (*connect.Response[v1.NetworkInspectResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.NetworkInspect is not implemented"))
 }
+
+func (UnimplementedDockerServiceHandler) NetworkConnect(context.Context, *connect.Request[v1.NetworkConnectRequest]) (*connect.Response[v1.NetworkConnectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.NetworkConnect is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) NetworkDisconnect(context.Context, *connect.Request[v1.NetworkDisconnectRequest]) (*connect.Response[v1.NetworkDisconnectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.NetworkDisconnect is not implemented"))
+}
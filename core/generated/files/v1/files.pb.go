@@ -21,10 +21,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ConflictPolicy int32
+
+const (
+	ConflictPolicy_SKIP      ConflictPolicy = 0 // default val
+	ConflictPolicy_OVERWRITE ConflictPolicy = 1
+	// keep both, the new file is written as "name (1).ext"
+	ConflictPolicy_RENAME ConflictPolicy = 2
+)
+
+// Enum value maps for ConflictPolicy.
+var (
+	ConflictPolicy_name = map[int32]string{
+		0: "SKIP",
+		1: "OVERWRITE",
+		2: "RENAME",
+	}
+	ConflictPolicy_value = map[string]int32{
+		"SKIP":      0,
+		"OVERWRITE": 1,
+		"RENAME":    2,
+	}
+)
+
+func (x ConflictPolicy) Enum() *ConflictPolicy {
+	p := new(ConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_files_v1_files_proto_enumTypes[0].Descriptor()
+}
+
+func (ConflictPolicy) Type() protoreflect.EnumType {
+	return &file_files_v1_files_proto_enumTypes[0]
+}
+
+func (x ConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictPolicy.Descriptor instead.
+func (ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{0}
+}
+
 type WriteTmplRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Dir           string                 `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
-	Tmpl          *Template              `protobuf:"bytes,1,opt,name=tmpl,proto3" json:"tmpl,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Dir   string                 `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	// name, source and the values in vars are used
+	Tmpl *Template `protobuf:"bytes,1,opt,name=tmpl,proto3" json:"tmpl,omitempty"`
+	// replace files that already exist in dir
+	Overwrite     bool `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,8 +126,17 @@ func (x *WriteTmplRequest) GetTmpl() *Template {
 	return nil
 }
 
+func (x *WriteTmplRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
 type WriteTmplResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// written files, <alias>/<relpath>
+	Files         []string `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -109,16 +171,149 @@ func (*WriteTmplResponse) Descriptor() ([]byte, []int) {
 	return file_files_v1_files_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *WriteTmplResponse) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type PreviewTmplResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Files []*TemplateFile        `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	// folders created by the template hooks
+	Dirs          []string `protobuf:"bytes,2,rep,name=dirs,proto3" json:"dirs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTmplResponse) Reset() {
+	*x = PreviewTmplResponse{}
+	mi := &file_files_v1_files_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTmplResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTmplResponse) ProtoMessage() {}
+
+func (x *PreviewTmplResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTmplResponse.ProtoReflect.Descriptor instead.
+func (*PreviewTmplResponse) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PreviewTmplResponse) GetFiles() []*TemplateFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *PreviewTmplResponse) GetDirs() []string {
+	if x != nil {
+		return x.Dirs
+	}
+	return nil
+}
+
+type TemplateFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// <alias>/<relpath>
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Contents string `protobuf:"bytes,2,opt,name=contents,proto3" json:"contents,omitempty"`
+	// writing fails unless overwrite is set
+	Exists bool `protobuf:"varint,3,opt,name=exists,proto3" json:"exists,omitempty"`
+	// contents are not sent for binary files
+	Binary        bool `protobuf:"varint,4,opt,name=binary,proto3" json:"binary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateFile) Reset() {
+	*x = TemplateFile{}
+	mi := &file_files_v1_files_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateFile) ProtoMessage() {}
+
+func (x *TemplateFile) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateFile.ProtoReflect.Descriptor instead.
+func (*TemplateFile) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TemplateFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *TemplateFile) GetContents() string {
+	if x != nil {
+		return x.Contents
+	}
+	return ""
+}
+
+func (x *TemplateFile) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *TemplateFile) GetBinary() bool {
+	if x != nil {
+		return x.Binary
+	}
+	return false
+}
+
 type GetTmplsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Alias string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// fetch git sources even if the cached clone is recent
+	Refresh       bool `protobuf:"varint,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTmplsRequest) Reset() {
 	*x = GetTmplsRequest{}
-	mi := &file_files_v1_files_proto_msgTypes[2]
+	mi := &file_files_v1_files_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -130,7 +325,7 @@ func (x *GetTmplsRequest) String() string {
 func (*GetTmplsRequest) ProtoMessage() {}
 
 func (x *GetTmplsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[2]
+	mi := &file_files_v1_files_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -143,7 +338,7 @@ func (x *GetTmplsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTmplsRequest.ProtoReflect.Descriptor instead.
 func (*GetTmplsRequest) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{2}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetTmplsRequest) GetAlias() string {
@@ -153,17 +348,32 @@ func (x *GetTmplsRequest) GetAlias() string {
 	return ""
 }
 
+func (x *GetTmplsRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
 type Template struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Vars          map[string]string      `protobuf:"bytes,2,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// var name to value, the defaults when listing
+	Vars map[string]string `protobuf:"bytes,2,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// configured source, empty for the templates folder of the alias
+	Source      string         `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Title       string         `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description string         `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	VarDefs     []*TemplateVar `protobuf:"bytes,6,rep,name=varDefs,proto3" json:"varDefs,omitempty"`
+	// files written by the template, before vars are filled in
+	Files         []string `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Template) Reset() {
 	*x = Template{}
-	mi := &file_files_v1_files_proto_msgTypes[3]
+	mi := &file_files_v1_files_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -175,7 +385,7 @@ func (x *Template) String() string {
 func (*Template) ProtoMessage() {}
 
 func (x *Template) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[3]
+	mi := &file_files_v1_files_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,45 +398,764 @@ func (x *Template) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Template.ProtoReflect.Descriptor instead.
 func (*Template) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{3}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Template) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Template) GetVars() map[string]string {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+func (x *Template) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Template) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Template) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Template) GetVarDefs() []*TemplateVar {
+	if x != nil {
+		return x.VarDefs
+	}
+	return nil
+}
+
+func (x *Template) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type TemplateVar struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// string, int, bool, enum
+	Type         string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	DefaultValue string `protobuf:"bytes,4,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
+	Required     bool   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	// regex the value must match
+	Pattern string `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// allowed values of an enum
+	Options []string `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	// mask the value, it is written to env files with 0600
+	Secret bool `protobuf:"varint,8,opt,name=secret,proto3" json:"secret,omitempty"`
+	// a random value is generated if left empty
+	Generate      bool `protobuf:"varint,9,opt,name=generate,proto3" json:"generate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateVar) Reset() {
+	*x = TemplateVar{}
+	mi := &file_files_v1_files_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateVar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateVar) ProtoMessage() {}
+
+func (x *TemplateVar) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateVar.ProtoReflect.Descriptor instead.
+func (*TemplateVar) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TemplateVar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateVar) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TemplateVar) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TemplateVar) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *TemplateVar) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TemplateVar) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *TemplateVar) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *TemplateVar) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
+}
+
+func (x *TemplateVar) GetGenerate() bool {
+	if x != nil {
+		return x.Generate
+	}
+	return false
+}
+
+type GetTmplsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templs        []*Template            `protobuf:"bytes,1,rep,name=templs,proto3" json:"templs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTmplsResponse) Reset() {
+	*x = GetTmplsResponse{}
+	mi := &file_files_v1_files_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTmplsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTmplsResponse) ProtoMessage() {}
+
+func (x *GetTmplsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTmplsResponse.ProtoReflect.Descriptor instead.
+func (*GetTmplsResponse) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTmplsResponse) GetTempls() []*Template {
+	if x != nil {
+		return x.Templs
+	}
+	return nil
+}
+
+type CopyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        *File                  `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Dest          *File                  `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyRequest) Reset() {
+	*x = CopyRequest{}
+	mi := &file_files_v1_files_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyRequest) ProtoMessage() {}
+
+func (x *CopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyRequest.ProtoReflect.Descriptor instead.
+func (*CopyRequest) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CopyRequest) GetSource() *File {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *CopyRequest) GetDest() *File {
+	if x != nil {
+		return x.Dest
+	}
+	return nil
+}
+
+type CopyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyResponse) Reset() {
+	*x = CopyResponse{}
+	mi := &file_files_v1_files_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyResponse) ProtoMessage() {}
+
+func (x *CopyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyResponse.ProtoReflect.Descriptor instead.
+func (*CopyResponse) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{9}
+}
+
+type ListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_files_v1_files_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*FsEntry             `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_files_v1_files_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListResponse) GetEntries() []*FsEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type FormatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatRequest) Reset() {
+	*x = FormatRequest{}
+	mi := &file_files_v1_files_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatRequest) ProtoMessage() {}
+
+func (x *FormatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatRequest.ProtoReflect.Descriptor instead.
+func (*FormatRequest) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FormatRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type FormatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      string                 `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatResponse) Reset() {
+	*x = FormatResponse{}
+	mi := &file_files_v1_files_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatResponse) ProtoMessage() {}
+
+func (x *FormatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatResponse.ProtoReflect.Descriptor instead.
+func (*FormatResponse) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FormatResponse) GetContents() string {
+	if x != nil {
+		return x.Contents
+	}
+	return ""
+}
+
+type FsEntry struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Filename string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	IsDir    bool                   `protobuf:"varint,3,opt,name=isDir,proto3" json:"isDir,omitempty"`
+	SubFiles []*FsEntry             `protobuf:"bytes,4,rep,name=subFiles,proto3" json:"subFiles,omitempty"`
+	// flag for lazy loading
+	IsFetched       bool   `protobuf:"varint,5,opt,name=isFetched,proto3" json:"isFetched,omitempty"`
+	IsComposeFolder string `protobuf:"bytes,6,opt,name=isComposeFolder,proto3" json:"isComposeFolder,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FsEntry) Reset() {
+	*x = FsEntry{}
+	mi := &file_files_v1_files_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FsEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FsEntry) ProtoMessage() {}
+
+func (x *FsEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FsEntry.ProtoReflect.Descriptor instead.
+func (*FsEntry) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FsEntry) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *FsEntry) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *FsEntry) GetSubFiles() []*FsEntry {
+	if x != nil {
+		return x.SubFiles
+	}
+	return nil
+}
+
+func (x *FsEntry) GetIsFetched() bool {
+	if x != nil {
+		return x.IsFetched
+	}
+	return false
+}
+
+func (x *FsEntry) GetIsComposeFolder() string {
+	if x != nil {
+		return x.IsComposeFolder
+	}
+	return ""
+}
+
+type TransferRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// <alias>/<relpath>
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// host of the source, defaults to the current host
+	SourceHost string `protobuf:"bytes,2,opt,name=sourceHost,proto3" json:"sourceHost,omitempty"`
+	Dest       string `protobuf:"bytes,3,opt,name=dest,proto3" json:"dest,omitempty"`
+	// host of the dest, defaults to the current host
+	DestHost string `protobuf:"bytes,4,opt,name=destHost,proto3" json:"destHost,omitempty"`
+	// remove the source once copied
+	Move          bool           `protobuf:"varint,5,opt,name=move,proto3" json:"move,omitempty"`
+	OnConflict    ConflictPolicy `protobuf:"varint,6,opt,name=onConflict,proto3,enum=files.v1.ConflictPolicy" json:"onConflict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	mi := &file_files_v1_files_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest) ProtoMessage() {}
+
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TransferRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetSourceHost() string {
+	if x != nil {
+		return x.SourceHost
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetDestHost() string {
+	if x != nil {
+		return x.DestHost
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetMove() bool {
+	if x != nil {
+		return x.Move
+	}
+	return false
+}
+
+func (x *TransferRequest) GetOnConflict() ConflictPolicy {
+	if x != nil {
+		return x.OnConflict
+	}
+	return ConflictPolicy_SKIP
+}
+
+type TransferProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// file being transferred, relative to the source
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// where the file is written to
+	Dest string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	// copying, copied, skipped, overwritten, renamed
+	State         string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	BytesDone     int64  `protobuf:"varint,4,opt,name=bytesDone,proto3" json:"bytesDone,omitempty"`
+	BytesTotal    int64  `protobuf:"varint,5,opt,name=bytesTotal,proto3" json:"bytesTotal,omitempty"`
+	FilesDone     int32  `protobuf:"varint,6,opt,name=filesDone,proto3" json:"filesDone,omitempty"`
+	FilesTotal    int32  `protobuf:"varint,7,opt,name=filesTotal,proto3" json:"filesTotal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferProgress) Reset() {
+	*x = TransferProgress{}
+	mi := &file_files_v1_files_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferProgress) ProtoMessage() {}
+
+func (x *TransferProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferProgress.ProtoReflect.Descriptor instead.
+func (*TransferProgress) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TransferProgress) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *TransferProgress) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *TransferProgress) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *TransferProgress) GetBytesDone() int64 {
+	if x != nil {
+		return x.BytesDone
+	}
+	return 0
+}
+
+func (x *TransferProgress) GetBytesTotal() int64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
 }
 
-func (x *Template) GetName() string {
+func (x *TransferProgress) GetFilesDone() int32 {
 	if x != nil {
-		return x.Name
+		return x.FilesDone
 	}
-	return ""
+	return 0
 }
 
-func (x *Template) GetVars() map[string]string {
+func (x *TransferProgress) GetFilesTotal() int32 {
 	if x != nil {
-		return x.Vars
+		return x.FilesTotal
 	}
-	return nil
+	return 0
 }
 
-type GetTmplsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Templs        []*Template            `protobuf:"bytes,1,rep,name=templs,proto3" json:"templs,omitempty"`
+type WatchFilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// folders watched recursively, <alias>/<relpath>
+	Dirs []string `protobuf:"bytes,1,rep,name=dirs,proto3" json:"dirs,omitempty"`
+	// files open in the editor, <alias>/<relpath>
+	Files         []string `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTmplsResponse) Reset() {
-	*x = GetTmplsResponse{}
-	mi := &file_files_v1_files_proto_msgTypes[4]
+func (x *WatchFilesRequest) Reset() {
+	*x = WatchFilesRequest{}
+	mi := &file_files_v1_files_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTmplsResponse) String() string {
+func (x *WatchFilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTmplsResponse) ProtoMessage() {}
+func (*WatchFilesRequest) ProtoMessage() {}
 
-func (x *GetTmplsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[4]
+func (x *WatchFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,41 +1166,47 @@ func (x *GetTmplsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTmplsResponse.ProtoReflect.Descriptor instead.
-func (*GetTmplsResponse) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use WatchFilesRequest.ProtoReflect.Descriptor instead.
+func (*WatchFilesRequest) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetTmplsResponse) GetTempls() []*Template {
+func (x *WatchFilesRequest) GetDirs() []string {
 	if x != nil {
-		return x.Templs
+		return x.Dirs
 	}
 	return nil
 }
 
-type CopyRequest struct {
+func (x *WatchFilesRequest) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type WatchFilesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Source        *File                  `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Dest          *File                  `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Events        []*FileEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CopyRequest) Reset() {
-	*x = CopyRequest{}
-	mi := &file_files_v1_files_proto_msgTypes[5]
+func (x *WatchFilesResponse) Reset() {
+	*x = WatchFilesResponse{}
+	mi := &file_files_v1_files_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CopyRequest) String() string {
+func (x *WatchFilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CopyRequest) ProtoMessage() {}
+func (*WatchFilesResponse) ProtoMessage() {}
 
-func (x *CopyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[5]
+func (x *WatchFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,46 +1217,50 @@ func (x *CopyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CopyRequest.ProtoReflect.Descriptor instead.
-func (*CopyRequest) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *CopyRequest) GetSource() *File {
-	if x != nil {
-		return x.Source
-	}
-	return nil
+// Deprecated: Use WatchFilesResponse.ProtoReflect.Descriptor instead.
+func (*WatchFilesResponse) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CopyRequest) GetDest() *File {
+func (x *WatchFilesResponse) GetEvents() []*FileEvent {
 	if x != nil {
-		return x.Dest
+		return x.Events
 	}
 	return nil
 }
 
-type CopyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type FileEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// create, modify, delete, rename
+	Op       string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// previous name of a renamed file
+	OldFilename string `protobuf:"bytes,3,opt,name=oldFilename,proto3" json:"oldFilename,omitempty"`
+	IsDir       bool   `protobuf:"varint,4,opt,name=isDir,proto3" json:"isDir,omitempty"`
+	Size        int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// unix seconds
+	ModTime int64 `protobuf:"varint,6,opt,name=modTime,proto3" json:"modTime,omitempty"`
+	// contents hash of a watched file, same as the ETag it is loaded with
+	Hash          string `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CopyResponse) Reset() {
-	*x = CopyResponse{}
-	mi := &file_files_v1_files_proto_msgTypes[6]
+func (x *FileEvent) Reset() {
+	*x = FileEvent{}
+	mi := &file_files_v1_files_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CopyResponse) String() string {
+func (x *FileEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CopyResponse) ProtoMessage() {}
+func (*FileEvent) ProtoMessage() {}
 
-func (x *CopyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[6]
+func (x *FileEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,77 +1271,94 @@ func (x *CopyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CopyResponse.ProtoReflect.Descriptor instead.
-func (*CopyResponse) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use FileEvent.ProtoReflect.Descriptor instead.
+func (*FileEvent) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{19}
 }
 
-type ListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FileEvent) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
 }
 
-func (x *ListRequest) Reset() {
-	*x = ListRequest{}
-	mi := &file_files_v1_files_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FileEvent) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
 }
 
-func (x *ListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FileEvent) GetOldFilename() string {
+	if x != nil {
+		return x.OldFilename
+	}
+	return ""
 }
 
-func (*ListRequest) ProtoMessage() {}
+func (x *FileEvent) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
 
-func (x *ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[7]
+func (x *FileEvent) GetSize() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Size
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
-func (*ListRequest) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{7}
+func (x *FileEvent) GetModTime() int64 {
+	if x != nil {
+		return x.ModTime
+	}
+	return 0
 }
 
-func (x *ListRequest) GetPath() string {
+func (x *FileEvent) GetHash() string {
 	if x != nil {
-		return x.Path
+		return x.Hash
 	}
 	return ""
 }
 
-type ListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*FsEntry             `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+type SearchContentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// folder to search, <alias>/<relpath>
+	Root          string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Query         string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Regex         bool   `protobuf:"varint,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	CaseSensitive bool   `protobuf:"varint,4,opt,name=caseSensitive,proto3" json:"caseSensitive,omitempty"`
+	WholeWord     bool   `protobuf:"varint,5,opt,name=wholeWord,proto3" json:"wholeWord,omitempty"`
+	// globs, a glob without a / matches the file name, ** matches any folders
+	Include []string `protobuf:"bytes,6,rep,name=include,proto3" json:"include,omitempty"`
+	Exclude []string `protobuf:"bytes,7,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	// lines shown before and after every match, max 10
+	ContextLines int32 `protobuf:"varint,8,opt,name=contextLines,proto3" json:"contextLines,omitempty"`
+	// stop after this many matches, 0 or over 1000 uses 1000
+	MaxMatches    int32 `protobuf:"varint,9,opt,name=maxMatches,proto3" json:"maxMatches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResponse) Reset() {
-	*x = ListResponse{}
-	mi := &file_files_v1_files_proto_msgTypes[8]
+func (x *SearchContentsRequest) Reset() {
+	*x = SearchContentsRequest{}
+	mi := &file_files_v1_files_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResponse) String() string {
+func (x *SearchContentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResponse) ProtoMessage() {}
+func (*SearchContentsRequest) ProtoMessage() {}
 
-func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[8]
+func (x *SearchContentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,84 +1369,100 @@ func (x *ListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
-func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SearchContentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchContentsRequest) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListResponse) GetEntries() []*FsEntry {
+func (x *SearchContentsRequest) GetRoot() string {
 	if x != nil {
-		return x.Entries
+		return x.Root
 	}
-	return nil
+	return ""
 }
 
-type FormatRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SearchContentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
 }
 
-func (x *FormatRequest) Reset() {
-	*x = FormatRequest{}
-	mi := &file_files_v1_files_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SearchContentsRequest) GetRegex() bool {
+	if x != nil {
+		return x.Regex
+	}
+	return false
 }
 
-func (x *FormatRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SearchContentsRequest) GetCaseSensitive() bool {
+	if x != nil {
+		return x.CaseSensitive
+	}
+	return false
 }
 
-func (*FormatRequest) ProtoMessage() {}
+func (x *SearchContentsRequest) GetWholeWord() bool {
+	if x != nil {
+		return x.WholeWord
+	}
+	return false
+}
 
-func (x *FormatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[9]
+func (x *SearchContentsRequest) GetInclude() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Include
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use FormatRequest.ProtoReflect.Descriptor instead.
-func (*FormatRequest) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{9}
+func (x *SearchContentsRequest) GetExclude() []string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
 }
 
-func (x *FormatRequest) GetFilename() string {
+func (x *SearchContentsRequest) GetContextLines() int32 {
 	if x != nil {
-		return x.Filename
+		return x.ContextLines
 	}
-	return ""
+	return 0
 }
 
-type FormatResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Contents      string                 `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+func (x *SearchContentsRequest) GetMaxMatches() int32 {
+	if x != nil {
+		return x.MaxMatches
+	}
+	return 0
+}
+
+type SearchContentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// <alias>/<relpath>
+	Filename string       `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Matches  []*LineMatch `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+	// set on the last message if the search stopped at maxMatches
+	Truncated     bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FormatResponse) Reset() {
-	*x = FormatResponse{}
-	mi := &file_files_v1_files_proto_msgTypes[10]
+func (x *SearchContentsResponse) Reset() {
+	*x = SearchContentsResponse{}
+	mi := &file_files_v1_files_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FormatResponse) String() string {
+func (x *SearchContentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FormatResponse) ProtoMessage() {}
+func (*SearchContentsResponse) ProtoMessage() {}
 
-func (x *FormatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[10]
+func (x *SearchContentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,45 +1473,60 @@ func (x *FormatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FormatResponse.ProtoReflect.Descriptor instead.
-func (*FormatResponse) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SearchContentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchContentsResponse) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *FormatResponse) GetContents() string {
+func (x *SearchContentsResponse) GetFilename() string {
 	if x != nil {
-		return x.Contents
+		return x.Filename
 	}
 	return ""
 }
 
-type FsEntry struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Filename string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	IsDir    bool                   `protobuf:"varint,3,opt,name=isDir,proto3" json:"isDir,omitempty"`
-	SubFiles []*FsEntry             `protobuf:"bytes,4,rep,name=subFiles,proto3" json:"subFiles,omitempty"`
-	// flag for lazy loading
-	IsFetched       bool   `protobuf:"varint,5,opt,name=isFetched,proto3" json:"isFetched,omitempty"`
-	IsComposeFolder string `protobuf:"bytes,6,opt,name=isComposeFolder,proto3" json:"isComposeFolder,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *SearchContentsResponse) GetMatches() []*LineMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
 }
 
-func (x *FsEntry) Reset() {
-	*x = FsEntry{}
-	mi := &file_files_v1_files_proto_msgTypes[11]
+func (x *SearchContentsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type LineMatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line and column start at 1, column and length count characters
+	Line          int32    `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Column        int32    `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	Length        int32    `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	Text          string   `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Before        []string `protobuf:"bytes,5,rep,name=before,proto3" json:"before,omitempty"`
+	After         []string `protobuf:"bytes,6,rep,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineMatch) Reset() {
+	*x = LineMatch{}
+	mi := &file_files_v1_files_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FsEntry) String() string {
+func (x *LineMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FsEntry) ProtoMessage() {}
+func (*LineMatch) ProtoMessage() {}
 
-func (x *FsEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[11]
+func (x *LineMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,44 +1537,51 @@ func (x *FsEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FsEntry.ProtoReflect.Descriptor instead.
-func (*FsEntry) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use LineMatch.ProtoReflect.Descriptor instead.
+func (*LineMatch) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FsEntry) GetFilename() string {
+func (x *LineMatch) GetLine() int32 {
 	if x != nil {
-		return x.Filename
+		return x.Line
 	}
-	return ""
+	return 0
 }
 
-func (x *FsEntry) GetIsDir() bool {
+func (x *LineMatch) GetColumn() int32 {
 	if x != nil {
-		return x.IsDir
+		return x.Column
 	}
-	return false
+	return 0
 }
 
-func (x *FsEntry) GetSubFiles() []*FsEntry {
+func (x *LineMatch) GetLength() int32 {
 	if x != nil {
-		return x.SubFiles
+		return x.Length
 	}
-	return nil
+	return 0
 }
 
-func (x *FsEntry) GetIsFetched() bool {
+func (x *LineMatch) GetText() string {
 	if x != nil {
-		return x.IsFetched
+		return x.Text
 	}
-	return false
+	return ""
 }
 
-func (x *FsEntry) GetIsComposeFolder() string {
+func (x *LineMatch) GetBefore() []string {
 	if x != nil {
-		return x.IsComposeFolder
+		return x.Before
 	}
-	return ""
+	return nil
+}
+
+func (x *LineMatch) GetAfter() []string {
+	if x != nil {
+		return x.After
+	}
+	return nil
 }
 
 type RenameFile struct {
@@ -600,7 +1594,7 @@ type RenameFile struct {
 
 func (x *RenameFile) Reset() {
 	*x = RenameFile{}
-	mi := &file_files_v1_files_proto_msgTypes[12]
+	mi := &file_files_v1_files_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -612,7 +1606,7 @@ func (x *RenameFile) String() string {
 func (*RenameFile) ProtoMessage() {}
 
 func (x *RenameFile) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[12]
+	mi := &file_files_v1_files_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +1619,7 @@ func (x *RenameFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameFile.ProtoReflect.Descriptor instead.
 func (*RenameFile) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{12}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RenameFile) GetOldFilePath() string {
@@ -652,7 +1646,7 @@ type File struct {
 
 func (x *File) Reset() {
 	*x = File{}
-	mi := &file_files_v1_files_proto_msgTypes[13]
+	mi := &file_files_v1_files_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -664,7 +1658,7 @@ func (x *File) String() string {
 func (*File) ProtoMessage() {}
 
 func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[13]
+	mi := &file_files_v1_files_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,7 +1671,7 @@ func (x *File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use File.ProtoReflect.Descriptor instead.
 func (*File) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{13}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *File) GetFilename() string {
@@ -702,7 +1696,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_files_v1_files_proto_msgTypes[14]
+	mi := &file_files_v1_files_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -714,7 +1708,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[14]
+	mi := &file_files_v1_files_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,26 +1721,52 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{14}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{25}
 }
 
 var File_files_v1_files_proto protoreflect.FileDescriptor
 
 const file_files_v1_files_proto_rawDesc = "" +
 	"\n" +
-	"\x14files/v1/files.proto\x12\bfiles.v1\"L\n" +
+	"\x14files/v1/files.proto\x12\bfiles.v1\"j\n" +
 	"\x10WriteTmplRequest\x12\x10\n" +
 	"\x03dir\x18\x02 \x01(\tR\x03dir\x12&\n" +
-	"\x04tmpl\x18\x01 \x01(\v2\x12.files.v1.TemplateR\x04tmpl\"\x13\n" +
-	"\x11WriteTmplResponse\"'\n" +
+	"\x04tmpl\x18\x01 \x01(\v2\x12.files.v1.TemplateR\x04tmpl\x12\x1c\n" +
+	"\toverwrite\x18\x03 \x01(\bR\toverwrite\")\n" +
+	"\x11WriteTmplResponse\x12\x14\n" +
+	"\x05files\x18\x01 \x03(\tR\x05files\"W\n" +
+	"\x13PreviewTmplResponse\x12,\n" +
+	"\x05files\x18\x01 \x03(\v2\x16.files.v1.TemplateFileR\x05files\x12\x12\n" +
+	"\x04dirs\x18\x02 \x03(\tR\x04dirs\"v\n" +
+	"\fTemplateFile\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x1a\n" +
+	"\bcontents\x18\x02 \x01(\tR\bcontents\x12\x16\n" +
+	"\x06exists\x18\x03 \x01(\bR\x06exists\x12\x16\n" +
+	"\x06binary\x18\x04 \x01(\bR\x06binary\"A\n" +
 	"\x0fGetTmplsRequest\x12\x14\n" +
-	"\x05alias\x18\x01 \x01(\tR\x05alias\"\x89\x01\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x12\x18\n" +
+	"\arefresh\x18\x02 \x01(\bR\arefresh\"\xa0\x02\n" +
 	"\bTemplate\x12\x12\n" +
 	"\x04Name\x18\x01 \x01(\tR\x04Name\x120\n" +
-	"\x04vars\x18\x02 \x03(\v2\x1c.files.v1.Template.VarsEntryR\x04vars\x1a7\n" +
+	"\x04vars\x18\x02 \x03(\v2\x1c.files.v1.Template.VarsEntryR\x04vars\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12/\n" +
+	"\avarDefs\x18\x06 \x03(\v2\x15.files.v1.TemplateVarR\avarDefs\x12\x14\n" +
+	"\x05files\x18\a \x03(\tR\x05files\x1a7\n" +
 	"\tVarsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xff\x01\n" +
+	"\vTemplateVar\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\"\n" +
+	"\fdefaultValue\x18\x04 \x01(\tR\fdefaultValue\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x18\n" +
+	"\apattern\x18\x06 \x01(\tR\apattern\x12\x18\n" +
+	"\aoptions\x18\a \x03(\tR\aoptions\x12\x16\n" +
+	"\x06secret\x18\b \x01(\bR\x06secret\x12\x1a\n" +
+	"\bgenerate\x18\t \x01(\bR\bgenerate\">\n" +
 	"\x10GetTmplsResponse\x12*\n" +
 	"\x06templs\x18\x01 \x03(\v2\x12.files.v1.TemplateR\x06templs\"Y\n" +
 	"\vCopyRequest\x12&\n" +
@@ -766,7 +1786,66 @@ const file_files_v1_files_proto_rawDesc = "" +
 	"\x05isDir\x18\x03 \x01(\bR\x05isDir\x12-\n" +
 	"\bsubFiles\x18\x04 \x03(\v2\x11.files.v1.FsEntryR\bsubFiles\x12\x1c\n" +
 	"\tisFetched\x18\x05 \x01(\bR\tisFetched\x12(\n" +
-	"\x0fisComposeFolder\x18\x06 \x01(\tR\x0fisComposeFolder\"P\n" +
+	"\x0fisComposeFolder\x18\x06 \x01(\tR\x0fisComposeFolder\"\xc7\x01\n" +
+	"\x0fTransferRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x1e\n" +
+	"\n" +
+	"sourceHost\x18\x02 \x01(\tR\n" +
+	"sourceHost\x12\x12\n" +
+	"\x04dest\x18\x03 \x01(\tR\x04dest\x12\x1a\n" +
+	"\bdestHost\x18\x04 \x01(\tR\bdestHost\x12\x12\n" +
+	"\x04move\x18\x05 \x01(\bR\x04move\x128\n" +
+	"\n" +
+	"onConflict\x18\x06 \x01(\x0e2\x18.files.v1.ConflictPolicyR\n" +
+	"onConflict\"\xcc\x01\n" +
+	"\x10TransferProgress\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12\x12\n" +
+	"\x04dest\x18\x02 \x01(\tR\x04dest\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x1c\n" +
+	"\tbytesDone\x18\x04 \x01(\x03R\tbytesDone\x12\x1e\n" +
+	"\n" +
+	"bytesTotal\x18\x05 \x01(\x03R\n" +
+	"bytesTotal\x12\x1c\n" +
+	"\tfilesDone\x18\x06 \x01(\x05R\tfilesDone\x12\x1e\n" +
+	"\n" +
+	"filesTotal\x18\a \x01(\x05R\n" +
+	"filesTotal\"=\n" +
+	"\x11WatchFilesRequest\x12\x12\n" +
+	"\x04dirs\x18\x01 \x03(\tR\x04dirs\x12\x14\n" +
+	"\x05files\x18\x02 \x03(\tR\x05files\"A\n" +
+	"\x12WatchFilesResponse\x12+\n" +
+	"\x06events\x18\x01 \x03(\v2\x13.files.v1.FileEventR\x06events\"\xb1\x01\n" +
+	"\tFileEvent\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12 \n" +
+	"\voldFilename\x18\x03 \x01(\tR\voldFilename\x12\x14\n" +
+	"\x05isDir\x18\x04 \x01(\bR\x05isDir\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x18\n" +
+	"\amodTime\x18\x06 \x01(\x03R\amodTime\x12\x12\n" +
+	"\x04hash\x18\a \x01(\tR\x04hash\"\x93\x02\n" +
+	"\x15SearchContentsRequest\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x14\n" +
+	"\x05regex\x18\x03 \x01(\bR\x05regex\x12$\n" +
+	"\rcaseSensitive\x18\x04 \x01(\bR\rcaseSensitive\x12\x1c\n" +
+	"\twholeWord\x18\x05 \x01(\bR\twholeWord\x12\x18\n" +
+	"\ainclude\x18\x06 \x03(\tR\ainclude\x12\x18\n" +
+	"\aexclude\x18\a \x03(\tR\aexclude\x12\"\n" +
+	"\fcontextLines\x18\b \x01(\x05R\fcontextLines\x12\x1e\n" +
+	"\n" +
+	"maxMatches\x18\t \x01(\x05R\n" +
+	"maxMatches\"\x81\x01\n" +
+	"\x16SearchContentsResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12-\n" +
+	"\amatches\x18\x02 \x03(\v2\x13.files.v1.LineMatchR\amatches\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"\x91\x01\n" +
+	"\tLineMatch\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\x05R\x06column\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x05R\x06length\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x16\n" +
+	"\x06before\x18\x05 \x03(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x06 \x03(\tR\x05after\"P\n" +
 	"\n" +
 	"RenameFile\x12 \n" +
 	"\voldFilePath\x18\x01 \x01(\tR\voldFilePath\x12 \n" +
@@ -774,16 +1853,26 @@ const file_files_v1_files_proto_rawDesc = "" +
 	"\x04File\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x14\n" +
 	"\x05isDir\x18\x02 \x01(\bR\x05isDir\"\a\n" +
-	"\x05Empty2\x85\x04\n" +
+	"\x05Empty*5\n" +
+	"\x0eConflictPolicy\x12\b\n" +
+	"\x04SKIP\x10\x00\x12\r\n" +
+	"\tOVERWRITE\x10\x01\x12\n" +
+	"\n" +
+	"\x06RENAME\x10\x022\xbe\x06\n" +
 	"\vFileService\x127\n" +
 	"\x04List\x12\x15.files.v1.ListRequest\x1a\x16.files.v1.ListResponse\"\x00\x12+\n" +
 	"\x06Create\x12\x0e.files.v1.File\x1a\x0f.files.v1.Empty\"\x00\x127\n" +
 	"\x04Copy\x12\x15.files.v1.CopyRequest\x1a\x16.files.v1.CopyResponse\"\x00\x12+\n" +
 	"\x06Delete\x12\x0e.files.v1.File\x1a\x0f.files.v1.Empty\"\x00\x12+\n" +
 	"\x06Exists\x12\x0e.files.v1.File\x1a\x0f.files.v1.Empty\"\x00\x121\n" +
-	"\x06Rename\x12\x14.files.v1.RenameFile\x1a\x0f.files.v1.Empty\"\x00\x12C\n" +
+	"\x06Rename\x12\x14.files.v1.RenameFile\x1a\x0f.files.v1.Empty\"\x00\x12E\n" +
+	"\bTransfer\x12\x19.files.v1.TransferRequest\x1a\x1a.files.v1.TransferProgress\"\x000\x01\x12K\n" +
+	"\n" +
+	"WatchFiles\x12\x1b.files.v1.WatchFilesRequest\x1a\x1c.files.v1.WatchFilesResponse\"\x000\x01\x12W\n" +
+	"\x0eSearchContents\x12\x1f.files.v1.SearchContentsRequest\x1a .files.v1.SearchContentsResponse\"\x000\x01\x12C\n" +
 	"\bGetTmpls\x12\x19.files.v1.GetTmplsRequest\x1a\x1a.files.v1.GetTmplsResponse\"\x00\x12F\n" +
-	"\tWriteTmpl\x12\x1a.files.v1.WriteTmplRequest\x1a\x1b.files.v1.WriteTmplResponse\"\x00\x12=\n" +
+	"\tWriteTmpl\x12\x1a.files.v1.WriteTmplRequest\x1a\x1b.files.v1.WriteTmplResponse\"\x00\x12J\n" +
+	"\vPreviewTmpl\x12\x1a.files.v1.WriteTmplRequest\x1a\x1d.files.v1.PreviewTmplResponse\"\x00\x12=\n" +
 	"\x06Format\x12\x17.files.v1.FormatRequest\x1a\x18.files.v1.FormatResponse\"\x00B\x88\x01\n" +
 	"\fcom.files.v1B\n" +
 	"FilesProtoP\x01Z+github.com/RA341/dockman/generated/files/v1\xa2\x02\x03FXX\xaa\x02\bFiles.V1\xca\x02\bFiles\\V1\xe2\x02\x14Files\\V1\\GPBMetadata\xea\x02\tFiles::V1b\x06proto3"
@@ -800,56 +1889,82 @@ func file_files_v1_files_proto_rawDescGZIP() []byte {
 	return file_files_v1_files_proto_rawDescData
 }
 
-var file_files_v1_files_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_files_v1_files_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_files_v1_files_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_files_v1_files_proto_goTypes = []any{
-	(*WriteTmplRequest)(nil),  // 0: files.v1.WriteTmplRequest
-	(*WriteTmplResponse)(nil), // 1: files.v1.WriteTmplResponse
-	(*GetTmplsRequest)(nil),   // 2: files.v1.GetTmplsRequest
-	(*Template)(nil),          // 3: files.v1.Template
-	(*GetTmplsResponse)(nil),  // 4: files.v1.GetTmplsResponse
-	(*CopyRequest)(nil),       // 5: files.v1.CopyRequest
-	(*CopyResponse)(nil),      // 6: files.v1.CopyResponse
-	(*ListRequest)(nil),       // 7: files.v1.ListRequest
-	(*ListResponse)(nil),      // 8: files.v1.ListResponse
-	(*FormatRequest)(nil),     // 9: files.v1.FormatRequest
-	(*FormatResponse)(nil),    // 10: files.v1.FormatResponse
-	(*FsEntry)(nil),           // 11: files.v1.FsEntry
-	(*RenameFile)(nil),        // 12: files.v1.RenameFile
-	(*File)(nil),              // 13: files.v1.File
-	(*Empty)(nil),             // 14: files.v1.Empty
-	nil,                       // 15: files.v1.Template.VarsEntry
+	(ConflictPolicy)(0),            // 0: files.v1.ConflictPolicy
+	(*WriteTmplRequest)(nil),       // 1: files.v1.WriteTmplRequest
+	(*WriteTmplResponse)(nil),      // 2: files.v1.WriteTmplResponse
+	(*PreviewTmplResponse)(nil),    // 3: files.v1.PreviewTmplResponse
+	(*TemplateFile)(nil),           // 4: files.v1.TemplateFile
+	(*GetTmplsRequest)(nil),        // 5: files.v1.GetTmplsRequest
+	(*Template)(nil),               // 6: files.v1.Template
+	(*TemplateVar)(nil),            // 7: files.v1.TemplateVar
+	(*GetTmplsResponse)(nil),       // 8: files.v1.GetTmplsResponse
+	(*CopyRequest)(nil),            // 9: files.v1.CopyRequest
+	(*CopyResponse)(nil),           // 10: files.v1.CopyResponse
+	(*ListRequest)(nil),            // 11: files.v1.ListRequest
+	(*ListResponse)(nil),           // 12: files.v1.ListResponse
+	(*FormatRequest)(nil),          // 13: files.v1.FormatRequest
+	(*FormatResponse)(nil),         // 14: files.v1.FormatResponse
+	(*FsEntry)(nil),                // 15: files.v1.FsEntry
+	(*TransferRequest)(nil),        // 16: files.v1.TransferRequest
+	(*TransferProgress)(nil),       // 17: files.v1.TransferProgress
+	(*WatchFilesRequest)(nil),      // 18: files.v1.WatchFilesRequest
+	(*WatchFilesResponse)(nil),     // 19: files.v1.WatchFilesResponse
+	(*FileEvent)(nil),              // 20: files.v1.FileEvent
+	(*SearchContentsRequest)(nil),  // 21: files.v1.SearchContentsRequest
+	(*SearchContentsResponse)(nil), // 22: files.v1.SearchContentsResponse
+	(*LineMatch)(nil),              // 23: files.v1.LineMatch
+	(*RenameFile)(nil),             // 24: files.v1.RenameFile
+	(*File)(nil),                   // 25: files.v1.File
+	(*Empty)(nil),                  // 26: files.v1.Empty
+	nil,                            // 27: files.v1.Template.VarsEntry
 }
 var file_files_v1_files_proto_depIdxs = []int32{
-	3,  // 0: files.v1.WriteTmplRequest.tmpl:type_name -> files.v1.Template
-	15, // 1: files.v1.Template.vars:type_name -> files.v1.Template.VarsEntry
-	3,  // 2: files.v1.GetTmplsResponse.templs:type_name -> files.v1.Template
-	13, // 3: files.v1.CopyRequest.source:type_name -> files.v1.File
-	13, // 4: files.v1.CopyRequest.dest:type_name -> files.v1.File
-	11, // 5: files.v1.ListResponse.entries:type_name -> files.v1.FsEntry
-	11, // 6: files.v1.FsEntry.subFiles:type_name -> files.v1.FsEntry
-	7,  // 7: files.v1.FileService.List:input_type -> files.v1.ListRequest
-	13, // 8: files.v1.FileService.Create:input_type -> files.v1.File
-	5,  // 9: files.v1.FileService.Copy:input_type -> files.v1.CopyRequest
-	13, // 10: files.v1.FileService.Delete:input_type -> files.v1.File
-	13, // 11: files.v1.FileService.Exists:input_type -> files.v1.File
-	12, // 12: files.v1.FileService.Rename:input_type -> files.v1.RenameFile
-	2,  // 13: files.v1.FileService.GetTmpls:input_type -> files.v1.GetTmplsRequest
-	0,  // 14: files.v1.FileService.WriteTmpl:input_type -> files.v1.WriteTmplRequest
-	9,  // 15: files.v1.FileService.Format:input_type -> files.v1.FormatRequest
-	8,  // 16: files.v1.FileService.List:output_type -> files.v1.ListResponse
-	14, // 17: files.v1.FileService.Create:output_type -> files.v1.Empty
-	6,  // 18: files.v1.FileService.Copy:output_type -> files.v1.CopyResponse
-	14, // 19: files.v1.FileService.Delete:output_type -> files.v1.Empty
-	14, // 20: files.v1.FileService.Exists:output_type -> files.v1.Empty
-	14, // 21: files.v1.FileService.Rename:output_type -> files.v1.Empty
-	4,  // 22: files.v1.FileService.GetTmpls:output_type -> files.v1.GetTmplsResponse
-	1,  // 23: files.v1.FileService.WriteTmpl:output_type -> files.v1.WriteTmplResponse
-	10, // 24: files.v1.FileService.Format:output_type -> files.v1.FormatResponse
-	16, // [16:25] is the sub-list for method output_type
-	7,  // [7:16] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	6,  // 0: files.v1.WriteTmplRequest.tmpl:type_name -> files.v1.Template
+	4,  // 1: files.v1.PreviewTmplResponse.files:type_name -> files.v1.TemplateFile
+	27, // 2: files.v1.Template.vars:type_name -> files.v1.Template.VarsEntry
+	7,  // 3: files.v1.Template.varDefs:type_name -> files.v1.TemplateVar
+	6,  // 4: files.v1.GetTmplsResponse.templs:type_name -> files.v1.Template
+	25, // 5: files.v1.CopyRequest.source:type_name -> files.v1.File
+	25, // 6: files.v1.CopyRequest.dest:type_name -> files.v1.File
+	15, // 7: files.v1.ListResponse.entries:type_name -> files.v1.FsEntry
+	15, // 8: files.v1.FsEntry.subFiles:type_name -> files.v1.FsEntry
+	0,  // 9: files.v1.TransferRequest.onConflict:type_name -> files.v1.ConflictPolicy
+	20, // 10: files.v1.WatchFilesResponse.events:type_name -> files.v1.FileEvent
+	23, // 11: files.v1.SearchContentsResponse.matches:type_name -> files.v1.LineMatch
+	11, // 12: files.v1.FileService.List:input_type -> files.v1.ListRequest
+	25, // 13: files.v1.FileService.Create:input_type -> files.v1.File
+	9,  // 14: files.v1.FileService.Copy:input_type -> files.v1.CopyRequest
+	25, // 15: files.v1.FileService.Delete:input_type -> files.v1.File
+	25, // 16: files.v1.FileService.Exists:input_type -> files.v1.File
+	24, // 17: files.v1.FileService.Rename:input_type -> files.v1.RenameFile
+	16, // 18: files.v1.FileService.Transfer:input_type -> files.v1.TransferRequest
+	18, // 19: files.v1.FileService.WatchFiles:input_type -> files.v1.WatchFilesRequest
+	21, // 20: files.v1.FileService.SearchContents:input_type -> files.v1.SearchContentsRequest
+	5,  // 21: files.v1.FileService.GetTmpls:input_type -> files.v1.GetTmplsRequest
+	1,  // 22: files.v1.FileService.WriteTmpl:input_type -> files.v1.WriteTmplRequest
+	1,  // 23: files.v1.FileService.PreviewTmpl:input_type -> files.v1.WriteTmplRequest
+	13, // 24: files.v1.FileService.Format:input_type -> files.v1.FormatRequest
+	12, // 25: files.v1.FileService.List:output_type -> files.v1.ListResponse
+	26, // 26: files.v1.FileService.Create:output_type -> files.v1.Empty
+	10, // 27: files.v1.FileService.Copy:output_type -> files.v1.CopyResponse
+	26, // 28: files.v1.FileService.Delete:output_type -> files.v1.Empty
+	26, // 29: files.v1.FileService.Exists:output_type -> files.v1.Empty
+	26, // 30: files.v1.FileService.Rename:output_type -> files.v1.Empty
+	17, // 31: files.v1.FileService.Transfer:output_type -> files.v1.TransferProgress
+	19, // 32: files.v1.FileService.WatchFiles:output_type -> files.v1.WatchFilesResponse
+	22, // 33: files.v1.FileService.SearchContents:output_type -> files.v1.SearchContentsResponse
+	8,  // 34: files.v1.FileService.GetTmpls:output_type -> files.v1.GetTmplsResponse
+	2,  // 35: files.v1.FileService.WriteTmpl:output_type -> files.v1.WriteTmplResponse
+	3,  // 36: files.v1.FileService.PreviewTmpl:output_type -> files.v1.PreviewTmplResponse
+	14, // 37: files.v1.FileService.Format:output_type -> files.v1.FormatResponse
+	25, // [25:38] is the sub-list for method output_type
+	12, // [12:25] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_files_v1_files_proto_init() }
@@ -862,13 +1977,14 @@ func file_files_v1_files_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_files_v1_files_proto_rawDesc), len(file_files_v1_files_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      1,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_files_v1_files_proto_goTypes,
 		DependencyIndexes: file_files_v1_files_proto_depIdxs,
+		EnumInfos:         file_files_v1_files_proto_enumTypes,
 		MessageInfos:      file_files_v1_files_proto_msgTypes,
 	}.Build()
 	File_files_v1_files_proto = out.File
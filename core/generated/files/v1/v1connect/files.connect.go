@@ -45,10 +45,19 @@ const (
 	FileServiceExistsProcedure = "/files.v1.FileService/Exists"
 	// FileServiceRenameProcedure is the fully-qualified name of the FileService's Rename RPC.
 	FileServiceRenameProcedure = "/files.v1.FileService/Rename"
+	// FileServiceTransferProcedure is the fully-qualified name of the FileService's Transfer RPC.
+	FileServiceTransferProcedure = "/files.v1.FileService/Transfer"
+	// FileServiceWatchFilesProcedure is the fully-qualified name of the FileService's WatchFiles RPC.
+	FileServiceWatchFilesProcedure = "/files.v1.FileService/WatchFiles"
+	// FileServiceSearchContentsProcedure is the fully-qualified name of the FileService's
+	// SearchContents RPC.
+	FileServiceSearchContentsProcedure = "/files.v1.FileService/SearchContents"
 	// FileServiceGetTmplsProcedure is the fully-qualified name of the FileService's GetTmpls RPC.
 	FileServiceGetTmplsProcedure = "/files.v1.FileService/GetTmpls"
 	// FileServiceWriteTmplProcedure is the fully-qualified name of the FileService's WriteTmpl RPC.
 	FileServiceWriteTmplProcedure = "/files.v1.FileService/WriteTmpl"
+	// FileServicePreviewTmplProcedure is the fully-qualified name of the FileService's PreviewTmpl RPC.
+	FileServicePreviewTmplProcedure = "/files.v1.FileService/PreviewTmpl"
 	// FileServiceFormatProcedure is the fully-qualified name of the FileService's Format RPC.
 	FileServiceFormatProcedure = "/files.v1.FileService/Format"
 )
@@ -61,8 +70,17 @@ type FileServiceClient interface {
 	Delete(context.Context, *connect.Request[v1.File]) (*connect.Response[v1.Empty], error)
 	Exists(context.Context, *connect.Request[v1.File]) (*connect.Response[v1.Empty], error)
 	Rename(context.Context, *connect.Request[v1.RenameFile]) (*connect.Response[v1.Empty], error)
+	// Transfer recursively copies or moves files across aliases and hosts
+	Transfer(context.Context, *connect.Request[v1.TransferRequest]) (*connect.ServerStreamForClient[v1.TransferProgress], error)
+	// WatchFiles streams changes made on disk to the tree and open files,
+	// including ones made outside dockman
+	WatchFiles(context.Context, *connect.Request[v1.WatchFilesRequest]) (*connect.ServerStreamForClient[v1.WatchFilesResponse], error)
+	// SearchContents streams the lines matching a query, one file at a time
+	SearchContents(context.Context, *connect.Request[v1.SearchContentsRequest]) (*connect.ServerStreamForClient[v1.SearchContentsResponse], error)
 	GetTmpls(context.Context, *connect.Request[v1.GetTmplsRequest]) (*connect.Response[v1.GetTmplsResponse], error)
 	WriteTmpl(context.Context, *connect.Request[v1.WriteTmplRequest]) (*connect.Response[v1.WriteTmplResponse], error)
+	// PreviewTmpl renders a template without writing it, secrets are masked
+	PreviewTmpl(context.Context, *connect.Request[v1.WriteTmplRequest]) (*connect.Response[v1.PreviewTmplResponse], error)
 	Format(context.Context, *connect.Request[v1.FormatRequest]) (*connect.Response[v1.FormatResponse], error)
 }
 
@@ -113,6 +131,24 @@ func NewFileServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(fileServiceMethods.ByName("Rename")),
 			connect.WithClientOptions(opts...),
 		),
+		transfer: connect.NewClient[v1.TransferRequest, v1.TransferProgress](
+			httpClient,
+			baseURL+FileServiceTransferProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("Transfer")),
+			connect.WithClientOptions(opts...),
+		),
+		watchFiles: connect.NewClient[v1.WatchFilesRequest, v1.WatchFilesResponse](
+			httpClient,
+			baseURL+FileServiceWatchFilesProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("WatchFiles")),
+			connect.WithClientOptions(opts...),
+		),
+		searchContents: connect.NewClient[v1.SearchContentsRequest, v1.SearchContentsResponse](
+			httpClient,
+			baseURL+FileServiceSearchContentsProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("SearchContents")),
+			connect.WithClientOptions(opts...),
+		),
 		getTmpls: connect.NewClient[v1.GetTmplsRequest, v1.GetTmplsResponse](
 			httpClient,
 			baseURL+FileServiceGetTmplsProcedure,
@@ -125,6 +161,12 @@ func NewFileServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(fileServiceMethods.ByName("WriteTmpl")),
 			connect.WithClientOptions(opts...),
 		),
+		previewTmpl: connect.NewClient[v1.WriteTmplRequest, v1.PreviewTmplResponse](
+			httpClient,
+			baseURL+FileServicePreviewTmplProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("PreviewTmpl")),
+			connect.WithClientOptions(opts...),
+		),
 		format: connect.NewClient[v1.FormatRequest, v1.FormatResponse](
 			httpClient,
 			baseURL+FileServiceFormatProcedure,
@@ -136,15 +178,19 @@ func NewFileServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // fileServiceClient implements FileServiceClient.
 type fileServiceClient struct {
-	list      *connect.Client[v1.ListRequest, v1.ListResponse]
-	create    *connect.Client[v1.File, v1.Empty]
-	copy      *connect.Client[v1.CopyRequest, v1.CopyResponse]
-	delete    *connect.Client[v1.File, v1.Empty]
-	exists    *connect.Client[v1.File, v1.Empty]
-	rename    *connect.Client[v1.RenameFile, v1.Empty]
-	getTmpls  *connect.Client[v1.GetTmplsRequest, v1.GetTmplsResponse]
-	writeTmpl *connect.Client[v1.WriteTmplRequest, v1.WriteTmplResponse]
-	format    *connect.Client[v1.FormatRequest, v1.FormatResponse]
+	list           *connect.Client[v1.ListRequest, v1.ListResponse]
+	create         *connect.Client[v1.File, v1.Empty]
+	copy           *connect.Client[v1.CopyRequest, v1.CopyResponse]
+	delete         *connect.Client[v1.File, v1.Empty]
+	exists         *connect.Client[v1.File, v1.Empty]
+	rename         *connect.Client[v1.RenameFile, v1.Empty]
+	transfer       *connect.Client[v1.TransferRequest, v1.TransferProgress]
+	watchFiles     *connect.Client[v1.WatchFilesRequest, v1.WatchFilesResponse]
+	searchContents *connect.Client[v1.SearchContentsRequest, v1.SearchContentsResponse]
+	getTmpls       *connect.Client[v1.GetTmplsRequest, v1.GetTmplsResponse]
+	writeTmpl      *connect.Client[v1.WriteTmplRequest, v1.WriteTmplResponse]
+	previewTmpl    *connect.Client[v1.WriteTmplRequest, v1.PreviewTmplResponse]
+	format         *connect.Client[v1.FormatRequest, v1.FormatResponse]
 }
 
 // List calls files.v1.FileService.List.
@@ -177,6 +223,21 @@ func (c *fileServiceClient) Rename(ctx context.Context, req *connect.Request[v1.
 	return c.rename.CallUnary(ctx, req)
 }
 
+// Transfer calls files.v1.FileService.Transfer.
+func (c *fileServiceClient) Transfer(ctx context.Context, req *connect.Request[v1.TransferRequest]) (*connect.ServerStreamForClient[v1.TransferProgress], error) {
+	return c.transfer.CallServerStream(ctx, req)
+}
+
+// WatchFiles calls files.v1.FileService.WatchFiles.
+func (c *fileServiceClient) WatchFiles(ctx context.Context, req *connect.Request[v1.WatchFilesRequest]) (*connect.ServerStreamForClient[v1.WatchFilesResponse], error) {
+	return c.watchFiles.CallServerStream(ctx, req)
+}
+
+// SearchContents calls files.v1.FileService.SearchContents.
+func (c *fileServiceClient) SearchContents(ctx context.Context, req *connect.Request[v1.SearchContentsRequest]) (*connect.ServerStreamForClient[v1.SearchContentsResponse], error) {
+	return c.searchContents.CallServerStream(ctx, req)
+}
+
 // GetTmpls calls files.v1.FileService.GetTmpls.
 func (c *fileServiceClient) GetTmpls(ctx context.Context, req *connect.Request[v1.GetTmplsRequest]) (*connect.Response[v1.GetTmplsResponse], error) {
 	return c.getTmpls.CallUnary(ctx, req)
@@ -187,6 +248,11 @@ func (c *fileServiceClient) WriteTmpl(ctx context.Context, req *connect.Request[
 	return c.writeTmpl.CallUnary(ctx, req)
 }
 
+// PreviewTmpl calls files.v1.FileService.PreviewTmpl.
+func (c *fileServiceClient) PreviewTmpl(ctx context.Context, req *connect.Request[v1.WriteTmplRequest]) (*connect.Response[v1.PreviewTmplResponse], error) {
+	return c.previewTmpl.CallUnary(ctx, req)
+}
+
 // Format calls files.v1.FileService.Format.
 func (c *fileServiceClient) Format(ctx context.Context, req *connect.Request[v1.FormatRequest]) (*connect.Response[v1.FormatResponse], error) {
 	return c.format.CallUnary(ctx, req)
@@ -200,8 +266,17 @@ type FileServiceHandler interface {
 	Delete(context.Context, *connect.Request[v1.File]) (*connect.Response[v1.Empty], error)
 	Exists(context.Context, *connect.Request[v1.File]) (*connect.Response[v1.Empty], error)
 	Rename(context.Context, *connect.Request[v1.RenameFile]) (*connect.Response[v1.Empty], error)
+	// Transfer recursively copies or moves files across aliases and hosts
+	Transfer(context.Context, *connect.Request[v1.TransferRequest], *connect.ServerStream[v1.TransferProgress]) error
+	// WatchFiles streams changes made on disk to the tree and open files,
+	// including ones made outside dockman
+	WatchFiles(context.Context, *connect.Request[v1.WatchFilesRequest], *connect.ServerStream[v1.WatchFilesResponse]) error
+	// SearchContents streams the lines matching a query, one file at a time
+	SearchContents(context.Context, *connect.Request[v1.SearchContentsRequest], *connect.ServerStream[v1.SearchContentsResponse]) error
 	GetTmpls(context.Context, *connect.Request[v1.GetTmplsRequest]) (*connect.Response[v1.GetTmplsResponse], error)
 	WriteTmpl(context.Context, *connect.Request[v1.WriteTmplRequest]) (*connect.Response[v1.WriteTmplResponse], error)
+	// PreviewTmpl renders a template without writing it, secrets are masked
+	PreviewTmpl(context.Context, *connect.Request[v1.WriteTmplRequest]) (*connect.Response[v1.PreviewTmplResponse], error)
 	Format(context.Context, *connect.Request[v1.FormatRequest]) (*connect.Response[v1.FormatResponse], error)
 }
 
@@ -248,6 +323,24 @@ func NewFileServiceHandler(svc FileServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(fileServiceMethods.ByName("Rename")),
 		connect.WithHandlerOptions(opts...),
 	)
+	fileServiceTransferHandler := connect.NewServerStreamHandler(
+		FileServiceTransferProcedure,
+		svc.Transfer,
+		connect.WithSchema(fileServiceMethods.ByName("Transfer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceWatchFilesHandler := connect.NewServerStreamHandler(
+		FileServiceWatchFilesProcedure,
+		svc.WatchFiles,
+		connect.WithSchema(fileServiceMethods.ByName("WatchFiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceSearchContentsHandler := connect.NewServerStreamHandler(
+		FileServiceSearchContentsProcedure,
+		svc.SearchContents,
+		connect.WithSchema(fileServiceMethods.ByName("SearchContents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	fileServiceGetTmplsHandler := connect.NewUnaryHandler(
 		FileServiceGetTmplsProcedure,
 		svc.GetTmpls,
@@ -260,6 +353,12 @@ func NewFileServiceHandler(svc FileServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(fileServiceMethods.ByName("WriteTmpl")),
 		connect.WithHandlerOptions(opts...),
 	)
+	fileServicePreviewTmplHandler := connect.NewUnaryHandler(
+		FileServicePreviewTmplProcedure,
+		svc.PreviewTmpl,
+		connect.WithSchema(fileServiceMethods.ByName("PreviewTmpl")),
+		connect.WithHandlerOptions(opts...),
+	)
 	fileServiceFormatHandler := connect.NewUnaryHandler(
 		FileServiceFormatProcedure,
 		svc.Format,
@@ -280,10 +379,18 @@ func NewFileServiceHandler(svc FileServiceHandler, opts ...connect.HandlerOption
 			fileServiceExistsHandler.ServeHTTP(w, r)
 		case FileServiceRenameProcedure:
 			fileServiceRenameHandler.ServeHTTP(w, r)
+		case FileServiceTransferProcedure:
+			fileServiceTransferHandler.ServeHTTP(w, r)
+		case FileServiceWatchFilesProcedure:
+			fileServiceWatchFilesHandler.ServeHTTP(w, r)
+		case FileServiceSearchContentsProcedure:
+			fileServiceSearchContentsHandler.ServeHTTP(w, r)
 		case FileServiceGetTmplsProcedure:
 			fileServiceGetTmplsHandler.ServeHTTP(w, r)
 		case FileServiceWriteTmplProcedure:
 			fileServiceWriteTmplHandler.ServeHTTP(w, r)
+		case FileServicePreviewTmplProcedure:
+			fileServicePreviewTmplHandler.ServeHTTP(w, r)
 		case FileServiceFormatProcedure:
 			fileServiceFormatHandler.ServeHTTP(w, r)
 		default:
@@ -319,6 +426,18 @@ func (UnimplementedFileServiceHandler) Rename(context.Context, *connect.Request[
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.Rename is not implemented"))
 }
 
+func (UnimplementedFileServiceHandler) Transfer(context.Context, *connect.Request[v1.TransferRequest], *connect.ServerStream[v1.TransferProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.Transfer is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) WatchFiles(context.Context, *connect.Request[v1.WatchFilesRequest], *connect.ServerStream[v1.WatchFilesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.WatchFiles is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) SearchContents(context.Context, *connect.Request[v1.SearchContentsRequest], *connect.ServerStream[v1.SearchContentsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.SearchContents is not implemented"))
+}
+
 func (UnimplementedFileServiceHandler) GetTmpls(context.Context, *connect.Request[v1.GetTmplsRequest]) (*connect.Response[v1.GetTmplsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.GetTmpls is not implemented"))
 }
@@ -327,6 +446,10 @@ func (UnimplementedFileServiceHandler) WriteTmpl(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.WriteTmpl is not implemented"))
 }
 
+func (UnimplementedFileServiceHandler) PreviewTmpl(context.Context, *connect.Request[v1.WriteTmplRequest]) (*connect.Response[v1.PreviewTmplResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.PreviewTmpl is not implemented"))
+}
+
 func (UnimplementedFileServiceHandler) Format(context.Context, *connect.Request[v1.FormatRequest]) (*connect.Response[v1.FormatResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.Format is not implemented"))
 }
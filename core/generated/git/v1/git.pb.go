@@ -21,15 +21,1168 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type GetSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncRequest) Reset() {
+	*x = GetSyncRequest{}
+	mi := &file_git_v1_git_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncRequest) ProtoMessage() {}
+
+func (x *GetSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncRequest) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSyncRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type SyncConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Alias             string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Enabled           bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Remote            string                 `protobuf:"bytes,3,opt,name=remote,proto3" json:"remote,omitempty"`
+	Branch            string                 `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	IntervalInMinutes uint32                 `protobuf:"varint,5,opt,name=intervalInMinutes,proto3" json:"intervalInMinutes,omitempty"`
+	// run compose up on every stack changed by a sync
+	Deploy   bool   `protobuf:"varint,6,opt,name=deploy,proto3" json:"deploy,omitempty"`
+	Username string `protobuf:"bytes,7,opt,name=username,proto3" json:"username,omitempty"`
+	// write only, empty keeps the saved token
+	Token    string `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
+	HasToken bool   `protobuf:"varint,9,opt,name=hasToken,proto3" json:"hasToken,omitempty"`
+	// name of the ssh key used for ssh remotes, empty is the dockman key
+	SshKey string `protobuf:"bytes,10,opt,name=sshKey,proto3" json:"sshKey,omitempty"`
+	// read only, saved on the first connect to an ssh remote
+	HostKey       string `protobuf:"bytes,11,opt,name=hostKey,proto3" json:"hostKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncConfig) Reset() {
+	*x = SyncConfig{}
+	mi := &file_git_v1_git_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncConfig) ProtoMessage() {}
+
+func (x *SyncConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncConfig.ProtoReflect.Descriptor instead.
+func (*SyncConfig) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SyncConfig) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *SyncConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SyncConfig) GetRemote() string {
+	if x != nil {
+		return x.Remote
+	}
+	return ""
+}
+
+func (x *SyncConfig) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *SyncConfig) GetIntervalInMinutes() uint32 {
+	if x != nil {
+		return x.IntervalInMinutes
+	}
+	return 0
+}
+
+func (x *SyncConfig) GetDeploy() bool {
+	if x != nil {
+		return x.Deploy
+	}
+	return false
+}
+
+func (x *SyncConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SyncConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SyncConfig) GetHasToken() bool {
+	if x != nil {
+		return x.HasToken
+	}
+	return false
+}
+
+func (x *SyncConfig) GetSshKey() string {
+	if x != nil {
+		return x.SshKey
+	}
+	return ""
+}
+
+func (x *SyncConfig) GetHostKey() string {
+	if x != nil {
+		return x.HostKey
+	}
+	return ""
+}
+
+type RunSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunSyncRequest) Reset() {
+	*x = RunSyncRequest{}
+	mi := &file_git_v1_git_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSyncRequest) ProtoMessage() {}
+
+func (x *RunSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSyncRequest.ProtoReflect.Descriptor instead.
+func (*RunSyncRequest) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RunSyncRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type ListSyncHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncHistoryRequest) Reset() {
+	*x = ListSyncHistoryRequest{}
+	mi := &file_git_v1_git_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncHistoryRequest) ProtoMessage() {}
+
+func (x *ListSyncHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListSyncHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSyncHistoryRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type ListSyncHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*SyncRun             `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncHistoryResponse) Reset() {
+	*x = ListSyncHistoryResponse{}
+	mi := &file_git_v1_git_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncHistoryResponse) ProtoMessage() {}
+
+func (x *ListSyncHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListSyncHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSyncHistoryResponse) GetRuns() []*SyncRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type SyncRun struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unix seconds
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// up-to-date, updated, conflict, failed
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	From  string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To    string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// files changed relative to the alias
+	Changed       []string      `protobuf:"bytes,6,rep,name=changed,proto3" json:"changed,omitempty"`
+	Deploys       []*SyncDeploy `protobuf:"bytes,7,rep,name=deploys,proto3" json:"deploys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncRun) Reset() {
+	*x = SyncRun{}
+	mi := &file_git_v1_git_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRun) ProtoMessage() {}
+
+func (x *SyncRun) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRun.ProtoReflect.Descriptor instead.
+func (*SyncRun) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SyncRun) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *SyncRun) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SyncRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SyncRun) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SyncRun) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SyncRun) GetChanged() []string {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *SyncRun) GetDeploys() []*SyncDeploy {
+	if x != nil {
+		return x.Deploys
+	}
+	return nil
+}
+
+type SyncDeploy struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Filename string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// pending, running, succeeded, failed, skipped
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// attach to the output with the docker JobAttach rpc
+	JobId         string `protobuf:"bytes,4,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncDeploy) Reset() {
+	*x = SyncDeploy{}
+	mi := &file_git_v1_git_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncDeploy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncDeploy) ProtoMessage() {}
+
+func (x *SyncDeploy) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncDeploy.ProtoReflect.Descriptor instead.
+func (*SyncDeploy) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SyncDeploy) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *SyncDeploy) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SyncDeploy) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SyncDeploy) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DiffRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// <alias>/<relpath>
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// commit hash, empty is the commit before to
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// commit hash, empty is the file as it is now
+	To            string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRequest) Reset() {
+	*x = DiffRequest{}
+	mi := &file_git_v1_git_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRequest) ProtoMessage() {}
+
+func (x *DiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRequest.ProtoReflect.Descriptor instead.
+func (*DiffRequest) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiffRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DiffRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DiffRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type DiffResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unified diff
+	Diff          string `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffResponse) Reset() {
+	*x = DiffResponse{}
+	mi := &file_git_v1_git_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffResponse) ProtoMessage() {}
+
+func (x *DiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffResponse.ProtoReflect.Descriptor instead.
+func (*DiffResponse) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DiffResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type StatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusRequest) Reset() {
+	*x = StatusRequest{}
+	mi := &file_git_v1_git_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusRequest) ProtoMessage() {}
+
+func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StatusRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*FileStatus          `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	mi := &file_git_v1_git_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StatusResponse) GetFiles() []*FileStatus {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type FileStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// <alias>/<relpath>
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// unmodified, untracked, modified, added, deleted, renamed, copied, unmerged
+	Staging       string `protobuf:"bytes,2,opt,name=staging,proto3" json:"staging,omitempty"`
+	Worktree      string `protobuf:"bytes,3,opt,name=worktree,proto3" json:"worktree,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileStatus) Reset() {
+	*x = FileStatus{}
+	mi := &file_git_v1_git_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileStatus) ProtoMessage() {}
+
+func (x *FileStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileStatus.ProtoReflect.Descriptor instead.
+func (*FileStatus) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FileStatus) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *FileStatus) GetStaging() string {
+	if x != nil {
+		return x.Staging
+	}
+	return ""
+}
+
+func (x *FileStatus) GetWorktree() string {
+	if x != nil {
+		return x.Worktree
+	}
+	return ""
+}
+
+type StageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Alias string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// relative to the alias
+	Files         []string `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageRequest) Reset() {
+	*x = StageRequest{}
+	mi := &file_git_v1_git_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageRequest) ProtoMessage() {}
+
+func (x *StageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageRequest.ProtoReflect.Descriptor instead.
+func (*StageRequest) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StageRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *StageRequest) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type PushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushRequest) Reset() {
+	*x = PushRequest{}
+	mi := &file_git_v1_git_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushRequest) ProtoMessage() {}
+
+func (x *PushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushRequest.ProtoReflect.Descriptor instead.
+func (*PushRequest) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PushRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type PullRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_git_v1_git_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PullRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type GitProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// git transfer progress output
+	Output string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// set on the last message of a pull
+	Result        *SyncRun `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitProgress) Reset() {
+	*x = GitProgress{}
+	mi := &file_git_v1_git_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitProgress) ProtoMessage() {}
+
+func (x *GitProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitProgress.ProtoReflect.Descriptor instead.
+func (*GitProgress) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GitProgress) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *GitProgress) GetResult() *SyncRun {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type RollbackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// compose file of the stack as <alias>/<relpath>
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Commit   string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// run compose up once the files are restored
+	Deploy        bool `protobuf:"varint,3,opt,name=deploy,proto3" json:"deploy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackRequest) Reset() {
+	*x = RollbackRequest{}
+	mi := &file_git_v1_git_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackRequest) ProtoMessage() {}
+
+func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackRequest.ProtoReflect.Descriptor instead.
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RollbackRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *RollbackRequest) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *RollbackRequest) GetDeploy() bool {
+	if x != nil {
+		return x.Deploy
+	}
+	return false
+}
+
+type RollbackProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// set on the first message, empty if the stack already matched the commit
+	Commit string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// restored or removed files, <alias>/<relpath>
+	Files []string `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	// set once the deploy job started
+	JobId string `protobuf:"bytes,3,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	// compose up output
+	Output        string `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackProgress) Reset() {
+	*x = RollbackProgress{}
+	mi := &file_git_v1_git_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackProgress) ProtoMessage() {}
+
+func (x *RollbackProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackProgress.ProtoReflect.Descriptor instead.
+func (*RollbackProgress) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RollbackProgress) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *RollbackProgress) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *RollbackProgress) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *RollbackProgress) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type GetIgnoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIgnoreRequest) Reset() {
+	*x = GetIgnoreRequest{}
+	mi := &file_git_v1_git_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIgnoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIgnoreRequest) ProtoMessage() {}
+
+func (x *GetIgnoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIgnoreRequest.ProtoReflect.Descriptor instead.
+func (*GetIgnoreRequest) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetIgnoreRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type IgnoreFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Contents      string                 `protobuf:"bytes,2,opt,name=contents,proto3" json:"contents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IgnoreFile) Reset() {
+	*x = IgnoreFile{}
+	mi := &file_git_v1_git_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IgnoreFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgnoreFile) ProtoMessage() {}
+
+func (x *IgnoreFile) ProtoReflect() protoreflect.Message {
+	mi := &file_git_v1_git_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgnoreFile.ProtoReflect.Descriptor instead.
+func (*IgnoreFile) Descriptor() ([]byte, []int) {
+	return file_git_v1_git_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *IgnoreFile) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *IgnoreFile) GetContents() string {
+	if x != nil {
+		return x.Contents
+	}
+	return ""
+}
+
 type ListBranchesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListBranchesRequest) Reset() {
 	*x = ListBranchesRequest{}
-	mi := &file_git_v1_git_proto_msgTypes[0]
+	mi := &file_git_v1_git_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -41,7 +1194,7 @@ func (x *ListBranchesRequest) String() string {
 func (*ListBranchesRequest) ProtoMessage() {}
 
 func (x *ListBranchesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_git_v1_git_proto_msgTypes[0]
+	mi := &file_git_v1_git_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -54,7 +1207,14 @@ func (x *ListBranchesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBranchesRequest.ProtoReflect.Descriptor instead.
 func (*ListBranchesRequest) Descriptor() ([]byte, []int) {
-	return file_git_v1_git_proto_rawDescGZIP(), []int{0}
+	return file_git_v1_git_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListBranchesRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
 }
 
 type ListBranchesResponse struct {
@@ -66,7 +1226,7 @@ type ListBranchesResponse struct {
 
 func (x *ListBranchesResponse) Reset() {
 	*x = ListBranchesResponse{}
-	mi := &file_git_v1_git_proto_msgTypes[1]
+	mi := &file_git_v1_git_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -78,7 +1238,7 @@ func (x *ListBranchesResponse) String() string {
 func (*ListBranchesResponse) ProtoMessage() {}
 
 func (x *ListBranchesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_git_v1_git_proto_msgTypes[1]
+	mi := &file_git_v1_git_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -91,7 +1251,7 @@ func (x *ListBranchesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBranchesResponse.ProtoReflect.Descriptor instead.
 func (*ListBranchesResponse) Descriptor() ([]byte, []int) {
-	return file_git_v1_git_proto_rawDescGZIP(), []int{1}
+	return file_git_v1_git_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListBranchesResponse) GetBranches() []string {
@@ -104,13 +1264,14 @@ func (x *ListBranchesResponse) GetBranches() []string {
 type BranchListFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Branch        string                 `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BranchListFileRequest) Reset() {
 	*x = BranchListFileRequest{}
-	mi := &file_git_v1_git_proto_msgTypes[2]
+	mi := &file_git_v1_git_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -122,7 +1283,7 @@ func (x *BranchListFileRequest) String() string {
 func (*BranchListFileRequest) ProtoMessage() {}
 
 func (x *BranchListFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_git_v1_git_proto_msgTypes[2]
+	mi := &file_git_v1_git_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -135,7 +1296,7 @@ func (x *BranchListFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BranchListFileRequest.ProtoReflect.Descriptor instead.
 func (*BranchListFileRequest) Descriptor() ([]byte, []int) {
-	return file_git_v1_git_proto_rawDescGZIP(), []int{2}
+	return file_git_v1_git_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *BranchListFileRequest) GetBranch() string {
@@ -145,6 +1306,13 @@ func (x *BranchListFileRequest) GetBranch() string {
 	return ""
 }
 
+func (x *BranchListFileRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
 type BranchListFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Files         []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
@@ -154,7 +1322,7 @@ type BranchListFileResponse struct {
 
 func (x *BranchListFileResponse) Reset() {
 	*x = BranchListFileResponse{}
-	mi := &file_git_v1_git_proto_msgTypes[3]
+	mi := &file_git_v1_git_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -166,7 +1334,7 @@ func (x *BranchListFileResponse) String() string {
 func (*BranchListFileResponse) ProtoMessage() {}
 
 func (x *BranchListFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_git_v1_git_proto_msgTypes[3]
+	mi := &file_git_v1_git_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -179,7 +1347,7 @@ func (x *BranchListFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BranchListFileResponse.ProtoReflect.Descriptor instead.
 func (*BranchListFileResponse) Descriptor() ([]byte, []int) {
-	return file_git_v1_git_proto_rawDescGZIP(), []int{3}
+	return file_git_v1_git_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *BranchListFileResponse) GetFiles() []string {
@@ -193,13 +1361,14 @@ type FileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Branch        string                 `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
 	Filepath      []string               `protobuf:"bytes,2,rep,name=filepath,proto3" json:"filepath,omitempty"`
+	Alias         string                 `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FileRequest) Reset() {
 	*x = FileRequest{}
-	mi := &file_git_v1_git_proto_msgTypes[4]
+	mi := &file_git_v1_git_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -211,7 +1380,7 @@ func (x *FileRequest) String() string {
 func (*FileRequest) ProtoMessage() {}
 
 func (x *FileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_git_v1_git_proto_msgTypes[4]
+	mi := &file_git_v1_git_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -224,7 +1393,7 @@ func (x *FileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileRequest.ProtoReflect.Descriptor instead.
 func (*FileRequest) Descriptor() ([]byte, []int) {
-	return file_git_v1_git_proto_rawDescGZIP(), []int{4}
+	return file_git_v1_git_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *FileRequest) GetBranch() string {
@@ -241,17 +1410,27 @@ func (x *FileRequest) GetFilepath() []string {
 	return nil
 }
 
+func (x *FileRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
 type CommitQuery struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	File          *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// optional, a file to stage and commit with everything already staged
+	File    *File  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// the alias to commit the staged files of when file is not set
+	Alias         string `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CommitQuery) Reset() {
 	*x = CommitQuery{}
-	mi := &file_git_v1_git_proto_msgTypes[5]
+	mi := &file_git_v1_git_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -263,7 +1442,7 @@ func (x *CommitQuery) String() string {
 func (*CommitQuery) ProtoMessage() {}
 
 func (x *CommitQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_git_v1_git_proto_msgTypes[5]
+	mi := &file_git_v1_git_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,7 +1455,7 @@ func (x *CommitQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitQuery.ProtoReflect.Descriptor instead.
 func (*CommitQuery) Descriptor() ([]byte, []int) {
-	return file_git_v1_git_proto_rawDescGZIP(), []int{5}
+	return file_git_v1_git_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CommitQuery) GetFile() *File {
@@ -293,6 +1472,13 @@ func (x *CommitQuery) GetMessage() string {
 	return ""
 }
 
+func (x *CommitQuery) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
 type CommitList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Commits       []*Commit              `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
@@ -302,7 +1488,7 @@ type CommitList struct {
 
 func (x *CommitList) Reset() {
 	*x = CommitList{}
-	mi := &file_git_v1_git_proto_msgTypes[6]
+	mi := &file_git_v1_git_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -314,7 +1500,7 @@ func (x *CommitList) String() string {
 func (*CommitList) ProtoMessage() {}
 
 func (x *CommitList) ProtoReflect() protoreflect.Message {
-	mi := &file_git_v1_git_proto_msgTypes[6]
+	mi := &file_git_v1_git_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +1513,7 @@ func (x *CommitList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitList.ProtoReflect.Descriptor instead.
 func (*CommitList) Descriptor() ([]byte, []int) {
-	return file_git_v1_git_proto_rawDescGZIP(), []int{6}
+	return file_git_v1_git_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CommitList) GetCommits() []*Commit {
@@ -350,7 +1536,7 @@ type Commit struct {
 
 func (x *Commit) Reset() {
 	*x = Commit{}
-	mi := &file_git_v1_git_proto_msgTypes[7]
+	mi := &file_git_v1_git_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -362,7 +1548,7 @@ func (x *Commit) String() string {
 func (*Commit) ProtoMessage() {}
 
 func (x *Commit) ProtoReflect() protoreflect.Message {
-	mi := &file_git_v1_git_proto_msgTypes[7]
+	mi := &file_git_v1_git_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,7 +1561,7 @@ func (x *Commit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Commit.ProtoReflect.Descriptor instead.
 func (*Commit) Descriptor() ([]byte, []int) {
-	return file_git_v1_git_proto_rawDescGZIP(), []int{7}
+	return file_git_v1_git_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Commit) GetHash() string {
@@ -414,15 +1600,16 @@ func (x *Commit) GetMessage() string {
 }
 
 type File struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// <alias>/<relpath>
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *File) Reset() {
 	*x = File{}
-	mi := &file_git_v1_git_proto_msgTypes[8]
+	mi := &file_git_v1_git_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -434,7 +1621,7 @@ func (x *File) String() string {
 func (*File) ProtoMessage() {}
 
 func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_git_v1_git_proto_msgTypes[8]
+	mi := &file_git_v1_git_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +1634,7 @@ func (x *File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use File.ProtoReflect.Descriptor instead.
 func (*File) Descriptor() ([]byte, []int) {
-	return file_git_v1_git_proto_rawDescGZIP(), []int{8}
+	return file_git_v1_git_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *File) GetName() string {
@@ -465,7 +1652,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_git_v1_git_proto_msgTypes[9]
+	mi := &file_git_v1_git_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -477,7 +1664,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_git_v1_git_proto_msgTypes[9]
+	mi := &file_git_v1_git_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,27 +1677,107 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_git_v1_git_proto_rawDescGZIP(), []int{9}
+	return file_git_v1_git_proto_rawDescGZIP(), []int{29}
 }
 
 var File_git_v1_git_proto protoreflect.FileDescriptor
 
 const file_git_v1_git_proto_rawDesc = "" +
 	"\n" +
-	"\x10git/v1/git.proto\x12\x06git.v1\"\x15\n" +
-	"\x13ListBranchesRequest\"2\n" +
+	"\x10git/v1/git.proto\x12\x06git.v1\"&\n" +
+	"\x0eGetSyncRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\"\xb2\x02\n" +
+	"\n" +
+	"SyncConfig\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06remote\x18\x03 \x01(\tR\x06remote\x12\x16\n" +
+	"\x06branch\x18\x04 \x01(\tR\x06branch\x12,\n" +
+	"\x11intervalInMinutes\x18\x05 \x01(\rR\x11intervalInMinutes\x12\x16\n" +
+	"\x06deploy\x18\x06 \x01(\bR\x06deploy\x12\x1a\n" +
+	"\busername\x18\a \x01(\tR\busername\x12\x14\n" +
+	"\x05token\x18\b \x01(\tR\x05token\x12\x1a\n" +
+	"\bhasToken\x18\t \x01(\bR\bhasToken\x12\x16\n" +
+	"\x06sshKey\x18\n" +
+	" \x01(\tR\x06sshKey\x12\x18\n" +
+	"\ahostKey\x18\v \x01(\tR\ahostKey\"&\n" +
+	"\x0eRunSyncRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\".\n" +
+	"\x16ListSyncHistoryRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\">\n" +
+	"\x17ListSyncHistoryResponse\x12#\n" +
+	"\x04runs\x18\x01 \x03(\v2\x0f.git.v1.SyncRunR\x04runs\"\xb5\x01\n" +
+	"\aSyncRun\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x03R\x04time\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x18\n" +
+	"\achanged\x18\x06 \x03(\tR\achanged\x12,\n" +
+	"\adeploys\x18\a \x03(\v2\x12.git.v1.SyncDeployR\adeploys\"j\n" +
+	"\n" +
+	"SyncDeploy\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x14\n" +
+	"\x05jobId\x18\x04 \x01(\tR\x05jobId\"M\n" +
+	"\vDiffRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\"\n" +
+	"\fDiffResponse\x12\x12\n" +
+	"\x04diff\x18\x01 \x01(\tR\x04diff\"%\n" +
+	"\rStatusRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\":\n" +
+	"\x0eStatusResponse\x12(\n" +
+	"\x05files\x18\x01 \x03(\v2\x12.git.v1.FileStatusR\x05files\"^\n" +
+	"\n" +
+	"FileStatus\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\astaging\x18\x02 \x01(\tR\astaging\x12\x1a\n" +
+	"\bworktree\x18\x03 \x01(\tR\bworktree\":\n" +
+	"\fStageRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x12\x14\n" +
+	"\x05files\x18\x02 \x03(\tR\x05files\"#\n" +
+	"\vPushRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\"#\n" +
+	"\vPullRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\"N\n" +
+	"\vGitProgress\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\x12'\n" +
+	"\x06result\x18\x02 \x01(\v2\x0f.git.v1.SyncRunR\x06result\"]\n" +
+	"\x0fRollbackRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\x12\x16\n" +
+	"\x06deploy\x18\x03 \x01(\bR\x06deploy\"n\n" +
+	"\x10RollbackProgress\x12\x16\n" +
+	"\x06commit\x18\x01 \x01(\tR\x06commit\x12\x14\n" +
+	"\x05files\x18\x02 \x03(\tR\x05files\x12\x14\n" +
+	"\x05jobId\x18\x03 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06output\x18\x04 \x01(\tR\x06output\"(\n" +
+	"\x10GetIgnoreRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\">\n" +
+	"\n" +
+	"IgnoreFile\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x12\x1a\n" +
+	"\bcontents\x18\x02 \x01(\tR\bcontents\"+\n" +
+	"\x13ListBranchesRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\"2\n" +
 	"\x14ListBranchesResponse\x12\x1a\n" +
-	"\bbranches\x18\x01 \x03(\tR\bbranches\"/\n" +
+	"\bbranches\x18\x01 \x03(\tR\bbranches\"E\n" +
 	"\x15BranchListFileRequest\x12\x16\n" +
-	"\x06branch\x18\x01 \x01(\tR\x06branch\".\n" +
+	"\x06branch\x18\x01 \x01(\tR\x06branch\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\".\n" +
 	"\x16BranchListFileResponse\x12\x14\n" +
-	"\x05files\x18\x01 \x03(\tR\x05files\"A\n" +
+	"\x05files\x18\x01 \x03(\tR\x05files\"W\n" +
 	"\vFileRequest\x12\x16\n" +
 	"\x06branch\x18\x01 \x01(\tR\x06branch\x12\x1a\n" +
-	"\bfilepath\x18\x02 \x03(\tR\bfilepath\"I\n" +
+	"\bfilepath\x18\x02 \x03(\tR\bfilepath\x12\x14\n" +
+	"\x05alias\x18\x03 \x01(\tR\x05alias\"_\n" +
 	"\vCommitQuery\x12 \n" +
 	"\x04file\x18\x01 \x01(\v2\f.git.v1.FileR\x04file\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05alias\x18\x03 \x01(\tR\x05alias\"6\n" +
 	"\n" +
 	"CommitList\x12(\n" +
 	"\acommits\x18\x01 \x03(\v2\x0e.git.v1.CommitR\acommits\"x\n" +
@@ -522,14 +1789,28 @@ const file_git_v1_git_proto_rawDesc = "" +
 	"\amessage\x18\x06 \x01(\tR\amessage\"\x1a\n" +
 	"\x04File\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"\a\n" +
-	"\x05Empty2\xc5\x02\n" +
+	"\x05Empty2\xac\b\n" +
 	"\n" +
 	"GitService\x121\n" +
 	"\vListCommits\x12\f.git.v1.File\x1a\x12.git.v1.CommitList\"\x00\x12.\n" +
 	"\x06Commit\x12\x13.git.v1.CommitQuery\x1a\r.git.v1.Empty\"\x00\x120\n" +
 	"\bSyncFile\x12\x13.git.v1.FileRequest\x1a\r.git.v1.Empty\"\x00\x12U\n" +
 	"\x12ListFileFromBranch\x12\x1d.git.v1.BranchListFileRequest\x1a\x1e.git.v1.BranchListFileResponse\"\x00\x12K\n" +
-	"\fListBranches\x12\x1b.git.v1.ListBranchesRequest\x1a\x1c.git.v1.ListBranchesResponse\"\x00Bz\n" +
+	"\fListBranches\x12\x1b.git.v1.ListBranchesRequest\x1a\x1c.git.v1.ListBranchesResponse\"\x00\x123\n" +
+	"\x04Diff\x12\x13.git.v1.DiffRequest\x1a\x14.git.v1.DiffResponse\"\x00\x129\n" +
+	"\x06Status\x12\x15.git.v1.StatusRequest\x1a\x16.git.v1.StatusResponse\"\x00\x12.\n" +
+	"\x05Stage\x12\x14.git.v1.StageRequest\x1a\r.git.v1.Empty\"\x00\x120\n" +
+	"\aUnstage\x12\x14.git.v1.StageRequest\x1a\r.git.v1.Empty\"\x00\x124\n" +
+	"\x04Push\x12\x13.git.v1.PushRequest\x1a\x13.git.v1.GitProgress\"\x000\x01\x124\n" +
+	"\x04Pull\x12\x13.git.v1.PullRequest\x1a\x13.git.v1.GitProgress\"\x000\x01\x12;\n" +
+	"\tGetIgnore\x12\x18.git.v1.GetIgnoreRequest\x1a\x12.git.v1.IgnoreFile\"\x00\x121\n" +
+	"\n" +
+	"SaveIgnore\x12\x12.git.v1.IgnoreFile\x1a\r.git.v1.Empty\"\x00\x12A\n" +
+	"\bRollback\x12\x17.git.v1.RollbackRequest\x1a\x18.git.v1.RollbackProgress\"\x000\x01\x127\n" +
+	"\aGetSync\x12\x16.git.v1.GetSyncRequest\x1a\x12.git.v1.SyncConfig\"\x00\x12/\n" +
+	"\bSaveSync\x12\x12.git.v1.SyncConfig\x1a\r.git.v1.Empty\"\x00\x124\n" +
+	"\aRunSync\x12\x16.git.v1.RunSyncRequest\x1a\x0f.git.v1.SyncRun\"\x00\x12T\n" +
+	"\x0fListSyncHistory\x12\x1e.git.v1.ListSyncHistoryRequest\x1a\x1f.git.v1.ListSyncHistoryResponse\"\x00Bz\n" +
 	"\n" +
 	"com.git.v1B\bGitProtoP\x01Z)github.com/RA341/dockman/generated/git/v1\xa2\x02\x03GXX\xaa\x02\x06Git.V1\xca\x02\x06Git\\V1\xe2\x02\x12Git\\V1\\GPBMetadata\xea\x02\aGit::V1b\x06proto3"
 
@@ -545,37 +1826,87 @@ func file_git_v1_git_proto_rawDescGZIP() []byte {
 	return file_git_v1_git_proto_rawDescData
 }
 
-var file_git_v1_git_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_git_v1_git_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_git_v1_git_proto_goTypes = []any{
-	(*ListBranchesRequest)(nil),    // 0: git.v1.ListBranchesRequest
-	(*ListBranchesResponse)(nil),   // 1: git.v1.ListBranchesResponse
-	(*BranchListFileRequest)(nil),  // 2: git.v1.BranchListFileRequest
-	(*BranchListFileResponse)(nil), // 3: git.v1.BranchListFileResponse
-	(*FileRequest)(nil),            // 4: git.v1.FileRequest
-	(*CommitQuery)(nil),            // 5: git.v1.CommitQuery
-	(*CommitList)(nil),             // 6: git.v1.CommitList
-	(*Commit)(nil),                 // 7: git.v1.Commit
-	(*File)(nil),                   // 8: git.v1.File
-	(*Empty)(nil),                  // 9: git.v1.Empty
+	(*GetSyncRequest)(nil),          // 0: git.v1.GetSyncRequest
+	(*SyncConfig)(nil),              // 1: git.v1.SyncConfig
+	(*RunSyncRequest)(nil),          // 2: git.v1.RunSyncRequest
+	(*ListSyncHistoryRequest)(nil),  // 3: git.v1.ListSyncHistoryRequest
+	(*ListSyncHistoryResponse)(nil), // 4: git.v1.ListSyncHistoryResponse
+	(*SyncRun)(nil),                 // 5: git.v1.SyncRun
+	(*SyncDeploy)(nil),              // 6: git.v1.SyncDeploy
+	(*DiffRequest)(nil),             // 7: git.v1.DiffRequest
+	(*DiffResponse)(nil),            // 8: git.v1.DiffResponse
+	(*StatusRequest)(nil),           // 9: git.v1.StatusRequest
+	(*StatusResponse)(nil),          // 10: git.v1.StatusResponse
+	(*FileStatus)(nil),              // 11: git.v1.FileStatus
+	(*StageRequest)(nil),            // 12: git.v1.StageRequest
+	(*PushRequest)(nil),             // 13: git.v1.PushRequest
+	(*PullRequest)(nil),             // 14: git.v1.PullRequest
+	(*GitProgress)(nil),             // 15: git.v1.GitProgress
+	(*RollbackRequest)(nil),         // 16: git.v1.RollbackRequest
+	(*RollbackProgress)(nil),        // 17: git.v1.RollbackProgress
+	(*GetIgnoreRequest)(nil),        // 18: git.v1.GetIgnoreRequest
+	(*IgnoreFile)(nil),              // 19: git.v1.IgnoreFile
+	(*ListBranchesRequest)(nil),     // 20: git.v1.ListBranchesRequest
+	(*ListBranchesResponse)(nil),    // 21: git.v1.ListBranchesResponse
+	(*BranchListFileRequest)(nil),   // 22: git.v1.BranchListFileRequest
+	(*BranchListFileResponse)(nil),  // 23: git.v1.BranchListFileResponse
+	(*FileRequest)(nil),             // 24: git.v1.FileRequest
+	(*CommitQuery)(nil),             // 25: git.v1.CommitQuery
+	(*CommitList)(nil),              // 26: git.v1.CommitList
+	(*Commit)(nil),                  // 27: git.v1.Commit
+	(*File)(nil),                    // 28: git.v1.File
+	(*Empty)(nil),                   // 29: git.v1.Empty
 }
 var file_git_v1_git_proto_depIdxs = []int32{
-	8, // 0: git.v1.CommitQuery.file:type_name -> git.v1.File
-	7, // 1: git.v1.CommitList.commits:type_name -> git.v1.Commit
-	8, // 2: git.v1.GitService.ListCommits:input_type -> git.v1.File
-	5, // 3: git.v1.GitService.Commit:input_type -> git.v1.CommitQuery
-	4, // 4: git.v1.GitService.SyncFile:input_type -> git.v1.FileRequest
-	2, // 5: git.v1.GitService.ListFileFromBranch:input_type -> git.v1.BranchListFileRequest
-	0, // 6: git.v1.GitService.ListBranches:input_type -> git.v1.ListBranchesRequest
-	6, // 7: git.v1.GitService.ListCommits:output_type -> git.v1.CommitList
-	9, // 8: git.v1.GitService.Commit:output_type -> git.v1.Empty
-	9, // 9: git.v1.GitService.SyncFile:output_type -> git.v1.Empty
-	3, // 10: git.v1.GitService.ListFileFromBranch:output_type -> git.v1.BranchListFileResponse
-	1, // 11: git.v1.GitService.ListBranches:output_type -> git.v1.ListBranchesResponse
-	7, // [7:12] is the sub-list for method output_type
-	2, // [2:7] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5,  // 0: git.v1.ListSyncHistoryResponse.runs:type_name -> git.v1.SyncRun
+	6,  // 1: git.v1.SyncRun.deploys:type_name -> git.v1.SyncDeploy
+	11, // 2: git.v1.StatusResponse.files:type_name -> git.v1.FileStatus
+	5,  // 3: git.v1.GitProgress.result:type_name -> git.v1.SyncRun
+	28, // 4: git.v1.CommitQuery.file:type_name -> git.v1.File
+	27, // 5: git.v1.CommitList.commits:type_name -> git.v1.Commit
+	28, // 6: git.v1.GitService.ListCommits:input_type -> git.v1.File
+	25, // 7: git.v1.GitService.Commit:input_type -> git.v1.CommitQuery
+	24, // 8: git.v1.GitService.SyncFile:input_type -> git.v1.FileRequest
+	22, // 9: git.v1.GitService.ListFileFromBranch:input_type -> git.v1.BranchListFileRequest
+	20, // 10: git.v1.GitService.ListBranches:input_type -> git.v1.ListBranchesRequest
+	7,  // 11: git.v1.GitService.Diff:input_type -> git.v1.DiffRequest
+	9,  // 12: git.v1.GitService.Status:input_type -> git.v1.StatusRequest
+	12, // 13: git.v1.GitService.Stage:input_type -> git.v1.StageRequest
+	12, // 14: git.v1.GitService.Unstage:input_type -> git.v1.StageRequest
+	13, // 15: git.v1.GitService.Push:input_type -> git.v1.PushRequest
+	14, // 16: git.v1.GitService.Pull:input_type -> git.v1.PullRequest
+	18, // 17: git.v1.GitService.GetIgnore:input_type -> git.v1.GetIgnoreRequest
+	19, // 18: git.v1.GitService.SaveIgnore:input_type -> git.v1.IgnoreFile
+	16, // 19: git.v1.GitService.Rollback:input_type -> git.v1.RollbackRequest
+	0,  // 20: git.v1.GitService.GetSync:input_type -> git.v1.GetSyncRequest
+	1,  // 21: git.v1.GitService.SaveSync:input_type -> git.v1.SyncConfig
+	2,  // 22: git.v1.GitService.RunSync:input_type -> git.v1.RunSyncRequest
+	3,  // 23: git.v1.GitService.ListSyncHistory:input_type -> git.v1.ListSyncHistoryRequest
+	26, // 24: git.v1.GitService.ListCommits:output_type -> git.v1.CommitList
+	29, // 25: git.v1.GitService.Commit:output_type -> git.v1.Empty
+	29, // 26: git.v1.GitService.SyncFile:output_type -> git.v1.Empty
+	23, // 27: git.v1.GitService.ListFileFromBranch:output_type -> git.v1.BranchListFileResponse
+	21, // 28: git.v1.GitService.ListBranches:output_type -> git.v1.ListBranchesResponse
+	8,  // 29: git.v1.GitService.Diff:output_type -> git.v1.DiffResponse
+	10, // 30: git.v1.GitService.Status:output_type -> git.v1.StatusResponse
+	29, // 31: git.v1.GitService.Stage:output_type -> git.v1.Empty
+	29, // 32: git.v1.GitService.Unstage:output_type -> git.v1.Empty
+	15, // 33: git.v1.GitService.Push:output_type -> git.v1.GitProgress
+	15, // 34: git.v1.GitService.Pull:output_type -> git.v1.GitProgress
+	19, // 35: git.v1.GitService.GetIgnore:output_type -> git.v1.IgnoreFile
+	29, // 36: git.v1.GitService.SaveIgnore:output_type -> git.v1.Empty
+	17, // 37: git.v1.GitService.Rollback:output_type -> git.v1.RollbackProgress
+	1,  // 38: git.v1.GitService.GetSync:output_type -> git.v1.SyncConfig
+	29, // 39: git.v1.GitService.SaveSync:output_type -> git.v1.Empty
+	5,  // 40: git.v1.GitService.RunSync:output_type -> git.v1.SyncRun
+	4,  // 41: git.v1.GitService.ListSyncHistory:output_type -> git.v1.ListSyncHistoryResponse
+	24, // [24:42] is the sub-list for method output_type
+	6,  // [6:24] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_git_v1_git_proto_init() }
@@ -589,7 +1920,7 @@ func file_git_v1_git_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_git_v1_git_proto_rawDesc), len(file_git_v1_git_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
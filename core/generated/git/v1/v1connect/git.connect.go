@@ -44,6 +44,33 @@ const (
 	GitServiceListFileFromBranchProcedure = "/git.v1.GitService/ListFileFromBranch"
 	// GitServiceListBranchesProcedure is the fully-qualified name of the GitService's ListBranches RPC.
 	GitServiceListBranchesProcedure = "/git.v1.GitService/ListBranches"
+	// GitServiceDiffProcedure is the fully-qualified name of the GitService's Diff RPC.
+	GitServiceDiffProcedure = "/git.v1.GitService/Diff"
+	// GitServiceStatusProcedure is the fully-qualified name of the GitService's Status RPC.
+	GitServiceStatusProcedure = "/git.v1.GitService/Status"
+	// GitServiceStageProcedure is the fully-qualified name of the GitService's Stage RPC.
+	GitServiceStageProcedure = "/git.v1.GitService/Stage"
+	// GitServiceUnstageProcedure is the fully-qualified name of the GitService's Unstage RPC.
+	GitServiceUnstageProcedure = "/git.v1.GitService/Unstage"
+	// GitServicePushProcedure is the fully-qualified name of the GitService's Push RPC.
+	GitServicePushProcedure = "/git.v1.GitService/Push"
+	// GitServicePullProcedure is the fully-qualified name of the GitService's Pull RPC.
+	GitServicePullProcedure = "/git.v1.GitService/Pull"
+	// GitServiceGetIgnoreProcedure is the fully-qualified name of the GitService's GetIgnore RPC.
+	GitServiceGetIgnoreProcedure = "/git.v1.GitService/GetIgnore"
+	// GitServiceSaveIgnoreProcedure is the fully-qualified name of the GitService's SaveIgnore RPC.
+	GitServiceSaveIgnoreProcedure = "/git.v1.GitService/SaveIgnore"
+	// GitServiceRollbackProcedure is the fully-qualified name of the GitService's Rollback RPC.
+	GitServiceRollbackProcedure = "/git.v1.GitService/Rollback"
+	// GitServiceGetSyncProcedure is the fully-qualified name of the GitService's GetSync RPC.
+	GitServiceGetSyncProcedure = "/git.v1.GitService/GetSync"
+	// GitServiceSaveSyncProcedure is the fully-qualified name of the GitService's SaveSync RPC.
+	GitServiceSaveSyncProcedure = "/git.v1.GitService/SaveSync"
+	// GitServiceRunSyncProcedure is the fully-qualified name of the GitService's RunSync RPC.
+	GitServiceRunSyncProcedure = "/git.v1.GitService/RunSync"
+	// GitServiceListSyncHistoryProcedure is the fully-qualified name of the GitService's
+	// ListSyncHistory RPC.
+	GitServiceListSyncHistoryProcedure = "/git.v1.GitService/ListSyncHistory"
 )
 
 // GitServiceClient is a client for the git.v1.GitService service.
@@ -53,6 +80,19 @@ type GitServiceClient interface {
 	SyncFile(context.Context, *connect.Request[v1.FileRequest]) (*connect.Response[v1.Empty], error)
 	ListFileFromBranch(context.Context, *connect.Request[v1.BranchListFileRequest]) (*connect.Response[v1.BranchListFileResponse], error)
 	ListBranches(context.Context, *connect.Request[v1.ListBranchesRequest]) (*connect.Response[v1.ListBranchesResponse], error)
+	Diff(context.Context, *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error)
+	Status(context.Context, *connect.Request[v1.StatusRequest]) (*connect.Response[v1.StatusResponse], error)
+	Stage(context.Context, *connect.Request[v1.StageRequest]) (*connect.Response[v1.Empty], error)
+	Unstage(context.Context, *connect.Request[v1.StageRequest]) (*connect.Response[v1.Empty], error)
+	Push(context.Context, *connect.Request[v1.PushRequest]) (*connect.ServerStreamForClient[v1.GitProgress], error)
+	Pull(context.Context, *connect.Request[v1.PullRequest]) (*connect.ServerStreamForClient[v1.GitProgress], error)
+	GetIgnore(context.Context, *connect.Request[v1.GetIgnoreRequest]) (*connect.Response[v1.IgnoreFile], error)
+	SaveIgnore(context.Context, *connect.Request[v1.IgnoreFile]) (*connect.Response[v1.Empty], error)
+	Rollback(context.Context, *connect.Request[v1.RollbackRequest]) (*connect.ServerStreamForClient[v1.RollbackProgress], error)
+	GetSync(context.Context, *connect.Request[v1.GetSyncRequest]) (*connect.Response[v1.SyncConfig], error)
+	SaveSync(context.Context, *connect.Request[v1.SyncConfig]) (*connect.Response[v1.Empty], error)
+	RunSync(context.Context, *connect.Request[v1.RunSyncRequest]) (*connect.Response[v1.SyncRun], error)
+	ListSyncHistory(context.Context, *connect.Request[v1.ListSyncHistoryRequest]) (*connect.Response[v1.ListSyncHistoryResponse], error)
 }
 
 // NewGitServiceClient constructs a client for the git.v1.GitService service. By default, it uses
@@ -96,6 +136,84 @@ func NewGitServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(gitServiceMethods.ByName("ListBranches")),
 			connect.WithClientOptions(opts...),
 		),
+		diff: connect.NewClient[v1.DiffRequest, v1.DiffResponse](
+			httpClient,
+			baseURL+GitServiceDiffProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("Diff")),
+			connect.WithClientOptions(opts...),
+		),
+		status: connect.NewClient[v1.StatusRequest, v1.StatusResponse](
+			httpClient,
+			baseURL+GitServiceStatusProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("Status")),
+			connect.WithClientOptions(opts...),
+		),
+		stage: connect.NewClient[v1.StageRequest, v1.Empty](
+			httpClient,
+			baseURL+GitServiceStageProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("Stage")),
+			connect.WithClientOptions(opts...),
+		),
+		unstage: connect.NewClient[v1.StageRequest, v1.Empty](
+			httpClient,
+			baseURL+GitServiceUnstageProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("Unstage")),
+			connect.WithClientOptions(opts...),
+		),
+		push: connect.NewClient[v1.PushRequest, v1.GitProgress](
+			httpClient,
+			baseURL+GitServicePushProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("Push")),
+			connect.WithClientOptions(opts...),
+		),
+		pull: connect.NewClient[v1.PullRequest, v1.GitProgress](
+			httpClient,
+			baseURL+GitServicePullProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("Pull")),
+			connect.WithClientOptions(opts...),
+		),
+		getIgnore: connect.NewClient[v1.GetIgnoreRequest, v1.IgnoreFile](
+			httpClient,
+			baseURL+GitServiceGetIgnoreProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("GetIgnore")),
+			connect.WithClientOptions(opts...),
+		),
+		saveIgnore: connect.NewClient[v1.IgnoreFile, v1.Empty](
+			httpClient,
+			baseURL+GitServiceSaveIgnoreProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("SaveIgnore")),
+			connect.WithClientOptions(opts...),
+		),
+		rollback: connect.NewClient[v1.RollbackRequest, v1.RollbackProgress](
+			httpClient,
+			baseURL+GitServiceRollbackProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("Rollback")),
+			connect.WithClientOptions(opts...),
+		),
+		getSync: connect.NewClient[v1.GetSyncRequest, v1.SyncConfig](
+			httpClient,
+			baseURL+GitServiceGetSyncProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("GetSync")),
+			connect.WithClientOptions(opts...),
+		),
+		saveSync: connect.NewClient[v1.SyncConfig, v1.Empty](
+			httpClient,
+			baseURL+GitServiceSaveSyncProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("SaveSync")),
+			connect.WithClientOptions(opts...),
+		),
+		runSync: connect.NewClient[v1.RunSyncRequest, v1.SyncRun](
+			httpClient,
+			baseURL+GitServiceRunSyncProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("RunSync")),
+			connect.WithClientOptions(opts...),
+		),
+		listSyncHistory: connect.NewClient[v1.ListSyncHistoryRequest, v1.ListSyncHistoryResponse](
+			httpClient,
+			baseURL+GitServiceListSyncHistoryProcedure,
+			connect.WithSchema(gitServiceMethods.ByName("ListSyncHistory")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -106,6 +224,19 @@ type gitServiceClient struct {
 	syncFile           *connect.Client[v1.FileRequest, v1.Empty]
 	listFileFromBranch *connect.Client[v1.BranchListFileRequest, v1.BranchListFileResponse]
 	listBranches       *connect.Client[v1.ListBranchesRequest, v1.ListBranchesResponse]
+	diff               *connect.Client[v1.DiffRequest, v1.DiffResponse]
+	status             *connect.Client[v1.StatusRequest, v1.StatusResponse]
+	stage              *connect.Client[v1.StageRequest, v1.Empty]
+	unstage            *connect.Client[v1.StageRequest, v1.Empty]
+	push               *connect.Client[v1.PushRequest, v1.GitProgress]
+	pull               *connect.Client[v1.PullRequest, v1.GitProgress]
+	getIgnore          *connect.Client[v1.GetIgnoreRequest, v1.IgnoreFile]
+	saveIgnore         *connect.Client[v1.IgnoreFile, v1.Empty]
+	rollback           *connect.Client[v1.RollbackRequest, v1.RollbackProgress]
+	getSync            *connect.Client[v1.GetSyncRequest, v1.SyncConfig]
+	saveSync           *connect.Client[v1.SyncConfig, v1.Empty]
+	runSync            *connect.Client[v1.RunSyncRequest, v1.SyncRun]
+	listSyncHistory    *connect.Client[v1.ListSyncHistoryRequest, v1.ListSyncHistoryResponse]
 }
 
 // ListCommits calls git.v1.GitService.ListCommits.
@@ -133,6 +264,71 @@ func (c *gitServiceClient) ListBranches(ctx context.Context, req *connect.Reques
 	return c.listBranches.CallUnary(ctx, req)
 }
 
+// Diff calls git.v1.GitService.Diff.
+func (c *gitServiceClient) Diff(ctx context.Context, req *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error) {
+	return c.diff.CallUnary(ctx, req)
+}
+
+// Status calls git.v1.GitService.Status.
+func (c *gitServiceClient) Status(ctx context.Context, req *connect.Request[v1.StatusRequest]) (*connect.Response[v1.StatusResponse], error) {
+	return c.status.CallUnary(ctx, req)
+}
+
+// Stage calls git.v1.GitService.Stage.
+func (c *gitServiceClient) Stage(ctx context.Context, req *connect.Request[v1.StageRequest]) (*connect.Response[v1.Empty], error) {
+	return c.stage.CallUnary(ctx, req)
+}
+
+// Unstage calls git.v1.GitService.Unstage.
+func (c *gitServiceClient) Unstage(ctx context.Context, req *connect.Request[v1.StageRequest]) (*connect.Response[v1.Empty], error) {
+	return c.unstage.CallUnary(ctx, req)
+}
+
+// Push calls git.v1.GitService.Push.
+func (c *gitServiceClient) Push(ctx context.Context, req *connect.Request[v1.PushRequest]) (*connect.ServerStreamForClient[v1.GitProgress], error) {
+	return c.push.CallServerStream(ctx, req)
+}
+
+// Pull calls git.v1.GitService.Pull.
+func (c *gitServiceClient) Pull(ctx context.Context, req *connect.Request[v1.PullRequest]) (*connect.ServerStreamForClient[v1.GitProgress], error) {
+	return c.pull.CallServerStream(ctx, req)
+}
+
+// GetIgnore calls git.v1.GitService.GetIgnore.
+func (c *gitServiceClient) GetIgnore(ctx context.Context, req *connect.Request[v1.GetIgnoreRequest]) (*connect.Response[v1.IgnoreFile], error) {
+	return c.getIgnore.CallUnary(ctx, req)
+}
+
+// SaveIgnore calls git.v1.GitService.SaveIgnore.
+func (c *gitServiceClient) SaveIgnore(ctx context.Context, req *connect.Request[v1.IgnoreFile]) (*connect.Response[v1.Empty], error) {
+	return c.saveIgnore.CallUnary(ctx, req)
+}
+
+// Rollback calls git.v1.GitService.Rollback.
+func (c *gitServiceClient) Rollback(ctx context.Context, req *connect.Request[v1.RollbackRequest]) (*connect.ServerStreamForClient[v1.RollbackProgress], error) {
+	return c.rollback.CallServerStream(ctx, req)
+}
+
+// GetSync calls git.v1.GitService.GetSync.
+func (c *gitServiceClient) GetSync(ctx context.Context, req *connect.Request[v1.GetSyncRequest]) (*connect.Response[v1.SyncConfig], error) {
+	return c.getSync.CallUnary(ctx, req)
+}
+
+// SaveSync calls git.v1.GitService.SaveSync.
+func (c *gitServiceClient) SaveSync(ctx context.Context, req *connect.Request[v1.SyncConfig]) (*connect.Response[v1.Empty], error) {
+	return c.saveSync.CallUnary(ctx, req)
+}
+
+// RunSync calls git.v1.GitService.RunSync.
+func (c *gitServiceClient) RunSync(ctx context.Context, req *connect.Request[v1.RunSyncRequest]) (*connect.Response[v1.SyncRun], error) {
+	return c.runSync.CallUnary(ctx, req)
+}
+
+// ListSyncHistory calls git.v1.GitService.ListSyncHistory.
+func (c *gitServiceClient) ListSyncHistory(ctx context.Context, req *connect.Request[v1.ListSyncHistoryRequest]) (*connect.Response[v1.ListSyncHistoryResponse], error) {
+	return c.listSyncHistory.CallUnary(ctx, req)
+}
+
 // GitServiceHandler is an implementation of the git.v1.GitService service.
 type GitServiceHandler interface {
 	ListCommits(context.Context, *connect.Request[v1.File]) (*connect.Response[v1.CommitList], error)
@@ -140,6 +336,19 @@ type GitServiceHandler interface {
 	SyncFile(context.Context, *connect.Request[v1.FileRequest]) (*connect.Response[v1.Empty], error)
 	ListFileFromBranch(context.Context, *connect.Request[v1.BranchListFileRequest]) (*connect.Response[v1.BranchListFileResponse], error)
 	ListBranches(context.Context, *connect.Request[v1.ListBranchesRequest]) (*connect.Response[v1.ListBranchesResponse], error)
+	Diff(context.Context, *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error)
+	Status(context.Context, *connect.Request[v1.StatusRequest]) (*connect.Response[v1.StatusResponse], error)
+	Stage(context.Context, *connect.Request[v1.StageRequest]) (*connect.Response[v1.Empty], error)
+	Unstage(context.Context, *connect.Request[v1.StageRequest]) (*connect.Response[v1.Empty], error)
+	Push(context.Context, *connect.Request[v1.PushRequest], *connect.ServerStream[v1.GitProgress]) error
+	Pull(context.Context, *connect.Request[v1.PullRequest], *connect.ServerStream[v1.GitProgress]) error
+	GetIgnore(context.Context, *connect.Request[v1.GetIgnoreRequest]) (*connect.Response[v1.IgnoreFile], error)
+	SaveIgnore(context.Context, *connect.Request[v1.IgnoreFile]) (*connect.Response[v1.Empty], error)
+	Rollback(context.Context, *connect.Request[v1.RollbackRequest], *connect.ServerStream[v1.RollbackProgress]) error
+	GetSync(context.Context, *connect.Request[v1.GetSyncRequest]) (*connect.Response[v1.SyncConfig], error)
+	SaveSync(context.Context, *connect.Request[v1.SyncConfig]) (*connect.Response[v1.Empty], error)
+	RunSync(context.Context, *connect.Request[v1.RunSyncRequest]) (*connect.Response[v1.SyncRun], error)
+	ListSyncHistory(context.Context, *connect.Request[v1.ListSyncHistoryRequest]) (*connect.Response[v1.ListSyncHistoryResponse], error)
 }
 
 // NewGitServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -179,6 +388,84 @@ func NewGitServiceHandler(svc GitServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(gitServiceMethods.ByName("ListBranches")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gitServiceDiffHandler := connect.NewUnaryHandler(
+		GitServiceDiffProcedure,
+		svc.Diff,
+		connect.WithSchema(gitServiceMethods.ByName("Diff")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServiceStatusHandler := connect.NewUnaryHandler(
+		GitServiceStatusProcedure,
+		svc.Status,
+		connect.WithSchema(gitServiceMethods.ByName("Status")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServiceStageHandler := connect.NewUnaryHandler(
+		GitServiceStageProcedure,
+		svc.Stage,
+		connect.WithSchema(gitServiceMethods.ByName("Stage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServiceUnstageHandler := connect.NewUnaryHandler(
+		GitServiceUnstageProcedure,
+		svc.Unstage,
+		connect.WithSchema(gitServiceMethods.ByName("Unstage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServicePushHandler := connect.NewServerStreamHandler(
+		GitServicePushProcedure,
+		svc.Push,
+		connect.WithSchema(gitServiceMethods.ByName("Push")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServicePullHandler := connect.NewServerStreamHandler(
+		GitServicePullProcedure,
+		svc.Pull,
+		connect.WithSchema(gitServiceMethods.ByName("Pull")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServiceGetIgnoreHandler := connect.NewUnaryHandler(
+		GitServiceGetIgnoreProcedure,
+		svc.GetIgnore,
+		connect.WithSchema(gitServiceMethods.ByName("GetIgnore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServiceSaveIgnoreHandler := connect.NewUnaryHandler(
+		GitServiceSaveIgnoreProcedure,
+		svc.SaveIgnore,
+		connect.WithSchema(gitServiceMethods.ByName("SaveIgnore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServiceRollbackHandler := connect.NewServerStreamHandler(
+		GitServiceRollbackProcedure,
+		svc.Rollback,
+		connect.WithSchema(gitServiceMethods.ByName("Rollback")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServiceGetSyncHandler := connect.NewUnaryHandler(
+		GitServiceGetSyncProcedure,
+		svc.GetSync,
+		connect.WithSchema(gitServiceMethods.ByName("GetSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServiceSaveSyncHandler := connect.NewUnaryHandler(
+		GitServiceSaveSyncProcedure,
+		svc.SaveSync,
+		connect.WithSchema(gitServiceMethods.ByName("SaveSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServiceRunSyncHandler := connect.NewUnaryHandler(
+		GitServiceRunSyncProcedure,
+		svc.RunSync,
+		connect.WithSchema(gitServiceMethods.ByName("RunSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gitServiceListSyncHistoryHandler := connect.NewUnaryHandler(
+		GitServiceListSyncHistoryProcedure,
+		svc.ListSyncHistory,
+		connect.WithSchema(gitServiceMethods.ByName("ListSyncHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/git.v1.GitService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GitServiceListCommitsProcedure:
@@ -191,6 +478,32 @@ func NewGitServiceHandler(svc GitServiceHandler, opts ...connect.HandlerOption)
 			gitServiceListFileFromBranchHandler.ServeHTTP(w, r)
 		case GitServiceListBranchesProcedure:
 			gitServiceListBranchesHandler.ServeHTTP(w, r)
+		case GitServiceDiffProcedure:
+			gitServiceDiffHandler.ServeHTTP(w, r)
+		case GitServiceStatusProcedure:
+			gitServiceStatusHandler.ServeHTTP(w, r)
+		case GitServiceStageProcedure:
+			gitServiceStageHandler.ServeHTTP(w, r)
+		case GitServiceUnstageProcedure:
+			gitServiceUnstageHandler.ServeHTTP(w, r)
+		case GitServicePushProcedure:
+			gitServicePushHandler.ServeHTTP(w, r)
+		case GitServicePullProcedure:
+			gitServicePullHandler.ServeHTTP(w, r)
+		case GitServiceGetIgnoreProcedure:
+			gitServiceGetIgnoreHandler.ServeHTTP(w, r)
+		case GitServiceSaveIgnoreProcedure:
+			gitServiceSaveIgnoreHandler.ServeHTTP(w, r)
+		case GitServiceRollbackProcedure:
+			gitServiceRollbackHandler.ServeHTTP(w, r)
+		case GitServiceGetSyncProcedure:
+			gitServiceGetSyncHandler.ServeHTTP(w, r)
+		case GitServiceSaveSyncProcedure:
+			gitServiceSaveSyncHandler.ServeHTTP(w, r)
+		case GitServiceRunSyncProcedure:
+			gitServiceRunSyncHandler.ServeHTTP(w, r)
+		case GitServiceListSyncHistoryProcedure:
+			gitServiceListSyncHistoryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -219,3 +532,55 @@ func (UnimplementedGitServiceHandler) ListFileFromBranch(context.Context, *conne
 func (UnimplementedGitServiceHandler) ListBranches(context.Context, *connect.Request[v1.ListBranchesRequest]) (*connect.Response[v1.ListBranchesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.ListBranches is not implemented"))
 }
+
+func (UnimplementedGitServiceHandler) Diff(context.Context, *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.Diff is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) Status(context.Context, *connect.Request[v1.StatusRequest]) (*connect.Response[v1.StatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.Status is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) Stage(context.Context, *connect.Request[v1.StageRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.Stage is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) Unstage(context.Context, *connect.Request[v1.StageRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.Unstage is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) Push(context.Context, *connect.Request[v1.PushRequest], *connect.ServerStream[v1.GitProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.Push is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) Pull(context.Context, *connect.Request[v1.PullRequest], *connect.ServerStream[v1.GitProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.Pull is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) GetIgnore(context.Context, *connect.Request[v1.GetIgnoreRequest]) (*connect.Response[v1.IgnoreFile], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.GetIgnore is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) SaveIgnore(context.Context, *connect.Request[v1.IgnoreFile]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.SaveIgnore is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) Rollback(context.Context, *connect.Request[v1.RollbackRequest], *connect.ServerStream[v1.RollbackProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.Rollback is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) GetSync(context.Context, *connect.Request[v1.GetSyncRequest]) (*connect.Response[v1.SyncConfig], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.GetSync is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) SaveSync(context.Context, *connect.Request[v1.SyncConfig]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.SaveSync is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) RunSync(context.Context, *connect.Request[v1.RunSyncRequest]) (*connect.Response[v1.SyncRun], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.RunSync is not implemented"))
+}
+
+func (UnimplementedGitServiceHandler) ListSyncHistory(context.Context, *connect.Request[v1.ListSyncHistoryRequest]) (*connect.Response[v1.ListSyncHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("git.v1.GitService.ListSyncHistory is not implemented"))
+}
@@ -0,0 +1,570 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hooks/v1/hooks.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Hook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// used in the hook url /api/hooks/{key}, generated on create
+	Key     string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled bool   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// compose-update, sync, cleaner, image-update
+	Action string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	// compose file for compose-update, alias for sync,
+	// image for image-update, unused for cleaner
+	Target string `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	// write only, empty keeps the saved secret.
+	// a secret generated on create is returned once by SaveHook
+	Secret        string `protobuf:"bytes,7,opt,name=secret,proto3" json:"secret,omitempty"`
+	HasSecret     bool   `protobuf:"varint,8,opt,name=hasSecret,proto3" json:"hasSecret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hook) Reset() {
+	*x = Hook{}
+	mi := &file_hooks_v1_hooks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hook) ProtoMessage() {}
+
+func (x *Hook) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_v1_hooks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hook.ProtoReflect.Descriptor instead.
+func (*Hook) Descriptor() ([]byte, []int) {
+	return file_hooks_v1_hooks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Hook) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Hook) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Hook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Hook) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Hook) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Hook) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Hook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Hook) GetHasSecret() bool {
+	if x != nil {
+		return x.HasSecret
+	}
+	return false
+}
+
+type ListHooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHooksRequest) Reset() {
+	*x = ListHooksRequest{}
+	mi := &file_hooks_v1_hooks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHooksRequest) ProtoMessage() {}
+
+func (x *ListHooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_v1_hooks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHooksRequest.ProtoReflect.Descriptor instead.
+func (*ListHooksRequest) Descriptor() ([]byte, []int) {
+	return file_hooks_v1_hooks_proto_rawDescGZIP(), []int{1}
+}
+
+type ListHooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hooks         []*Hook                `protobuf:"bytes,1,rep,name=hooks,proto3" json:"hooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHooksResponse) Reset() {
+	*x = ListHooksResponse{}
+	mi := &file_hooks_v1_hooks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHooksResponse) ProtoMessage() {}
+
+func (x *ListHooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_v1_hooks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHooksResponse.ProtoReflect.Descriptor instead.
+func (*ListHooksResponse) Descriptor() ([]byte, []int) {
+	return file_hooks_v1_hooks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListHooksResponse) GetHooks() []*Hook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+type DeleteHookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHookRequest) Reset() {
+	*x = DeleteHookRequest{}
+	mi := &file_hooks_v1_hooks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHookRequest) ProtoMessage() {}
+
+func (x *DeleteHookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_v1_hooks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHookRequest) Descriptor() ([]byte, []int) {
+	return file_hooks_v1_hooks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteHookRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteHookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHookResponse) Reset() {
+	*x = DeleteHookResponse{}
+	mi := &file_hooks_v1_hooks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHookResponse) ProtoMessage() {}
+
+func (x *DeleteHookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_v1_hooks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHookResponse) Descriptor() ([]byte, []int) {
+	return file_hooks_v1_hooks_proto_rawDescGZIP(), []int{4}
+}
+
+type ListInvocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HookId        uint32                 `protobuf:"varint,1,opt,name=hookId,proto3" json:"hookId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvocationsRequest) Reset() {
+	*x = ListInvocationsRequest{}
+	mi := &file_hooks_v1_hooks_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvocationsRequest) ProtoMessage() {}
+
+func (x *ListInvocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_v1_hooks_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvocationsRequest) Descriptor() ([]byte, []int) {
+	return file_hooks_v1_hooks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListInvocationsRequest) GetHookId() uint32 {
+	if x != nil {
+		return x.HookId
+	}
+	return 0
+}
+
+type ListInvocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invocations   []*Invocation          `protobuf:"bytes,1,rep,name=invocations,proto3" json:"invocations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvocationsResponse) Reset() {
+	*x = ListInvocationsResponse{}
+	mi := &file_hooks_v1_hooks_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvocationsResponse) ProtoMessage() {}
+
+func (x *ListInvocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_v1_hooks_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvocationsResponse) Descriptor() ([]byte, []int) {
+	return file_hooks_v1_hooks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListInvocationsResponse) GetInvocations() []*Invocation {
+	if x != nil {
+		return x.Invocations
+	}
+	return nil
+}
+
+type Invocation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unix seconds
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// github, gitea, gitlab
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Event    string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// running, success, failed, rejected
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// set for compose-update
+	JobId         string `protobuf:"bytes,6,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invocation) Reset() {
+	*x = Invocation{}
+	mi := &file_hooks_v1_hooks_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invocation) ProtoMessage() {}
+
+func (x *Invocation) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_v1_hooks_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invocation.ProtoReflect.Descriptor instead.
+func (*Invocation) Descriptor() ([]byte, []int) {
+	return file_hooks_v1_hooks_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Invocation) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Invocation) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Invocation) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Invocation) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Invocation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Invocation) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+var File_hooks_v1_hooks_proto protoreflect.FileDescriptor
+
+const file_hooks_v1_hooks_proto_rawDesc = "" +
+	"\n" +
+	"\x14hooks/v1/hooks.proto\x12\bhooks.v1\"\xbc\x01\n" +
+	"\x04Hook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x06 \x01(\tR\x06target\x12\x16\n" +
+	"\x06secret\x18\a \x01(\tR\x06secret\x12\x1c\n" +
+	"\thasSecret\x18\b \x01(\bR\thasSecret\"\x12\n" +
+	"\x10ListHooksRequest\"9\n" +
+	"\x11ListHooksResponse\x12$\n" +
+	"\x05hooks\x18\x01 \x03(\v2\x0e.hooks.v1.HookR\x05hooks\"#\n" +
+	"\x11DeleteHookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\x14\n" +
+	"\x12DeleteHookResponse\"0\n" +
+	"\x16ListInvocationsRequest\x12\x16\n" +
+	"\x06hookId\x18\x01 \x01(\rR\x06hookId\"Q\n" +
+	"\x17ListInvocationsResponse\x126\n" +
+	"\vinvocations\x18\x01 \x03(\v2\x14.hooks.v1.InvocationR\vinvocations\"\x94\x01\n" +
+	"\n" +
+	"Invocation\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x03R\x04time\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x14\n" +
+	"\x05jobId\x18\x06 \x01(\tR\x05jobId2\xa9\x02\n" +
+	"\fHooksService\x12F\n" +
+	"\tListHooks\x12\x1a.hooks.v1.ListHooksRequest\x1a\x1b.hooks.v1.ListHooksResponse\"\x00\x12,\n" +
+	"\bSaveHook\x12\x0e.hooks.v1.Hook\x1a\x0e.hooks.v1.Hook\"\x00\x12I\n" +
+	"\n" +
+	"DeleteHook\x12\x1b.hooks.v1.DeleteHookRequest\x1a\x1c.hooks.v1.DeleteHookResponse\"\x00\x12X\n" +
+	"\x0fListInvocations\x12 .hooks.v1.ListInvocationsRequest\x1a!.hooks.v1.ListInvocationsResponse\"\x00B\x88\x01\n" +
+	"\fcom.hooks.v1B\n" +
+	"HooksProtoP\x01Z+github.com/RA341/dockman/generated/hooks/v1\xa2\x02\x03HXX\xaa\x02\bHooks.V1\xca\x02\bHooks\\V1\xe2\x02\x14Hooks\\V1\\GPBMetadata\xea\x02\tHooks::V1b\x06proto3"
+
+var (
+	file_hooks_v1_hooks_proto_rawDescOnce sync.Once
+	file_hooks_v1_hooks_proto_rawDescData []byte
+)
+
+func file_hooks_v1_hooks_proto_rawDescGZIP() []byte {
+	file_hooks_v1_hooks_proto_rawDescOnce.Do(func() {
+		file_hooks_v1_hooks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hooks_v1_hooks_proto_rawDesc), len(file_hooks_v1_hooks_proto_rawDesc)))
+	})
+	return file_hooks_v1_hooks_proto_rawDescData
+}
+
+var file_hooks_v1_hooks_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_hooks_v1_hooks_proto_goTypes = []any{
+	(*Hook)(nil),                    // 0: hooks.v1.Hook
+	(*ListHooksRequest)(nil),        // 1: hooks.v1.ListHooksRequest
+	(*ListHooksResponse)(nil),       // 2: hooks.v1.ListHooksResponse
+	(*DeleteHookRequest)(nil),       // 3: hooks.v1.DeleteHookRequest
+	(*DeleteHookResponse)(nil),      // 4: hooks.v1.DeleteHookResponse
+	(*ListInvocationsRequest)(nil),  // 5: hooks.v1.ListInvocationsRequest
+	(*ListInvocationsResponse)(nil), // 6: hooks.v1.ListInvocationsResponse
+	(*Invocation)(nil),              // 7: hooks.v1.Invocation
+}
+var file_hooks_v1_hooks_proto_depIdxs = []int32{
+	0, // 0: hooks.v1.ListHooksResponse.hooks:type_name -> hooks.v1.Hook
+	7, // 1: hooks.v1.ListInvocationsResponse.invocations:type_name -> hooks.v1.Invocation
+	1, // 2: hooks.v1.HooksService.ListHooks:input_type -> hooks.v1.ListHooksRequest
+	0, // 3: hooks.v1.HooksService.SaveHook:input_type -> hooks.v1.Hook
+	3, // 4: hooks.v1.HooksService.DeleteHook:input_type -> hooks.v1.DeleteHookRequest
+	5, // 5: hooks.v1.HooksService.ListInvocations:input_type -> hooks.v1.ListInvocationsRequest
+	2, // 6: hooks.v1.HooksService.ListHooks:output_type -> hooks.v1.ListHooksResponse
+	0, // 7: hooks.v1.HooksService.SaveHook:output_type -> hooks.v1.Hook
+	4, // 8: hooks.v1.HooksService.DeleteHook:output_type -> hooks.v1.DeleteHookResponse
+	6, // 9: hooks.v1.HooksService.ListInvocations:output_type -> hooks.v1.ListInvocationsResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_hooks_v1_hooks_proto_init() }
+func file_hooks_v1_hooks_proto_init() {
+	if File_hooks_v1_hooks_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hooks_v1_hooks_proto_rawDesc), len(file_hooks_v1_hooks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hooks_v1_hooks_proto_goTypes,
+		DependencyIndexes: file_hooks_v1_hooks_proto_depIdxs,
+		MessageInfos:      file_hooks_v1_hooks_proto_msgTypes,
+	}.Build()
+	File_hooks_v1_hooks_proto = out.File
+	file_hooks_v1_hooks_proto_goTypes = nil
+	file_hooks_v1_hooks_proto_depIdxs = nil
+}
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: hooks/v1/hooks.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/RA341/dockman/generated/hooks/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// HooksServiceName is the fully-qualified name of the HooksService service.
+	HooksServiceName = "hooks.v1.HooksService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// HooksServiceListHooksProcedure is the fully-qualified name of the HooksService's ListHooks RPC.
+	HooksServiceListHooksProcedure = "/hooks.v1.HooksService/ListHooks"
+	// HooksServiceSaveHookProcedure is the fully-qualified name of the HooksService's SaveHook RPC.
+	HooksServiceSaveHookProcedure = "/hooks.v1.HooksService/SaveHook"
+	// HooksServiceDeleteHookProcedure is the fully-qualified name of the HooksService's DeleteHook RPC.
+	HooksServiceDeleteHookProcedure = "/hooks.v1.HooksService/DeleteHook"
+	// HooksServiceListInvocationsProcedure is the fully-qualified name of the HooksService's
+	// ListInvocations RPC.
+	HooksServiceListInvocationsProcedure = "/hooks.v1.HooksService/ListInvocations"
+)
+
+// HooksServiceClient is a client for the hooks.v1.HooksService service.
+type HooksServiceClient interface {
+	ListHooks(context.Context, *connect.Request[v1.ListHooksRequest]) (*connect.Response[v1.ListHooksResponse], error)
+	SaveHook(context.Context, *connect.Request[v1.Hook]) (*connect.Response[v1.Hook], error)
+	DeleteHook(context.Context, *connect.Request[v1.DeleteHookRequest]) (*connect.Response[v1.DeleteHookResponse], error)
+	ListInvocations(context.Context, *connect.Request[v1.ListInvocationsRequest]) (*connect.Response[v1.ListInvocationsResponse], error)
+}
+
+// NewHooksServiceClient constructs a client for the hooks.v1.HooksService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewHooksServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) HooksServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	hooksServiceMethods := v1.File_hooks_v1_hooks_proto.Services().ByName("HooksService").Methods()
+	return &hooksServiceClient{
+		listHooks: connect.NewClient[v1.ListHooksRequest, v1.ListHooksResponse](
+			httpClient,
+			baseURL+HooksServiceListHooksProcedure,
+			connect.WithSchema(hooksServiceMethods.ByName("ListHooks")),
+			connect.WithClientOptions(opts...),
+		),
+		saveHook: connect.NewClient[v1.Hook, v1.Hook](
+			httpClient,
+			baseURL+HooksServiceSaveHookProcedure,
+			connect.WithSchema(hooksServiceMethods.ByName("SaveHook")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteHook: connect.NewClient[v1.DeleteHookRequest, v1.DeleteHookResponse](
+			httpClient,
+			baseURL+HooksServiceDeleteHookProcedure,
+			connect.WithSchema(hooksServiceMethods.ByName("DeleteHook")),
+			connect.WithClientOptions(opts...),
+		),
+		listInvocations: connect.NewClient[v1.ListInvocationsRequest, v1.ListInvocationsResponse](
+			httpClient,
+			baseURL+HooksServiceListInvocationsProcedure,
+			connect.WithSchema(hooksServiceMethods.ByName("ListInvocations")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// hooksServiceClient implements HooksServiceClient.
+type hooksServiceClient struct {
+	listHooks       *connect.Client[v1.ListHooksRequest, v1.ListHooksResponse]
+	saveHook        *connect.Client[v1.Hook, v1.Hook]
+	deleteHook      *connect.Client[v1.DeleteHookRequest, v1.DeleteHookResponse]
+	listInvocations *connect.Client[v1.ListInvocationsRequest, v1.ListInvocationsResponse]
+}
+
+// ListHooks calls hooks.v1.HooksService.ListHooks.
+func (c *hooksServiceClient) ListHooks(ctx context.Context, req *connect.Request[v1.ListHooksRequest]) (*connect.Response[v1.ListHooksResponse], error) {
+	return c.listHooks.CallUnary(ctx, req)
+}
+
+// SaveHook calls hooks.v1.HooksService.SaveHook.
+func (c *hooksServiceClient) SaveHook(ctx context.Context, req *connect.Request[v1.Hook]) (*connect.Response[v1.Hook], error) {
+	return c.saveHook.CallUnary(ctx, req)
+}
+
+// DeleteHook calls hooks.v1.HooksService.DeleteHook.
+func (c *hooksServiceClient) DeleteHook(ctx context.Context, req *connect.Request[v1.DeleteHookRequest]) (*connect.Response[v1.DeleteHookResponse], error) {
+	return c.deleteHook.CallUnary(ctx, req)
+}
+
+// ListInvocations calls hooks.v1.HooksService.ListInvocations.
+func (c *hooksServiceClient) ListInvocations(ctx context.Context, req *connect.Request[v1.ListInvocationsRequest]) (*connect.Response[v1.ListInvocationsResponse], error) {
+	return c.listInvocations.CallUnary(ctx, req)
+}
+
+// HooksServiceHandler is an implementation of the hooks.v1.HooksService service.
+type HooksServiceHandler interface {
+	ListHooks(context.Context, *connect.Request[v1.ListHooksRequest]) (*connect.Response[v1.ListHooksResponse], error)
+	SaveHook(context.Context, *connect.Request[v1.Hook]) (*connect.Response[v1.Hook], error)
+	DeleteHook(context.Context, *connect.Request[v1.DeleteHookRequest]) (*connect.Response[v1.DeleteHookResponse], error)
+	ListInvocations(context.Context, *connect.Request[v1.ListInvocationsRequest]) (*connect.Response[v1.ListInvocationsResponse], error)
+}
+
+// NewHooksServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewHooksServiceHandler(svc HooksServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	hooksServiceMethods := v1.File_hooks_v1_hooks_proto.Services().ByName("HooksService").Methods()
+	hooksServiceListHooksHandler := connect.NewUnaryHandler(
+		HooksServiceListHooksProcedure,
+		svc.ListHooks,
+		connect.WithSchema(hooksServiceMethods.ByName("ListHooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	hooksServiceSaveHookHandler := connect.NewUnaryHandler(
+		HooksServiceSaveHookProcedure,
+		svc.SaveHook,
+		connect.WithSchema(hooksServiceMethods.ByName("SaveHook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	hooksServiceDeleteHookHandler := connect.NewUnaryHandler(
+		HooksServiceDeleteHookProcedure,
+		svc.DeleteHook,
+		connect.WithSchema(hooksServiceMethods.ByName("DeleteHook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	hooksServiceListInvocationsHandler := connect.NewUnaryHandler(
+		HooksServiceListInvocationsProcedure,
+		svc.ListInvocations,
+		connect.WithSchema(hooksServiceMethods.ByName("ListInvocations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/hooks.v1.HooksService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case HooksServiceListHooksProcedure:
+			hooksServiceListHooksHandler.ServeHTTP(w, r)
+		case HooksServiceSaveHookProcedure:
+			hooksServiceSaveHookHandler.ServeHTTP(w, r)
+		case HooksServiceDeleteHookProcedure:
+			hooksServiceDeleteHookHandler.ServeHTTP(w, r)
+		case HooksServiceListInvocationsProcedure:
+			hooksServiceListInvocationsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedHooksServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedHooksServiceHandler struct{}
+
+func (UnimplementedHooksServiceHandler) ListHooks(context.Context, *connect.Request[v1.ListHooksRequest]) (*connect.Response[v1.ListHooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.v1.HooksService.ListHooks is not implemented"))
+}
+
+func (UnimplementedHooksServiceHandler) SaveHook(context.Context, *connect.Request[v1.Hook]) (*connect.Response[v1.Hook], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.v1.HooksService.SaveHook is not implemented"))
+}
+
+func (UnimplementedHooksServiceHandler) DeleteHook(context.Context, *connect.Request[v1.DeleteHookRequest]) (*connect.Response[v1.DeleteHookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.v1.HooksService.DeleteHook is not implemented"))
+}
+
+func (UnimplementedHooksServiceHandler) ListInvocations(context.Context, *connect.Request[v1.ListInvocationsRequest]) (*connect.Response[v1.ListInvocationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.v1.HooksService.ListInvocations is not implemented"))
+}
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: secrets/v1/secrets.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/RA341/dockman/generated/secrets/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SecretsServiceName is the fully-qualified name of the SecretsService service.
+	SecretsServiceName = "secrets.v1.SecretsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SecretsServiceListSecretsProcedure is the fully-qualified name of the SecretsService's
+	// ListSecrets RPC.
+	SecretsServiceListSecretsProcedure = "/secrets.v1.SecretsService/ListSecrets"
+	// SecretsServiceSaveSecretProcedure is the fully-qualified name of the SecretsService's SaveSecret
+	// RPC.
+	SecretsServiceSaveSecretProcedure = "/secrets.v1.SecretsService/SaveSecret"
+	// SecretsServiceDeleteSecretProcedure is the fully-qualified name of the SecretsService's
+	// DeleteSecret RPC.
+	SecretsServiceDeleteSecretProcedure = "/secrets.v1.SecretsService/DeleteSecret"
+)
+
+// SecretsServiceClient is a client for the secrets.v1.SecretsService service.
+type SecretsServiceClient interface {
+	ListSecrets(context.Context, *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error)
+	SaveSecret(context.Context, *connect.Request[v1.SaveSecretRequest]) (*connect.Response[v1.Empty], error)
+	DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.Empty], error)
+}
+
+// NewSecretsServiceClient constructs a client for the secrets.v1.SecretsService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSecretsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SecretsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	secretsServiceMethods := v1.File_secrets_v1_secrets_proto.Services().ByName("SecretsService").Methods()
+	return &secretsServiceClient{
+		listSecrets: connect.NewClient[v1.ListSecretsRequest, v1.ListSecretsResponse](
+			httpClient,
+			baseURL+SecretsServiceListSecretsProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("ListSecrets")),
+			connect.WithClientOptions(opts...),
+		),
+		saveSecret: connect.NewClient[v1.SaveSecretRequest, v1.Empty](
+			httpClient,
+			baseURL+SecretsServiceSaveSecretProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("SaveSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSecret: connect.NewClient[v1.DeleteSecretRequest, v1.Empty](
+			httpClient,
+			baseURL+SecretsServiceDeleteSecretProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("DeleteSecret")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// secretsServiceClient implements SecretsServiceClient.
+type secretsServiceClient struct {
+	listSecrets  *connect.Client[v1.ListSecretsRequest, v1.ListSecretsResponse]
+	saveSecret   *connect.Client[v1.SaveSecretRequest, v1.Empty]
+	deleteSecret *connect.Client[v1.DeleteSecretRequest, v1.Empty]
+}
+
+// ListSecrets calls secrets.v1.SecretsService.ListSecrets.
+func (c *secretsServiceClient) ListSecrets(ctx context.Context, req *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error) {
+	return c.listSecrets.CallUnary(ctx, req)
+}
+
+// SaveSecret calls secrets.v1.SecretsService.SaveSecret.
+func (c *secretsServiceClient) SaveSecret(ctx context.Context, req *connect.Request[v1.SaveSecretRequest]) (*connect.Response[v1.Empty], error) {
+	return c.saveSecret.CallUnary(ctx, req)
+}
+
+// DeleteSecret calls secrets.v1.SecretsService.DeleteSecret.
+func (c *secretsServiceClient) DeleteSecret(ctx context.Context, req *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deleteSecret.CallUnary(ctx, req)
+}
+
+// SecretsServiceHandler is an implementation of the secrets.v1.SecretsService service.
+type SecretsServiceHandler interface {
+	ListSecrets(context.Context, *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error)
+	SaveSecret(context.Context, *connect.Request[v1.SaveSecretRequest]) (*connect.Response[v1.Empty], error)
+	DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.Empty], error)
+}
+
+// NewSecretsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSecretsServiceHandler(svc SecretsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	secretsServiceMethods := v1.File_secrets_v1_secrets_proto.Services().ByName("SecretsService").Methods()
+	secretsServiceListSecretsHandler := connect.NewUnaryHandler(
+		SecretsServiceListSecretsProcedure,
+		svc.ListSecrets,
+		connect.WithSchema(secretsServiceMethods.ByName("ListSecrets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceSaveSecretHandler := connect.NewUnaryHandler(
+		SecretsServiceSaveSecretProcedure,
+		svc.SaveSecret,
+		connect.WithSchema(secretsServiceMethods.ByName("SaveSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceDeleteSecretHandler := connect.NewUnaryHandler(
+		SecretsServiceDeleteSecretProcedure,
+		svc.DeleteSecret,
+		connect.WithSchema(secretsServiceMethods.ByName("DeleteSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/secrets.v1.SecretsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SecretsServiceListSecretsProcedure:
+			secretsServiceListSecretsHandler.ServeHTTP(w, r)
+		case SecretsServiceSaveSecretProcedure:
+			secretsServiceSaveSecretHandler.ServeHTTP(w, r)
+		case SecretsServiceDeleteSecretProcedure:
+			secretsServiceDeleteSecretHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSecretsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSecretsServiceHandler struct{}
+
+func (UnimplementedSecretsServiceHandler) ListSecrets(context.Context, *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secrets.v1.SecretsService.ListSecrets is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) SaveSecret(context.Context, *connect.Request[v1.SaveSecretRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secrets.v1.SecretsService.SaveSecret is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secrets.v1.SecretsService.DeleteSecret is not implemented"))
+}
@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: volumestats/v1/volumestats.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/RA341/dockman/generated/volumestats/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// VolumeStatsServiceName is the fully-qualified name of the VolumeStatsService service.
+	VolumeStatsServiceName = "volumestats.v1.VolumeStatsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// VolumeStatsServiceSizeHistoryProcedure is the fully-qualified name of the VolumeStatsService's
+	// SizeHistory RPC.
+	VolumeStatsServiceSizeHistoryProcedure = "/volumestats.v1.VolumeStatsService/SizeHistory"
+)
+
+// VolumeStatsServiceClient is a client for the volumestats.v1.VolumeStatsService service.
+type VolumeStatsServiceClient interface {
+	// SizeHistory sizes of a volume on the current host, oldest first
+	SizeHistory(context.Context, *connect.Request[v1.SizeHistoryRequest]) (*connect.Response[v1.SizeHistoryResponse], error)
+}
+
+// NewVolumeStatsServiceClient constructs a client for the volumestats.v1.VolumeStatsService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewVolumeStatsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) VolumeStatsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	volumeStatsServiceMethods := v1.File_volumestats_v1_volumestats_proto.Services().ByName("VolumeStatsService").Methods()
+	return &volumeStatsServiceClient{
+		sizeHistory: connect.NewClient[v1.SizeHistoryRequest, v1.SizeHistoryResponse](
+			httpClient,
+			baseURL+VolumeStatsServiceSizeHistoryProcedure,
+			connect.WithSchema(volumeStatsServiceMethods.ByName("SizeHistory")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// volumeStatsServiceClient implements VolumeStatsServiceClient.
+type volumeStatsServiceClient struct {
+	sizeHistory *connect.Client[v1.SizeHistoryRequest, v1.SizeHistoryResponse]
+}
+
+// SizeHistory calls volumestats.v1.VolumeStatsService.SizeHistory.
+func (c *volumeStatsServiceClient) SizeHistory(ctx context.Context, req *connect.Request[v1.SizeHistoryRequest]) (*connect.Response[v1.SizeHistoryResponse], error) {
+	return c.sizeHistory.CallUnary(ctx, req)
+}
+
+// VolumeStatsServiceHandler is an implementation of the volumestats.v1.VolumeStatsService service.
+type VolumeStatsServiceHandler interface {
+	// SizeHistory sizes of a volume on the current host, oldest first
+	SizeHistory(context.Context, *connect.Request[v1.SizeHistoryRequest]) (*connect.Response[v1.SizeHistoryResponse], error)
+}
+
+// NewVolumeStatsServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewVolumeStatsServiceHandler(svc VolumeStatsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	volumeStatsServiceMethods := v1.File_volumestats_v1_volumestats_proto.Services().ByName("VolumeStatsService").Methods()
+	volumeStatsServiceSizeHistoryHandler := connect.NewUnaryHandler(
+		VolumeStatsServiceSizeHistoryProcedure,
+		svc.SizeHistory,
+		connect.WithSchema(volumeStatsServiceMethods.ByName("SizeHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/volumestats.v1.VolumeStatsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case VolumeStatsServiceSizeHistoryProcedure:
+			volumeStatsServiceSizeHistoryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedVolumeStatsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedVolumeStatsServiceHandler struct{}
+
+func (UnimplementedVolumeStatsServiceHandler) SizeHistory(context.Context, *connect.Request[v1.SizeHistoryRequest]) (*connect.Response[v1.SizeHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("volumestats.v1.VolumeStatsService.SizeHistory is not implemented"))
+}
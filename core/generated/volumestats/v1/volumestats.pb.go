@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: volumestats/v1/volumestats.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SizeHistoryRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Volume string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	// unix seconds, only samples after this are returned, 0 returns all of them
+	Since         int64 `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SizeHistoryRequest) Reset() {
+	*x = SizeHistoryRequest{}
+	mi := &file_volumestats_v1_volumestats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SizeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeHistoryRequest) ProtoMessage() {}
+
+func (x *SizeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volumestats_v1_volumestats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*SizeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_volumestats_v1_volumestats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SizeHistoryRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *SizeHistoryRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type SizeHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Samples       []*SizeSample          `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SizeHistoryResponse) Reset() {
+	*x = SizeHistoryResponse{}
+	mi := &file_volumestats_v1_volumestats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SizeHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeHistoryResponse) ProtoMessage() {}
+
+func (x *SizeHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volumestats_v1_volumestats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeHistoryResponse.ProtoReflect.Descriptor instead.
+func (*SizeHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_volumestats_v1_volumestats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SizeHistoryResponse) GetSamples() []*SizeSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type SizeSample struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unix seconds
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// bytes
+	Size          int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SizeSample) Reset() {
+	*x = SizeSample{}
+	mi := &file_volumestats_v1_volumestats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SizeSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeSample) ProtoMessage() {}
+
+func (x *SizeSample) ProtoReflect() protoreflect.Message {
+	mi := &file_volumestats_v1_volumestats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeSample.ProtoReflect.Descriptor instead.
+func (*SizeSample) Descriptor() ([]byte, []int) {
+	return file_volumestats_v1_volumestats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SizeSample) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *SizeSample) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+var File_volumestats_v1_volumestats_proto protoreflect.FileDescriptor
+
+const file_volumestats_v1_volumestats_proto_rawDesc = "" +
+	"\n" +
+	" volumestats/v1/volumestats.proto\x12\x0evolumestats.v1\"B\n" +
+	"\x12SizeHistoryRequest\x12\x16\n" +
+	"\x06volume\x18\x01 \x01(\tR\x06volume\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\x03R\x05since\"K\n" +
+	"\x13SizeHistoryResponse\x124\n" +
+	"\asamples\x18\x01 \x03(\v2\x1a.volumestats.v1.SizeSampleR\asamples\"4\n" +
+	"\n" +
+	"SizeSample\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x03R\x04time\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size2n\n" +
+	"\x12VolumeStatsService\x12X\n" +
+	"\vSizeHistory\x12\".volumestats.v1.SizeHistoryRequest\x1a#.volumestats.v1.SizeHistoryResponse\"\x00B\xb2\x01\n" +
+	"\x12com.volumestats.v1B\x10VolumestatsProtoP\x01Z1github.com/RA341/dockman/generated/volumestats/v1\xa2\x02\x03VXX\xaa\x02\x0eVolumestats.V1\xca\x02\x0eVolumestats\\V1\xe2\x02\x1aVolumestats\\V1\\GPBMetadata\xea\x02\x0fVolumestats::V1b\x06proto3"
+
+var (
+	file_volumestats_v1_volumestats_proto_rawDescOnce sync.Once
+	file_volumestats_v1_volumestats_proto_rawDescData []byte
+)
+
+func file_volumestats_v1_volumestats_proto_rawDescGZIP() []byte {
+	file_volumestats_v1_volumestats_proto_rawDescOnce.Do(func() {
+		file_volumestats_v1_volumestats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_volumestats_v1_volumestats_proto_rawDesc), len(file_volumestats_v1_volumestats_proto_rawDesc)))
+	})
+	return file_volumestats_v1_volumestats_proto_rawDescData
+}
+
+var file_volumestats_v1_volumestats_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_volumestats_v1_volumestats_proto_goTypes = []any{
+	(*SizeHistoryRequest)(nil),  // 0: volumestats.v1.SizeHistoryRequest
+	(*SizeHistoryResponse)(nil), // 1: volumestats.v1.SizeHistoryResponse
+	(*SizeSample)(nil),          // 2: volumestats.v1.SizeSample
+}
+var file_volumestats_v1_volumestats_proto_depIdxs = []int32{
+	2, // 0: volumestats.v1.SizeHistoryResponse.samples:type_name -> volumestats.v1.SizeSample
+	0, // 1: volumestats.v1.VolumeStatsService.SizeHistory:input_type -> volumestats.v1.SizeHistoryRequest
+	1, // 2: volumestats.v1.VolumeStatsService.SizeHistory:output_type -> volumestats.v1.SizeHistoryResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_volumestats_v1_volumestats_proto_init() }
+func file_volumestats_v1_volumestats_proto_init() {
+	if File_volumestats_v1_volumestats_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_volumestats_v1_volumestats_proto_rawDesc), len(file_volumestats_v1_volumestats_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_volumestats_v1_volumestats_proto_goTypes,
+		DependencyIndexes: file_volumestats_v1_volumestats_proto_depIdxs,
+		MessageInfos:      file_volumestats_v1_volumestats_proto_msgTypes,
+	}.Build()
+	File_volumestats_v1_volumestats_proto = out.File
+	file_volumestats_v1_volumestats_proto_goTypes = nil
+	file_volumestats_v1_volumestats_proto_depIdxs = nil
+}
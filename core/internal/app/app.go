@@ -12,17 +12,25 @@ import (
 	"github.com/RA341/dockman/internal/app/middleware"
 	"github.com/RA341/dockman/internal/app/ui"
 	"github.com/RA341/dockman/internal/auth"
+	"github.com/RA341/dockman/internal/backup"
 	"github.com/RA341/dockman/internal/cleaner"
 	"github.com/RA341/dockman/internal/config"
 	"github.com/RA341/dockman/internal/database"
 	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/docker/jobs"
 	"github.com/RA341/dockman/internal/dockyaml"
 	"github.com/RA341/dockman/internal/files"
+	"github.com/RA341/dockman/internal/git"
+	"github.com/RA341/dockman/internal/hooks"
 	"github.com/RA341/dockman/internal/host"
 	hostMiddleware "github.com/RA341/dockman/internal/host/middleware"
 	"github.com/RA341/dockman/internal/info"
+	"github.com/RA341/dockman/internal/lsp"
+	"github.com/RA341/dockman/internal/notifications"
+	"github.com/RA341/dockman/internal/secrets"
 	"github.com/RA341/dockman/internal/ssh"
 	"github.com/RA341/dockman/internal/viewer"
+	"github.com/RA341/dockman/internal/volumestats"
 	"github.com/RA341/dockman/pkg/argos"
 	"github.com/RA341/dockman/pkg/logger"
 
@@ -41,6 +49,14 @@ type App struct {
 	CleanerSrv    *cleaner.Service
 	Viewer        *viewer.Service
 	DockYaml      *dockyaml.Service
+	Jobs          *jobs.Service
+	Git           *git.Service
+	GitSync       *git.SyncService
+	Hooks         *hooks.Service
+	Secrets       *secrets.Service
+	Backup        *backup.Service
+	VolumeStats   *volumestats.Service
+	Notifications *notifications.Service
 }
 
 func (a *App) VerifyServices() error {
@@ -99,37 +115,93 @@ func NewApp(opt ...config.AppOpt) (app *App) {
 	store := dockyaml.NewStore(dockyamlPath)
 	dockyamlSrv := dockyaml.New(store)
 
+	secretsSrv, err := secrets.New(
+		secrets.NewStore(gormDB),
+		filepath.Join(conf.ConfigDir, "secrets.key"),
+	)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error loading secrets key")
+	}
+
 	aliasStore := host.NewAliasStore(gormDB)
 	hostStore := host.NewStore(gormDB)
 	hostManager := host.NewService(
 		hostStore,
 		aliasStore,
 		sshSrv,
+		dockyamlSrv.GetYaml,
+		secretsSrv.ForStack,
 		conf.ComposeRoot,
 		conf.LocalAddr,
 	)
 
+	gitSrv := git.New(
+		hostManager.GetAlias,
+		filepath.Join(conf.ConfigDir, "history"),
+	)
+
 	fileSrv := files.New(
 		hostManager.GetAlias,
 		dockyamlSrv.GetYaml,
+		filepath.Join(conf.ConfigDir, "templates"),
+		func(hostname, alias, relpath string, contents []byte) {
+			if host.IsVolumeAlias(alias) {
+				// volume contents are application data, not config to keep history of
+				return
+			}
+			if err := gitSrv.Snapshot(hostname, alias, relpath, contents, ""); err != nil {
+				log.Warn().Err(err).Str("host", hostname).Str("file", relpath).
+					Msg("unable to snapshot file history")
+			}
+		},
 	)
 
-	//err := git.NewMigrator(composeRoot)
-	//if err != nil {
-	//	log.Fatal().Err(err).Msg("unable to complete git migration")
-	//}
-
 	userConfigSrv := config.NewService(
 		userDb,
 		func() {},
 	)
 
+	jobSrv := jobs.New(filepath.Join(conf.ConfigDir, "jobs"))
+
+	gitSyncSrv := git.NewSyncService(
+		gitSrv,
+		git.NewSyncStore(gormDB),
+		sshDb,
+		hostManager.GetDockerService,
+		jobSrv,
+	)
+
 	cleanerStore := cleaner.NewStore(gormDB)
 	cleanerSrv := cleaner.NewService(
 		hostManager.GetDockerService,
 		cleanerStore,
 	)
 
+	hooksSrv := hooks.New(
+		hooks.NewStore(gormDB),
+		hostManager.GetDockerService,
+		jobSrv,
+		gitSyncSrv,
+		cleanerSrv,
+		secretsSrv,
+	)
+
+	notifSrv := notifications.New(notifications.NewStore(gormDB))
+
+	backupSrv := backup.New(
+		backup.NewStore(gormDB),
+		hostManager.GetDockerService,
+		hostManager.GetAlias,
+		jobSrv,
+		notifSrv,
+	)
+
+	volumeStatsSrv := volumestats.New(
+		volumestats.NewStore(gormDB),
+		hostManager.ListConnected,
+		hostManager.GetDockerService,
+	)
+
 	viewerSrv := viewer.New(
 		hostManager.GetDockerService,
 		func(input, host string) (root string, relpath string, err error) {
@@ -156,6 +228,14 @@ func NewApp(opt ...config.AppOpt) (app *App) {
 		UserConfigSrv: userConfigSrv,
 		CleanerSrv:    cleanerSrv,
 		Viewer:        viewerSrv,
+		Jobs:          jobSrv,
+		Git:           gitSrv,
+		GitSync:       gitSyncSrv,
+		Hooks:         hooksSrv,
+		Secrets:       secretsSrv,
+		Backup:        backupSrv,
+		VolumeStats:   volumeStatsSrv,
+		Notifications: notifSrv,
 	}
 	err = app.VerifyServices()
 	if err != nil {
@@ -193,6 +273,7 @@ Registers all routes required by dockman with the following hierarchy
 	/ <- UI files
 	/api
 	|-----/ <- public endpoints
+	|-----/hooks <- webhook endpoints
 	|-----/auth <- auth endpoints
 	|-----/protec <- protected paths
 	|-----|-----/ 	     <- normal endpoints
@@ -250,6 +331,9 @@ func (a *App) registerApiRoutes(publicApiMux *http.ServeMux) {
 		},
 	)
 
+	// /hooks, verified by signature instead of auth
+	withSubRouter(publicApiMux, "/hooks", hooks.NewHandlerHttp(a.Hooks))
+
 	// /auth
 	authRouter := http.NewServeMux()
 	a.registerApiAuthRoutes(authRouter)
@@ -329,10 +413,14 @@ func (a *App) registerApiHostRoutes(hostMux *http.ServeMux) {
 			files.NewFileHandler(a.File),
 		),
 	)
+	// git history
+	hostMux.Handle(git.NewConnectHandler(a.Git, a.GitSync))
+	withSubRouter(hostMux, "/git", git.NewFileHandler(a.Git))
 	// docker
 	hostMux.Handle(
 		docker.NewConnectHandler(
 			a.HostManager.GetDockerService,
+			a.Jobs,
 		),
 	)
 	// docker http
@@ -341,8 +429,31 @@ func (a *App) registerApiHostRoutes(hostMux *http.ServeMux) {
 		"/docker",
 		docker.NewHandlerHttp(a.HostManager.GetDockerService),
 	)
+	// compose language server over websocket
+	hostMux.Handle(
+		"/lsp",
+		lsp.WebSocketHandler(
+			lsp.DefaultUpgrader,
+			a.HostManager.GetDockerService,
+			func(filename, host string) ([]byte, error) {
+				fs, relpath, _, err := a.File.LoadAll(filename, host)
+				if err != nil {
+					return nil, err
+				}
+				return fs.ReadFile(relpath)
+			},
+		),
+	)
 	// cleaner
 	hostMux.Handle(cleaner.NewHandler(a.CleanerSrv))
+	// webhooks
+	hostMux.Handle(hooks.NewHandler(a.Hooks))
+	// stack secrets
+	hostMux.Handle(secrets.NewHandler(a.Secrets))
+	// stack backups
+	hostMux.Handle(backup.NewHandler(a.Backup))
+	// volume size history
+	hostMux.Handle(volumestats.NewHandler(a.VolumeStats))
 	// viewer
 	hostMux.Handle(viewer.NewHandler(a.Viewer))
 }
@@ -14,7 +14,7 @@ const KeyUserCtx = "user"
 
 func Middleware(service *Service, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ok := CheckAuth(w, r, service)
+		r, ok := CheckAuth(w, r, service)
 		if !ok {
 			return
 		}
@@ -24,14 +24,15 @@ func Middleware(service *Service, next http.Handler) http.Handler {
 
 const oidcPage = "/api/auth/login/oidc"
 
-func CheckAuth(w http.ResponseWriter, r *http.Request, srv *Service) (ok bool) {
+// CheckAuth verifies the session of a request, the returned
+// request carries the logged-in user, see GetUser
+func CheckAuth(w http.ResponseWriter, r *http.Request, srv *Service) (*http.Request, bool) {
 	u, err := verifyCookie(r.Cookies(), srv)
 	if err == nil {
-		r.WithContext(context.WithValue(
+		return r.WithContext(context.WithValue(
 			r.Context(),
 			KeyUserCtx, u,
-		))
-		return true
+		)), true
 	}
 
 	if srv.config.OIDCEnable && srv.config.OIDCAutoRedirect {
@@ -42,11 +43,20 @@ func CheckAuth(w http.ResponseWriter, r *http.Request, srv *Service) (ok bool) {
 		if err != nil {
 			log.Warn().Err(err).Msg("Failed to write response")
 		}
-		return false
+		return r, false
 	}
 
 	http.Error(w, err.Error(), http.StatusUnauthorized)
-	return false
+	return r, false
+}
+
+// GetUser returns the user logged in for a request that passed the auth Middleware
+func GetUser(ctx context.Context) (*User, error) {
+	u, ok := ctx.Value(KeyUserCtx).(*User)
+	if !ok || u == nil {
+		return nil, fmt.Errorf("user not found in context")
+	}
+	return u, nil
 }
 
 func getCookie(cookieName string, cookies []*http.Cookie) (*http.Cookie, error) {
@@ -0,0 +1,106 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/backup/v1"
+	"github.com/RA341/dockman/generated/backup/v1/v1connect"
+	"github.com/RA341/dockman/internal/docker/jobs"
+	"github.com/RA341/dockman/internal/host/middleware"
+	"github.com/RA341/dockman/pkg/listutils"
+)
+
+type Handler struct {
+	srv *Service
+}
+
+func NewHandler(srv *Service) (string, http.Handler) {
+	h := &Handler{srv: srv}
+	return v1connect.NewBackupServiceHandler(h)
+}
+
+func (h *Handler) GetConfig(ctx context.Context, req *connect.Request[v1.GetConfigRequest]) (*connect.Response[v1.Config], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := h.srv.GetConfig(hostname, req.Msg.Filename)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(conf.ToProto()), nil
+}
+
+func (h *Handler) SaveConfig(ctx context.Context, req *connect.Request[v1.Config]) (*connect.Response[v1.SaveConfigResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var conf Config
+	conf.FromProto(req.Msg)
+	conf.Host = hostname
+
+	if err = h.srv.SaveConfig(&conf); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewResponse(&v1.SaveConfigResponse{}), nil
+}
+
+func (h *Handler) RunBackup(ctx context.Context, req *connect.Request[v1.RunBackupRequest]) (*connect.Response[v1.JobResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := h.srv.Start(hostname, req.Msg.Filename)
+	if err != nil {
+		return nil, toConnectErr(err)
+	}
+	return connect.NewResponse(&v1.JobResponse{JobId: job.ID}), nil
+}
+
+func (h *Handler) ListRuns(ctx context.Context, req *connect.Request[v1.ListRunsRequest]) (*connect.Response[v1.ListRunsResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := h.srv.Runs(hostname, req.Msg.Filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ListRunsResponse{
+		Runs: listutils.ToMap(runs, func(run Run) *v1.Run {
+			return run.ToProto()
+		}),
+	}), nil
+}
+
+func (h *Handler) Restore(ctx context.Context, req *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.JobResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := h.srv.Restore(hostname, uint(req.Msg.RunId), req.Msg.Sources)
+	if err != nil {
+		return nil, toConnectErr(err)
+	}
+	return connect.NewResponse(&v1.JobResponse{JobId: job.ID}), nil
+}
+
+func toConnectErr(err error) error {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, jobs.ErrStackBusy):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	}
+	return err
+}
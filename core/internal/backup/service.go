@@ -0,0 +1,594 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/docker/container"
+	"github.com/RA341/dockman/internal/docker/jobs"
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/RA341/dockman/internal/notifications"
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/RA341/dockman/pkg/syncmap"
+	"github.com/go-co-op/gocron/v2"
+	dockerContainer "github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+var ErrNotFound = errors.New("backup not found")
+
+type DockerProvider func(host string) (*docker.Service, error)
+
+type FsProvider func(host, alias string) (filesystem.FileSystem, error)
+
+// maxFailedRuns failed runs kept per stack, successful runs follow the retention of the config
+const maxFailedRuns = 20
+
+// runDirFormat names the directory of a run in the destination
+const runDirFormat = "20060102-150405"
+
+type Service struct {
+	store  Store
+	docker DockerProvider
+	fs     FsProvider
+	jobs   *jobs.Service
+	notify *notifications.Service
+	log    zerolog.Logger
+
+	taskList syncmap.Map[string, gocron.Job]
+	schd     gocron.Scheduler
+}
+
+func New(store Store, docker DockerProvider, fs FsProvider, jobs *jobs.Service, notify *notifications.Service) *Service {
+	s := &Service{
+		store:  store,
+		docker: docker,
+		fs:     fs,
+		jobs:   jobs,
+		notify: notify,
+		log:    log.With().Str("service", "backup").Logger(),
+	}
+
+	// jobs don't survive a restart, anything still running was cut off
+	if err := store.FailRunning("interrupted by a restart of dockman"); err != nil {
+		s.log.Warn().Err(err).Msg("Failed to mark interrupted backups as failed")
+	}
+
+	schd, err := gocron.NewScheduler()
+	if err != nil {
+		s.log.Fatal().Err(err).Msg("Failed to initialize backup scheduler")
+	}
+	s.schd = schd
+	schd.Start()
+
+	s.StartEnabled()
+
+	return s
+}
+
+func (s *Service) StartEnabled() {
+	enabled, err := s.store.GetEnabled()
+	if err != nil {
+		s.log.Warn().Err(err).Msg("Failed to get enabled backup configs")
+		return
+	}
+
+	for _, conf := range enabled {
+		if err = s.schedule(&conf); err != nil {
+			s.log.Warn().Err(err).Str("host", conf.Host).Str("stack", conf.Stack).
+				Msg("Failed to schedule backup")
+		}
+	}
+}
+
+func (s *Service) GetConfig(host, stack string) (Config, error) {
+	return s.store.GetConfig(host, stack)
+}
+
+// SaveConfig updates the backup config of a stack and reschedules it
+func (s *Service) SaveConfig(conf *Config) error {
+	if !conf.Volumes && !conf.BindMounts {
+		return fmt.Errorf("select volumes, bind mounts or both to back up")
+	}
+	if conf.DestHost == "" && !filepath.IsAbs(conf.DestPath) {
+		return fmt.Errorf("destination path must be absolute when no host is set")
+	}
+	if conf.DestHost != "" && conf.DestAlias == "" {
+		return fmt.Errorf("destination alias is required when a host is set")
+	}
+	if conf.Enabled && conf.Schedule == "" {
+		return fmt.Errorf("schedule is required")
+	}
+
+	existing, err := s.store.GetConfig(conf.Host, conf.Stack)
+	if err != nil {
+		return err
+	}
+	conf.ID = existing.ID
+	conf.CreatedAt = existing.CreatedAt
+
+	// scheduling first rejects invalid cron expressions
+	if err = s.schedule(conf); err != nil {
+		return fmt.Errorf("invalid schedule: %w", err)
+	}
+	return s.store.SaveConfig(conf)
+}
+
+func (s *Service) Runs(host, stack string) ([]Run, error) {
+	return s.store.ListRuns(host, stack)
+}
+
+func (s *Service) schedule(conf *Config) error {
+	key := conf.Host + "/" + conf.Stack
+
+	jb, ok := s.taskList.Load(key)
+	if !conf.Enabled {
+		if ok {
+			s.taskList.Delete(key)
+			return s.schd.RemoveJob(jb.ID())
+		}
+		return nil
+	}
+
+	jobDef := gocron.CronJob(conf.Schedule, false)
+	task := gocron.NewTask(s.scheduled, conf.Host, conf.Stack)
+
+	var err error
+	if ok {
+		jb, err = s.schd.Update(jb.ID(), jobDef, task)
+	} else {
+		jb, err = s.schd.NewJob(jobDef, task, gocron.WithSingletonMode(gocron.LimitModeReschedule))
+	}
+	if err != nil {
+		return err
+	}
+
+	s.taskList.Store(key, jb)
+	return nil
+}
+
+func (s *Service) scheduled(ctx context.Context, host, stack string) {
+	job, err := s.Start(host, stack)
+	if err == nil {
+		err = job.Wait(ctx)
+	}
+	if err != nil {
+		s.log.Warn().Err(err).Str("host", host).Str("stack", stack).Msg("backup failed")
+		s.notify.Send(
+			notifications.LevelBackup,
+			fmt.Sprintf("Backup of %s on %s failed", stack, host),
+			err.Error(),
+		)
+	}
+}
+
+// Start backs up a stack in a job, which holds the stack
+// so no other operation runs on it in the meantime
+func (s *Service) Start(host, stack string) (*jobs.Job, error) {
+	conf, err := s.store.GetConfig(host, stack)
+	if err != nil {
+		return nil, err
+	}
+	if conf.ID == 0 {
+		return nil, fmt.Errorf("%s has no backup config", stack)
+	}
+
+	return s.jobs.Start(host, stack, "backup", true, func(ctx context.Context, out io.Writer) error {
+		return s.backup(ctx, &conf, out)
+	})
+}
+
+// Restore extracts the archives of a run back into their volumes and bind mounts in a job,
+// sources limits it to some of the archives. The contents of each target are replaced
+// and the running containers of the stack are stopped while restoring
+func (s *Service) Restore(host string, runID uint, sources []string) (*jobs.Job, error) {
+	run, err := s.store.GetRun(host, runID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if run.State != StateSuccess {
+		return nil, fmt.Errorf("only successful backups can be restored")
+	}
+
+	archives := run.Archives
+	if len(sources) != 0 {
+		archives = slices.DeleteFunc(archives, func(archive Archive) bool {
+			return !slices.Contains(sources, archive.Source)
+		})
+	}
+	if len(archives) == 0 {
+		return nil, fmt.Errorf("no archives to restore")
+	}
+
+	return s.jobs.Start(host, run.Stack, "restore", true, func(ctx context.Context, out io.Writer) error {
+		return s.restore(ctx, &run, archives, out)
+	})
+}
+
+func (s *Service) backup(ctx context.Context, conf *Config, out io.Writer) error {
+	run := &Run{
+		Host:      conf.Host,
+		Stack:     conf.Stack,
+		State:     StateRunning,
+		DestHost:  conf.DestHost,
+		DestAlias: conf.DestAlias,
+		DestPath:  conf.DestPath,
+		Dir:       path.Join(stackDir(conf.Stack), time.Now().Format(runDirFormat)),
+	}
+	if err := s.store.SaveRun(run); err != nil {
+		return err
+	}
+
+	err := s.archive(ctx, conf, run, out)
+	if err != nil {
+		run.State = StateFailed
+		run.Err = err.Error()
+	} else {
+		run.State = StateSuccess
+	}
+	if saveErr := s.store.SaveRun(run); saveErr != nil {
+		return errors.Join(err, saveErr)
+	}
+
+	s.prune(conf)
+	return err
+}
+
+// archive writes a tarball of every volume and bind mount of the stack to the run directory,
+// nothing is left behind if any of them fails
+func (s *Service) archive(ctx context.Context, conf *Config, run *Run, out io.Writer) (err error) {
+	dkSrv, err := s.docker(conf.Host)
+	if err != nil {
+		return err
+	}
+	fsCli, dir, err := s.destination(run)
+	if err != nil {
+		return fmt.Errorf("unable to open destination: %w", err)
+	}
+
+	containers, err := dkSrv.Compose.List(ctx, conf.Stack)
+	if err != nil {
+		return fmt.Errorf("unable to list containers: %w", err)
+	}
+	sources, err := collectSources(ctx, dkSrv.Container, containers, conf.Volumes, conf.BindMounts)
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		return fmt.Errorf("stack has no volumes or bind mounts to back up")
+	}
+
+	if err = fsCli.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("unable to create %s: %w", dir, err)
+	}
+	defer func() {
+		if err != nil {
+			run.Archives = nil
+			if rmErr := fsCli.RemoveAll(dir); rmErr != nil {
+				s.log.Warn().Err(rmErr).Str("dir", dir).Msg("unable to remove incomplete backup")
+			}
+		}
+	}()
+
+	if conf.StopServices {
+		if running := runningIds(containers); len(running) != 0 {
+			_, _ = fmt.Fprintf(out, "stopping %d containers\n", len(running))
+			if err = dkSrv.Container.ContainersStop(ctx, running...); err != nil {
+				return err
+			}
+			defer func() {
+				_, _ = fmt.Fprintf(out, "starting %d containers\n", len(running))
+				// the services have to come back even if the backup was canceled
+				startErr := dkSrv.Container.ContainersStart(context.WithoutCancel(ctx), running...)
+				err = errors.Join(err, startErr)
+			}()
+		}
+	}
+
+	for _, src := range sources {
+		_, _ = fmt.Fprintf(out, "archiving %s %s\n", src.Kind, src.Source)
+
+		name := src.archiveName()
+		size, err := archiveSource(ctx, dkSrv.Container, fsCli, path.Join(dir, name), src)
+		if err != nil {
+			return fmt.Errorf("unable to archive %s %s: %w", src.Kind, src.Source, err)
+		}
+		run.Archives = append(run.Archives, Archive{
+			Kind:   src.Kind,
+			Source: src.Source,
+			Name:   name,
+			Size:   size,
+		})
+	}
+
+	_, _ = fmt.Fprintf(out, "wrote %d archives to %s\n", len(run.Archives), dir)
+	return nil
+}
+
+func (s *Service) restore(ctx context.Context, run *Run, archives []Archive, out io.Writer) (err error) {
+	dkSrv, err := s.docker(run.Host)
+	if err != nil {
+		return err
+	}
+	fsCli, dir, err := s.destination(run)
+	if err != nil {
+		return fmt.Errorf("unable to open destination: %w", err)
+	}
+
+	containers, err := dkSrv.Compose.List(ctx, run.Stack)
+	if err != nil {
+		return fmt.Errorf("unable to list containers: %w", err)
+	}
+	if running := runningIds(containers); len(running) != 0 {
+		_, _ = fmt.Fprintf(out, "stopping %d containers\n", len(running))
+		if err = dkSrv.Container.ContainersStop(ctx, running...); err != nil {
+			return err
+		}
+		defer func() {
+			_, _ = fmt.Fprintf(out, "starting %d containers\n", len(running))
+			startErr := dkSrv.Container.ContainersStart(context.WithoutCancel(ctx), running...)
+			err = errors.Join(err, startErr)
+		}()
+	}
+
+	for _, archive := range archives {
+		_, _ = fmt.Fprintf(out, "restoring %s %s\n", archive.Kind, archive.Source)
+
+		err = restoreArchive(ctx, dkSrv.Container, fsCli, path.Join(dir, archive.Name), archive)
+		if err != nil {
+			return fmt.Errorf("unable to restore %s %s: %w", archive.Kind, archive.Source, err)
+		}
+	}
+	return nil
+}
+
+// prune deletes the successful runs beyond the retention of the stack
+// along with their archives, and failed runs beyond maxFailedRuns
+func (s *Service) prune(conf *Config) {
+	runs, err := s.store.ListRuns(conf.Host, conf.Stack)
+	if err != nil {
+		s.log.Warn().Err(err).Str("stack", conf.Stack).Msg("unable to list backups to prune")
+		return
+	}
+
+	var kept, failed uint
+	for _, run := range runs {
+		switch run.State {
+		case StateSuccess:
+			kept++
+			if conf.Retention == 0 || kept <= conf.Retention {
+				continue
+			}
+		case StateFailed:
+			failed++
+			if failed <= maxFailedRuns {
+				continue
+			}
+		default:
+			continue
+		}
+
+		if err = s.deleteRun(&run); err != nil {
+			s.log.Warn().Err(err).Str("stack", conf.Stack).Str("dir", run.Dir).
+				Msg("unable to prune backup")
+		}
+	}
+}
+
+func (s *Service) deleteRun(run *Run) error {
+	if run.State == StateSuccess {
+		fsCli, dir, err := s.destination(run)
+		if err != nil {
+			return err
+		}
+		// the run is kept if its archives can't be removed, so it is retried on the next prune
+		if err = fsCli.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+	return s.store.DeleteRun(run.ID)
+}
+
+// destination returns the filesystem archives of a run are stored on
+// and the directory of the run in it
+func (s *Service) destination(run *Run) (filesystem.FileSystem, string, error) {
+	if run.DestHost == "" {
+		return filesystem.NewLocal(run.DestPath), run.Dir, nil
+	}
+
+	fsCli, err := s.fs(run.DestHost, run.DestAlias)
+	if err != nil {
+		return nil, "", err
+	}
+	return fsCli, path.Join(run.DestPath, run.Dir), nil
+}
+
+// stackDir the directory backups of a stack are kept in
+func stackDir(stack string) string {
+	return slug(strings.TrimSuffix(stack, path.Ext(stack)))
+}
+
+func slug(name string) string {
+	return strings.ReplaceAll(strings.Trim(name, "/"), "/", "_")
+}
+
+type source struct {
+	Kind Kind
+	// Source volume name or host path
+	Source string
+}
+
+// archiveName the slug is only readable, different sources can share one
+// like /srv/a_b and /srv/a/b so a short hash of the source keeps names unique
+func (s source) archiveName() string {
+	sum := sha256.Sum256([]byte(s.Source))
+	return string(s.Kind) + "-" + slug(s.Source) + "-" + hex.EncodeToString(sum[:4]) + ".tar.gz"
+}
+
+func (s source) mount(target string, readOnly bool) mount.Mount {
+	typ := mount.TypeVolume
+	if s.Kind == KindBind {
+		typ = mount.TypeBind
+	}
+	return mount.Mount{Type: typ, Source: s.Source, Target: target, ReadOnly: readOnly}
+}
+
+// collectSources lists the volumes and bind mounts used by containers,
+// bind mounts of single files such as sockets are skipped
+func collectSources(
+	ctx context.Context,
+	cli *container.Service,
+	containers []dockerContainer.Summary,
+	volumes, binds bool,
+) ([]source, error) {
+	var sources []source
+	var bindPaths []string
+	for _, cont := range containers {
+		for _, mnt := range cont.Mounts {
+			switch {
+			case volumes && mnt.Type == mount.TypeVolume && mnt.Name != "":
+				src := source{Kind: KindVolume, Source: mnt.Name}
+				if !slices.Contains(sources, src) {
+					sources = append(sources, src)
+				}
+			case binds && mnt.Type == mount.TypeBind && !slices.Contains(bindPaths, mnt.Source):
+				bindPaths = append(bindPaths, mnt.Source)
+			}
+		}
+	}
+
+	dirs, err := directories(ctx, cli, bindPaths)
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range dirs {
+		sources = append(sources, source{Kind: KindBind, Source: dir})
+	}
+	return sources, nil
+}
+
+// directories returns the paths on the docker host that are directories
+func directories(ctx context.Context, cli *container.Service, paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	mounts := make([]mount.Mount, len(paths))
+	for i, p := range paths {
+		mounts[i] = source{Kind: KindBind, Source: p}.mount("/probe/"+strconv.Itoa(i), true)
+	}
+
+	var stdout bytes.Buffer
+	err := cli.RunHelper(ctx, container.HelperOptions{
+		Mounts: mounts,
+		Cmd:    []string{"sh", "-c", `for p in /probe/*; do [ -d "$p" ] && basename "$p"; done; true`},
+		Stdout: &stdout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to inspect bind mounts: %w", err)
+	}
+
+	var dirs []string
+	for _, line := range strings.Fields(stdout.String()) {
+		i, err := strconv.Atoi(line)
+		if err != nil || i < 0 || i >= len(paths) {
+			continue
+		}
+		dirs = append(dirs, paths[i])
+	}
+	slices.Sort(dirs)
+	return dirs, nil
+}
+
+// archiveSource streams a tarball of src from a helper container to filename
+func archiveSource(
+	ctx context.Context,
+	cli *container.Service,
+	fsCli filesystem.FileSystem,
+	filename string,
+	src source,
+) (int64, error) {
+	pr, pw := io.Pipe()
+	counter := &countingWriter{w: pw}
+
+	helperErr := make(chan error, 1)
+	go func() {
+		err := cli.RunHelper(ctx, container.HelperOptions{
+			Mounts: []mount.Mount{src.mount("/source", true)},
+			Cmd:    []string{"tar", "-czf", "-", "-C", "/source", "."},
+			Stdout: counter,
+		})
+		_ = pw.CloseWithError(err)
+		helperErr <- err
+	}()
+
+	err := filesystem.WriteAtomic(fsCli, filename, pr, 0o600)
+	// unblocks the helper if writing failed
+	_ = pr.CloseWithError(err)
+	if hErr := <-helperErr; hErr != nil {
+		return 0, hErr
+	}
+	return counter.n, err
+}
+
+// restoreArchive replaces the contents of the volume or bind mount of archive with filename
+func restoreArchive(
+	ctx context.Context,
+	cli *container.Service,
+	fsCli filesystem.FileSystem,
+	filename string,
+	archive Archive,
+) error {
+	file, err := fsCli.OpenFile(filename, os.O_RDONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(file)
+
+	src := source{Kind: archive.Kind, Source: archive.Source}
+	return cli.RunHelper(ctx, container.HelperOptions{
+		Mounts: []mount.Mount{src.mount("/target", false)},
+		Cmd:    []string{"sh", "-c", "find /target -mindepth 1 -delete && tar -xzf - -C /target"},
+		Stdin:  file,
+	})
+}
+
+func runningIds(containers []dockerContainer.Summary) []string {
+	var ids []string
+	for _, cont := range containers {
+		if cont.State == dockerContainer.StateRunning {
+			ids = append(ids, cont.ID)
+		}
+	}
+	return ids
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,109 @@
+package backup
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/RA341/dockman/internal/database"
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrune(t *testing.T) {
+	errNoHost := errors.New("no such host")
+	store := NewStore(database.New(t.TempDir(), false))
+	srv := New(
+		store,
+		func(host string) (*docker.Service, error) {
+			return nil, errNoHost
+		},
+		func(host, alias string) (filesystem.FileSystem, error) {
+			return nil, errNoHost
+		},
+		nil,
+		nil,
+	)
+
+	dest := t.TempDir()
+	stack := "compose/app/compose.yaml"
+	conf := &Config{Host: "local", Stack: stack, Volumes: true, DestPath: dest, Retention: 2}
+
+	require.Error(t, srv.SaveConfig(&Config{Host: "local", Stack: stack, DestPath: dest}))
+	require.Error(t, srv.SaveConfig(&Config{Host: "local", Stack: stack, Volumes: true, DestPath: "backups"}))
+	require.Error(t, srv.SaveConfig(&Config{Host: "local", Stack: stack, Volumes: true, DestHost: "remote"}))
+	require.Error(t, srv.SaveConfig(&Config{
+		Host: "local", Stack: stack, Volumes: true, DestPath: dest, Enabled: true, Schedule: "every night",
+	}))
+	require.NoError(t, srv.SaveConfig(conf))
+
+	_, err := srv.Start("local", "compose/other/compose.yaml")
+	require.Error(t, err)
+
+	start := time.Now().Add(-time.Hour)
+	for i := range 3 {
+		run := &Run{
+			Host:     "local",
+			Stack:    stack,
+			State:    StateSuccess,
+			DestPath: dest,
+			Dir:      filepath.Join(stackDir(stack), start.Add(time.Duration(i)*time.Minute).Format(runDirFormat)),
+			Archives: []Archive{{Kind: KindVolume, Source: "app_data", Name: "volume-app_data.tar.gz"}},
+		}
+		run.CreatedAt = start.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, os.MkdirAll(filepath.Join(dest, run.Dir), 0o755))
+		require.NoError(t, store.SaveRun(run))
+	}
+	failed := &Run{Host: "local", Stack: stack, State: StateFailed, Err: "boom"}
+	require.NoError(t, store.SaveRun(failed))
+
+	srv.prune(conf)
+
+	runs, err := srv.Runs("local", stack)
+	require.NoError(t, err)
+	require.Len(t, runs, 3)
+	require.Equal(t, StateFailed, runs[0].State)
+	require.Len(t, runs[1].Archives, 1)
+
+	entries, err := os.ReadDir(filepath.Join(dest, "compose_app_compose"))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for i, entry := range entries {
+		require.Equal(t, filepath.Base(runs[2-i].Dir), entry.Name())
+	}
+
+	_, err = srv.Restore("local", failed.ID, nil)
+	require.Error(t, err)
+	_, err = srv.Restore("local", runs[1].ID, []string{"missing"})
+	require.Error(t, err)
+	_, err = srv.Restore("remote", runs[1].ID, nil)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestArchiveName(t *testing.T) {
+	require.Regexp(t, `^volume-app_data-[0-9a-f]{8}\.tar\.gz$`, source{Kind: KindVolume, Source: "app_data"}.archiveName())
+	require.Regexp(t, `^bind-srv_app_config-[0-9a-f]{8}\.tar\.gz$`, source{Kind: KindBind, Source: "/srv/app/config"}.archiveName())
+	require.NotEqual(t,
+		source{Kind: KindBind, Source: "/srv/a_b"}.archiveName(),
+		source{Kind: KindBind, Source: "/srv/a/b"}.archiveName(),
+	)
+	require.Equal(t, "compose_app_compose", stackDir("compose/app/compose.yaml"))
+}
+
+func TestFailInterrupted(t *testing.T) {
+	store := NewStore(database.New(t.TempDir(), false))
+	stack := "compose/app/compose.yaml"
+	require.NoError(t, store.SaveRun(&Run{Host: "local", Stack: stack, State: StateRunning}))
+	require.NoError(t, store.SaveRun(&Run{Host: "local", Stack: stack, State: StateSuccess}))
+
+	New(store, nil, nil, nil, nil)
+
+	runs, err := store.ListRuns("local", stack)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	states := []State{runs[0].State, runs[1].State}
+	require.ElementsMatch(t, []State{StateFailed, StateSuccess}, states)
+}
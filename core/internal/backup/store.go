@@ -0,0 +1,103 @@
+package backup
+
+import (
+	"gorm.io/gorm"
+)
+
+type State string
+
+const (
+	StateRunning State = "running"
+	StateSuccess State = "success"
+	StateFailed  State = "failed"
+)
+
+type Kind string
+
+const (
+	KindVolume Kind = "volume"
+	KindBind   Kind = "bind"
+)
+
+// Config how and when a stack is backed up
+type Config struct {
+	gorm.Model
+	Host string `gorm:"uniqueIndex:idx_backup_stack"`
+	// Stack the compose file as <alias>/<relpath>
+	Stack string `gorm:"uniqueIndex:idx_backup_stack"`
+
+	Enabled bool
+	// Schedule cron expression backups are run on
+	Schedule string
+	// StopServices stops the running containers of the stack while archiving
+	StopServices bool
+	Volumes      bool
+	BindMounts   bool
+
+	// DestHost and DestAlias the alias archives are written to,
+	// if DestHost is empty DestPath is a directory on the machine running dockman
+	DestHost  string
+	DestAlias string
+	// DestPath directory archives are written to, relative to the alias if set
+	DestPath string
+	// Retention number of successful backups kept, 0 keeps all of them
+	Retention uint
+}
+
+func (*Config) TableName() string {
+	return "backup_configs"
+}
+
+// Run a single backup of a stack
+type Run struct {
+	gorm.Model
+	Host  string `gorm:"index:idx_backup_run"`
+	Stack string `gorm:"index:idx_backup_run"`
+
+	State State
+	Err   string
+
+	// destination the archives were written to, copied from the config
+	// so a run can be restored after the config changed
+	DestHost  string
+	DestAlias string
+	DestPath  string
+	// Dir of the archives in the destination
+	Dir string
+
+	Archives []Archive
+}
+
+func (*Run) TableName() string {
+	return "backup_runs"
+}
+
+// Archive a compressed tarball of a volume or bind mount
+type Archive struct {
+	gorm.Model
+	RunID uint `gorm:"index"`
+
+	Kind Kind
+	// Source the volume name or host path of the bind mount
+	Source string
+	// Name of the archive file in the run directory
+	Name string
+	Size int64
+}
+
+func (*Archive) TableName() string {
+	return "backup_archives"
+}
+
+type Store interface {
+	GetEnabled() ([]Config, error)
+	GetConfig(host, stack string) (Config, error)
+	SaveConfig(*Config) error
+
+	SaveRun(*Run) error
+	GetRun(host string, id uint) (Run, error)
+	ListRuns(host, stack string) ([]Run, error)
+	DeleteRun(id uint) error
+	// FailRunning marks every run still running as failed with msg
+	FailRunning(msg string) error
+}
@@ -0,0 +1,85 @@
+package backup
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type GormStore struct {
+	db *gorm.DB
+}
+
+func NewStore(db *gorm.DB) *GormStore {
+	return &GormStore{db: db}
+}
+
+func (g *GormStore) GetEnabled() ([]Config, error) {
+	var results []Config
+	err := g.db.
+		Where("enabled = ?", true).
+		Find(&results).
+		Error
+	return results, err
+}
+
+// GetConfig returns the backup config of a stack, a zero config if it has none
+func (g *GormStore) GetConfig(host, stack string) (Config, error) {
+	var dest Config
+	err := g.db.
+		Where("host = ? AND stack = ?", host, stack).
+		First(&dest).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Config{Host: host, Stack: stack}, nil
+	}
+	return dest, err
+}
+
+func (g *GormStore) SaveConfig(config *Config) error {
+	return g.db.Save(config).Error
+}
+
+// SaveRun creates or updates a run along with its archives
+func (g *GormStore) SaveRun(run *Run) error {
+	return g.db.Save(run).Error
+}
+
+func (g *GormStore) GetRun(host string, id uint) (Run, error) {
+	var run Run
+	err := g.db.
+		Preload("Archives").
+		Where("host = ? AND id = ?", host, id).
+		First(&run).
+		Error
+	return run, err
+}
+
+func (g *GormStore) ListRuns(host, stack string) ([]Run, error) {
+	var runs []Run
+	err := g.db.
+		Preload("Archives").
+		Where("host = ? AND stack = ?", host, stack).
+		Order("created_at DESC").
+		Find(&runs).
+		Error
+	return runs, err
+}
+
+func (g *GormStore) FailRunning(msg string) error {
+	return g.db.
+		Model(&Run{}).
+		Where("state = ?", StateRunning).
+		Updates(map[string]any{"state": StateFailed, "err": msg}).
+		Error
+}
+
+func (g *GormStore) DeleteRun(id uint) error {
+	return g.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("run_id = ?", id).Delete(&Archive{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Delete(&Run{}, id).Error
+	})
+}
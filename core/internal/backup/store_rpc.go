@@ -0,0 +1,60 @@
+package backup
+
+import (
+	"path"
+
+	v1 "github.com/RA341/dockman/generated/backup/v1"
+	"github.com/RA341/dockman/pkg/listutils"
+)
+
+func (c *Config) ToProto() *v1.Config {
+	return &v1.Config{
+		Filename:     c.Stack,
+		Enabled:      c.Enabled,
+		Schedule:     c.Schedule,
+		StopServices: c.StopServices,
+		Volumes:      c.Volumes,
+		BindMounts:   c.BindMounts,
+		DestHost:     c.DestHost,
+		DestAlias:    c.DestAlias,
+		DestPath:     c.DestPath,
+		Retention:    uint32(c.Retention),
+	}
+}
+
+func (c *Config) FromProto(rpcConf *v1.Config) {
+	c.Stack = rpcConf.Filename
+	c.Enabled = rpcConf.Enabled
+	c.Schedule = rpcConf.Schedule
+	c.StopServices = rpcConf.StopServices
+	c.Volumes = rpcConf.Volumes
+	c.BindMounts = rpcConf.BindMounts
+	c.DestHost = rpcConf.DestHost
+	c.DestAlias = rpcConf.DestAlias
+	c.DestPath = rpcConf.DestPath
+	c.Retention = uint(rpcConf.Retention)
+}
+
+func (r *Run) ToProto() *v1.Run {
+	return &v1.Run{
+		Id:        uint32(r.ID),
+		Time:      r.CreatedAt.Unix(),
+		State:     string(r.State),
+		Error:     r.Err,
+		DestHost:  r.DestHost,
+		DestAlias: r.DestAlias,
+		Dir:       path.Join(r.DestPath, r.Dir),
+		Archives: listutils.ToMap(r.Archives, func(a Archive) *v1.Archive {
+			return a.ToProto()
+		}),
+	}
+}
+
+func (a *Archive) ToProto() *v1.Archive {
+	return &v1.Archive{
+		Kind:   string(a.Kind),
+		Source: a.Source,
+		Name:   a.Name,
+		Size:   a.Size,
+	}
+}
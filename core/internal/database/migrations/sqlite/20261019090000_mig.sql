@@ -0,0 +1,73 @@
+-- +goose Up
+-- create "sync_configs" table
+CREATE TABLE IF NOT EXISTS `sync_configs`
+(
+    `id`         integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at` datetime NULL,
+    `updated_at` datetime NULL,
+    `deleted_at` datetime NULL,
+    `host`       text     NULL,
+    `alias`      text     NULL,
+    `enabled`    numeric  NULL,
+    `interval`   integer  NULL,
+    `remote`     text     NULL,
+    `branch`     text     NULL,
+    `deploy`     numeric  NULL,
+    `username`   text     NULL,
+    `token`      text     NULL,
+    `ssh_key`    text     NULL,
+    `host_key`   text     NULL
+);
+-- create index "idx_sync_alias" to table: "sync_configs"
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_sync_alias` ON `sync_configs` (`host`, `alias`);
+-- create index "idx_sync_configs_deleted_at" to table: "sync_configs"
+CREATE INDEX IF NOT EXISTS `idx_sync_configs_deleted_at` ON `sync_configs` (`deleted_at`);
+-- create "sync_results" table
+CREATE TABLE IF NOT EXISTS `sync_results`
+(
+    `id`         integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at` datetime NULL,
+    `updated_at` datetime NULL,
+    `deleted_at` datetime NULL,
+    `host`       text     NULL,
+    `alias`      text     NULL,
+    `state`      text     NULL,
+    `err`        text     NULL,
+    `from`       text     NULL,
+    `to`         text     NULL,
+    `changed`    text     NULL
+);
+-- create index "idx_sync_results_deleted_at" to table: "sync_results"
+CREATE INDEX IF NOT EXISTS `idx_sync_results_deleted_at` ON `sync_results` (`deleted_at`);
+-- create "sync_deploys" table
+CREATE TABLE IF NOT EXISTS `sync_deploys`
+(
+    `id`             integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at`     datetime NULL,
+    `updated_at`     datetime NULL,
+    `deleted_at`     datetime NULL,
+    `sync_result_id` integer  NULL,
+    `filename`       text     NULL,
+    `job_id`         text     NULL,
+    `state`          text     NULL,
+    `err`            text     NULL,
+    CONSTRAINT `fk_sync_results_deploys` FOREIGN KEY (`sync_result_id`) REFERENCES `sync_results` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- create index "idx_sync_deploys_deleted_at" to table: "sync_deploys"
+CREATE INDEX IF NOT EXISTS `idx_sync_deploys_deleted_at` ON `sync_deploys` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_sync_deploys_deleted_at" to table: "sync_deploys"
+DROP INDEX `idx_sync_deploys_deleted_at`;
+-- reverse: create "sync_deploys" table
+DROP TABLE `sync_deploys`;
+-- reverse: create index "idx_sync_results_deleted_at" to table: "sync_results"
+DROP INDEX `idx_sync_results_deleted_at`;
+-- reverse: create "sync_results" table
+DROP TABLE `sync_results`;
+-- reverse: create index "idx_sync_configs_deleted_at" to table: "sync_configs"
+DROP INDEX `idx_sync_configs_deleted_at`;
+-- reverse: create index "idx_sync_alias" to table: "sync_configs"
+DROP INDEX `idx_sync_alias`;
+-- reverse: create "sync_configs" table
+DROP TABLE `sync_configs`;
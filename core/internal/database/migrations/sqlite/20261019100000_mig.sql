@@ -0,0 +1,56 @@
+-- +goose Up
+-- create "hooks" table
+CREATE TABLE IF NOT EXISTS `hooks`
+(
+    `id`         integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at` datetime NULL,
+    `updated_at` datetime NULL,
+    `deleted_at` datetime NULL,
+    `key`        text     NULL,
+    `host`       text     NULL,
+    `name`       text     NULL,
+    `enabled`    numeric  NULL,
+    `action`     text     NULL,
+    `target`     text     NULL,
+    `secret`     text     NULL
+);
+-- create index "idx_hooks_host" to table: "hooks"
+CREATE INDEX IF NOT EXISTS `idx_hooks_host` ON `hooks` (`host`);
+-- create index "idx_hooks_key" to table: "hooks"
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_hooks_key` ON `hooks` (`key`);
+-- create index "idx_hooks_deleted_at" to table: "hooks"
+CREATE INDEX IF NOT EXISTS `idx_hooks_deleted_at` ON `hooks` (`deleted_at`);
+-- create "hook_invocations" table
+CREATE TABLE IF NOT EXISTS `hook_invocations`
+(
+    `id`         integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at` datetime NULL,
+    `updated_at` datetime NULL,
+    `deleted_at` datetime NULL,
+    `hook_id`    integer  NULL,
+    `provider`   text     NULL,
+    `event`      text     NULL,
+    `state`      text     NULL,
+    `err`        text     NULL,
+    `job_id`     text     NULL
+);
+-- create index "idx_hook_invocations_hook_id" to table: "hook_invocations"
+CREATE INDEX IF NOT EXISTS `idx_hook_invocations_hook_id` ON `hook_invocations` (`hook_id`);
+-- create index "idx_hook_invocations_deleted_at" to table: "hook_invocations"
+CREATE INDEX IF NOT EXISTS `idx_hook_invocations_deleted_at` ON `hook_invocations` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_hook_invocations_deleted_at" to table: "hook_invocations"
+DROP INDEX `idx_hook_invocations_deleted_at`;
+-- reverse: create index "idx_hook_invocations_hook_id" to table: "hook_invocations"
+DROP INDEX `idx_hook_invocations_hook_id`;
+-- reverse: create "hook_invocations" table
+DROP TABLE `hook_invocations`;
+-- reverse: create index "idx_hooks_deleted_at" to table: "hooks"
+DROP INDEX `idx_hooks_deleted_at`;
+-- reverse: create index "idx_hooks_key" to table: "hooks"
+DROP INDEX `idx_hooks_key`;
+-- reverse: create index "idx_hooks_host" to table: "hooks"
+DROP INDEX `idx_hooks_host`;
+-- reverse: create "hooks" table
+DROP TABLE `hooks`;
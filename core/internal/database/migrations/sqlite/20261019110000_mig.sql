@@ -0,0 +1,26 @@
+-- +goose Up
+-- create "secrets" table
+CREATE TABLE IF NOT EXISTS `secrets`
+(
+    `id`         integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at` datetime NULL,
+    `updated_at` datetime NULL,
+    `deleted_at` datetime NULL,
+    `host`       text     NULL,
+    `stack`      text     NULL,
+    `name`       text     NULL,
+    `mode`       text     NULL,
+    `value`      blob     NULL
+);
+-- create index "idx_secret_name" to table: "secrets"
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_secret_name` ON `secrets` (`host`, `stack`, `name`);
+-- create index "idx_secrets_deleted_at" to table: "secrets"
+CREATE INDEX IF NOT EXISTS `idx_secrets_deleted_at` ON `secrets` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_secrets_deleted_at" to table: "secrets"
+DROP INDEX `idx_secrets_deleted_at`;
+-- reverse: create index "idx_secret_name" to table: "secrets"
+DROP INDEX `idx_secret_name`;
+-- reverse: create "secrets" table
+DROP TABLE `secrets`;
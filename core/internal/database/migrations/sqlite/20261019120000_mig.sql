@@ -0,0 +1,82 @@
+-- +goose Up
+-- create "backup_configs" table
+CREATE TABLE IF NOT EXISTS `backup_configs`
+(
+    `id`            integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at`    datetime NULL,
+    `updated_at`    datetime NULL,
+    `deleted_at`    datetime NULL,
+    `host`          text     NULL,
+    `stack`         text     NULL,
+    `enabled`       numeric  NULL,
+    `schedule`      text     NULL,
+    `stop_services` numeric  NULL,
+    `volumes`       numeric  NULL,
+    `bind_mounts`   numeric  NULL,
+    `dest_host`     text     NULL,
+    `dest_alias`    text     NULL,
+    `dest_path`     text     NULL,
+    `retention`     integer  NULL
+);
+-- create index "idx_backup_stack" to table: "backup_configs"
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_backup_stack` ON `backup_configs` (`host`, `stack`);
+-- create index "idx_backup_configs_deleted_at" to table: "backup_configs"
+CREATE INDEX IF NOT EXISTS `idx_backup_configs_deleted_at` ON `backup_configs` (`deleted_at`);
+-- create "backup_runs" table
+CREATE TABLE IF NOT EXISTS `backup_runs`
+(
+    `id`         integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at` datetime NULL,
+    `updated_at` datetime NULL,
+    `deleted_at` datetime NULL,
+    `host`       text     NULL,
+    `stack`      text     NULL,
+    `state`      text     NULL,
+    `err`        text     NULL,
+    `dest_host`  text     NULL,
+    `dest_alias` text     NULL,
+    `dest_path`  text     NULL,
+    `dir`        text     NULL
+);
+-- create index "idx_backup_run" to table: "backup_runs"
+CREATE INDEX IF NOT EXISTS `idx_backup_run` ON `backup_runs` (`host`, `stack`);
+-- create index "idx_backup_runs_deleted_at" to table: "backup_runs"
+CREATE INDEX IF NOT EXISTS `idx_backup_runs_deleted_at` ON `backup_runs` (`deleted_at`);
+-- create "backup_archives" table
+CREATE TABLE IF NOT EXISTS `backup_archives`
+(
+    `id`         integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at` datetime NULL,
+    `updated_at` datetime NULL,
+    `deleted_at` datetime NULL,
+    `run_id`     integer  NULL,
+    `kind`       text     NULL,
+    `source`     text     NULL,
+    `name`       text     NULL,
+    `size`       integer  NULL,
+    CONSTRAINT `fk_backup_runs_archives` FOREIGN KEY (`run_id`) REFERENCES `backup_runs` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- create index "idx_backup_archives_run_id" to table: "backup_archives"
+CREATE INDEX IF NOT EXISTS `idx_backup_archives_run_id` ON `backup_archives` (`run_id`);
+-- create index "idx_backup_archives_deleted_at" to table: "backup_archives"
+CREATE INDEX IF NOT EXISTS `idx_backup_archives_deleted_at` ON `backup_archives` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_backup_archives_deleted_at" to table: "backup_archives"
+DROP INDEX `idx_backup_archives_deleted_at`;
+-- reverse: create index "idx_backup_archives_run_id" to table: "backup_archives"
+DROP INDEX `idx_backup_archives_run_id`;
+-- reverse: create "backup_archives" table
+DROP TABLE `backup_archives`;
+-- reverse: create index "idx_backup_runs_deleted_at" to table: "backup_runs"
+DROP INDEX `idx_backup_runs_deleted_at`;
+-- reverse: create index "idx_backup_run" to table: "backup_runs"
+DROP INDEX `idx_backup_run`;
+-- reverse: create "backup_runs" table
+DROP TABLE `backup_runs`;
+-- reverse: create index "idx_backup_configs_deleted_at" to table: "backup_configs"
+DROP INDEX `idx_backup_configs_deleted_at`;
+-- reverse: create index "idx_backup_stack" to table: "backup_configs"
+DROP INDEX `idx_backup_stack`;
+-- reverse: create "backup_configs" table
+DROP TABLE `backup_configs`;
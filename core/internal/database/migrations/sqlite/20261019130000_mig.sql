@@ -0,0 +1,24 @@
+-- +goose Up
+-- create "volume_size_samples" table
+CREATE TABLE IF NOT EXISTS `volume_size_samples`
+(
+    `id`         integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at` datetime NULL,
+    `updated_at` datetime NULL,
+    `deleted_at` datetime NULL,
+    `host`       text     NULL,
+    `volume`     text     NULL,
+    `size`       integer  NULL
+);
+-- create index "idx_volume_sample" to table: "volume_size_samples"
+CREATE INDEX IF NOT EXISTS `idx_volume_sample` ON `volume_size_samples` (`host`, `volume`);
+-- create index "idx_volume_size_samples_deleted_at" to table: "volume_size_samples"
+CREATE INDEX IF NOT EXISTS `idx_volume_size_samples_deleted_at` ON `volume_size_samples` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_volume_size_samples_deleted_at" to table: "volume_size_samples"
+DROP INDEX `idx_volume_size_samples_deleted_at`;
+-- reverse: create index "idx_volume_sample" to table: "volume_size_samples"
+DROP INDEX `idx_volume_sample`;
+-- reverse: create "volume_size_samples" table
+DROP TABLE `volume_size_samples`;
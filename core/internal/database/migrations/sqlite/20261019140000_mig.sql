@@ -0,0 +1,19 @@
+-- +goose Up
+-- create "notifications" table
+CREATE TABLE IF NOT EXISTS `notifications`
+(
+    `id`         integer  NULL PRIMARY KEY AUTOINCREMENT,
+    `created_at` datetime NULL,
+    `updated_at` datetime NULL,
+    `deleted_at` datetime NULL,
+    `level`      text     NOT NULL,
+    `config`     json     NULL
+);
+-- create index "idx_notifications_deleted_at" to table: "notifications"
+CREATE INDEX IF NOT EXISTS `idx_notifications_deleted_at` ON `notifications` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_notifications_deleted_at" to table: "notifications"
+DROP INDEX `idx_notifications_deleted_at`;
+-- reverse: create "notifications" table
+DROP TABLE `notifications`;
@@ -6,11 +6,17 @@ import (
 	"os"
 
 	"github.com/RA341/dockman/internal/auth"
+	"github.com/RA341/dockman/internal/backup"
 	"github.com/RA341/dockman/internal/cleaner"
 	"github.com/RA341/dockman/internal/config"
+	"github.com/RA341/dockman/internal/git"
+	"github.com/RA341/dockman/internal/hooks"
 	"github.com/RA341/dockman/internal/host"
 	"github.com/RA341/dockman/internal/info"
+	"github.com/RA341/dockman/internal/notifications"
+	"github.com/RA341/dockman/internal/secrets"
 	"github.com/RA341/dockman/internal/ssh"
+	"github.com/RA341/dockman/internal/volumestats"
 
 	"ariga.io/atlas-provider-gorm/gormschema"
 )
@@ -30,6 +36,17 @@ func main() {
 			&ssh.MachineOptions{},
 			&host.Config{},
 			&host.FolderAlias{},
+			&git.SyncConfig{},
+			&git.SyncResult{},
+			&git.SyncDeploy{},
+			&hooks.Hook{},
+			&hooks.Invocation{},
+			&secrets.Secret{},
+			&backup.Config{},
+			&backup.Run{},
+			&backup.Archive{},
+			&volumestats.Sample{},
+			&notifications.Notification{},
 		)
 	if err != nil {
 		log.Fatalf("failed to load Gorm schema: %v\n", err)
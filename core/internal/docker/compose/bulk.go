@@ -0,0 +1,217 @@
+package compose
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type BulkState string
+
+const (
+	BulkPending   BulkState = "pending"
+	BulkRunning   BulkState = "running"
+	BulkSucceeded BulkState = "succeeded"
+	BulkFailed    BulkState = "failed"
+	BulkSkipped   BulkState = "skipped"
+)
+
+type BulkOptions struct {
+	// max stacks running at the same time, defaults to 1
+	Parallelism int
+	// stop starting new stacks after the first failure
+	StopOnFailure bool
+	// run dependents before their dependencies, used for down
+	Reverse bool
+}
+
+type BulkProgress struct {
+	Filename string
+	State    BulkState
+	Err      error
+}
+
+// BulkRunFunc runs the operation for a single stack,
+// it is responsible for reporting BulkRunning once the stack has started
+type BulkRunFunc func(ctx context.Context, filename string) error
+
+var ErrDependencyCycle = errors.New("dependency cycle detected")
+
+// RunBulk runs stacks in dependency order, a stack only starts once all the stacks it
+// depends on have succeeded, dependencies outside of stacks are ignored
+//
+// if a stack fails, everything depending on it is skipped,
+// with StopOnFailure all stacks that have not started yet are skipped
+//
+// progress is never called concurrently
+func RunBulk(
+	ctx context.Context,
+	stacks []string,
+	deps map[string][]string,
+	opts BulkOptions,
+	run BulkRunFunc,
+	progress func(BulkProgress),
+) error {
+	waitsOn, dependents := buildGraph(stacks, deps, opts.Reverse)
+	if cycle := findCycle(stacks, waitsOn); len(cycle) != 0 {
+		return fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(cycle, " -> "))
+	}
+
+	parallel := max(opts.Parallelism, 1)
+	remaining := make(map[string]int, len(stacks))
+	states := make(map[string]BulkState, len(stacks))
+
+	var ready []string
+	for _, stack := range stacks {
+		remaining[stack] = len(waitsOn[stack])
+		states[stack] = BulkPending
+		progress(BulkProgress{Filename: stack, State: BulkPending})
+		if remaining[stack] == 0 {
+			ready = append(ready, stack)
+		}
+	}
+
+	type result struct {
+		filename string
+		err      error
+	}
+	results := make(chan result)
+
+	var skip func(stack string, reason error)
+	skip = func(stack string, reason error) {
+		if states[stack] != BulkPending {
+			return
+		}
+		states[stack] = BulkSkipped
+		progress(BulkProgress{Filename: stack, State: BulkSkipped, Err: reason})
+		for _, dep := range dependents[stack] {
+			skip(dep, fmt.Errorf("dependency %s was skipped", stack))
+		}
+	}
+
+	stopped := false
+	running := 0
+	var failed []string
+	for {
+		for len(ready) > 0 && running < parallel && !stopped && ctx.Err() == nil {
+			stack := ready[0]
+			ready = ready[1:]
+			if states[stack] != BulkPending {
+				continue
+			}
+
+			states[stack] = BulkRunning
+			running++
+			go func() {
+				results <- result{filename: stack, err: run(ctx, stack)}
+			}()
+		}
+
+		if running == 0 {
+			break
+		}
+
+		res := <-results
+		running--
+
+		if res.err != nil {
+			states[res.filename] = BulkFailed
+			failed = append(failed, res.filename)
+			progress(BulkProgress{Filename: res.filename, State: BulkFailed, Err: res.err})
+
+			for _, dep := range dependents[res.filename] {
+				skip(dep, fmt.Errorf("dependency %s failed", res.filename))
+			}
+			if opts.StopOnFailure {
+				stopped = true
+			}
+			continue
+		}
+
+		states[res.filename] = BulkSucceeded
+		progress(BulkProgress{Filename: res.filename, State: BulkSucceeded})
+		for _, dep := range dependents[res.filename] {
+			remaining[dep]--
+			if remaining[dep] == 0 {
+				ready = append(ready, dep)
+			}
+		}
+	}
+
+	for _, stack := range stacks {
+		if ctx.Err() != nil {
+			skip(stack, ctx.Err())
+		} else {
+			skip(stack, fmt.Errorf("stopped after failure"))
+		}
+	}
+
+	if len(failed) != 0 {
+		return fmt.Errorf("%d stacks failed: %s", len(failed), strings.Join(failed, ", "))
+	}
+	return ctx.Err()
+}
+
+// buildGraph returns for each stack the stacks it waits on and the stacks waiting on it
+func buildGraph(stacks []string, deps map[string][]string, reverse bool) (waitsOn, dependents map[string][]string) {
+	waitsOn = make(map[string][]string, len(stacks))
+	dependents = make(map[string][]string, len(stacks))
+
+	for _, stack := range stacks {
+		for _, dep := range deps[stack] {
+			if !slices.Contains(stacks, dep) || dep == stack {
+				continue
+			}
+
+			before, after := dep, stack
+			if reverse {
+				before, after = stack, dep
+			}
+			waitsOn[after] = append(waitsOn[after], before)
+			dependents[before] = append(dependents[before], after)
+		}
+	}
+	return waitsOn, dependents
+}
+
+// findCycle returns the stacks forming a cycle, or nil if there is none
+func findCycle(stacks []string, waitsOn map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	marks := make(map[string]int, len(stacks))
+	var path []string
+
+	var visit func(stack string) []string
+	visit = func(stack string) []string {
+		switch marks[stack] {
+		case visiting:
+			start := slices.Index(path, stack)
+			return append(slices.Clone(path[start:]), stack)
+		case visited:
+			return nil
+		}
+
+		marks[stack] = visiting
+		path = append(path, stack)
+		for _, dep := range waitsOn[stack] {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		marks[stack] = visited
+		return nil
+	}
+
+	for _, stack := range stacks {
+		if cycle := visit(stack); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
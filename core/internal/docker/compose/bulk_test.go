@@ -0,0 +1,99 @@
+package compose
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type bulkRecorder struct {
+	mu     sync.Mutex
+	order  []string
+	states map[string]BulkState
+}
+
+func newBulkRecorder() *bulkRecorder {
+	return &bulkRecorder{states: map[string]BulkState{}}
+}
+
+func (b *bulkRecorder) run(fail ...string) BulkRunFunc {
+	return func(ctx context.Context, filename string) error {
+		b.mu.Lock()
+		b.order = append(b.order, filename)
+		b.mu.Unlock()
+
+		for _, f := range fail {
+			if f == filename {
+				return errors.New("failed")
+			}
+		}
+		return nil
+	}
+}
+
+func (b *bulkRecorder) progress(p BulkProgress) {
+	b.states[p.Filename] = p.State
+}
+
+var bulkDeps = map[string][]string{
+	"apps/compose.yaml":  {"db/compose.yaml", "proxy/compose.yaml"},
+	"db/compose.yaml":    {"proxy/compose.yaml"},
+	"other/compose.yaml": {"not/selected/compose.yaml"},
+}
+
+var bulkStacks = []string{"apps/compose.yaml", "db/compose.yaml", "other/compose.yaml", "proxy/compose.yaml"}
+
+func TestRunBulkOrder(t *testing.T) {
+	rec := newBulkRecorder()
+	err := RunBulk(context.Background(), bulkStacks, bulkDeps, BulkOptions{}, rec.run(), rec.progress)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"other/compose.yaml", "proxy/compose.yaml", "db/compose.yaml", "apps/compose.yaml"}, rec.order)
+	for _, stack := range bulkStacks {
+		require.Equal(t, BulkSucceeded, rec.states[stack])
+	}
+}
+
+func TestRunBulkReverse(t *testing.T) {
+	rec := newBulkRecorder()
+	err := RunBulk(context.Background(), bulkStacks, bulkDeps, BulkOptions{Reverse: true}, rec.run(), rec.progress)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"apps/compose.yaml", "other/compose.yaml", "db/compose.yaml", "proxy/compose.yaml"}, rec.order)
+}
+
+func TestRunBulkFailureSkipsDependents(t *testing.T) {
+	rec := newBulkRecorder()
+	err := RunBulk(context.Background(), bulkStacks, bulkDeps, BulkOptions{Parallelism: 4}, rec.run("db/compose.yaml"), rec.progress)
+	require.Error(t, err)
+
+	require.Equal(t, BulkSucceeded, rec.states["proxy/compose.yaml"])
+	require.Equal(t, BulkFailed, rec.states["db/compose.yaml"])
+	require.Equal(t, BulkSkipped, rec.states["apps/compose.yaml"])
+	require.Equal(t, BulkSucceeded, rec.states["other/compose.yaml"])
+}
+
+func TestRunBulkStopOnFailure(t *testing.T) {
+	rec := newBulkRecorder()
+	err := RunBulk(context.Background(), bulkStacks, bulkDeps, BulkOptions{StopOnFailure: true}, rec.run("other/compose.yaml"), rec.progress)
+	require.Error(t, err)
+
+	require.Equal(t, []string{"other/compose.yaml"}, rec.order)
+	require.Equal(t, BulkSkipped, rec.states["proxy/compose.yaml"])
+	require.Equal(t, BulkSkipped, rec.states["apps/compose.yaml"])
+}
+
+func TestRunBulkCycle(t *testing.T) {
+	deps := map[string][]string{
+		"a/compose.yaml": {"b/compose.yaml"},
+		"b/compose.yaml": {"a/compose.yaml"},
+	}
+
+	rec := newBulkRecorder()
+	err := RunBulk(context.Background(), []string{"a/compose.yaml", "b/compose.yaml"}, deps, BulkOptions{}, rec.run(), rec.progress)
+	require.ErrorIs(t, err, ErrDependencyCycle)
+	require.Empty(t, rec.order)
+}
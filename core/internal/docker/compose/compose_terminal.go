@@ -26,14 +26,25 @@ const composePlugin = "docker compose"
 type Host struct {
 	Fs      filesystem.FileSystem
 	Relpath string
+	// Stack optional multi-file config for the compose file
+	Stack Stack
+	// HostFs optional filesystem rooted at "/" of the docker host,
+	// used to check paths outside the compose root
+	HostFs filesystem.FileSystem
+	// Secrets passed to every compose command of the stack
+	Secrets []Secret
 }
 
 type FilenameParser func(filename string, host string) (Host, error)
 
+// StackLister lists compose files in folder and/or tagged with tag
+type StackLister func(host, folder, tag string) ([]string, error)
+
 type Service struct {
 	TTY      bool
 	cont     *container.Service
 	parser   FilenameParser
+	lister   StackLister
 	runner   CmdRunner
 	hostname string
 }
@@ -42,6 +53,7 @@ func NewComposeTerminal(
 	hostname string,
 	cont *container.Service,
 	getFs FilenameParser,
+	lister StackLister,
 	cli *ssh.Client,
 	// TTY bool,
 ) *Service {
@@ -56,6 +68,7 @@ func NewComposeTerminal(
 		TTY:      true,
 		cont:     cont,
 		parser:   getFs,
+		lister:   lister,
 		runner:   runner,
 		hostname: hostname,
 	}
@@ -79,6 +92,7 @@ func (c *Service) version(ctx context.Context) ([]string, error) {
 		[]string{split[0], split[1], "version"},
 		"",
 		nil,
+		nil,
 		&errWriter,
 	)
 	if err == nil {
@@ -90,6 +104,7 @@ func (c *Service) version(ctx context.Context) ([]string, error) {
 		[]string{composeStandalone, "version"},
 		"",
 		nil,
+		nil,
 		&errWriter,
 	)
 	if err == nil {
@@ -113,78 +128,151 @@ func (c *Service) withCmd(
 	addCmd WithCmd,
 	services []string,
 ) error {
-	fileParts, err := c.parser(filename, c.hostname)
+	cmd, fileParts, cleanup, err := c.composeCmd(ctx, filename, addCmd, services)
 	if err != nil {
 		return err
 	}
+	defer cleanup()
+
+	if stream != nil {
+		_, err = stream.Write([]byte(green(strings.Join(cmd, " ") + " ")))
+		if err != nil {
+			return fmt.Errorf("could not write to stream: %w", err)
+		}
+	}
+
+	errWriter := new(bytes.Buffer)
+	err = c.runner.Run(ctx, cmd, fileParts.Fs.Root(), secretEnv(fileParts.Secrets), stream, errWriter)
+	if err != nil {
+		if errWriter.Len() == 0 {
+			return err
+		}
+		return fmt.Errorf("%s", errWriter.String())
+	}
+	return nil
+}
+
+// output runs a compose command keeping stdout and stderr separate,
+// used for commands whose output is parsed
+func (c *Service) output(
+	ctx context.Context,
+	filename string,
+	addCmd WithCmd,
+) (stdout string, stderr string, err error) {
+	cmd, fileParts, cleanup, err := c.composeCmd(ctx, filename, addCmd, []string{})
+	if err != nil {
+		return "", "", err
+	}
+	defer cleanup()
+
+	outWriter := new(bytes.Buffer)
+	errWriter := new(bytes.Buffer)
+	err = c.runner.Output(ctx, cmd, fileParts.Fs.Root(), secretEnv(fileParts.Secrets), outWriter, errWriter)
+	if err != nil && errWriter.Len() != 0 {
+		err = fmt.Errorf("%s", errWriter.String())
+	}
+	return outWriter.String(), errWriter.String(), err
+}
+
+// composeCmd builds the full compose command with the env files and stack options for filename,
+// cleanup removes the temp files the command needs once it is done
+func (c *Service) composeCmd(
+	ctx context.Context,
+	filename string,
+	addCmd WithCmd,
+	services []string,
+) ([]string, Host, func(), error) {
+	fileParts, err := c.parser(filename, c.hostname)
+	if err != nil {
+		return nil, Host{}, nil, err
+	}
 
 	binary, err := c.version(ctx)
 	if err != nil {
-		return err
+		return nil, Host{}, nil, err
 	}
 
-	envs := loadEnvFile(fileParts.Fs, fileParts.Relpath)
+	secretArgs, cleanup, err := c.secretsOverride(ctx, fileParts.Secrets)
+	if err != nil {
+		return nil, Host{}, nil, fmt.Errorf("unable to declare secrets: %w", err)
+	}
 
-	// docker compose --envfile=... -f some/file/path/compose.yml --progress=<val>
+	envFiles := findEnvFiles(fileParts.Fs, fileParts.Relpath, c.hostname)
+	stack := resolveStack(fileParts.Fs, fileParts.Relpath, fileParts.Stack, envFiles)
+
+	// docker compose --envfile=... --progress=<val> -f some/file/path/compose.yml -f ... --profile ...
 	fullCmd := append(
-		append(binary, envs...),
+		append(binary, envFileArgs(fileParts.Fs, envFiles)...),
 		c.progressOut(),
-		"-f", fileParts.Relpath,
 	)
+	fullCmd = append(fullCmd, stack.args()...)
+	fullCmd = append(fullCmd, secretArgs...)
 
 	fullCmd = addCmd(fullCmd)
 	fullCmd = append(fullCmd, services...)
 
 	var cleanCmd = make([]string, 0, len(fullCmd))
-	var sb strings.Builder
 	for _, cmd := range fullCmd {
 		cl := strings.TrimSpace(cmd)
 		if cl == "" {
 			continue
 		}
-
 		cleanCmd = append(cleanCmd, cl)
-		sb.WriteString(cl + " ")
 	}
 
-	if stream != nil {
-		_, err = stream.Write([]byte(green(sb.String())))
-		if err != nil {
-			return fmt.Errorf("could not write to stream: %w", err)
-		}
-	}
-
-	errWriter := new(bytes.Buffer)
-	err = c.runner.Run(ctx, cleanCmd, fileParts.Fs.Root(), stream, errWriter)
-	if err != nil {
-		return fmt.Errorf("%s", errWriter.String())
-	}
-	return nil
+	return cleanCmd, fileParts, cleanup, nil
 }
 
 const envFileName = ".env"
 
-func loadEnvFile(fs filesystem.FileSystem, filename string) []string {
+func loadEnvFile(fs filesystem.FileSystem, filename string, host string) []string {
+	return envFileArgs(fs, findEnvFiles(fs, filename, host))
+}
+
+func envFileArgs(fs filesystem.FileSystem, envFiles []string) []string {
+	args := make([]string, 0, len(envFiles))
+	for _, envPath := range envFiles {
+		absEnvPath := fs.Join(fs.Root(), envPath)
+		args = append(args, "--env-file="+absEnvPath)
+	}
+	return args
+}
+
+// findEnvFiles returns the .env files from the fs root down to the compose file directory
+//
+// each directory can also contain a host overlay .env.<host>,
+// which is loaded after the .env in the same directory
+func findEnvFiles(fs filesystem.FileSystem, filename string, host string) []string {
 	// remove leading '/' if left it will break filepath.dir
 	filename = strings.TrimPrefix(filename, "/")
-	var envPaths []string
+	var dirs []string
 
 	// some/relative/path/compose.yml
 	start := filename
 	for start != "." { // "." will return for empty
-		// some/relative
-		start = filepath.Dir(start) // some/relative/path
-		// some/relative/path/.env
-		envPath := fs.Join(start, envFileName)
-		_, err := fs.Stat(envPath)
-		if err == nil {
-			absEnvPath := fs.Join(fs.Root(), envPath)
-			envPaths = append(envPaths, "--env-file="+absEnvPath)
-		}
+		// some/relative/path
+		start = filepath.Dir(start)
+		dirs = append(dirs, start)
 	}
 
 	// envs (lower) outer -> (higher) inner
-	slices.Reverse(envPaths)
+	slices.Reverse(dirs)
+
+	var envPaths []string
+	for _, dir := range dirs {
+		candidates := []string{envFileName}
+		if host != "" {
+			candidates = append(candidates, envFileName+"."+host)
+		}
+
+		for _, name := range candidates {
+			// some/relative/path/.env
+			envPath := fs.Join(dir, name)
+			if _, err := fs.Stat(envPath); err == nil {
+				envPaths = append(envPaths, envPath)
+			}
+		}
+	}
 
 	return envPaths
 }
@@ -303,6 +391,20 @@ func (c *Service) Update(
 	return c.Up(ctx, filename, io, services...)
 }
 
+// ListStacks returns the compose files in folder and/or tagged with tag
+func (c *Service) ListStacks(folder, tag string) ([]string, error) {
+	return c.lister(c.hostname, folder, tag)
+}
+
+// Dependencies returns the compose files filename depends on
+func (c *Service) Dependencies(filename string) ([]string, error) {
+	fileParts, err := c.parser(filename, c.hostname)
+	if err != nil {
+		return nil, err
+	}
+	return fileParts.Stack.DependsOn, nil
+}
+
 func (c *Service) List(ctx context.Context, filename string) ([]container2.Summary, error) {
 	lines, err := c.listIds(ctx, filename)
 	if err != nil {
@@ -325,23 +427,18 @@ func (c *Service) Stats(ctx context.Context, filename string) ([]container.Stats
 }
 
 func (c *Service) listIds(ctx context.Context, filename string) ([]string, error) {
-	sb := new(bytes.Buffer)
-	err := c.withCmd(ctx, filename, sb,
+	// output does not echo the command, every line is an id
+	out, _, err := c.output(ctx, filename,
 		func(cmdList []string) []string {
-			return append(
-				cmdList,
-				"ps", "-a", "--format", "{{.ID}}",
-			)
+			return append(cmdList, "ps", "-a", "-q")
 		},
-		[]string{},
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	output := sb.String()
-	lines := strings.Split(output, "\n")
-	return lines, err
+	// an empty id would match every container on the host
+	return splitList(out, "\n"), nil
 }
 
 func (c *Service) Status(ctx context.Context, filename string) (*StackState, error) {
@@ -407,86 +504,6 @@ type StackState struct {
 	UnhealthyCount uint
 }
 
-func (c *Service) Validate(ctx context.Context, filename string) []error {
-	buf := new(bytes.Buffer)
-	err := c.withCmd(ctx, filename, buf,
-		func(cmdList []string) []string {
-			return append(
-				cmdList, "config",
-			)
-		},
-		[]string{},
-	)
-	if err == nil {
-		return []error{}
-	}
-
-	s := buf.String()
-	fileErr := fmt.Errorf("failed to validate compose file: %s", s)
-	// todo more validations
-
-	return []error{fileErr}
-}
-
-// todo validate ports
-//	var errs []error
-//
-//	project, err := s.LoadProject(ctx, shortName)
-//	if err != nil {
-//		return append(errs, err)
-//	}
-//
-//	runningContainers, err := s.cont.ContainersList(ctx)
-//	if err != nil {
-//		return append(errs, err)
-//	}
-//
-//	for svcName, svc := range project.Services {
-//		for _, portConfig := range svc.Ports {
-//			published, err := strconv.Atoi(portConfig.Published)
-//			if err != nil {
-//				errs = append(errs, fmt.Errorf("invalid port %q in service %s: %w", portConfig.Published, svcName, err))
-//				continue
-//			}
-//
-//			// check running Containers using this port
-//			conflicts := s.findConflictingContainers(runningContainers, svcName, uint16(published))
-//			for _, c := range conflicts {
-//				errs = append(errs, fmt.Errorf(
-//					"service %q wants port %d, but container %q (id=%s) is already using it",
-//					svcName, published, c.Names[0], c.ID[:12],
-//				))
-//			}
-//		}
-//	}
-//
-//	return errs
-//}
-//
-//// findConflictingContainers returns containers using the given port but not matching the service name
-//func (s *Service) findConflictingContainers(containers []container.Summary, serviceName string, port uint16) []container.Summary {
-//	var matches []container.Summary
-//	for _, c := range containers {
-//		for _, p := range c.Ports {
-//			if p.PublicPort == port {
-//				// container names have leading "/" -> strip when comparing
-//				containerName := c.Names[0]
-//				if len(containerName) > 0 && containerName[0] == '/' {
-//					containerName = containerName[1:]
-//				}
-//
-//				serviceLabel := c.Labels[api.ServiceLabel]
-//				if serviceLabel != serviceName {
-//					matches = append(matches, c)
-//				}
-//			}
-//		}
-//	}
-//
-//	return matches
-//}
-//}
-
 //func (s *Service) LoadProject(ctx context.Context, resourcePath string) (*types.Project, error) {
 //	// fsCli is a file system
 //	fsCli, relpath, err := s.getFs(resourcePath)
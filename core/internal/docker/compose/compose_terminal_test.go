@@ -2,6 +2,7 @@ package compose
 
 import (
 	"context"
+	"io"
 	"log"
 	"os"
 	"testing"
@@ -36,9 +37,10 @@ func init() {
 			}, nil
 		},
 		nil,
+		nil,
 	)
 
-	loadEnvFile(filesystem.NewLocal(working), "/some/rooted/path/"+com)
+	loadEnvFile(filesystem.NewLocal(working), "/some/rooted/path/"+com, "local")
 }
 
 func TestVersion(t *testing.T) {
@@ -108,3 +110,71 @@ func TestUp(t *testing.T) {
 //		Writer: os.Stdout,
 //	}
 //}
+
+func TestSecretsOverride(t *testing.T) {
+	srv := &Service{runner: NewLocalRunner()}
+
+	args, cleanup, err := srv.secretsOverride(t.Context(), []Secret{{Name: "TOKEN", Value: "abc"}})
+	require.NoError(t, err)
+	require.Empty(t, args, "env secrets need no override")
+	cleanup()
+
+	args, cleanup, err = srv.secretsOverride(t.Context(), []Secret{
+		{Name: "TOKEN", Value: "abc"},
+		{Name: "DB_PASS", Value: "hunter2", File: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, args, 2)
+
+	contents, err := os.ReadFile(args[1])
+	require.NoError(t, err)
+	require.Equal(t, "secrets:\n  DB_PASS:\n    environment: DB_PASS\n", string(contents))
+	require.NotContains(t, string(contents), "hunter2")
+
+	cleanup()
+	require.NoFileExists(t, args[1])
+
+	require.Equal(t,
+		"export DB_PASS='it'\\''s $ecret'\nexport TOKEN=abc\n",
+		exportEnv(secretEnv([]Secret{{Name: "DB_PASS", Value: "it's $ecret"}, {Name: "TOKEN", Value: "abc"}})),
+	)
+}
+
+// outputRunner answers every command with a fixed stdout
+type outputRunner struct {
+	LocalRunner
+	stdout string
+	cmd    []string
+}
+
+func (o *outputRunner) Run(context.Context, []string, string, []string, io.Writer, io.Writer) error {
+	return nil
+}
+
+func (o *outputRunner) Output(_ context.Context, cmd []string, _ string, _ []string, stdOut io.Writer, _ io.Writer) error {
+	o.cmd = cmd
+	_, err := io.WriteString(stdOut, o.stdout)
+	return err
+}
+
+func TestListIds(t *testing.T) {
+	dir := t.TempDir()
+	runner := &outputRunner{stdout: "3f2a9c1d0b7e\n9e8d7c6b5a41\n\n"}
+	srv := &Service{
+		runner:   runner,
+		hostname: "local",
+		parser: func(filename string, host string) (Host, error) {
+			return Host{Fs: filesystem.NewLocal(dir), Relpath: filename}, nil
+		},
+	}
+
+	ids, err := srv.listIds(t.Context(), "compose.yaml")
+	require.NoError(t, err)
+	require.Equal(t, []string{"3f2a9c1d0b7e", "9e8d7c6b5a41"}, ids)
+	require.Equal(t, []string{"ps", "-a", "-q"}, runner.cmd[len(runner.cmd)-3:])
+
+	runner.stdout = ""
+	ids, err = srv.listIds(t.Context(), "compose.yaml")
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
@@ -0,0 +1,146 @@
+package compose
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/template"
+	"github.com/goccy/go-yaml"
+)
+
+const secretMask = "********"
+
+// SecretSource the source of variables set by dockman secrets
+const SecretSource = "dockman secrets"
+
+// values of variables matching this are masked
+var secretPattern = regexp.MustCompile(`(?i)(pass|secret|token|key|credential|auth|private)`)
+
+type Variable struct {
+	Name string
+	// Value is masked if Secret is set
+	Value string
+	// Source the env file that set the value, empty if not defined
+	Source       string
+	DefaultValue string
+	Defined      bool
+	Required     bool
+	Secret       bool
+}
+
+type Resolved struct {
+	// Config the fully interpolated compose config
+	Config    string
+	Variables []Variable
+	// Warnings output by compose while resolving
+	Warnings []string
+	// Err is set if the config could not be resolved, variables are still returned
+	Err error
+}
+
+// Resolve returns the interpolated compose config for filename along with
+// every variable it references and which env file it was loaded from
+func (c *Service) Resolve(ctx context.Context, filename string) (*Resolved, error) {
+	fileParts, err := c.parser(filename, c.hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	envFiles := findEnvFiles(fileParts.Fs, fileParts.Relpath, c.hostname)
+	stack := resolveStack(fileParts.Fs, fileParts.Relpath, fileParts.Stack, envFiles)
+
+	values, sources := readEnvFiles(fileParts.Fs, envFiles)
+	// secrets are passed in the environment which takes precedence over env files
+	for _, secret := range fileParts.Secrets {
+		values[secret.Name] = secret.Value
+		sources[secret.Name] = SecretSource
+	}
+
+	referenced := map[string]template.Variable{}
+	for _, file := range stack.files {
+		contents, err := fileParts.Fs.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %w", file, err)
+		}
+
+		var dict map[string]any
+		if err = yaml.Unmarshal(contents, &dict); err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", file, err)
+		}
+
+		for name, variable := range template.ExtractVariables(dict, nil) {
+			referenced[name] = variable
+		}
+	}
+
+	res := &Resolved{}
+	var secrets []string
+	for name, ref := range referenced {
+		value, defined := values[name]
+		variable := Variable{
+			Name:         name,
+			Value:        value,
+			Source:       sources[name],
+			DefaultValue: ref.DefaultValue,
+			Defined:      defined,
+			Required:     ref.Required,
+			Secret:       secretPattern.MatchString(name) || sources[name] == SecretSource,
+		}
+
+		if variable.Secret && value != "" {
+			secrets = append(secrets, value)
+			variable.Value = secretMask
+		}
+		res.Variables = append(res.Variables, variable)
+	}
+	slices.SortFunc(res.Variables, func(a, b Variable) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	stdout, stderr, err := c.output(ctx, filename, func(cmdList []string) []string {
+		return append(cmdList, "config")
+	})
+	if err != nil {
+		res.Err = err
+		return res, nil
+	}
+
+	for _, line := range strings.Split(stderr, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			res.Warnings = append(res.Warnings, line)
+		}
+	}
+
+	res.Config = maskSecrets(stdout, secrets)
+	return res, nil
+}
+
+// Env returns the variables set by the .env chain of filename,
+// along with the env file each was loaded from
+func (c *Service) Env(filename string) (values, sources map[string]string, err error) {
+	fileParts, err := c.parser(filename, c.hostname)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values, sources = readEnvFiles(
+		fileParts.Fs,
+		findEnvFiles(fileParts.Fs, fileParts.Relpath, c.hostname),
+	)
+	return values, sources, nil
+}
+
+func maskSecrets(config string, secrets []string) string {
+	// replace longer values first, so a secret containing another is fully masked
+	slices.SortFunc(secrets, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	for _, secret := range secrets {
+		config = strings.ReplaceAll(config, secret, secretMask)
+	}
+	return config
+}
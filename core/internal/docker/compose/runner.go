@@ -1,24 +1,44 @@
 package compose
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
 	"strings"
 
 	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/rs/zerolog/log"
 	"golang.org/x/crypto/ssh"
 )
 
 type CmdRunner interface {
+	// Run runs cmd in wd, env is added to the environment as KEY=value
+	// without showing up in the command line
 	Run(
 		ctx context.Context,
 		cmd []string,
 		wd string,
+		env []string,
 		stdIn io.Writer,
 		stdErr io.Writer,
 	) error
+
+	// Output same as Run but always keeps stdout and stderr separate
+	Output(
+		ctx context.Context,
+		cmd []string,
+		wd string,
+		env []string,
+		stdOut io.Writer,
+		stdErr io.Writer,
+	) error
+
+	// WriteTemp writes contents to a temp file on the machine the commands run on,
+	// remove deletes it again
+	WriteTemp(ctx context.Context, pattern string, contents []byte) (path string, remove func(), err error)
 }
 
 type LocalRunner struct{}
@@ -31,6 +51,7 @@ func (l *LocalRunner) Run(
 	ctx context.Context,
 	cmd []string,
 	wd string,
+	env []string,
 	out io.Writer,
 	errWriter io.Writer,
 ) error {
@@ -40,6 +61,7 @@ func (l *LocalRunner) Run(
 
 	ins := exec.CommandContext(ctx, cmd[0], cmd[1:]...)
 	ins.Dir = wd
+	ins.Env = withEnv(env)
 	ins.Stdout = out
 	ins.Stderr = out
 	ins.Stdin = nil
@@ -48,6 +70,59 @@ func (l *LocalRunner) Run(
 	return err
 }
 
+func (l *LocalRunner) Output(
+	ctx context.Context,
+	cmd []string,
+	wd string,
+	env []string,
+	out io.Writer,
+	errWriter io.Writer,
+) error {
+	if len(cmd) < 1 {
+		return fmt.Errorf("invalid command")
+	}
+
+	ins := exec.CommandContext(ctx, cmd[0], cmd[1:]...)
+	ins.Dir = wd
+	ins.Env = withEnv(env)
+	ins.Stdout = out
+	ins.Stderr = errWriter
+	ins.Stdin = nil
+
+	return ins.Run()
+}
+
+func (l *LocalRunner) WriteTemp(_ context.Context, pattern string, contents []byte) (string, func(), error) {
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", nil, err
+	}
+	remove := func() {
+		if err := os.Remove(file.Name()); err != nil {
+			log.Warn().Err(err).Str("path", file.Name()).Msg("unable to remove temp file")
+		}
+	}
+
+	_, err = file.Write(contents)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		remove()
+		return "", nil, err
+	}
+	return file.Name(), remove, nil
+}
+
+// withEnv returns the dockman environment with env added,
+// nil keeps the default of inheriting it as is
+func withEnv(env []string) []string {
+	if len(env) == 0 {
+		return nil
+	}
+	return append(os.Environ(), env...)
+}
+
 type RemoteRunner struct {
 	cli *ssh.Client
 }
@@ -62,6 +137,30 @@ func (r *RemoteRunner) Run(
 	ctx context.Context,
 	cmd []string,
 	wd string,
+	env []string,
+	out io.Writer,
+	errWriter io.Writer,
+) error {
+	fullCmd := fmt.Sprintf(
+		"cd %s && %s",
+		shellQuote(wd),
+		shellJoin(cmd),
+	)
+
+	var stdin io.Reader
+	if len(env) != 0 {
+		// sshd usually refuses Setenv and the command line is visible to
+		// every user on the host, so the env is sourced from stdin instead
+		fullCmd = ". /dev/stdin && " + fullCmd
+		stdin = strings.NewReader(exportEnv(env))
+	}
+	return r.run(ctx, fullCmd, stdin, out, errWriter)
+}
+
+func (r *RemoteRunner) run(
+	ctx context.Context,
+	fullCmd string,
+	stdin io.Reader,
 	out io.Writer,
 	errWriter io.Writer,
 ) error {
@@ -71,17 +170,12 @@ func (r *RemoteRunner) Run(
 	}
 	defer fileutil.Close(session)
 
-	fullCmd := fmt.Sprintf(
-		"cd %s && %s",
-		wd,
-		strings.Join(cmd, " "),
-	)
-
 	session.Stdout = out
 	session.Stderr = errWriter
-	session.Stdin = nil
+	session.Stdin = stdin
 
 	done := make(chan struct{})
+	defer close(done)
 	go func() {
 		select {
 		case <-ctx.Done():
@@ -90,7 +184,75 @@ func (r *RemoteRunner) Run(
 		case <-done:
 		}
 	}()
-	close(done)
 
 	return session.Run(fullCmd)
 }
+
+func (r *RemoteRunner) Output(
+	ctx context.Context,
+	cmd []string,
+	wd string,
+	env []string,
+	out io.Writer,
+	errWriter io.Writer,
+) error {
+	// remote sessions already keep stdout and stderr separate
+	return r.Run(ctx, cmd, wd, env, out, errWriter)
+}
+
+func (r *RemoteRunner) WriteTemp(ctx context.Context, pattern string, contents []byte) (string, func(), error) {
+	var out, errWriter bytes.Buffer
+	err := r.run(
+		ctx,
+		fmt.Sprintf(
+			`f=$(mktemp "${TMPDIR:-/tmp}"/%s) && cat > "$f" && echo "$f"`,
+			shellQuote(strings.ReplaceAll(pattern, "*", "XXXXXX")),
+		),
+		bytes.NewReader(contents),
+		&out,
+		&errWriter,
+	)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to write temp file: %w: %s", err, errWriter.String())
+	}
+
+	path := strings.TrimSpace(out.String())
+	remove := func() {
+		// the command may have been canceled, removing should still happen
+		err := r.run(context.Background(), "rm -f "+shellQuote(path), nil, nil, nil)
+		if err != nil {
+			log.Warn().Err(err).Str("path", path).Msg("unable to remove remote temp file")
+		}
+	}
+	return path, remove, nil
+}
+
+// exportEnv formats env as shell export statements
+func exportEnv(env []string) string {
+	var sb strings.Builder
+	for _, kv := range env {
+		key, value, _ := strings.Cut(kv, "=")
+		sb.WriteString("export " + key + "=" + shellQuote(value) + "\n")
+	}
+	return sb.String()
+}
+
+// shellJoin quotes each arg so paths with spaces and
+// format strings like "{{.State}} {{.Health}}" survive the remote shell
+func shellJoin(cmd []string) string {
+	quoted := make([]string, len(cmd))
+	for i, arg := range cmd {
+		quoted[i] = shellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func shellQuote(arg string) string {
+	if arg == "" {
+		return "''"
+	}
+	if !strings.ContainsAny(arg, " \t\n'\"\\$`!*?[]{}()<>|&;#~") {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
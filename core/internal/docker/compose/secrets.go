@@ -0,0 +1,51 @@
+package compose
+
+import (
+	"context"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Secret a value kept out of the compose files,
+// passed to compose as an environment variable
+type Secret struct {
+	Name  string
+	Value string
+	// File also declares a top level compose secret with the same name,
+	// so services can mount it as /run/secrets/<name>
+	File bool
+}
+
+// secretEnv returns the secrets as KEY=value pairs
+func secretEnv(secrets []Secret) []string {
+	env := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		env = append(env, secret.Name+"="+secret.Value)
+	}
+	return env
+}
+
+// secretsOverride writes a compose file declaring the file secrets,
+// each is sourced from its environment variable so the value is never on disk.
+// Returns the -f args for it and a func to remove it, nil if there are none
+func (c *Service) secretsOverride(ctx context.Context, secrets []Secret) ([]string, func(), error) {
+	declared := map[string]map[string]string{}
+	for _, secret := range secrets {
+		if secret.File {
+			declared[secret.Name] = map[string]string{"environment": secret.Name}
+		}
+	}
+	if len(declared) == 0 {
+		return nil, func() {}, nil
+	}
+
+	contents, err := yaml.Marshal(map[string]any{"secrets": declared})
+	if err != nil {
+		return nil, nil, err
+	}
+	path, remove, err := c.runner.WriteTemp(ctx, "dockman-secrets-*", contents)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []string{"-f", path}, remove, nil
+}
@@ -0,0 +1,169 @@
+package compose
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/joho/godotenv"
+	"github.com/rs/zerolog/log"
+)
+
+// Stack describes how a compose project is assembled
+// when it is made up of more than a single file
+type Stack struct {
+	// Files are layered on top of the base compose file in order,
+	// paths are relative to the directory of the base file
+	Files []string
+	// Profiles passed as --profile
+	Profiles []string
+	// ProjectName passed as --project-name
+	ProjectName string
+	// DependsOn other compose files that must be up before this stack, used for bulk operations
+	DependsOn []string
+}
+
+const (
+	envComposeFile          = "COMPOSE_FILE"
+	envComposeProfiles      = "COMPOSE_PROFILES"
+	envComposeProjectName   = "COMPOSE_PROJECT_NAME"
+	envComposePathSeparator = "COMPOSE_PATH_SEPARATOR"
+)
+
+// resolvedStack the final list of compose args for a stack
+type resolvedStack struct {
+	// files relative to the fs root, base file is always first
+	files       []string
+	profiles    []string
+	projectName string
+}
+
+func (r resolvedStack) args() []string {
+	var cmds []string
+	for _, file := range r.files {
+		cmds = append(cmds, "-f", file)
+	}
+	for _, profile := range r.profiles {
+		cmds = append(cmds, "--profile", profile)
+	}
+	if r.projectName != "" {
+		cmds = append(cmds, "--project-name", r.projectName)
+	}
+	return cmds
+}
+
+// resolveStack merges the stack config from dockman.yml with
+// COMPOSE_FILE, COMPOSE_PROFILES and COMPOSE_PROJECT_NAME from the .env files,
+// dockman.yml always has the final say
+//
+// when neither defines any files, a compose.override.yml next to the base file is included
+// to match the default compose behaviour, which is disabled when passing -f
+func resolveStack(fs filesystem.FileSystem, relpath string, stack Stack, envFiles []string) resolvedStack {
+	relpath = strings.TrimPrefix(relpath, "/")
+	dir := filepath.Dir(relpath)
+
+	envs, _ := readEnvFiles(fs, envFiles)
+	res := resolvedStack{
+		files:       []string{relpath},
+		profiles:    splitList(envs[envComposeProfiles], ","),
+		projectName: envs[envComposeProjectName],
+	}
+
+	explicit := false
+	if composeFile := envs[envComposeFile]; composeFile != "" {
+		sep := envs[envComposePathSeparator]
+		if sep == "" {
+			sep = string(filepath.ListSeparator)
+		}
+
+		res.files = res.files[:0]
+		for _, file := range splitList(composeFile, sep) {
+			res.files = append(res.files, stackPath(fs, dir, file))
+		}
+		explicit = true
+	}
+
+	if len(stack.Files) != 0 {
+		res.files = []string{relpath}
+		for _, file := range stack.Files {
+			res.files = append(res.files, stackPath(fs, dir, file))
+		}
+		explicit = true
+	}
+
+	if !explicit {
+		if override := findOverride(fs, relpath); override != "" {
+			res.files = append(res.files, override)
+		}
+	}
+
+	if len(stack.Profiles) != 0 {
+		res.profiles = stack.Profiles
+	}
+	if stack.ProjectName != "" {
+		res.projectName = stack.ProjectName
+	}
+
+	return res
+}
+
+// stackPath joins file to the compose directory
+// absolute paths are interpreted as relative to the fs root
+func stackPath(fs filesystem.FileSystem, dir string, file string) string {
+	if filepath.IsAbs(file) {
+		return strings.TrimPrefix(filepath.Clean(file), "/")
+	}
+	return fs.Join(dir, file)
+}
+
+// findOverride looks for <name>.override.<ext> next to the compose file
+func findOverride(fs filesystem.FileSystem, relpath string) string {
+	ext := filepath.Ext(relpath)
+	stem := strings.TrimSuffix(relpath, ext)
+
+	for _, candidate := range []string{ext, ".yml", ".yaml"} {
+		override := stem + ".override" + candidate
+		if _, err := fs.Stat(override); err == nil {
+			return override
+		}
+	}
+	return ""
+}
+
+// readEnvFiles parses envFiles in order, later files override earlier ones,
+// sources records which file set each variable
+func readEnvFiles(fs filesystem.FileSystem, envFiles []string) (envs map[string]string, sources map[string]string) {
+	envs = map[string]string{}
+	sources = map[string]string{}
+	for _, envFile := range envFiles {
+		contents, err := fs.ReadFile(envFile)
+		if err != nil {
+			log.Warn().Err(err).Str("path", envFile).Msg("unable to read env file")
+			continue
+		}
+
+		parsed, err := godotenv.Parse(bytes.NewReader(contents))
+		if err != nil {
+			log.Warn().Err(err).Str("path", envFile).Msg("unable to parse env file")
+			continue
+		}
+
+		for key, val := range parsed {
+			envs[key] = val
+			sources[key] = envFile
+		}
+	}
+	return envs, sources
+}
+
+func splitList(val string, sep string) []string {
+	var res []string
+	for _, item := range strings.Split(val, sep) {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
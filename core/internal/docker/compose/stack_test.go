@@ -0,0 +1,105 @@
+package compose
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/stretchr/testify/require"
+)
+
+func writeStackFiles(t *testing.T, files map[string]string) filesystem.FileSystem {
+	root := t.TempDir()
+	for name, contents := range files {
+		full := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+		require.NoError(t, os.WriteFile(full, []byte(contents), 0644))
+	}
+	return filesystem.NewLocal(root)
+}
+
+func TestResolveStackOverride(t *testing.T) {
+	fs := writeStackFiles(t, map[string]string{
+		"app/compose.yaml":          "",
+		"app/compose.override.yaml": "",
+	})
+
+	res := resolveStack(fs, "app/compose.yaml", Stack{}, findEnvFiles(fs, "app/compose.yaml", "local"))
+	require.Equal(t, []string{"app/compose.yaml", "app/compose.override.yaml"}, res.files)
+	require.Empty(t, res.profiles)
+	require.Empty(t, res.projectName)
+}
+
+func TestResolveStackEnv(t *testing.T) {
+	fs := writeStackFiles(t, map[string]string{
+		".env":                      "COMPOSE_PROFILES=base",
+		"app/.env":                  "COMPOSE_FILE=compose.yaml:compose.prod.yaml\nCOMPOSE_PROFILES=web, db\nCOMPOSE_PROJECT_NAME=prod",
+		"app/compose.yaml":          "",
+		"app/compose.override.yaml": "",
+	})
+
+	res := resolveStack(fs, "app/compose.yaml", Stack{}, findEnvFiles(fs, "app/compose.yaml", "local"))
+	require.Equal(t, []string{"app/compose.yaml", "app/compose.prod.yaml"}, res.files)
+	require.Equal(t, []string{"web", "db"}, res.profiles)
+	require.Equal(t, "prod", res.projectName)
+}
+
+func TestResolveStackDockyamlOverridesEnv(t *testing.T) {
+	fs := writeStackFiles(t, map[string]string{
+		"app/.env":         "COMPOSE_FILE=compose.yaml:compose.prod.yaml\nCOMPOSE_PROJECT_NAME=prod",
+		"app/compose.yaml": "",
+	})
+
+	stack := Stack{
+		Files:       []string{"compose.dev.yaml", "/shared/logging.yaml"},
+		Profiles:    []string{"debug"},
+		ProjectName: "dev",
+	}
+	res := resolveStack(fs, "/app/compose.yaml", stack, findEnvFiles(fs, "app/compose.yaml", "local"))
+	require.Equal(t, []string{"app/compose.yaml", "app/compose.dev.yaml", "shared/logging.yaml"}, res.files)
+	require.Equal(t, []string{"debug"}, res.profiles)
+	require.Equal(t, "dev", res.projectName)
+
+	require.Equal(t, []string{
+		"-f", "app/compose.yaml",
+		"-f", "app/compose.dev.yaml",
+		"-f", "shared/logging.yaml",
+		"--profile", "debug",
+		"--project-name", "dev",
+	}, res.args())
+}
+
+func TestFindEnvFilesHostOverlay(t *testing.T) {
+	fs := writeStackFiles(t, map[string]string{
+		".env":             "",
+		".env.nas":         "",
+		"app/.env":         "",
+		"app/.env.local":   "",
+		"app/.env.nas":     "",
+		"app/compose.yaml": "",
+	})
+
+	require.Equal(t,
+		[]string{".env", ".env.nas", "app/.env", "app/.env.nas"},
+		findEnvFiles(fs, "app/compose.yaml", "nas"),
+	)
+	require.Equal(t,
+		[]string{".env", "app/.env", "app/.env.local"},
+		findEnvFiles(fs, "app/compose.yaml", "local"),
+	)
+}
+
+func TestMaskSecrets(t *testing.T) {
+	config := "DB_PASSWORD: hunter22\nAPI_TOKEN: hunter22-extra\nUSER: admin\n"
+	masked := maskSecrets(config, []string{"hunter22", "hunter22-extra"})
+	require.Equal(t, "DB_PASSWORD: ********\nAPI_TOKEN: ********\nUSER: admin\n", masked)
+}
+
+func TestShellQuote(t *testing.T) {
+	require.Equal(t,
+		`docker compose '--env-file=/my stacks/.env' ps --format '{{.State}} {{.Health}}'`,
+		shellJoin([]string{"docker", "compose", "--env-file=/my stacks/.env", "ps", "--format", "{{.State}} {{.Health}}"}),
+	)
+	require.Equal(t, `'it'\''s'`, shellQuote("it's"))
+}
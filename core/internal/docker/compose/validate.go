@@ -0,0 +1,535 @@
+package compose
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/docker/compose/v5/pkg/api"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/goccy/go-yaml/token"
+	container2 "github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// validation rules, used by clients to group or silence diagnostics
+const (
+	RuleConfig          = "compose-config"
+	RulePortConflict    = "port-conflict"
+	RuleContainerName   = "duplicate-container-name"
+	RuleExternalNetwork = "missing-external-network"
+	RuleExternalVolume  = "missing-external-volume"
+	RuleBindSource      = "missing-bind-source"
+	RuleImage           = "unresolvable-image"
+)
+
+// registry lookups are slow, don't let a single image hold up validation
+const imageResolveTimeout = 10 * time.Second
+
+// Diagnostic a single problem found in a compose stack,
+// Line and Column are 1-based and 0 if the position is unknown
+type Diagnostic struct {
+	File     string
+	Line     int
+	Column   int
+	Severity Severity
+	Rule     string
+	Message  string
+}
+
+// Validate checks the stack for filename against the target host,
+// an error is only returned if validation itself could not run
+func (c *Service) Validate(ctx context.Context, filename string) ([]Diagnostic, error) {
+	fileParts, err := c.parser(filename, c.hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	envFiles := findEnvFiles(fileParts.Fs, fileParts.Relpath, c.hostname)
+	stack := resolveStack(fileParts.Fs, fileParts.Relpath, fileParts.Stack, envFiles)
+	loc := newLocator(
+		fileParts.Fs,
+		strings.TrimSuffix(filename, fileParts.Relpath),
+		stack.files,
+	)
+
+	stdout, stderr, err := c.output(ctx, filename, func(cmdList []string) []string {
+		return append(cmdList, "config", "--format", "json")
+	})
+	if err != nil {
+		return []Diagnostic{loc.configError(stderr, err)}, nil
+	}
+
+	var project composeProject
+	if err = json.Unmarshal([]byte(stdout), &project); err != nil {
+		return nil, fmt.Errorf("unable to parse compose config: %w", err)
+	}
+
+	containers, err := c.cont.ContainersList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list containers: %w", err)
+	}
+
+	var diags []Diagnostic
+	diags = append(diags, checkPorts(loc, project, containers)...)
+	diags = append(diags, checkContainerNames(loc, project, containers)...)
+	diags = append(diags, c.checkExternal(ctx, loc, project)...)
+	diags = append(diags, checkBindSources(loc, project, fileParts)...)
+	diags = append(diags, c.checkImages(ctx, loc, project)...)
+
+	return diags, nil
+}
+
+// composeProject the parts of `docker compose config --format json` used for validation
+type composeProject struct {
+	Name     string                     `json:"name"`
+	Services map[string]composeService  `json:"services"`
+	Networks map[string]composeExternal `json:"networks"`
+	Volumes  map[string]composeExternal `json:"volumes"`
+}
+
+type composeService struct {
+	Image         string          `json:"image"`
+	ContainerName string          `json:"container_name"`
+	Build         json.RawMessage `json:"build"`
+	Ports         []composePort   `json:"ports"`
+	Volumes       []composeVolume `json:"volumes"`
+}
+
+type composePort struct {
+	HostIP    string `json:"host_ip"`
+	Target    uint32 `json:"target"`
+	Published string `json:"published"`
+	Protocol  string `json:"protocol"`
+}
+
+type composeVolume struct {
+	Type   string `json:"type"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Bind   *struct {
+		CreateHostPath bool `json:"create_host_path"`
+	} `json:"bind"`
+}
+
+type composeExternal struct {
+	Name     string `json:"name"`
+	External bool   `json:"external"`
+}
+
+func checkPorts(loc *locator, project composeProject, containers []container2.Summary) []Diagnostic {
+	var diags []Diagnostic
+	for _, svcName := range sortedKeys(project.Services) {
+		for i, port := range project.Services[svcName].Ports {
+			start, end, err := parsePublished(port.Published)
+			if err != nil {
+				diags = append(diags, loc.diagnostic(
+					SeverityError, RulePortConflict,
+					fmt.Sprintf("service %q has an invalid published port %q: %v", svcName, port.Published, err),
+					"services", svcName, "ports", i,
+				))
+				continue
+			}
+			if start == 0 {
+				// no published port, docker picks a free one
+				continue
+			}
+
+			for _, cont := range containers {
+				if cont.State != container2.StateRunning || cont.Labels[api.ProjectLabel] == project.Name {
+					continue
+				}
+
+				for _, used := range cont.Ports {
+					if used.PublicPort < start || used.PublicPort > end || !sameProtocol(port.Protocol, used.Type) {
+						continue
+					}
+					if !overlappingIP(port.HostIP, used.IP) {
+						continue
+					}
+
+					diags = append(diags, loc.diagnostic(
+						SeverityError, RulePortConflict,
+						fmt.Sprintf(
+							"service %q wants port %d/%s, but container %q is already using it",
+							svcName, used.PublicPort, used.Type, containerName(cont),
+						),
+						"services", svcName, "ports", i,
+					))
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+// parsePublished parses a published port or port range, returns 0 if none is set
+func parsePublished(published string) (start, end uint16, err error) {
+	if published == "" {
+		return 0, 0, nil
+	}
+
+	first, last, isRange := strings.Cut(published, "-")
+	s, err := strconv.ParseUint(first, 10, 16)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !isRange {
+		return uint16(s), uint16(s), nil
+	}
+
+	e, err := strconv.ParseUint(last, 10, 16)
+	if err != nil {
+		return 0, 0, err
+	}
+	if e < s {
+		return 0, 0, fmt.Errorf("range end is lower than start")
+	}
+	return uint16(s), uint16(e), nil
+}
+
+func sameProtocol(want, used string) bool {
+	if want == "" {
+		want = "tcp"
+	}
+	return strings.EqualFold(want, used)
+}
+
+// overlappingIP reports whether two host ips can clash, unset and
+// unspecified addresses listen on all interfaces
+func overlappingIP(hostIP string, used netip.Addr) bool {
+	if !used.IsValid() || used.IsUnspecified() {
+		return true
+	}
+
+	want, err := netip.ParseAddr(hostIP)
+	if err != nil || want.IsUnspecified() {
+		return true
+	}
+	return want == used
+}
+
+func checkContainerNames(loc *locator, project composeProject, containers []container2.Summary) []Diagnostic {
+	var diags []Diagnostic
+	for _, svcName := range sortedKeys(project.Services) {
+		name := project.Services[svcName].ContainerName
+		if name == "" {
+			continue
+		}
+
+		for _, cont := range containers {
+			if containerName(cont) != name || cont.Labels[api.ProjectLabel] == project.Name {
+				continue
+			}
+
+			msg := fmt.Sprintf("container name %q is already in use", name)
+			if owner := cont.Labels[api.ProjectLabel]; owner != "" {
+				msg += fmt.Sprintf(" by project %q", owner)
+			}
+			diags = append(diags, loc.diagnostic(
+				SeverityError, RuleContainerName, msg,
+				"services", svcName, "container_name",
+			))
+		}
+	}
+
+	return diags
+}
+
+func (c *Service) checkExternal(ctx context.Context, loc *locator, project composeProject) []Diagnostic {
+	var diags []Diagnostic
+	for _, key := range sortedKeys(project.Networks) {
+		network := project.Networks[key]
+		if !network.External {
+			continue
+		}
+
+		_, err := c.cont.Client.NetworkInspect(ctx, network.Name, client.NetworkInspectOptions{})
+		if err != nil {
+			diags = append(diags, loc.diagnostic(
+				SeverityError, RuleExternalNetwork,
+				fmt.Sprintf("external network %q not found: %v", network.Name, err),
+				"networks", key,
+			))
+		}
+	}
+
+	for _, key := range sortedKeys(project.Volumes) {
+		volume := project.Volumes[key]
+		if !volume.External {
+			continue
+		}
+
+		_, err := c.cont.Client.VolumeInspect(ctx, volume.Name, client.VolumeInspectOptions{})
+		if err != nil {
+			diags = append(diags, loc.diagnostic(
+				SeverityError, RuleExternalVolume,
+				fmt.Sprintf("external volume %q not found: %v", volume.Name, err),
+				"volumes", key,
+			))
+		}
+	}
+
+	return diags
+}
+
+// checkBindSources checks bind mount sources exist, paths outside the
+// compose root are only checked if the host provides HostFs
+func checkBindSources(loc *locator, project composeProject, fileParts Host) []Diagnostic {
+	root := fileParts.Fs.Root()
+
+	var diags []Diagnostic
+	for _, svcName := range sortedKeys(project.Services) {
+		for i, vol := range project.Services[svcName].Volumes {
+			if vol.Type != "bind" || vol.Source == "" {
+				continue
+			}
+
+			var err error
+			if rel, relErr := filepath.Rel(root, vol.Source); relErr == nil && !strings.HasPrefix(rel, "..") {
+				_, err = fileParts.Fs.Stat(rel)
+			} else if fileParts.HostFs != nil {
+				_, err = fileParts.HostFs.Stat(vol.Source)
+			} else {
+				continue
+			}
+			if err == nil {
+				continue
+			}
+
+			severity := SeverityError
+			msg := fmt.Sprintf("bind mount source %q does not exist", vol.Source)
+			if vol.Bind == nil || vol.Bind.CreateHostPath {
+				severity = SeverityWarning
+				msg += ", docker will create it as an empty directory"
+			}
+
+			diags = append(diags, loc.diagnostic(
+				severity, RuleBindSource, msg,
+				"services", svcName, "volumes", i,
+			))
+		}
+	}
+
+	return diags
+}
+
+// checkImages checks each image exists locally or can be found in its registry,
+// services that are built are skipped
+func (c *Service) checkImages(ctx context.Context, loc *locator, project composeProject) []Diagnostic {
+	var diags []Diagnostic
+	for _, svcName := range sortedKeys(project.Services) {
+		svc := project.Services[svcName]
+		if svc.Image == "" || len(svc.Build) != 0 {
+			continue
+		}
+
+		if _, err := c.cont.Client.ImageInspect(ctx, svc.Image); err == nil {
+			continue
+		}
+
+		lookupCtx, cancel := context.WithTimeout(ctx, imageResolveTimeout)
+		_, err := c.cont.Client.DistributionInspect(lookupCtx, svc.Image, client.DistributionInspectOptions{})
+		cancel()
+		if err != nil {
+			diags = append(diags, loc.diagnostic(
+				SeverityWarning, RuleImage,
+				fmt.Sprintf("image %q is not available locally and could not be resolved: %v", svc.Image, err),
+				"services", svcName, "image",
+			))
+		}
+	}
+
+	return diags
+}
+
+func containerName(cont container2.Summary) string {
+	if len(cont.Names) == 0 {
+		return cont.ID
+	}
+	// container names have a leading "/"
+	return strings.TrimPrefix(cont.Names[0], "/")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// locator maps a path in the compose config back to
+// the file, line and column it was defined at
+type locator struct {
+	// prefix added to file paths so they match the names used by clients
+	prefix string
+	files  []locatorFile
+}
+
+type locatorFile struct {
+	name string
+	root ast.Node
+}
+
+func newLocator(fs filesystem.FileSystem, prefix string, files []string) *locator {
+	loc := &locator{prefix: prefix}
+	for _, file := range files {
+		contents, err := fs.ReadFile(file)
+		if err != nil {
+			continue
+		}
+
+		parsed, err := parser.ParseBytes(contents, 0)
+		if err != nil || len(parsed.Docs) == 0 {
+			// still report diagnostics against the file
+			loc.files = append(loc.files, locatorFile{name: file})
+			continue
+		}
+		loc.files = append(loc.files, locatorFile{name: file, root: parsed.Docs[0].Body})
+	}
+	return loc
+}
+
+// find returns the position of path, path elements are mapping keys or sequence indexes
+//
+// later files override earlier ones so the last file with the deepest match wins,
+// if no file defines the full path the closest parent is used
+func (l *locator) find(path ...any) (file string, line, column int) {
+	if len(l.files) == 0 {
+		return "", 0, 0
+	}
+
+	best := -1
+	file = l.files[0].name
+	for i := len(l.files) - 1; i >= 0; i-- {
+		depth, node := walkPath(l.files[i].root, path)
+		if node == nil || depth <= best {
+			continue
+		}
+
+		best = depth
+		file = l.files[i].name
+		pos := positionOf(node)
+		line, column = pos.Line, pos.Column
+	}
+
+	return l.prefix + file, line, column
+}
+
+func (l *locator) diagnostic(severity Severity, rule, msg string, path ...any) Diagnostic {
+	file, line, column := l.find(path...)
+	return Diagnostic{
+		File:     file,
+		Line:     line,
+		Column:   column,
+		Severity: severity,
+		Rule:     rule,
+		Message:  msg,
+	}
+}
+
+var lineNumberPattern = regexp.MustCompile(`line (\d+)`)
+
+// configError converts a failed `compose config` into a diagnostic,
+// pointing at the reported file and line when compose includes them
+func (l *locator) configError(stderr string, err error) Diagnostic {
+	msg := strings.TrimSpace(stderr)
+	if msg == "" {
+		msg = err.Error()
+	}
+
+	diag := Diagnostic{
+		Severity: SeverityError,
+		Rule:     RuleConfig,
+		Message:  msg,
+	}
+	if len(l.files) == 0 {
+		return diag
+	}
+
+	file := l.files[0].name
+	for _, f := range l.files {
+		if strings.Contains(msg, f.name) {
+			file = f.name
+		}
+	}
+	diag.File = l.prefix + file
+
+	if match := lineNumberPattern.FindStringSubmatch(msg); match != nil {
+		diag.Line, _ = strconv.Atoi(match[1])
+	}
+	return diag
+}
+
+// walkPath follows path from node, returning how many
+// elements matched and the node of the last match
+func walkPath(node ast.Node, path []any) (int, ast.Node) {
+	var found ast.Node
+	for depth, elem := range path {
+		next := childNode(node, elem)
+		if next == nil {
+			if found == nil {
+				return -1, nil
+			}
+			return depth, found
+		}
+		found, node = next, valueOf(next)
+	}
+	return len(path), found
+}
+
+// childNode returns the key node for a mapping key or the value for a sequence index
+func childNode(node ast.Node, elem any) ast.Node {
+	switch n := node.(type) {
+	case *ast.MappingNode:
+		for _, value := range n.Values {
+			if key, ok := elem.(string); ok && value.Key.GetToken().Value == key {
+				return value
+			}
+		}
+	case *ast.MappingValueNode:
+		if key, ok := elem.(string); ok && n.Key.GetToken().Value == key {
+			return n
+		}
+	case *ast.SequenceNode:
+		if idx, ok := elem.(int); ok && idx < len(n.Values) {
+			return n.Values[idx]
+		}
+	}
+	return nil
+}
+
+// positionOf returns where node starts, for key-value pairs that is the key
+func positionOf(node ast.Node) *token.Position {
+	if kv, ok := node.(*ast.MappingValueNode); ok {
+		return kv.Key.GetToken().Position
+	}
+	return node.GetToken().Position
+}
+
+// valueOf unwraps key-value pairs so walking can continue into the value
+func valueOf(node ast.Node) ast.Node {
+	if kv, ok := node.(*ast.MappingValueNode); ok {
+		return kv.Value
+	}
+	return node
+}
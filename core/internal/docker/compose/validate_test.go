@@ -0,0 +1,109 @@
+package compose
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/docker/compose/v5/pkg/api"
+	container2 "github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/require"
+)
+
+const validateBase = `services:
+  web:
+    image: nginx
+    ports:
+      - "8080:80"
+      - "9000-9001:9000-9001"
+`
+
+const validateOverride = `services:
+  web:
+    container_name: web
+`
+
+func TestLocatorFind(t *testing.T) {
+	fs := writeStackFiles(t, map[string]string{
+		"app/compose.yaml":          validateBase,
+		"app/compose.override.yaml": validateOverride,
+	})
+	loc := newLocator(fs, "compose/", []string{"app/compose.yaml", "app/compose.override.yaml"})
+
+	file, line, col := loc.find("services", "web", "ports", 1)
+	require.Equal(t, "compose/app/compose.yaml", file)
+	require.Equal(t, 6, line)
+	require.Equal(t, 9, col)
+
+	file, line, _ = loc.find("services", "web", "container_name")
+	require.Equal(t, "compose/app/compose.override.yaml", file)
+	require.Equal(t, 3, line)
+
+	// falls back to the closest parent
+	file, line, _ = loc.find("services", "web", "volumes", 0)
+	require.Equal(t, "compose/app/compose.override.yaml", file)
+	require.Equal(t, 2, line)
+}
+
+func TestParsePublished(t *testing.T) {
+	start, end, err := parsePublished("8080")
+	require.NoError(t, err)
+	require.Equal(t, []uint16{8080, 8080}, []uint16{start, end})
+
+	start, end, err = parsePublished("9000-9001")
+	require.NoError(t, err)
+	require.Equal(t, []uint16{9000, 9001}, []uint16{start, end})
+
+	start, _, err = parsePublished("")
+	require.NoError(t, err)
+	require.Zero(t, start)
+
+	_, _, err = parsePublished("9001-9000")
+	require.Error(t, err)
+}
+
+func TestCheckPorts(t *testing.T) {
+	fs := writeStackFiles(t, map[string]string{"app/compose.yaml": validateBase})
+	loc := newLocator(fs, "", []string{"app/compose.yaml"})
+
+	project := composeProject{
+		Name: "app",
+		Services: map[string]composeService{
+			"web": {Ports: []composePort{
+				{Published: "8080", Target: 80},
+				{Published: "9000-9001", Target: 9000, HostIP: "127.0.0.1"},
+			}},
+		},
+	}
+	containers := []container2.Summary{
+		{
+			Names: []string{"/other"},
+			State: container2.StateRunning,
+			Ports: []container2.PortSummary{{PublicPort: 8080, Type: "tcp"}},
+		},
+		{
+			// bound to a different interface
+			Names: []string{"/lan"},
+			State: container2.StateRunning,
+			Ports: []container2.PortSummary{{PublicPort: 9001, Type: "tcp", IP: netip.MustParseAddr("192.168.1.2")}},
+		},
+		{
+			// same project, replaced on up
+			Names:  []string{"/app-web-1"},
+			State:  container2.StateRunning,
+			Labels: map[string]string{api.ProjectLabel: "app"},
+			Ports:  []container2.PortSummary{{PublicPort: 8080, Type: "tcp"}},
+		},
+		{
+			Names: []string{"/stopped"},
+			State: container2.StateExited,
+			Ports: []container2.PortSummary{{PublicPort: 9000, Type: "tcp"}},
+		},
+	}
+
+	diags := checkPorts(loc, project, containers)
+	require.Len(t, diags, 1)
+	require.Equal(t, RulePortConflict, diags[0].Rule)
+	require.Equal(t, SeverityError, diags[0].Severity)
+	require.Equal(t, 5, diags[0].Line)
+	require.Contains(t, diags[0].Message, `"other"`)
+}
@@ -43,6 +43,11 @@ func (s *Service) ContainersList(ctx context.Context) ([]container.Summary, erro
 }
 
 func (s *Service) ContainerListByIDs(ctx context.Context, containerID ...string) ([]container.Summary, error) {
+	if len(containerID) == 0 {
+		// without an id filter every container would be listed
+		return nil, nil
+	}
+
 	filterArgs := client.Filters{}
 	for _, id := range containerID {
 		filterArgs.Add("id", id)
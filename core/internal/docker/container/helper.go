@@ -0,0 +1,164 @@
+package container
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/google/uuid"
+	"github.com/moby/moby/api/pkg/stdcopy"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/client"
+	"github.com/rs/zerolog/log"
+)
+
+// HelperImage used for short-lived helper containers, it only needs a shell and tar
+const HelperImage = "alpine:3"
+
+// HelperLabel marks helper containers so leftovers can be told apart
+const HelperLabel = "dockman.helper"
+
+type HelperOptions struct {
+	// Mounts the volumes and bind mounts the command works on
+	Mounts []mount.Mount
+	Cmd    []string
+	// Stdin is streamed to the command and closed once read, nil for none
+	Stdin io.Reader
+	// Stdout receives the output of the command, nil discards it
+	Stdout io.Writer
+}
+
+// maxHelperStderr bytes of stderr kept for the error of a failed command
+const maxHelperStderr = 4096
+
+// RunHelper runs a command in a throwaway container on the docker host and
+// waits for it to exit, the container is removed afterwards.
+// Files are streamed through stdin and stdout so this works the same for remote hosts
+func (s *Service) RunHelper(ctx context.Context, opts HelperOptions) error {
+	if err := s.ensureImage(ctx, HelperImage); err != nil {
+		return err
+	}
+
+	withStdin := opts.Stdin != nil
+	create, err := s.Client.ContainerCreate(ctx, client.ContainerCreateOptions{
+		Name: "dockman-helper-" + uuid.New().String()[:12],
+		Config: &container.Config{
+			Image:        HelperImage,
+			Cmd:          opts.Cmd,
+			AttachStdin:  withStdin,
+			OpenStdin:    withStdin,
+			StdinOnce:    withStdin,
+			AttachStdout: true,
+			AttachStderr: true,
+			Labels:       map[string]string{HelperLabel: "true"},
+		},
+		HostConfig: &container.HostConfig{
+			Mounts: opts.Mounts,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create helper container: %w", err)
+	}
+	defer func() {
+		// the container has to go even if ctx was canceled
+		_, err := s.Client.ContainerRemove(context.WithoutCancel(ctx), create.ID, client.ContainerRemoveOptions{
+			Force: true,
+		})
+		if err != nil {
+			log.Warn().Err(err).Str("container", create.ID).Msg("unable to remove helper container")
+		}
+	}()
+
+	attach, err := s.Client.ContainerAttach(ctx, create.ID, client.ContainerAttachOptions{
+		Stream: true,
+		Stdin:  withStdin,
+		Stdout: true,
+		Stderr: true,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to attach to helper container: %w", err)
+	}
+	defer attach.Close()
+
+	// wait before starting so a quick exit is not missed
+	wait := s.Client.ContainerWait(ctx, create.ID, client.ContainerWaitOptions{
+		Condition: container.WaitConditionNextExit,
+	})
+	if _, err = s.Client.ContainerStart(ctx, create.ID, client.ContainerStartOptions{}); err != nil {
+		return fmt.Errorf("unable to start helper container: %w", err)
+	}
+
+	stdinErr := make(chan error, 1)
+	if withStdin {
+		go func() {
+			_, err := io.Copy(attach.Conn, opts.Stdin)
+			if cerr := attach.CloseWrite(); err == nil {
+				err = cerr
+			}
+			stdinErr <- err
+		}()
+	} else {
+		stdinErr <- nil
+	}
+
+	stdout := opts.Stdout
+	if stdout == nil {
+		stdout = io.Discard
+	}
+	stderr := &limitedBuffer{max: maxHelperStderr}
+	if _, err = stdcopy.StdCopy(stdout, stderr, attach.Reader); err != nil {
+		return fmt.Errorf("unable to read helper output: %w", err)
+	}
+
+	select {
+	case err = <-wait.Error:
+		return fmt.Errorf("unable to wait for helper container: %w", err)
+	case res := <-wait.Result:
+		// a failed command may not have read all of stdin, so don't wait on the copy
+		if res.StatusCode != 0 {
+			return fmt.Errorf(
+				"%s exited with code %d: %s",
+				strings.Join(opts.Cmd, " "), res.StatusCode, strings.TrimSpace(stderr.String()),
+			)
+		}
+		if err = <-stdinErr; err != nil {
+			return fmt.Errorf("unable to stream input to helper container: %w", err)
+		}
+		return nil
+	}
+}
+
+// ensureImage pulls image if the host does not have it yet
+func (s *Service) ensureImage(ctx context.Context, image string) error {
+	_, err := s.Client.ImageInspect(ctx, image)
+	if err == nil {
+		return nil
+	}
+
+	progress, err := s.Client.ImagePull(ctx, image, client.ImagePullOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", image, err)
+	}
+	defer fileutil.Close(progress)
+	if err = progress.Wait(ctx); err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", image, err)
+	}
+	return nil
+}
+
+// limitedBuffer keeps the first max bytes written to it and drops the rest
+type limitedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.max - b.Len(); room > 0 {
+		b.Buffer.Write(p[:min(room, len(p))])
+	}
+	return len(p), nil
+}
@@ -3,6 +3,7 @@ package container
 import (
 	"context"
 	"fmt"
+	"net/netip"
 
 	"github.com/moby/moby/api/types/network"
 	"github.com/moby/moby/client"
@@ -42,8 +43,160 @@ func (s *Service) NetworksInspect(ctx context.Context, id string) (network.Inspe
 	return inspect.Network, err
 }
 
-func (s *Service) NetworksCreate(ctx context.Context, name string) error {
-	_, err := s.Client.NetworkCreate(ctx, name, client.NetworkCreateOptions{})
+type NetworkCreateOptions struct {
+	Name string
+	// Driver bridge, macvlan, ipvlan, overlay or a plugin, bridge if empty
+	Driver string
+	// Options passed to the driver, e.g. parent=eth0 for macvlan
+	Options map[string]string
+	Labels  map[string]string
+	// Subnets docker picks one if empty
+	Subnets    []NetworkSubnet
+	EnableIPv6 bool
+	Internal   bool
+	Attachable bool
+}
+
+type NetworkSubnet struct {
+	// Subnet in CIDR notation
+	Subnet  string
+	Gateway string
+	// IPRange in CIDR notation, containers get addresses from it
+	IPRange string
+}
+
+// NetworksCreate creates a network, warnings are returned by the daemon
+// for settings it accepted but could not fully apply
+func (s *Service) NetworksCreate(ctx context.Context, opts NetworkCreateOptions) (id string, warnings []string, err error) {
+	if opts.Name == "" {
+		return "", nil, fmt.Errorf("network name is required")
+	}
+
+	ipam, ipv6, err := networkIPAM(opts.Subnets)
+	if err != nil {
+		return "", nil, err
+	}
+	// left unset otherwise so the daemon's default-network-opts apply
+	var enableIPv6 *bool
+	if opts.EnableIPv6 || ipv6 {
+		enable := true
+		enableIPv6 = &enable
+	}
+
+	res, err := s.Client.NetworkCreate(ctx, opts.Name, client.NetworkCreateOptions{
+		Driver:     opts.Driver,
+		EnableIPv6: enableIPv6,
+		IPAM:       ipam,
+		Internal:   opts.Internal,
+		Attachable: opts.Attachable,
+		Options:    opts.Options,
+		Labels:     opts.Labels,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return res.ID, res.Warning, nil
+}
+
+// networkIPAM validates subnets, ipv6 is set if any of them is an ipv6 subnet
+func networkIPAM(subnets []NetworkSubnet) (ipam *network.IPAM, ipv6 bool, err error) {
+	if len(subnets) == 0 {
+		return nil, false, nil
+	}
+
+	ipam = &network.IPAM{}
+	for _, sub := range subnets {
+		prefix, err := netip.ParsePrefix(sub.Subnet)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid subnet %q: %w", sub.Subnet, err)
+		}
+		conf := network.IPAMConfig{Subnet: prefix.Masked()}
+
+		if sub.Gateway != "" {
+			conf.Gateway, err = netip.ParseAddr(sub.Gateway)
+			if err != nil {
+				return nil, false, fmt.Errorf("invalid gateway %q: %w", sub.Gateway, err)
+			}
+			if !conf.Subnet.Contains(conf.Gateway) {
+				return nil, false, fmt.Errorf("gateway %s is outside of subnet %s", conf.Gateway, conf.Subnet)
+			}
+		}
+		if sub.IPRange != "" {
+			conf.IPRange, err = netip.ParsePrefix(sub.IPRange)
+			if err != nil {
+				return nil, false, fmt.Errorf("invalid ip range %q: %w", sub.IPRange, err)
+			}
+			conf.IPRange = conf.IPRange.Masked()
+			if conf.IPRange.Bits() < conf.Subnet.Bits() || !conf.Subnet.Contains(conf.IPRange.Addr()) {
+				return nil, false, fmt.Errorf("ip range %s is outside of subnet %s", conf.IPRange, conf.Subnet)
+			}
+		}
+
+		ipv6 = ipv6 || conf.Subnet.Addr().Is6()
+		ipam.Config = append(ipam.Config, conf)
+	}
+	return ipam, ipv6, nil
+}
+
+type NetworkConnectOptions struct {
+	// Aliases extra dns names of the container on the network
+	Aliases []string
+	// IPv4 and IPv6 static addresses, empty lets docker pick
+	IPv4 string
+	IPv6 string
+}
+
+func (s *Service) NetworksConnect(ctx context.Context, networkID, containerID string, opts NetworkConnectOptions) error {
+	ipam, err := endpointIPAM(opts.IPv4, opts.IPv6)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Client.NetworkConnect(ctx, networkID, client.NetworkConnectOptions{
+		Container: containerID,
+		EndpointConfig: &network.EndpointSettings{
+			Aliases:    opts.Aliases,
+			IPAMConfig: ipam,
+		},
+	})
+	return err
+}
+
+// endpointIPAM validates static addresses, each has to be of the family of its field
+func endpointIPAM(ipv4, ipv6 string) (*network.EndpointIPAMConfig, error) {
+	if ipv4 == "" && ipv6 == "" {
+		return nil, nil
+	}
+
+	conf := &network.EndpointIPAMConfig{}
+	if ipv4 != "" {
+		addr, err := netip.ParseAddr(ipv4)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ipv4 address %q: %w", ipv4, err)
+		}
+		if !addr.Is4() {
+			return nil, fmt.Errorf("%s is not an ipv4 address", ipv4)
+		}
+		conf.IPv4Address = addr
+	}
+	if ipv6 != "" {
+		addr, err := netip.ParseAddr(ipv6)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ipv6 address %q: %w", ipv6, err)
+		}
+		if !addr.Is6() {
+			return nil, fmt.Errorf("%s is not an ipv6 address", ipv6)
+		}
+		conf.IPv6Address = addr
+	}
+	return conf, nil
+}
+
+func (s *Service) NetworksDisconnect(ctx context.Context, networkID, containerID string, force bool) error {
+	_, err := s.Client.NetworkDisconnect(ctx, networkID, client.NetworkDisconnectOptions{
+		Container: containerID,
+		Force:     force,
+	})
 	return err
 }
 
@@ -0,0 +1,58 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkIPAM(t *testing.T) {
+	ipam, ipv6, err := networkIPAM(nil)
+	require.NoError(t, err)
+	require.Nil(t, ipam)
+	require.False(t, ipv6)
+
+	ipam, ipv6, err = networkIPAM([]NetworkSubnet{
+		{Subnet: "172.30.0.0/16", Gateway: "172.30.0.1", IPRange: "172.30.5.0/24"},
+		{Subnet: "fd00:dead:beef::/48"},
+	})
+	require.NoError(t, err)
+	require.True(t, ipv6)
+	require.Len(t, ipam.Config, 2)
+	require.Equal(t, "172.30.0.1", ipam.Config[0].Gateway.String())
+	require.Equal(t, "172.30.5.0/24", ipam.Config[0].IPRange.String())
+
+	// host bits are masked off
+	ipam, _, err = networkIPAM([]NetworkSubnet{{Subnet: "10.1.2.3/24"}})
+	require.NoError(t, err)
+	require.Equal(t, "10.1.2.0/24", ipam.Config[0].Subnet.String())
+
+	for _, sub := range []NetworkSubnet{
+		{Subnet: "not a subnet"},
+		{Subnet: "172.30.0.0/16", Gateway: "10.0.0.1"},
+		{Subnet: "172.30.0.0/16", Gateway: "gateway"},
+		{Subnet: "172.30.0.0/16", IPRange: "172.31.0.0/24"},
+		{Subnet: "172.30.0.0/16", IPRange: "172.0.0.0/8"},
+	} {
+		_, _, err = networkIPAM([]NetworkSubnet{sub})
+		require.Error(t, err, sub)
+	}
+}
+
+func TestEndpointIPAM(t *testing.T) {
+	conf, err := endpointIPAM("", "")
+	require.NoError(t, err)
+	require.Nil(t, conf)
+
+	conf, err = endpointIPAM("172.30.5.10", "fd00::10")
+	require.NoError(t, err)
+	require.Equal(t, "172.30.5.10", conf.IPv4Address.String())
+	require.Equal(t, "fd00::10", conf.IPv6Address.String())
+
+	_, err = endpointIPAM("fd00::10", "")
+	require.Error(t, err, "ipv6 address in the ipv4 field")
+	_, err = endpointIPAM("", "172.30.5.10")
+	require.Error(t, err, "ipv4 address in the ipv6 field")
+	_, err = endpointIPAM("300.1.1.1", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,221 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"strings"
+
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/volume"
+	"github.com/moby/moby/client"
+	"github.com/rs/zerolog/log"
+)
+
+// composeLabelPrefix labels compose uses to claim a volume, a copy is not part of the project
+const composeLabelPrefix = "com.docker.compose."
+
+// VolumesClone copies source into a new volume named target,
+// which is created with the driver, options and labels of source.
+// Volumes backed by a device, like a bind or nfs share, can't be cloned
+func (s *Service) VolumesClone(ctx context.Context, source, target string) error {
+	src, err := s.volumeForCopy(ctx, source, target)
+	if err != nil {
+		return err
+	}
+	if err = sharedStorage(src); err != nil {
+		return err
+	}
+
+	_, err = s.Client.VolumeCreate(ctx, client.VolumeCreateOptions{
+		Name:       target,
+		Driver:     src.Driver,
+		DriverOpts: src.Options,
+		Labels:     copyLabels(src.Labels),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create volume %s: %w", target, err)
+	}
+
+	err = s.RunHelper(ctx, HelperOptions{
+		Mounts: []mount.Mount{
+			{Type: mount.TypeVolume, Source: source, Target: "/source", ReadOnly: true},
+			{Type: mount.TypeVolume, Source: target, Target: "/target"},
+		},
+		Cmd: []string{"cp", "-a", "/source/.", "/target/"},
+	})
+	if err != nil {
+		return errors.Join(
+			fmt.Errorf("unable to copy %s to %s: %w", source, target, err),
+			s.removeVolume(ctx, target),
+		)
+	}
+	return nil
+}
+
+// VolumesRename clones source to target and removes source,
+// volumes can't be renamed in place. Source must not be in use
+func (s *Service) VolumesRename(ctx context.Context, source, target string) error {
+	if err := s.volumeUnused(ctx, source); err != nil {
+		return err
+	}
+	if err := s.VolumesClone(ctx, source, target); err != nil {
+		return err
+	}
+
+	if _, err := s.Client.VolumeRemove(ctx, source, client.VolumeRemoveOptions{}); err != nil {
+		return fmt.Errorf("copied %s to %s but unable to remove it: %w", source, target, err)
+	}
+	return nil
+}
+
+// VolumesMigrate streams the contents of source to a new volume named target on
+// the docker host of dest, the data goes straight from one helper container
+// to the other. Driver options are specific to a host, so only labels are carried over
+func (s *Service) VolumesMigrate(ctx context.Context, source string, dest *Service, target string, removeSource bool) error {
+	if target == "" {
+		target = source
+	}
+	if removeSource {
+		if err := s.volumeUnused(ctx, source); err != nil {
+			return err
+		}
+	}
+
+	src, err := s.Client.VolumeInspect(ctx, source, client.VolumeInspectOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to find volume %s: %w", source, err)
+	}
+	if _, err = dest.Client.VolumeInspect(ctx, target, client.VolumeInspectOptions{}); err == nil {
+		return fmt.Errorf("volume %s already exists on the destination", target)
+	}
+
+	_, err = dest.Client.VolumeCreate(ctx, client.VolumeCreateOptions{
+		Name:   target,
+		Labels: copyLabels(src.Volume.Labels),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create volume %s on the destination: %w", target, err)
+	}
+
+	if err = streamVolume(ctx, s, source, dest, target); err != nil {
+		return errors.Join(
+			fmt.Errorf("unable to migrate %s: %w", source, err),
+			dest.removeVolume(ctx, target),
+		)
+	}
+
+	if removeSource {
+		if _, err = s.Client.VolumeRemove(ctx, source, client.VolumeRemoveOptions{}); err != nil {
+			return fmt.Errorf("migrated %s but unable to remove it: %w", source, err)
+		}
+	}
+	return nil
+}
+
+// streamVolume pipes a tarball of source on src into target on dest
+func streamVolume(ctx context.Context, src *Service, source string, dest *Service, target string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pr, pw := io.Pipe()
+	srcErr := make(chan error, 1)
+	go func() {
+		err := src.RunHelper(ctx, HelperOptions{
+			Mounts: []mount.Mount{{Type: mount.TypeVolume, Source: source, Target: "/source", ReadOnly: true}},
+			Cmd:    []string{"tar", "-czf", "-", "-C", "/source", "."},
+			Stdout: pw,
+		})
+		_ = pw.CloseWithError(err)
+		srcErr <- err
+	}()
+
+	err := dest.RunHelper(ctx, HelperOptions{
+		Mounts: []mount.Mount{{Type: mount.TypeVolume, Source: target, Target: "/target"}},
+		Cmd:    []string{"tar", "-xzf", "-", "-C", "/target"},
+		Stdin:  pr,
+	})
+	if err != nil {
+		// stops the source if the destination gave up early
+		cancel()
+		_ = pr.CloseWithError(err)
+	}
+
+	if sErr := <-srcErr; sErr != nil && err == nil {
+		return fmt.Errorf("source: %w", sErr)
+	}
+	if err != nil {
+		return fmt.Errorf("destination: %w", err)
+	}
+	return nil
+}
+
+// volumeForCopy inspects source and makes sure target is free
+func (s *Service) volumeForCopy(ctx context.Context, source, target string) (volume.Volume, error) {
+	if target == "" || target == source {
+		return volume.Volume{}, fmt.Errorf("a new volume name is required")
+	}
+
+	src, err := s.Client.VolumeInspect(ctx, source, client.VolumeInspectOptions{})
+	if err != nil {
+		return volume.Volume{}, fmt.Errorf("unable to find volume %s: %w", source, err)
+	}
+	if _, err = s.Client.VolumeInspect(ctx, target, client.VolumeInspectOptions{}); err == nil {
+		return volume.Volume{}, fmt.Errorf("volume %s already exists", target)
+	}
+	return src.Volume, nil
+}
+
+// volumeUnused fails if any container, running or not, mounts the volume
+func (s *Service) volumeUnused(ctx context.Context, name string) error {
+	filters := client.Filters{}
+	filters.Add("volume", name)
+
+	list, err := s.Client.ContainerList(ctx, client.ContainerListOptions{
+		All:     true,
+		Filters: filters,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list containers: %w", err)
+	}
+	if len(list.Items) != 0 {
+		var names []string
+		for _, cont := range list.Items {
+			if len(cont.Names) > 0 {
+				names = append(names, strings.TrimPrefix(cont.Names[0], "/"))
+			}
+		}
+		return fmt.Errorf("volume %s is used by %s, remove the containers first", name, strings.Join(names, ", "))
+	}
+	return nil
+}
+
+func (s *Service) removeVolume(ctx context.Context, name string) error {
+	_, err := s.Client.VolumeRemove(context.WithoutCancel(ctx), name, client.VolumeRemoveOptions{Force: true})
+	if err != nil {
+		log.Warn().Err(err).Str("volume", name).Msg("unable to remove incomplete volume copy")
+	}
+	return err
+}
+
+// sharedStorage fails if the driver options of vol point to a device, a volume
+// created with the same options would mount the same storage
+func sharedStorage(vol volume.Volume) error {
+	if vol.Options["device"] != "" {
+		return fmt.Errorf(
+			"volume %s is backed by %s, a copy would share the same storage",
+			vol.Name, vol.Options["device"],
+		)
+	}
+	return nil
+}
+
+func copyLabels(labels map[string]string) map[string]string {
+	res := maps.Clone(labels)
+	maps.DeleteFunc(res, func(key, _ string) bool {
+		return strings.HasPrefix(key, composeLabelPrefix)
+	})
+	return res
+}
@@ -0,0 +1,34 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/moby/moby/api/types/volume"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyLabels(t *testing.T) {
+	labels := map[string]string{
+		"com.docker.compose.project": "app",
+		"com.docker.compose.volume":  "data",
+		"backup":                     "nightly",
+	}
+
+	require.Equal(t, map[string]string{"backup": "nightly"}, copyLabels(labels))
+	require.Len(t, labels, 3)
+	require.Nil(t, copyLabels(nil))
+}
+
+func TestSharedStorage(t *testing.T) {
+	require.NoError(t, sharedStorage(volume.Volume{Name: "data", Driver: "local"}))
+	require.Error(t, sharedStorage(volume.Volume{
+		Name:    "media",
+		Driver:  "local",
+		Options: map[string]string{"type": "none", "o": "bind", "device": "/srv/media"},
+	}))
+	require.Error(t, sharedStorage(volume.Volume{
+		Name:    "nfs",
+		Driver:  "local",
+		Options: map[string]string{"type": "nfs", "o": "addr=10.0.0.2,rw", "device": ":/export"},
+	}))
+}
@@ -0,0 +1,629 @@
+package container
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moby/moby/api/pkg/stdcopy"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/client"
+	"github.com/rs/zerolog/log"
+)
+
+// VolumeRoot the volume is mounted here in the browser container
+const VolumeRoot = "/volume"
+
+const (
+	// volumeIdle the browser container is removed after this long without use
+	volumeIdle = 5 * time.Minute
+	// volumeLifetime the browser container exits on its own after this long,
+	// so it does not outlive a dockman that went away without removing it
+	volumeLifetime = 6 * time.Hour
+	// volumeOpTimeout for operations that do not stream file contents
+	volumeOpTimeout = time.Minute
+)
+
+// codeNotExist exit code of the scripts below when the path they work on does not exist
+const codeNotExist = 100
+
+// statFormat raw mode in hex, size, mtime and name, the name goes last as it may contain spaces
+const statFormat = "%f %s %Y %n"
+
+// VolumeFs implements filesystem.FileSystem for a named volume.
+// Commands are exec'd in a helper container mounting the volume, which is
+// started on first use and removed once idle, so it works the same for remote hosts
+type VolumeFs struct {
+	srv    *Service
+	volume string
+
+	mu     sync.Mutex
+	id     string
+	active int
+	timer  *time.Timer
+}
+
+func NewVolumeFs(srv *Service, volume string) *VolumeFs {
+	return &VolumeFs{srv: srv, volume: volume}
+}
+
+func (v *VolumeFs) Root() string {
+	return VolumeRoot
+}
+
+func (v *VolumeFs) Abs(name string) (string, error) {
+	return v.fullPath(name), nil
+}
+
+func (v *VolumeFs) Join(elem ...string) string {
+	return path.Join(elem...)
+}
+
+func (v *VolumeFs) MkdirAll(name string, perm os.FileMode) error {
+	return v.run([]string{"mkdir", "-p", "-m", modeArg(perm), v.fullPath(name)})
+}
+
+func (v *VolumeFs) ReadDir(name string) ([]fs.DirEntry, error) {
+	full := v.fullPath(name)
+
+	var stdout bytes.Buffer
+	err := v.runOutput(
+		[]string{"sh", "-c", `[ -d "$1" ] || exit ` + strconv.Itoa(codeNotExist) +
+			`; find "$1" -mindepth 1 -maxdepth 1 -exec stat -c "$2" {} +`,
+			"sh", full, statFormat},
+		&stdout,
+	)
+	if err != nil {
+		return nil, pathErr("readdir", full, err)
+	}
+
+	var entries []fs.DirEntry
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		if line == "" {
+			continue
+		}
+		info, err := parseStat(line)
+		if err != nil {
+			// names with a newline can't be told apart from the next entry
+			log.Debug().Err(err).Str("line", line).Msg("skipping unparsable volume entry")
+			continue
+		}
+		entries = append(entries, fs.FileInfoToDirEntry(info))
+	}
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return entries, nil
+}
+
+func (v *VolumeFs) Stat(name string) (os.FileInfo, error) {
+	full := v.fullPath(name)
+
+	var stdout bytes.Buffer
+	err := v.runOutput(
+		[]string{"sh", "-c", `[ -e "$1" ] || [ -L "$1" ] || exit ` + strconv.Itoa(codeNotExist) +
+			`; stat -c "$2" "$1"`,
+			"sh", full, statFormat},
+		&stdout,
+	)
+	if err != nil {
+		return nil, pathErr("stat", full, err)
+	}
+	return parseStat(strings.TrimSuffix(stdout.String(), "\n"))
+}
+
+// Lstat stat does not follow symlinks unless asked to, so it is the same as Stat
+func (v *VolumeFs) Lstat(name string) (os.FileInfo, error) {
+	return v.Stat(name)
+}
+
+// OpenFile streams the file through cat, a write only handle
+// reports errors of the write when it is closed
+func (v *VolumeFs) OpenFile(filename string, flag int, perm fs.FileMode) (io.ReadWriteCloser, error) {
+	full := v.fullPath(filename)
+
+	if flag&(os.O_WRONLY|os.O_RDWR) == 0 {
+		if _, err := v.Stat(filename); err != nil {
+			return nil, err
+		}
+
+		pr, pw := io.Pipe()
+		go func() {
+			err := v.exec(context.Background(), []string{"cat", full}, nil, pw)
+			_ = pw.CloseWithError(pathErr("read", full, err))
+		}()
+		return &volumeFile{r: pr, close: pr.Close}, nil
+	}
+
+	var script strings.Builder
+	if flag&os.O_CREATE == 0 {
+		script.WriteString(`[ -f "$1" ] || exit ` + strconv.Itoa(codeNotExist) + `; `)
+	}
+	if flag&os.O_EXCL != 0 {
+		// noclobber makes > fail if the file exists
+		script.WriteString("set -C; ")
+	}
+	script.WriteString(`[ -e "$1" ] || created=1; `)
+	if flag&os.O_APPEND != 0 {
+		script.WriteString(`cat >> "$1"`)
+	} else {
+		script.WriteString(`cat > "$1"`)
+	}
+	script.WriteString(` && { [ -z "$created" ] || chmod "$2" "$1"; }`)
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := v.exec(context.Background(), []string{"sh", "-c", script.String(), "sh", full, modeArg(perm)}, pr, nil)
+		err = pathErr("open", full, err)
+		// unblocks writes once the command is gone
+		_ = pr.CloseWithError(err)
+		done <- err
+	}()
+
+	return &volumeFile{
+		w: pw,
+		close: func() error {
+			_ = pw.Close()
+			return <-done
+		},
+	}, nil
+}
+
+// LoadFile copies the file to a local temp file so it can be seeked,
+// the temp file is removed on close
+func (v *VolumeFs) LoadFile(filename string) (io.ReadSeekCloser, time.Time, error) {
+	stat, err := v.Stat(filename)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if stat.IsDir() {
+		return nil, time.Time{}, &fs.PathError{Op: "open", Path: v.fullPath(filename), Err: errors.New("is a directory")}
+	}
+
+	tmp, err := os.CreateTemp("", "dockman-volume-*")
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	file := &tempFile{File: tmp}
+
+	full := v.fullPath(filename)
+	if err = v.exec(context.Background(), []string{"cat", full}, nil, tmp); err != nil {
+		_ = file.Close()
+		return nil, time.Time{}, pathErr("read", full, err)
+	}
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		_ = file.Close()
+		return nil, time.Time{}, err
+	}
+	return file, stat.ModTime(), nil
+}
+
+func (v *VolumeFs) ReadFile(filename string) ([]byte, error) {
+	full := v.fullPath(filename)
+
+	var stdout bytes.Buffer
+	err := v.runOutput(
+		[]string{"sh", "-c", `[ -e "$1" ] || exit ` + strconv.Itoa(codeNotExist) + `; cat "$1"`, "sh", full},
+		&stdout,
+	)
+	if err != nil {
+		return nil, pathErr("read", full, err)
+	}
+	return stdout.Bytes(), nil
+}
+
+func (v *VolumeFs) RemoveAll(name string) error {
+	return v.run([]string{"rm", "-rf", v.fullPath(name)})
+}
+
+func (v *VolumeFs) Rename(oldPath string, newPath string) error {
+	return v.run([]string{"mv", "-f", v.fullPath(oldPath), v.fullPath(newPath)})
+}
+
+func (v *VolumeFs) Chmod(name string, mode os.FileMode) error {
+	return v.run([]string{"chmod", modeArg(mode), v.fullPath(name)})
+}
+
+// WalkDir walks the tree like fs.WalkDir, paths passed to fn include the root
+func (v *VolumeFs) WalkDir(root string, fn func(path string, d fs.DirEntry, err error) error) error {
+	full := v.fullPath(root)
+
+	info, err := v.Stat(root)
+	if err != nil {
+		err = fn(full, nil, err)
+	} else {
+		err = v.walk(full, fs.FileInfoToDirEntry(info), fn)
+	}
+	if errors.Is(err, fs.SkipDir) || errors.Is(err, fs.SkipAll) {
+		return nil
+	}
+	return err
+}
+
+func (v *VolumeFs) walk(full string, d fs.DirEntry, fn fs.WalkDirFunc) error {
+	if err := fn(full, d, nil); err != nil || !d.IsDir() {
+		if errors.Is(err, fs.SkipDir) && d.IsDir() {
+			err = nil
+		}
+		return err
+	}
+
+	entries, err := v.ReadDir(v.relPath(full))
+	if err != nil {
+		if err = fn(full, d, err); err != nil {
+			if errors.Is(err, fs.SkipDir) {
+				err = nil
+			}
+			return err
+		}
+	}
+
+	for _, entry := range entries {
+		if err = v.walk(path.Join(full, entry.Name()), entry, fn); err != nil {
+			if errors.Is(err, fs.SkipDir) {
+				break
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Close removes the browser container, it is started again on next use
+func (v *VolumeFs) Close() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.timer != nil {
+		v.timer.Stop()
+	}
+	return v.removeLocked()
+}
+
+func (v *VolumeFs) fullPath(name string) string {
+	// rooting the path first keeps .. from leaving the volume
+	return path.Join(VolumeRoot, path.Clean("/"+name))
+}
+
+func (v *VolumeFs) relPath(full string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(full, VolumeRoot), "/")
+}
+
+func (v *VolumeFs) run(cmd []string) error {
+	err := v.runOutput(cmd, nil)
+	if err != nil {
+		return pathErr(cmd[0], cmd[len(cmd)-1], err)
+	}
+	return nil
+}
+
+func (v *VolumeFs) runOutput(cmd []string, stdout io.Writer) error {
+	ctx, cancel := context.WithTimeout(context.Background(), volumeOpTimeout)
+	defer cancel()
+	return v.exec(ctx, cmd, nil, stdout)
+}
+
+// exec runs cmd in the browser container, starting it if needed
+func (v *VolumeFs) exec(ctx context.Context, cmd []string, stdin io.Reader, stdout io.Writer) error {
+	id, err := v.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer v.release()
+
+	execId, err := v.execCreate(ctx, id, cmd, stdin != nil)
+	if err != nil {
+		// the container may have exited or been removed behind our back, start a new one
+		v.reset(id)
+		if id, err = v.acquireLocked(ctx); err != nil {
+			return err
+		}
+		if execId, err = v.execCreate(ctx, id, cmd, stdin != nil); err != nil {
+			return err
+		}
+	}
+
+	attach, err := v.srv.Client.ExecAttach(ctx, execId, client.ExecAttachOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to attach to exec: %w", err)
+	}
+	defer attach.Close()
+
+	if stdin != nil {
+		go func() {
+			_, _ = io.Copy(attach.Conn, stdin)
+			_ = attach.CloseWrite()
+		}()
+	}
+
+	if stdout == nil {
+		stdout = io.Discard
+	}
+	stderr := &limitedBuffer{max: maxHelperStderr}
+	if _, err = stdcopy.StdCopy(stdout, stderr, attach.Reader); err != nil {
+		return err
+	}
+
+	inspect, err := v.srv.Client.ExecInspect(ctx, execId, client.ExecInspectOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to inspect exec: %w", err)
+	}
+	if inspect.ExitCode != 0 {
+		return &execError{code: inspect.ExitCode, stderr: strings.TrimSpace(stderr.String())}
+	}
+	return nil
+}
+
+func (v *VolumeFs) execCreate(ctx context.Context, id string, cmd []string, withStdin bool) (string, error) {
+	created, err := v.srv.Client.ExecCreate(ctx, id, client.ExecCreateOptions{
+		AttachStdin:  withStdin,
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          cmd,
+	})
+	if err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+// acquire returns the running browser container, it is not removed until release is called
+func (v *VolumeFs) acquire(ctx context.Context) (string, error) {
+	v.mu.Lock()
+	v.active++
+	v.mu.Unlock()
+
+	id, err := v.acquireLocked(ctx)
+	if err != nil {
+		v.release()
+	}
+	return id, err
+}
+
+// acquireLocked starts the browser container if there is none, callers must hold a use
+func (v *VolumeFs) acquireLocked(ctx context.Context) (string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.id != "" {
+		return v.id, nil
+	}
+	id, err := v.start(ctx)
+	if err != nil {
+		return "", err
+	}
+	v.id = id
+	return id, nil
+}
+
+func (v *VolumeFs) release() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.active--
+	if v.timer == nil {
+		v.timer = time.AfterFunc(volumeIdle, v.idle)
+	} else {
+		v.timer.Reset(volumeIdle)
+	}
+}
+
+func (v *VolumeFs) idle() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.active > 0 {
+		// a long transfer is still going, check again later
+		v.timer.Reset(volumeIdle)
+		return
+	}
+	if err := v.removeLocked(); err != nil {
+		log.Warn().Err(err).Str("volume", v.volume).Msg("unable to remove volume browser container")
+	}
+}
+
+// reset forgets a container that no longer works
+func (v *VolumeFs) reset(id string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.id == id {
+		_ = v.removeLocked()
+	}
+}
+
+func (v *VolumeFs) removeLocked() error {
+	if v.id == "" {
+		return nil
+	}
+	id := v.id
+	v.id = ""
+
+	ctx, cancel := context.WithTimeout(context.Background(), volumeOpTimeout)
+	defer cancel()
+	_, err := v.srv.Client.ContainerRemove(ctx, id, client.ContainerRemoveOptions{Force: true})
+	return err
+}
+
+func (v *VolumeFs) start(ctx context.Context) (string, error) {
+	// a volume mount creates missing volumes, which browsing should not do
+	if _, err := v.srv.Client.VolumeInspect(ctx, v.volume, client.VolumeInspectOptions{}); err != nil {
+		return "", fmt.Errorf("unable to find volume %s: %w", v.volume, err)
+	}
+	if err := v.srv.ensureImage(ctx, HelperImage); err != nil {
+		return "", err
+	}
+
+	create, err := v.srv.Client.ContainerCreate(ctx, client.ContainerCreateOptions{
+		Name: "dockman-volume-" + uuid.New().String()[:12],
+		Config: &container.Config{
+			Image:  HelperImage,
+			Cmd:    []string{"sleep", strconv.Itoa(int(volumeLifetime.Seconds()))},
+			Labels: map[string]string{HelperLabel: "true"},
+		},
+		HostConfig: &container.HostConfig{
+			AutoRemove: true,
+			Mounts: []mount.Mount{{
+				Type:   mount.TypeVolume,
+				Source: v.volume,
+				Target: VolumeRoot,
+			}},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to create volume browser container: %w", err)
+	}
+
+	if _, err = v.srv.Client.ContainerStart(ctx, create.ID, client.ContainerStartOptions{}); err != nil {
+		_, _ = v.srv.Client.ContainerRemove(context.WithoutCancel(ctx), create.ID, client.ContainerRemoveOptions{Force: true})
+		return "", fmt.Errorf("unable to start volume browser container: %w", err)
+	}
+	return create.ID, nil
+}
+
+type execError struct {
+	code   int
+	stderr string
+}
+
+func (e *execError) Error() string {
+	if e.stderr == "" {
+		return fmt.Sprintf("exited with code %d", e.code)
+	}
+	return e.stderr
+}
+
+// pathErr wraps err in a *fs.PathError, missing paths match fs.ErrNotExist
+func pathErr(op, name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var ee *execError
+	if errors.As(err, &ee) && ee.code == codeNotExist {
+		err = fs.ErrNotExist
+	}
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
+
+func modeArg(mode os.FileMode) string {
+	return strconv.FormatUint(uint64(mode.Perm()), 8)
+}
+
+// parseStat parses a line of stat output in statFormat
+func parseStat(line string) (*volumeFileInfo, error) {
+	fields := strings.SplitN(line, " ", 4)
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("unexpected stat output %q", line)
+	}
+
+	raw, err := strconv.ParseUint(fields[0], 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mode %q: %w", fields[0], err)
+	}
+	size, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid size %q: %w", fields[1], err)
+	}
+	mtime, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mtime %q: %w", fields[2], err)
+	}
+
+	return &volumeFileInfo{
+		name:    path.Base(fields[3]),
+		size:    size,
+		mode:    unixMode(uint32(raw)),
+		modTime: time.Unix(mtime, 0),
+	}, nil
+}
+
+// unixMode converts a raw st_mode to a fs.FileMode
+func unixMode(raw uint32) fs.FileMode {
+	mode := fs.FileMode(raw & 0o777)
+	switch raw & 0o170000 {
+	case 0o040000:
+		mode |= fs.ModeDir
+	case 0o120000:
+		mode |= fs.ModeSymlink
+	case 0o010000:
+		mode |= fs.ModeNamedPipe
+	case 0o140000:
+		mode |= fs.ModeSocket
+	case 0o020000:
+		mode |= fs.ModeDevice | fs.ModeCharDevice
+	case 0o060000:
+		mode |= fs.ModeDevice
+	}
+	if raw&0o4000 != 0 {
+		mode |= fs.ModeSetuid
+	}
+	if raw&0o2000 != 0 {
+		mode |= fs.ModeSetgid
+	}
+	if raw&0o1000 != 0 {
+		mode |= fs.ModeSticky
+	}
+	return mode
+}
+
+type volumeFileInfo struct {
+	name    string
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func (i *volumeFileInfo) Name() string       { return i.name }
+func (i *volumeFileInfo) Size() int64        { return i.size }
+func (i *volumeFileInfo) Mode() fs.FileMode  { return i.mode }
+func (i *volumeFileInfo) ModTime() time.Time { return i.modTime }
+func (i *volumeFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i *volumeFileInfo) Sys() any           { return nil }
+
+// volumeFile a handle opened by OpenFile, either for reading or writing
+type volumeFile struct {
+	r     io.Reader
+	w     io.Writer
+	close func() error
+}
+
+func (f *volumeFile) Read(p []byte) (int, error) {
+	if f.r == nil {
+		return 0, errors.New("file is opened write only")
+	}
+	return f.r.Read(p)
+}
+
+func (f *volumeFile) Write(p []byte) (int, error) {
+	if f.w == nil {
+		return 0, errors.New("file is opened read only")
+	}
+	return f.w.Write(p)
+}
+
+func (f *volumeFile) Close() error {
+	return f.close()
+}
+
+// tempFile removes itself once closed
+type tempFile struct {
+	*os.File
+}
+
+func (t *tempFile) Close() error {
+	err := t.File.Close()
+	return errors.Join(err, os.Remove(t.Name()))
+}
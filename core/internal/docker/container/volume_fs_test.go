@@ -0,0 +1,43 @@
+package container
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVolumeFsParse(t *testing.T) {
+	info, err := parseStat("41ed 4096 1760000000 /volume/conf dir")
+	require.NoError(t, err)
+	require.Equal(t, "conf dir", info.Name())
+	require.True(t, info.IsDir())
+	require.Equal(t, fs.FileMode(0o755), info.Mode().Perm())
+	require.Equal(t, time.Unix(1760000000, 0), info.ModTime())
+
+	info, err = parseStat("81a4 12 1760000000 /volume/app.yml")
+	require.NoError(t, err)
+	require.True(t, info.Mode().IsRegular())
+	require.Equal(t, int64(12), info.Size())
+
+	info, err = parseStat("a1ff 7 1760000000 /volume/link")
+	require.NoError(t, err)
+	require.Equal(t, fs.ModeSymlink, info.Mode().Type())
+
+	_, err = parseStat("name with no fields")
+	require.Error(t, err)
+
+	vfs := NewVolumeFs(nil, "data")
+	require.Equal(t, "/volume/app/config.yml", vfs.fullPath("app/config.yml"))
+	require.Equal(t, "/volume/etc/passwd", vfs.fullPath("../../etc/passwd"))
+	require.Equal(t, "/volume", vfs.fullPath("."))
+	require.Equal(t, "app", vfs.relPath("/volume/app"))
+
+	err = pathErr("stat", "/volume/missing", &execError{code: codeNotExist})
+	require.ErrorIs(t, err, fs.ErrNotExist)
+	err = pathErr("stat", "/volume/denied", &execError{code: 1, stderr: "permission denied"})
+	require.False(t, errors.Is(err, fs.ErrNotExist))
+	require.ErrorContains(t, err, "permission denied")
+}
@@ -94,9 +94,44 @@ func (s *Service) VolumesList(ctx context.Context) ([]VolumeInfo, error) {
 	return volumes, nil
 }
 
-func (s *Service) VolumesCreate(ctx context.Context, name string) (volume.Volume, error) {
+// VolumesSizes returns the disk usage of every volume in bytes,
+// volumes the driver can't report a size for are left out
+func (s *Service) VolumesSizes(ctx context.Context) (map[string]int64, error) {
+	diskUsage, err := s.Client.DiskUsage(ctx, client.DiskUsageOptions{
+		Volumes: true,
+		Verbose: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk usage data: %w", err)
+	}
+
+	sizes := make(map[string]int64, len(diskUsage.Volumes.Items))
+	for _, vol := range diskUsage.Volumes.Items {
+		// -1 means the size is unknown
+		if vol.UsageData == nil || vol.UsageData.Size < 0 {
+			continue
+		}
+		sizes[vol.Name] = vol.UsageData.Size
+	}
+	return sizes, nil
+}
+
+type VolumeCreateOptions struct {
+	// Name generated by docker if empty
+	Name string
+	// Driver local if empty
+	Driver string
+	// DriverOpts e.g. type=nfs, o=addr=10.0.0.2,rw and device=:/export for an nfs share
+	DriverOpts map[string]string
+	Labels     map[string]string
+}
+
+func (s *Service) VolumesCreate(ctx context.Context, opts VolumeCreateOptions) (volume.Volume, error) {
 	create, err := s.Client.VolumeCreate(ctx, client.VolumeCreateOptions{
-		Name: name,
+		Name:       opts.Name,
+		Driver:     opts.Driver,
+		DriverOpts: opts.DriverOpts,
+		Labels:     opts.Labels,
 	})
 	if err != nil {
 		return volume.Volume{}, err
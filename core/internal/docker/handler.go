@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,7 +14,9 @@ import (
 
 	v1 "github.com/RA341/dockman/generated/docker/v1"
 	dockerpc "github.com/RA341/dockman/generated/docker/v1/v1connect"
+	"github.com/RA341/dockman/internal/docker/compose"
 	contSrv "github.com/RA341/dockman/internal/docker/container"
+	"github.com/RA341/dockman/internal/docker/jobs"
 	hm "github.com/RA341/dockman/internal/host/middleware"
 	"github.com/RA341/dockman/pkg/fileutil"
 	"github.com/RA341/dockman/pkg/listutils"
@@ -28,12 +31,14 @@ import (
 type ServiceProvider func(host string) (*Service, error)
 
 type Handler struct {
-	srv ServiceProvider
+	srv  ServiceProvider
+	jobs *jobs.Service
 }
 
-func NewConnectHandler(srv ServiceProvider) (string, http.Handler) {
+func NewConnectHandler(srv ServiceProvider, jobSrv *jobs.Service) (string, http.Handler) {
 	h := &Handler{
-		srv: srv,
+		srv:  srv,
+		jobs: jobSrv,
 	}
 	return dockerpc.NewDockerServiceHandler(h)
 }
@@ -100,9 +105,9 @@ func (h *Handler) ComposeFileStatus(ctx context.Context, c *connect.Request[v1.C
 }
 
 func (h *Handler) ComposeUp(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
-	return h.WithClientAndStream(ctx, responseStream, func(dkSrv *Service, writer io.Writer) error {
+	return h.WithComposeJob(ctx, req.Msg.Filename, "up", responseStream, func(jobCtx context.Context, dkSrv *Service, writer io.Writer) error {
 		return dkSrv.Compose.Up(
-			ctx,
+			jobCtx,
 			req.Msg.Filename,
 			writer,
 			req.Msg.SelectedServices...,
@@ -111,9 +116,9 @@ func (h *Handler) ComposeUp(ctx context.Context, req *connect.Request[v1.Compose
 }
 
 func (h *Handler) ComposeStart(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
-	return h.WithClientAndStream(ctx, responseStream, func(dkSrv *Service, writer io.Writer) error {
+	return h.WithComposeJob(ctx, req.Msg.Filename, "start", responseStream, func(jobCtx context.Context, dkSrv *Service, writer io.Writer) error {
 		return dkSrv.Compose.Start(
-			ctx,
+			jobCtx,
 			req.Msg.Filename,
 			writer,
 			req.Msg.SelectedServices...,
@@ -122,9 +127,9 @@ func (h *Handler) ComposeStart(ctx context.Context, req *connect.Request[v1.Comp
 }
 
 func (h *Handler) ComposeStop(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
-	return h.WithClientAndStream(ctx, responseStream, func(dkSrv *Service, writer io.Writer) error {
+	return h.WithComposeJob(ctx, req.Msg.Filename, "stop", responseStream, func(jobCtx context.Context, dkSrv *Service, writer io.Writer) error {
 		return dkSrv.Compose.Stop(
-			ctx,
+			jobCtx,
 			req.Msg.Filename,
 			writer,
 			req.Msg.SelectedServices...,
@@ -133,9 +138,9 @@ func (h *Handler) ComposeStop(ctx context.Context, req *connect.Request[v1.Compo
 }
 
 func (h *Handler) ComposeDown(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
-	return h.WithClientAndStream(ctx, responseStream, func(dkSrv *Service, writer io.Writer) error {
+	return h.WithComposeJob(ctx, req.Msg.Filename, "down", responseStream, func(jobCtx context.Context, dkSrv *Service, writer io.Writer) error {
 		return dkSrv.Compose.Down(
-			ctx,
+			jobCtx,
 			req.Msg.Filename,
 			writer,
 			req.Msg.SelectedServices...,
@@ -144,9 +149,9 @@ func (h *Handler) ComposeDown(ctx context.Context, req *connect.Request[v1.Compo
 }
 
 func (h *Handler) ComposeRestart(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
-	return h.WithClientAndStream(ctx, responseStream, func(dkSrv *Service, writer io.Writer) error {
+	return h.WithComposeJob(ctx, req.Msg.Filename, "restart", responseStream, func(jobCtx context.Context, dkSrv *Service, writer io.Writer) error {
 		return dkSrv.Compose.Restart(
-			ctx,
+			jobCtx,
 			req.Msg.Filename,
 			writer,
 			req.Msg.SelectedServices...,
@@ -156,8 +161,8 @@ func (h *Handler) ComposeRestart(ctx context.Context, req *connect.Request[v1.Co
 }
 
 func (h *Handler) ComposeUpdate(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
-	return h.WithClientAndStream(ctx, responseStream, func(dkSrv *Service, writer io.Writer) error {
-		return dkSrv.Compose.Update(ctx, req.Msg.Filename, writer, req.Msg.SelectedServices...)
+	return h.WithComposeJob(ctx, req.Msg.Filename, "update", responseStream, func(jobCtx context.Context, dkSrv *Service, writer io.Writer) error {
+		return dkSrv.Compose.Update(jobCtx, req.Msg.Filename, writer, req.Msg.SelectedServices...)
 	})
 
 	// todo
@@ -166,31 +171,71 @@ func (h *Handler) ComposeUpdate(ctx context.Context, req *connect.Request[v1.Com
 }
 
 func (h *Handler) ComposeValidate(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeValidateResponse], error) {
-	var validationResult []error
+	var diags []compose.Diagnostic
+	err := h.WithClient(ctx, func(dkSrv *Service) error {
+		var err error
+		diags, err = dkSrv.Compose.Validate(ctx, req.Msg.Filename)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	return connect.NewResponse(&v1.ComposeValidateResponse{
+		Errs: listutils.ToMap(diags, func(t compose.Diagnostic) string {
+			return t.Message
+		}),
+		Diagnostics: listutils.ToMap(diags, toRPCDiagnostic),
+	}), nil
+}
+
+func (h *Handler) ComposeResolve(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeResolveResponse], error) {
+	var resolved *compose.Resolved
 	err := h.WithClient(ctx, func(dkSrv *Service) error {
-		errs := dkSrv.Compose.Validate(ctx, req.Msg.Filename)
-		validationResult = errs
-		return nil
+		var err error
+		resolved, err = dkSrv.Compose.Resolve(ctx, req.Msg.Filename)
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	if validationResult == nil {
-		return connect.NewResponse(&v1.ComposeValidateResponse{
-			Errs: []string{},
-		}), nil
+	var errMsg string
+	if resolved.Err != nil {
+		errMsg = resolved.Err.Error()
 	}
 
-	toMap := listutils.ToMap(validationResult, func(t error) string {
-		return t.Error()
-	})
-	return connect.NewResponse(&v1.ComposeValidateResponse{
-		Errs: toMap,
+	return connect.NewResponse(&v1.ComposeResolveResponse{
+		Config:    resolved.Config,
+		Variables: listutils.ToMap(resolved.Variables, toRPCVariable),
+		Warnings:  resolved.Warnings,
+		Error:     errMsg,
 	}), nil
 }
 
+func toRPCVariable(variable compose.Variable) *v1.ComposeVariable {
+	return &v1.ComposeVariable{
+		Name:         variable.Name,
+		Value:        variable.Value,
+		Source:       variable.Source,
+		DefaultValue: variable.DefaultValue,
+		Defined:      variable.Defined,
+		Required:     variable.Required,
+		Secret:       variable.Secret,
+	}
+}
+
+func toRPCDiagnostic(diag compose.Diagnostic) *v1.ComposeDiagnostic {
+	return &v1.ComposeDiagnostic{
+		File:     diag.File,
+		Line:     int32(diag.Line),
+		Column:   int32(diag.Column),
+		Severity: string(diag.Severity),
+		Rule:     diag.Rule,
+		Message:  diag.Message,
+	}
+}
+
 func (h *Handler) ComposeList(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ListResponse], error) {
 	var result []*v1.ContainerList
 	err := h.WithClient(ctx, func(dkSrv *Service) error {
@@ -232,6 +277,61 @@ func (h *Handler) WithClientAndStream(
 	return nil
 }
 
+// WithComposeJob runs a mutating compose operation as a background job and
+// streams its output, the first message contains the job id
+//
+// the job is not tied to ctx, if the client disconnects it keeps running
+// and can be reattached with JobAttach
+func (h *Handler) WithComposeJob(
+	ctx context.Context,
+	filename string,
+	action string,
+	responseStream *connect.ServerStream[v1.LogsMessage],
+	run func(jobCtx context.Context, srv *Service, writer io.Writer) error,
+) error {
+	hostname, dkSrv, err := h.getHost(ctx)
+	if err != nil {
+		return err
+	}
+
+	job, err := h.jobs.Start(hostname, filename, action, true, func(jobCtx context.Context, out io.Writer) error {
+		return run(jobCtx, dkSrv, out)
+	})
+	if err != nil {
+		if errors.Is(err, jobs.ErrStackBusy) {
+			return connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return err
+	}
+
+	if err = responseStream.Send(&v1.LogsMessage{JobId: job.ID}); err != nil {
+		return err
+	}
+
+	return h.followJob(ctx, job, false, responseStream)
+}
+
+// followJob streams job output until the job finishes or the client detaches,
+// returns the job error if it failed
+func (h *Handler) followJob(
+	ctx context.Context,
+	job *jobs.Job,
+	fromStart bool,
+	responseStream *connect.ServerStream[v1.LogsMessage],
+) error {
+	stream := LogStreamWriter{responseStream: responseStream}
+	if err := job.Follow(ctx, fromStart, &stream); err != nil {
+		return err
+	}
+
+	status, err := job.Status()
+	if status == jobs.StatusRunning {
+		// client detached
+		return nil
+	}
+	return err
+}
+
 ////////////////////////////////////////////
 // 				Utils 			  		  //
 ////////////////////////////////////////////
@@ -0,0 +1,128 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/docker/v1"
+	"github.com/RA341/dockman/internal/docker/compose"
+	"github.com/rs/zerolog/log"
+)
+
+func (h *Handler) ComposeUpMany(ctx context.Context, req *connect.Request[v1.ComposeManyRequest], responseStream *connect.ServerStream[v1.ComposeManyProgress]) error {
+	return h.composeMany(ctx, req.Msg, "up", false, responseStream,
+		func(jobCtx context.Context, dkSrv *Service, filename string, writer io.Writer) error {
+			return dkSrv.Compose.Up(jobCtx, filename, writer)
+		},
+	)
+}
+
+func (h *Handler) ComposeUpdateMany(ctx context.Context, req *connect.Request[v1.ComposeManyRequest], responseStream *connect.ServerStream[v1.ComposeManyProgress]) error {
+	return h.composeMany(ctx, req.Msg, "update", false, responseStream,
+		func(jobCtx context.Context, dkSrv *Service, filename string, writer io.Writer) error {
+			return dkSrv.Compose.Update(jobCtx, filename, writer)
+		},
+	)
+}
+
+func (h *Handler) ComposeDownMany(ctx context.Context, req *connect.Request[v1.ComposeManyRequest], responseStream *connect.ServerStream[v1.ComposeManyProgress]) error {
+	// dependents go down before their dependencies
+	return h.composeMany(ctx, req.Msg, "down", true, responseStream,
+		func(jobCtx context.Context, dkSrv *Service, filename string, writer io.Writer) error {
+			return dkSrv.Compose.Down(jobCtx, filename, writer)
+		},
+	)
+}
+
+// composeMany runs action on every selected stack in dependency order,
+// each stack runs as its own job so its output can be attached to with JobAttach
+//
+// the bulk run is not tied to the request, if the client disconnects
+// the remaining stacks are still processed
+func (h *Handler) composeMany(
+	ctx context.Context,
+	req *v1.ComposeManyRequest,
+	action string,
+	reverse bool,
+	responseStream *connect.ServerStream[v1.ComposeManyProgress],
+	run func(jobCtx context.Context, dkSrv *Service, filename string, writer io.Writer) error,
+) error {
+	hostname, dkSrv, err := h.getHost(ctx)
+	if err != nil {
+		return err
+	}
+
+	stacks, err := dkSrv.Compose.ListStacks(req.Folder, req.Tag)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(stacks) == 0 {
+		return connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("no stacks found for folder:%q tag:%q", req.Folder, req.Tag),
+		)
+	}
+
+	deps := make(map[string][]string, len(stacks))
+	for _, stack := range stacks {
+		deps[stack], err = dkSrv.Compose.Dependencies(stack)
+		if err != nil {
+			return err
+		}
+	}
+
+	var sendMu sync.Mutex
+	send := func(msg *v1.ComposeManyProgress) {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+
+		if ctx.Err() != nil {
+			// client detached
+			return
+		}
+		if err := responseStream.Send(msg); err != nil {
+			log.Warn().Err(err).Str("file", msg.Filename).Msg("unable to send bulk progress")
+		}
+	}
+
+	return compose.RunBulk(
+		context.WithoutCancel(ctx),
+		stacks,
+		deps,
+		compose.BulkOptions{
+			Parallelism:   int(req.Parallelism),
+			StopOnFailure: req.StopOnFailure,
+			Reverse:       reverse,
+		},
+		func(bulkCtx context.Context, filename string) error {
+			job, err := h.jobs.Start(hostname, filename, action, true, func(jobCtx context.Context, out io.Writer) error {
+				return run(jobCtx, dkSrv, filename, out)
+			})
+			if err != nil {
+				return err
+			}
+
+			send(&v1.ComposeManyProgress{
+				Filename: filename,
+				State:    string(compose.BulkRunning),
+				JobId:    job.ID,
+			})
+			return job.Wait(bulkCtx)
+		},
+		func(progress compose.BulkProgress) {
+			var errMsg string
+			if progress.Err != nil {
+				errMsg = progress.Err.Error()
+			}
+
+			send(&v1.ComposeManyProgress{
+				Filename: progress.Filename,
+				State:    string(progress.State),
+				Error:    errMsg,
+			})
+		},
+	)
+}
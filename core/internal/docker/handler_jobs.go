@@ -0,0 +1,86 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/docker/v1"
+	"github.com/RA341/dockman/internal/docker/jobs"
+	hm "github.com/RA341/dockman/internal/host/middleware"
+	"github.com/RA341/dockman/pkg/listutils"
+)
+
+func (h *Handler) JobList(ctx context.Context, _ *connect.Request[v1.JobListRequest]) (*connect.Response[v1.JobListResponse], error) {
+	hostname, err := hm.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := listutils.ToMap(h.jobs.List(hostname), toRPCJob)
+	return connect.NewResponse(&v1.JobListResponse{Jobs: list}), nil
+}
+
+func (h *Handler) JobAttach(ctx context.Context, req *connect.Request[v1.JobAttachRequest], responseStream *connect.ServerStream[v1.LogsMessage]) error {
+	job, err := h.getJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return err
+	}
+
+	if err = responseStream.Send(&v1.LogsMessage{JobId: job.ID}); err != nil {
+		return err
+	}
+
+	return h.followJob(ctx, job, req.Msg.FromStart, responseStream)
+}
+
+func (h *Handler) JobCancel(ctx context.Context, req *connect.Request[v1.JobCancelRequest]) (*connect.Response[v1.JobCancelResponse], error) {
+	job, err := h.getJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	job.Cancel()
+	return connect.NewResponse(&v1.JobCancelResponse{}), nil
+}
+
+// getJob loads a job making sure it belongs to the requested host
+func (h *Handler) getJob(ctx context.Context, id string) (*jobs.Job, error) {
+	hostname, err := hm.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := h.jobs.Get(id)
+	if err != nil || job.Host != hostname {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("%w: %s", jobs.ErrJobNotFound, id),
+		)
+	}
+	return job, nil
+}
+
+func toRPCJob(job *jobs.Job) *v1.Job {
+	status, err := job.Status()
+
+	var errMsg string
+	if err != nil {
+		errMsg = err.Error()
+	}
+
+	var endedAt int64
+	if ended := job.EndedAt(); !ended.IsZero() {
+		endedAt = ended.Unix()
+	}
+
+	return &v1.Job{
+		Id:        job.ID,
+		Filename:  job.Filename,
+		Action:    job.Action,
+		Status:    string(status),
+		Error:     errMsg,
+		StartedAt: job.StartedAt.Unix(),
+		EndedAt:   endedAt,
+	}
+}
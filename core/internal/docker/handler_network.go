@@ -2,13 +2,13 @@ package docker
 
 import (
 	"context"
-	"fmt"
 	"maps"
 	"slices"
 	"time"
 
 	"connectrpc.com/connect"
 	v1 "github.com/RA341/dockman/generated/docker/v1"
+	contSrv "github.com/RA341/dockman/internal/docker/container"
 	"github.com/docker/compose/v5/pkg/api"
 	"github.com/moby/moby/api/types/network"
 )
@@ -92,9 +92,69 @@ func getSubnet(netI network.Inspect) string {
 	return netI.IPAM.Config[0].Subnet.String()
 }
 
-func (h *Handler) NetworkCreate(_ context.Context, _ *connect.Request[v1.CreateNetworkRequest]) (*connect.Response[v1.CreateNetworkResponse], error) {
-	//TODO implement me
-	return nil, fmt.Errorf(" implement me NetworkCreate")
+func (h *Handler) NetworkCreate(ctx context.Context, req *connect.Request[v1.CreateNetworkRequest]) (*connect.Response[v1.CreateNetworkResponse], error) {
+	_, dkSrv, err := h.getHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subnets := make([]contSrv.NetworkSubnet, 0, len(req.Msg.Subnets))
+	for _, sub := range req.Msg.Subnets {
+		subnets = append(subnets, contSrv.NetworkSubnet{
+			Subnet:  sub.Subnet,
+			Gateway: sub.Gateway,
+			IPRange: sub.IpRange,
+		})
+	}
+
+	id, warnings, err := dkSrv.Container.NetworksCreate(ctx, contSrv.NetworkCreateOptions{
+		Name:       req.Msg.Name,
+		Driver:     req.Msg.Driver,
+		Options:    req.Msg.Options,
+		Labels:     req.Msg.Labels,
+		Subnets:    subnets,
+		EnableIPv6: req.Msg.EnableIpv6,
+		Internal:   req.Msg.Internal,
+		Attachable: req.Msg.Attachable,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.CreateNetworkResponse{
+		Id:       id,
+		Warnings: warnings,
+	}), nil
+}
+
+func (h *Handler) NetworkConnect(ctx context.Context, req *connect.Request[v1.NetworkConnectRequest]) (*connect.Response[v1.NetworkConnectResponse], error) {
+	_, dkSrv, err := h.getHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dkSrv.Container.NetworksConnect(ctx, req.Msg.NetworkId, req.Msg.ContainerId, contSrv.NetworkConnectOptions{
+		Aliases: req.Msg.Aliases,
+		IPv4:    req.Msg.Ipv4,
+		IPv6:    req.Msg.Ipv6,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.NetworkConnectResponse{}), nil
+}
+
+func (h *Handler) NetworkDisconnect(ctx context.Context, req *connect.Request[v1.NetworkDisconnectRequest]) (*connect.Response[v1.NetworkDisconnectResponse], error) {
+	_, dkSrv, err := h.getHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dkSrv.Container.NetworksDisconnect(ctx, req.Msg.NetworkId, req.Msg.ContainerId, req.Msg.Force)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.NetworkDisconnectResponse{}), nil
 }
 
 func (h *Handler) NetworkDelete(ctx context.Context, req *connect.Request[v1.DeleteNetworkRequest]) (*connect.Response[v1.DeleteNetworkResponse], error) {
@@ -65,9 +65,30 @@ func getVolumeProjectNameFromLabel(labels map[string]string) string {
 	return ""
 }
 
-func (h *Handler) VolumeCreate(_ context.Context, req *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error) {
-	//TODO implement me
-	return nil, fmt.Errorf(" implement me VolumeCreate")
+func (h *Handler) VolumeCreate(ctx context.Context, req *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error) {
+	_, dkSrv, err := h.getHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vol, err := dkSrv.Container.VolumesCreate(ctx, contSrv.VolumeCreateOptions{
+		Name:       req.Msg.Name,
+		Driver:     req.Msg.Driver,
+		DriverOpts: req.Msg.DriverOpts,
+		Labels:     req.Msg.Labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.CreateVolumeResponse{
+		Volume: &v1.Volume{
+			Name:       vol.Name,
+			CreatedAt:  vol.CreatedAt,
+			Labels:     getVolumeProjectNameFromLabel(vol.Labels),
+			MountPoint: vol.Mountpoint,
+		},
+	}), nil
 }
 
 func (h *Handler) VolumeDelete(ctx context.Context, req *connect.Request[v1.DeleteVolumeRequest]) (*connect.Response[v1.DeleteVolumeResponse], error) {
@@ -88,3 +109,60 @@ func (h *Handler) VolumeDelete(ctx context.Context, req *connect.Request[v1.Dele
 
 	return connect.NewResponse(&v1.DeleteVolumeResponse{}), err
 }
+
+func (h *Handler) VolumeClone(ctx context.Context, req *connect.Request[v1.VolumeCloneRequest]) (*connect.Response[v1.VolumeCloneResponse], error) {
+	_, dkSrv, err := h.getHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// copying keeps going if the client disconnects, so no half copied volume is left behind
+	err = dkSrv.Container.VolumesClone(context.WithoutCancel(ctx), req.Msg.Source, req.Msg.Target)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.VolumeCloneResponse{}), nil
+}
+
+func (h *Handler) VolumeRename(ctx context.Context, req *connect.Request[v1.VolumeCloneRequest]) (*connect.Response[v1.VolumeCloneResponse], error) {
+	_, dkSrv, err := h.getHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dkSrv.Container.VolumesRename(context.WithoutCancel(ctx), req.Msg.Source, req.Msg.Target)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.VolumeCloneResponse{}), nil
+}
+
+func (h *Handler) VolumeMigrate(ctx context.Context, req *connect.Request[v1.VolumeMigrateRequest]) (*connect.Response[v1.VolumeMigrateResponse], error) {
+	hostname, dkSrv, err := h.getHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.DestHost == hostname {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("destination is the same host, clone the volume instead"),
+		)
+	}
+
+	destSrv, err := h.srv(req.Msg.DestHost)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dkSrv.Container.VolumesMigrate(
+		context.WithoutCancel(ctx),
+		req.Msg.Source,
+		destSrv.Container,
+		req.Msg.Target,
+		req.Msg.RemoveSource,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.VolumeMigrateResponse{}), nil
+}
@@ -0,0 +1,195 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/rs/zerolog/log"
+)
+
+type Status string
+
+const (
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+// RunFunc is the operation executed by a job,
+// all output written to out is kept by the job
+type RunFunc func(ctx context.Context, out io.Writer) error
+
+type Job struct {
+	ID       string
+	Host     string
+	Filename string
+	Action   string
+	// Mutating jobs hold the stack lock for their whole lifetime
+	Mutating  bool
+	StartedAt time.Time
+
+	mu      sync.Mutex
+	endedAt time.Time
+	status  Status
+	err     error
+	ring    *ringBuffer
+	logFile *os.File
+	logPath string
+	// closed and replaced on every write to wake up followers
+	notify chan struct{}
+	cancel context.CancelFunc
+}
+
+func (j *Job) Write(p []byte) (int, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	_, _ = j.ring.Write(p)
+	if j.logFile != nil {
+		if _, err := j.logFile.Write(p); err != nil {
+			log.Warn().Err(err).Str("job", j.ID).Msg("unable to write job output to disk")
+		}
+	}
+
+	j.wake()
+	return len(p), nil
+}
+
+// wake notifies all followers, must be called with mu held
+func (j *Job) wake() {
+	close(j.notify)
+	j.notify = make(chan struct{})
+}
+
+func (j *Job) finish(err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.endedAt = time.Now()
+	j.err = err
+	switch {
+	case err == nil:
+		j.status = StatusSucceeded
+	case errors.Is(err, context.Canceled):
+		j.status = StatusCancelled
+	default:
+		j.status = StatusFailed
+	}
+
+	if j.logFile != nil {
+		if err := j.logFile.Close(); err != nil {
+			log.Warn().Err(err).Str("job", j.ID).Msg("unable to close job log")
+		}
+		j.logFile = nil
+	}
+
+	j.wake()
+}
+
+// Status returns the current status, the error is only set for failed or cancelled jobs
+func (j *Job) Status() (Status, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status, j.err
+}
+
+func (j *Job) EndedAt() time.Time {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.endedAt
+}
+
+func (j *Job) Done() bool {
+	status, _ := j.Status()
+	return status != StatusRunning
+}
+
+// Wait blocks until the job is finished or ctx is done and returns the job error
+func (j *Job) Wait(ctx context.Context) error {
+	for {
+		j.mu.Lock()
+		done := j.status != StatusRunning
+		err := j.err
+		notify := j.notify
+		j.mu.Unlock()
+
+		if done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		}
+	}
+}
+
+func (j *Job) Cancel() {
+	j.cancel()
+}
+
+// Follow writes the job output to out until the job is finished or ctx is done,
+// cancelling ctx only detaches the follower, the job keeps running
+//
+// if fromStart is set the full output is replayed from disk,
+// otherwise only what is still in the in-memory buffer is replayed
+func (j *Job) Follow(ctx context.Context, fromStart bool, out io.Writer) error {
+	var offset int64
+	if fromStart {
+		var err error
+		offset, err = j.replayFromDisk(out)
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		j.mu.Lock()
+		data, next := j.ring.ReadFrom(offset)
+		done := j.status != StatusRunning
+		notify := j.notify
+		j.mu.Unlock()
+
+		offset = next
+		if len(data) != 0 {
+			if _, err := out.Write(data); err != nil {
+				return err
+			}
+		}
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notify:
+		}
+	}
+}
+
+// replayFromDisk copies the on-disk log to out and returns the offset copied up to
+func (j *Job) replayFromDisk(out io.Writer) (int64, error) {
+	file, err := os.Open(j.logPath)
+	if err != nil {
+		log.Warn().Err(err).Str("job", j.ID).Msg("unable to open job log, replaying from memory")
+		return 0, nil
+	}
+	defer fileutil.Close(file)
+
+	// only replay what was written when we started,
+	// anything after is picked up from the ring buffer
+	j.mu.Lock()
+	limit := j.ring.Written()
+	j.mu.Unlock()
+
+	return io.Copy(out, io.LimitReader(file, limit))
+}
@@ -0,0 +1,59 @@
+package jobs
+
+// ringBuffer keeps the last size bytes written to it,
+// readers track their position using the absolute offset of all bytes written
+type ringBuffer struct {
+	buf     []byte
+	written int64
+}
+
+func newRingBuffer(size int) *ringBuffer {
+	return &ringBuffer{buf: make([]byte, size)}
+}
+
+func (r *ringBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	size := len(r.buf)
+	if n >= size {
+		// only the tail fits, the byte at offset k is always stored at k % size
+		r.written += int64(n)
+		pos := int(r.written % int64(size))
+		for i, b := range p[n-size:] {
+			r.buf[(pos+i)%size] = b
+		}
+		return n, nil
+	}
+
+	start := int(r.written % int64(size))
+	copied := copy(r.buf[start:], p)
+	copy(r.buf, p[copied:])
+	r.written += int64(n)
+	return n, nil
+}
+
+// ReadFrom returns all bytes after offset that are still in the buffer
+// along with the new offset, if the offset has already been overwritten
+// the read starts at the oldest available byte
+func (r *ringBuffer) ReadFrom(offset int64) ([]byte, int64) {
+	size := int64(len(r.buf))
+	oldest := max(r.written-size, 0)
+	offset = max(offset, oldest)
+	if offset >= r.written {
+		return nil, r.written
+	}
+
+	out := make([]byte, 0, r.written-offset)
+	start := int(offset % size)
+	end := int(r.written % size)
+	if start < end {
+		out = append(out, r.buf[start:end]...)
+	} else {
+		out = append(out, r.buf[start:]...)
+		out = append(out, r.buf[:end]...)
+	}
+	return out, r.written
+}
+
+func (r *ringBuffer) Written() int64 {
+	return r.written
+}
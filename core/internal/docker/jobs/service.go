@@ -0,0 +1,174 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/RA341/dockman/pkg/syncmap"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrStackBusy = errors.New("another operation is already running for this stack")
+var ErrJobNotFound = errors.New("job not found")
+
+const (
+	// bytes of output kept in memory per job
+	ringSize = 256 * 1024
+	// finished jobs kept around before their logs are removed
+	maxFinishedJobs = 50
+)
+
+// Service runs compose operations detached from the request that started them,
+// so closing the browser does not kill a running operation
+type Service struct {
+	logDir string
+	jobs   syncmap.Map[string, *Job]
+
+	// stack key -> running mutating job id
+	stackLocks map[string]string
+	lockMu     sync.Mutex
+}
+
+func New(logDir string) *Service {
+	if err := os.MkdirAll(logDir, 0755); err != nil {
+		log.Fatal().Err(err).Str("path", logDir).Msg("unable to create job log directory")
+	}
+
+	// jobs do not survive restarts, logs from a previous run are orphaned
+	stale, err := filepath.Glob(filepath.Join(logDir, "*.log"))
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to list stale job logs")
+	}
+	for _, path := range stale {
+		if err = os.Remove(path); err != nil {
+			log.Warn().Err(err).Str("path", path).Msg("unable to remove stale job log")
+		}
+	}
+
+	return &Service{
+		logDir:     logDir,
+		stackLocks: map[string]string{},
+	}
+}
+
+func stackKey(host, filename string) string {
+	return host + ":" + filename
+}
+
+// Start runs fn in the background, mutating jobs fail with ErrStackBusy
+// if another mutating job is running for the same stack
+func (s *Service) Start(host, filename, action string, mutating bool, fn RunFunc) (*Job, error) {
+	id := uuid.New().String()
+
+	if mutating {
+		key := stackKey(host, filename)
+		s.lockMu.Lock()
+		if running, ok := s.stackLocks[key]; ok {
+			s.lockMu.Unlock()
+			return nil, fmt.Errorf("%w: job %s", ErrStackBusy, running)
+		}
+		s.stackLocks[key] = id
+		s.lockMu.Unlock()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &Job{
+		ID:        id,
+		Host:      host,
+		Filename:  filename,
+		Action:    action,
+		Mutating:  mutating,
+		StartedAt: time.Now(),
+		status:    StatusRunning,
+		ring:      newRingBuffer(ringSize),
+		logPath:   filepath.Join(s.logDir, id+".log"),
+		notify:    make(chan struct{}),
+		cancel:    cancel,
+	}
+
+	logFile, err := os.Create(job.logPath)
+	if err != nil {
+		log.Warn().Err(err).Str("job", id).Msg("unable to create job log, output will only be kept in memory")
+	} else {
+		job.logFile = logFile
+	}
+
+	s.jobs.Store(id, job)
+
+	go func() {
+		defer cancel()
+
+		err := fn(ctx, job)
+		if err != nil && ctx.Err() != nil {
+			err = fmt.Errorf("%w: %w", ctx.Err(), err)
+		}
+		job.finish(err)
+
+		if mutating {
+			s.lockMu.Lock()
+			delete(s.stackLocks, stackKey(host, filename))
+			s.lockMu.Unlock()
+		}
+
+		s.prune()
+	}()
+
+	return job, nil
+}
+
+func (s *Service) Get(id string) (*Job, error) {
+	job, ok := s.jobs.Load(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrJobNotFound, id)
+	}
+	return job, nil
+}
+
+// List returns running and recent jobs for a host, newest first
+func (s *Service) List(host string) []*Job {
+	var res []*Job
+	s.jobs.Range(func(_ string, job *Job) bool {
+		if job.Host == host {
+			res = append(res, job)
+		}
+		return true
+	})
+
+	slices.SortFunc(res, func(a, b *Job) int {
+		return b.StartedAt.Compare(a.StartedAt)
+	})
+	return res
+}
+
+// prune removes the oldest finished jobs and their logs once over maxFinishedJobs
+func (s *Service) prune() {
+	var finished []*Job
+	s.jobs.Range(func(_ string, job *Job) bool {
+		if job.Done() {
+			finished = append(finished, job)
+		}
+		return true
+	})
+
+	if len(finished) <= maxFinishedJobs {
+		return
+	}
+
+	slices.SortFunc(finished, func(a, b *Job) int {
+		return b.EndedAt().Compare(a.EndedAt())
+	})
+
+	for _, job := range finished[maxFinishedJobs:] {
+		s.jobs.Delete(job.ID)
+		if err := os.Remove(job.logPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Warn().Err(err).Str("job", job.ID).Msg("unable to remove job log")
+		}
+	}
+}
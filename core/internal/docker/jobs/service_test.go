@@ -0,0 +1,102 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRingBuffer(t *testing.T) {
+	r := newRingBuffer(8)
+
+	_, _ = r.Write([]byte("hello"))
+	data, off := r.ReadFrom(0)
+	require.Equal(t, "hello", string(data))
+	require.Equal(t, int64(5), off)
+
+	_, _ = r.Write([]byte(" world"))
+	data, off = r.ReadFrom(off)
+	require.Equal(t, " world", string(data))
+	require.Equal(t, int64(11), off)
+
+	// offset 0 has been overwritten, only the last 8 bytes are left
+	data, _ = r.ReadFrom(0)
+	require.Equal(t, "lo world", string(data))
+
+	_, _ = r.Write([]byte("0123456789"))
+	data, off = r.ReadFrom(0)
+	require.Equal(t, "23456789", string(data))
+	require.Equal(t, int64(21), off)
+}
+
+func TestStackLock(t *testing.T) {
+	srv := New(t.TempDir())
+
+	release := make(chan struct{})
+	first, err := srv.Start("local", "compose/app/compose.yaml", "up", true,
+		func(ctx context.Context, out io.Writer) error {
+			_, _ = out.Write([]byte("starting\n"))
+			<-release
+			return nil
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = srv.Start("local", "compose/app/compose.yaml", "down", true,
+		func(ctx context.Context, out io.Writer) error { return nil },
+	)
+	require.ErrorIs(t, err, ErrStackBusy)
+
+	// other stacks and hosts are not blocked
+	_, err = srv.Start("remote", "compose/app/compose.yaml", "down", true,
+		func(ctx context.Context, out io.Writer) error { return nil },
+	)
+	require.NoError(t, err)
+
+	close(release)
+	out := new(bytes.Buffer)
+	require.NoError(t, first.Follow(context.Background(), true, out))
+	require.Equal(t, "starting\n", out.String())
+
+	status, err := first.Status()
+	require.NoError(t, err)
+	require.Equal(t, StatusSucceeded, status)
+
+	require.Eventually(t, func() bool {
+		_, err = srv.Start("local", "compose/app/compose.yaml", "down", true,
+			func(ctx context.Context, out io.Writer) error { return nil },
+		)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestCancel(t *testing.T) {
+	srv := New(t.TempDir())
+
+	job, err := srv.Start("local", "compose/app/compose.yaml", "up", true,
+		func(ctx context.Context, out io.Writer) error {
+			<-ctx.Done()
+			return errors.New("killed")
+		},
+	)
+	require.NoError(t, err)
+
+	// detaching does not cancel the job
+	detached, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, job.Follow(detached, false, io.Discard))
+	require.False(t, job.Done())
+
+	job.Cancel()
+	require.NoError(t, job.Follow(context.Background(), false, io.Discard))
+
+	status, err := job.Status()
+	require.Equal(t, StatusCancelled, status)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, srv.List("local"), 1)
+}
@@ -24,10 +24,11 @@ func NewService(
 	mobyClient *client.Client,
 	sshCli *ssh.Client,
 	fs compose.FilenameParser,
+	lister compose.StackLister,
 ) *Service {
 	containerClient := container.New(mobyClient)
 	// todo potentially cache sshCli get and fs get ops
-	composeClient := compose.NewComposeTerminal(hostname, containerClient, fs, sshCli)
+	composeClient := compose.NewComposeTerminal(hostname, containerClient, fs, lister, sshCli)
 
 	upClient := updater.New(containerClient, hostname, "", nil)
 	dbgClient := debug.New(containerClient)
@@ -1,8 +1,16 @@
 package dockyaml
 
+import (
+	"path/filepath"
+	"strings"
+)
+
 var defaultDockmanYaml = DockmanYaml{
 	TabLimit:    5,
 	SearchLimit: 10,
+	Templates: TemplatesConfig{
+		Folder: "templates",
+	},
 	VolumesPage: VolumesConfig{
 		Sort: Sort{
 			Field: "Volume Name",
@@ -57,6 +65,56 @@ type DockmanYaml struct {
 	SearchLimit int `yaml:"searchLimit"`
 
 	CustomTools map[string]string `yaml:"customTools"`
+
+	// Stacks define compose stacks made up of multiple files,
+	// keyed by the compose file path e.g. compose/media/compose.yaml
+	Stacks map[string]StackConfig `yaml:"stacks"`
+
+	// Templates configure where stack templates are loaded from
+	Templates TemplatesConfig `yaml:"templates"`
+}
+
+type TemplatesConfig struct {
+	// folder in every alias that holds its templates
+	Folder string `yaml:"folder"`
+	// shared templates from a git repo or a folder on another host
+	Sources []TemplateSource `yaml:"sources"`
+}
+
+type TemplateSource struct {
+	// name shown in the template gallery, must be unique
+	Name string `yaml:"name"`
+	// url of a git repo, it is cloned and refreshed periodically
+	Git string `yaml:"git"`
+	// branch or tag of the git repo, defaults to the default branch
+	Ref string `yaml:"ref"`
+	// host with the templates, defaults to the current host
+	Host string `yaml:"host"`
+	// alias with the templates, used when git is not set
+	Alias string `yaml:"alias"`
+	// folder holding the templates, relative to the repo or alias
+	Path string `yaml:"path"`
+}
+
+type StackConfig struct {
+	// additional compose files layered on top of the base file, relative to the base file directory
+	Files []string `yaml:"files"`
+	// profiles to enable
+	Profiles []string `yaml:"profiles"`
+	// override the default project name
+	ProjectName string `yaml:"projectName"`
+	// compose files that must be up before this stack when running bulk operations
+	DependsOn []string `yaml:"dependsOn"`
+	// tags to select stacks in bulk operations
+	Tags []string `yaml:"tags"`
+}
+
+// GetStack returns the stack config for filename, or an empty config if none is defined
+func (d *DockmanYaml) GetStack(filename string) StackConfig {
+	if stack, ok := d.Stacks[filename]; ok {
+		return stack
+	}
+	return d.Stacks[filepath.Clean(strings.TrimPrefix(filename, "/"))]
 }
 
 type VolumesConfig struct {
@@ -76,6 +134,6 @@ type ImageConfig struct {
 }
 
 type Sort struct {
-	Order string `yaml:"order"`
+	Order string `yaml:"order" enum:"asc,desc"`
 	Field string `yaml:"field"`
 }
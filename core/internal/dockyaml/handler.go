@@ -71,6 +71,25 @@ func (d *DockmanYaml) ToProto() *v1.DockmanYaml {
 		NetworkPage:                d.NetworkPage.toProto(),
 		ImagePage:                  d.ImagePage.toProto(),
 		ContainerPage:              d.ContainerPage.toProto(),
+		Stacks:                     stacksToProto(d.Stacks),
+	}
+}
+
+func stacksToProto(stacks map[string]StackConfig) map[string]*v1.StackConfig {
+	res := make(map[string]*v1.StackConfig, len(stacks))
+	for name, stack := range stacks {
+		res[name] = stack.toProto()
+	}
+	return res
+}
+
+func (s StackConfig) toProto() *v1.StackConfig {
+	return &v1.StackConfig{
+		Files:       s.Files,
+		Profiles:    s.Profiles,
+		ProjectName: s.ProjectName,
+		DependsOn:   s.DependsOn,
+		Tags:        s.Tags,
 	}
 }
 
@@ -0,0 +1,73 @@
+package dockyaml
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// JSONSchema generates the json schema of dockman.yml from DockmanYaml,
+// used by the editor to validate and complete the file.
+//
+// Keys come from the yaml tags, defaults from defaultDockmanYaml
+// and a comma separated `enum` tag restricts the allowed values
+func JSONSchema() ([]byte, error) {
+	root := typeSchema(reflect.TypeFor[DockmanYaml](), reflect.ValueOf(defaultDockmanYaml))
+	root["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	root["title"] = "dockman.yml"
+	return json.Marshal(root)
+}
+
+// typeSchema returns the schema of t, def is the default value if there is one
+func typeSchema(t reflect.Type, def reflect.Value) map[string]any {
+	res := map[string]any{}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		props := map[string]any{}
+		for i := range t.NumField() {
+			field := t.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+			if !field.IsExported() || name == "" || name == "-" {
+				continue
+			}
+
+			var fieldDef reflect.Value
+			if def.IsValid() {
+				fieldDef = def.Field(i)
+			}
+			prop := typeSchema(field.Type, fieldDef)
+			if enum := field.Tag.Get("enum"); enum != "" {
+				prop["enum"] = strings.Split(enum, ",")
+			}
+			props[name] = prop
+		}
+		res["type"] = "object"
+		res["properties"] = props
+		// unknown keys are ignored when loading, so they are most likely typos
+		res["additionalProperties"] = false
+		return res
+	case reflect.Map:
+		res["type"] = "object"
+		res["additionalProperties"] = typeSchema(t.Elem(), reflect.Value{})
+		return res
+	case reflect.Slice, reflect.Array:
+		res["type"] = "array"
+		res["items"] = typeSchema(t.Elem(), reflect.Value{})
+		return res
+	case reflect.Bool:
+		res["type"] = "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		res["type"] = "integer"
+	case reflect.Float32, reflect.Float64:
+		res["type"] = "number"
+	case reflect.String:
+		res["type"] = "string"
+	}
+
+	if def.IsValid() && !def.IsZero() {
+		res["default"] = def.Interface()
+	}
+	return res
+}
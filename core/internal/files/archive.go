@@ -0,0 +1,360 @@
+package files
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/rs/zerolog/log"
+)
+
+type ArchiveFormat string
+
+const (
+	ArchiveZip   ArchiveFormat = "zip"
+	ArchiveTarGz ArchiveFormat = "tar.gz"
+)
+
+var ErrIsDir = errors.New("path is a directory")
+
+func ParseArchiveFormat(format string) (ArchiveFormat, error) {
+	switch strings.TrimPrefix(strings.ToLower(format), ".") {
+	case "zip":
+		return ArchiveZip, nil
+	case "tar.gz", "tgz":
+		return ArchiveTarGz, nil
+	}
+	return "", fmt.Errorf("unsupported archive format %q, use zip or tar.gz", format)
+}
+
+func (f ArchiveFormat) ContentType() string {
+	if f == ArchiveZip {
+		return "application/zip"
+	}
+	return "application/gzip"
+}
+
+// Archive writes dirname and everything under it to w, entries are
+// streamed straight from the host filesystem as they are walked
+func (s *Service) Archive(dirname, hostname string, format ArchiveFormat, w io.Writer) error {
+	fsCli, relpath, _, err := s.LoadFs(dirname, hostname)
+	if err != nil {
+		return err
+	}
+	stat, err := fsCli.Stat(relpath)
+	if err != nil {
+		return err
+	}
+	if !stat.IsDir() {
+		return fmt.Errorf("%s is not a directory", dirname)
+	}
+
+	var aw archiveWriter
+	switch format {
+	case ArchiveZip:
+		aw = &zipWriter{zw: zip.NewWriter(w)}
+	case ArchiveTarGz:
+		gz := gzip.NewWriter(w)
+		aw = &tarWriter{gz: gz, tw: tar.NewWriter(gz)}
+	default:
+		return fmt.Errorf("unsupported archive format %q", format)
+	}
+
+	// WalkDir reports full paths, entries are named relative to the archived folder
+	root, err := fsCli.Abs(relpath)
+	if err != nil {
+		return err
+	}
+	prefix := path.Base(filepath.ToSlash(relpath))
+
+	err = fsCli.WalkDir(relpath, func(fullpath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel := strings.TrimPrefix(filepath.ToSlash(strings.TrimPrefix(fullpath, root)), "/")
+		name := path.Join(prefix, rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsDir() && !info.Mode().IsRegular() {
+			log.Debug().Str("path", fullpath).Msg("skipping non regular file in archive")
+			return nil
+		}
+		if info.IsDir() {
+			return aw.addDir(name+"/", info)
+		}
+
+		file, err := fsCli.OpenFile(fsCli.Join(relpath, rel), os.O_RDONLY, 0)
+		if err != nil {
+			return err
+		}
+		defer fileutil.Close(file)
+		return aw.addFile(name, info, file)
+	})
+	if err != nil {
+		return err
+	}
+	return aw.Close()
+}
+
+type archiveWriter interface {
+	addDir(name string, info fs.FileInfo) error
+	addFile(name string, info fs.FileInfo, contents io.Reader) error
+	io.Closer
+}
+
+type zipWriter struct {
+	zw *zip.Writer
+}
+
+func (z *zipWriter) addDir(name string, info fs.FileInfo) error {
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	_, err = z.zw.CreateHeader(header)
+	return err
+}
+
+func (z *zipWriter) addFile(name string, info fs.FileInfo, contents io.Reader) error {
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	header.Method = zip.Deflate
+
+	w, err := z.zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, contents)
+	return err
+}
+
+func (z *zipWriter) Close() error {
+	return z.zw.Close()
+}
+
+type tarWriter struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+}
+
+func (t *tarWriter) addDir(name string, info fs.FileInfo) error {
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	return t.tw.WriteHeader(header)
+}
+
+func (t *tarWriter) addFile(name string, info fs.FileInfo, contents io.Reader) error {
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	if err = t.tw.WriteHeader(header); err != nil {
+		return err
+	}
+	// the header promised exactly Size bytes
+	_, err = io.CopyN(t.tw, contents, header.Size)
+	return err
+}
+
+func (t *tarWriter) Close() error {
+	return errors.Join(t.tw.Close(), t.gz.Close())
+}
+
+// maxExtractSize the most bytes an archive may unpack to, uploads are capped
+// on their compressed size which says little about what they contain
+const maxExtractSize = 32 << 30
+
+// Extract unpacks an archive into dest, creating it if needed, existing files are overwritten.
+// Entries that would end up outside dest, links, special files
+// and paths through a symlink already in dest are rejected
+func (s *Service) Extract(dest, hostname string, format ArchiveFormat, archive io.Reader) error {
+	fsCli, relpath, _, err := s.LoadFs(dest, hostname)
+	if err != nil {
+		return err
+	}
+	if err = fsCli.MkdirAll(relpath, 0755); err != nil {
+		return err
+	}
+
+	ex := &extractor{fsCli: fsCli, dest: relpath, remaining: maxExtractSize}
+	switch format {
+	case ArchiveTarGz:
+		return ex.tarGz(archive)
+	case ArchiveZip:
+		return ex.zip(archive)
+	}
+	return fmt.Errorf("unsupported archive format %q", format)
+}
+
+type extractor struct {
+	fsCli filesystem.FileSystem
+	dest  string
+	// remaining bytes that may still be written
+	remaining int64
+}
+
+func (e *extractor) tarGz(archive io.Reader) error {
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		return fmt.Errorf("invalid gzip archive: %w", err)
+	}
+	defer fileutil.Close(gz)
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid tar archive: %w", err)
+		}
+
+		target, err := e.target(header.Name)
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = e.fsCli.MkdirAll(target, 0755)
+		case tar.TypeReg:
+			err = e.file(target, header.FileInfo().Mode(), tr)
+		case tar.TypeXGlobalHeader:
+			continue
+		default:
+			return fmt.Errorf("%s: links and special files are not allowed", header.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to extract %s: %w", header.Name, err)
+		}
+	}
+}
+
+func (e *extractor) zip(archive io.Reader) error {
+	// zip keeps its index at the end, so it needs random access
+	spool, err := os.CreateTemp("", "dockman-upload-*.zip")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fileutil.Close(spool)
+		if err := os.Remove(spool.Name()); err != nil {
+			log.Warn().Err(err).Str("file", spool.Name()).Msg("unable to remove uploaded archive")
+		}
+	}()
+
+	size, err := io.Copy(spool, archive)
+	if err != nil {
+		return err
+	}
+	zr, err := zip.NewReader(spool, size)
+	if err != nil {
+		return fmt.Errorf("invalid zip archive: %w", err)
+	}
+
+	for _, entry := range zr.File {
+		target, err := e.target(entry.Name)
+		if err != nil {
+			return err
+		}
+
+		mode := entry.Mode()
+		switch {
+		case mode.IsDir():
+			err = e.fsCli.MkdirAll(target, 0755)
+		case mode.IsRegular():
+			err = e.zipFile(target, entry)
+		default:
+			return fmt.Errorf("%s: links and special files are not allowed", entry.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to extract %s: %w", entry.Name, err)
+		}
+	}
+	return nil
+}
+
+func (e *extractor) zipFile(target string, entry *zip.File) error {
+	contents, err := entry.Open()
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(contents)
+	return e.file(target, entry.Mode(), contents)
+}
+
+func (e *extractor) file(target string, mode fs.FileMode, contents io.Reader) error {
+	if err := e.fsCli.MkdirAll(path.Dir(filepath.ToSlash(target)), 0755); err != nil {
+		return err
+	}
+
+	file, err := e.fsCli.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm()|0600)
+	if err != nil {
+		return err
+	}
+	// one byte over the limit is enough to know it was exceeded
+	n, err := io.Copy(file, io.LimitReader(contents, e.remaining+1))
+	e.remaining -= n
+	if err == nil && e.remaining < 0 {
+		err = fmt.Errorf("archive unpacks to more than %d bytes", int64(maxExtractSize))
+	}
+	return errors.Join(err, file.Close())
+}
+
+// target returns where an entry is written to, failing if it is outside
+// dest or if any part of its path below dest is a symlink, which writes would follow
+func (e *extractor) target(name string) (string, error) {
+	target, err := extractPath(e.dest, name)
+	if err != nil {
+		return "", err
+	}
+
+	cur := filepath.ToSlash(e.dest)
+	for _, part := range strings.Split(strings.TrimPrefix(target, cur+"/"), "/") {
+		cur = path.Join(cur, part)
+		info, err := e.fsCli.Lstat(cur)
+		if errors.Is(err, fs.ErrNotExist) {
+			// nothing below it exists either
+			return target, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return "", fmt.Errorf("refusing to extract %q: %s is a symlink", name, cur)
+		}
+	}
+	return target, nil
+}
+
+// extractPath joins an archive entry name onto dest,
+// rejecting names that would escape it
+func extractPath(dest, name string) (string, error) {
+	clean := strings.TrimSuffix(filepath.ToSlash(name), "/")
+	if clean == "" || !filepath.IsLocal(filepath.FromSlash(clean)) {
+		return "", fmt.Errorf("refusing to extract %q: path is outside the target folder", name)
+	}
+	return path.Join(filepath.ToSlash(dest), clean), nil
+}
@@ -2,6 +2,7 @@ package files
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/google/yamlfmt/formatters/basic"
 )
@@ -14,6 +15,16 @@ var availableFormatters Formatters = map[string]FormatFn{
 	".yml":  yamlFormatter,
 }
 
+// FormatContents formats contents with the formatter for the extension of filename,
+// contents are returned as is if there is no formatter for it
+func FormatContents(filename string, contents []byte) ([]byte, error) {
+	formatter, ok := availableFormatters[filepath.Ext(filename)]
+	if !ok {
+		return contents, nil
+	}
+	return formatter(contents)
+}
+
 // todo look into this
 // --- Variant B: create a ConsecutiveEngine and use FormatContent ---
 //
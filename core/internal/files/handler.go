@@ -1,8 +1,11 @@
 package files
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"net/http"
 	"strings"
 
@@ -35,15 +38,37 @@ func (h *Handler) GetTmpls(ctx context.Context, req *connect.Request[v1.GetTmpls
 		return nil, err
 	}
 
-	tmpls, err := h.srv.GetTemplates(req.Msg.Alias, hostname)
+	tmpls, err := h.srv.GetTemplates(req.Msg.Alias, hostname, req.Msg.Refresh)
 	if err != nil {
 		return nil, err
 	}
 
 	rpcTmpls := ToMap(tmpls, func(t Template) *v1.Template {
+		defaults := make(map[string]string, len(t.Vars))
+		for _, v := range t.Vars {
+			defaults[v.Name] = v.Default
+		}
+
 		return &v1.Template{
-			Name: t.name,
-			Vars: t.vars,
+			Name:        t.Name,
+			Vars:        defaults,
+			Source:      t.Source,
+			Title:       t.Title,
+			Description: t.Description,
+			Files:       t.Files,
+			VarDefs: ToMap(t.Vars, func(v TemplateVar) *v1.TemplateVar {
+				return &v1.TemplateVar{
+					Name:         v.Name,
+					Description:  v.Description,
+					Type:         string(v.Type),
+					DefaultValue: v.Default,
+					Required:     v.Required,
+					Pattern:      v.Pattern,
+					Options:      v.Options,
+					Secret:       v.Secret,
+					Generate:     v.Generate,
+				}
+			}),
 		}
 	})
 
@@ -58,17 +83,41 @@ func (h *Handler) WriteTmpl(ctx context.Context, c *connect.Request[v1.WriteTmpl
 		return nil, err
 	}
 
-	tmpl := Template{
-		name: c.Msg.Tmpl.Name,
-		vars: c.Msg.Tmpl.Vars,
+	ref := TemplateRef{Source: c.Msg.Tmpl.Source, Name: c.Msg.Tmpl.Name}
+	written, err := h.srv.WriteTemplate(hostname, c.Msg.Dir, ref, c.Msg.Tmpl.Vars, c.Msg.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.WriteTmplResponse{Files: written}), nil
+}
+
+func (h *Handler) PreviewTmpl(ctx context.Context, c *connect.Request[v1.WriteTmplRequest]) (*connect.Response[v1.PreviewTmplResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	err = h.srv.WriteTemplate(hostname, c.Msg.Dir, &tmpl)
+	ref := TemplateRef{Source: c.Msg.Tmpl.Source, Name: c.Msg.Tmpl.Name}
+	preview, err := h.srv.PreviewTemplate(hostname, c.Msg.Dir, ref, c.Msg.Tmpl.Vars)
 	if err != nil {
 		return nil, err
 	}
 
-	return connect.NewResponse(&v1.WriteTmplResponse{}), nil
+	return connect.NewResponse(&v1.PreviewTmplResponse{
+		Dirs: preview.Dirs,
+		Files: ToMap(preview.Files, func(file PreviewFile) *v1.TemplateFile {
+			res := &v1.TemplateFile{
+				Filename: file.Path,
+				Exists:   file.Exists,
+				Binary:   file.Binary,
+			}
+			if !file.Binary {
+				res.Contents = string(file.Contents)
+			}
+			return res
+		}),
+	}), nil
 }
 
 func (h *Handler) List(ctx context.Context, req *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error) {
@@ -157,9 +206,8 @@ func (h *Handler) Copy(ctx context.Context, req *connect.Request[v1.CopyRequest]
 
 	dest := req.Msg.Dest.Filename
 	src := req.Msg.Source.Filename
-	isDir := req.Msg.Source.IsDir
 
-	err = h.srv.Copy(src, dest, hostname, isDir)
+	err = h.srv.Copy(src, dest, hostname)
 	if err != nil {
 		return nil, err
 	}
@@ -167,6 +215,108 @@ func (h *Handler) Copy(ctx context.Context, req *connect.Request[v1.CopyRequest]
 	return &connect.Response[v1.CopyResponse]{}, nil
 }
 
+func (h *Handler) Transfer(ctx context.Context, req *connect.Request[v1.TransferRequest], responseStream *connect.ServerStream[v1.TransferProgress]) error {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts := TransferOpts{
+		Source:     req.Msg.Source,
+		SourceHost: cmp.Or(req.Msg.SourceHost, hostname),
+		Dest:       req.Msg.Dest,
+		DestHost:   cmp.Or(req.Msg.DestHost, hostname),
+		Move:       req.Msg.Move,
+		OnConflict: ConflictPolicy(req.Msg.OnConflict),
+	}
+
+	var sendErr error
+	err = h.srv.Transfer(ctx, opts, func(progress TransferProgress) {
+		if sendErr != nil {
+			return
+		}
+		sendErr = responseStream.Send(&v1.TransferProgress{
+			File:       progress.File,
+			Dest:       progress.Dest,
+			State:      string(progress.State),
+			BytesDone:  progress.BytesDone,
+			BytesTotal: progress.BytesTotal,
+			FilesDone:  int32(progress.FilesDone),
+			FilesTotal: int32(progress.FilesTotal),
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return sendErr
+}
+
+func (h *Handler) WatchFiles(ctx context.Context, req *connect.Request[v1.WatchFilesRequest], responseStream *connect.ServerStream[v1.WatchFilesResponse]) error {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts := WatchOpts{
+		Dirs:  req.Msg.Dirs,
+		Files: req.Msg.Files,
+		Host:  hostname,
+	}
+	return h.srv.Watch(ctx, opts, func(events []WatchEvent) error {
+		return responseStream.Send(&v1.WatchFilesResponse{
+			Events: ToMap(events, func(event WatchEvent) *v1.FileEvent {
+				return &v1.FileEvent{
+					Op:          string(event.Op),
+					Filename:    event.Path,
+					OldFilename: event.OldPath,
+					IsDir:       event.IsDir,
+					Size:        event.Size,
+					ModTime:     event.ModTime.Unix(),
+					Hash:        event.Hash,
+				}
+			}),
+		})
+	})
+}
+
+func (h *Handler) SearchContents(ctx context.Context, req *connect.Request[v1.SearchContentsRequest], responseStream *connect.ServerStream[v1.SearchContentsResponse]) error {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts := ContentSearchOpts{
+		Root:          req.Msg.Root,
+		Query:         req.Msg.Query,
+		Regex:         req.Msg.Regex,
+		CaseSensitive: req.Msg.CaseSensitive,
+		WholeWord:     req.Msg.WholeWord,
+		Include:       req.Msg.Include,
+		Exclude:       req.Msg.Exclude,
+		ContextLines:  int(req.Msg.ContextLines),
+		MaxMatches:    int(req.Msg.MaxMatches),
+	}
+	err = h.srv.SearchContents(ctx, opts, hostname, func(file FileMatches) error {
+		return responseStream.Send(&v1.SearchContentsResponse{
+			Filename: file.File,
+			Matches: ToMap(file.Matches, func(match LineMatch) *v1.LineMatch {
+				return &v1.LineMatch{
+					Line:   int32(match.Line),
+					Column: int32(match.Column),
+					Length: int32(match.Length),
+					Text:   match.Text,
+					Before: match.Before,
+					After:  match.After,
+				}
+			}),
+		})
+	})
+	if errors.Is(err, ErrSearchLimit) {
+		return responseStream.Send(&v1.SearchContentsResponse{Truncated: true})
+	}
+	return err
+}
+
 func (h *Handler) Exists(ctx context.Context, req *connect.Request[v1.File]) (*connect.Response[v1.Empty], error) {
 	hostname, err := middleware.GetHost(ctx)
 	if err != nil {
@@ -205,6 +355,9 @@ func (h *Handler) Rename(ctx context.Context, req *connect.Request[v1.RenameFile
 	}
 
 	err = h.srv.Rename(req.Msg.OldFilePath, req.Msg.NewFilePath, hostname)
+	if errors.Is(err, fs.ErrExist) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1,10 +1,17 @@
 package files
 
 import (
+	"bytes"
 	b64 "encoding/base64"
+	"encoding/json"
 	"errors"
+	"io"
+	"mime"
 	"net/http"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/RA341/dockman/internal/host/middleware"
 	fu "github.com/RA341/dockman/pkg/fileutil"
@@ -14,6 +21,10 @@ import (
 
 const fileContentsFormKey = "contents"
 
+// fileBaseFormKey optional form field with the contents the editor
+// started from, returned on conflict to build a three-way diff
+const fileBaseFormKey = "base"
+
 type FileHandler struct {
 	srv *Service
 }
@@ -28,12 +39,18 @@ func (h *FileHandler) register() http.Handler {
 	subMux.HandleFunc("POST /save", h.saveFile)
 	subMux.HandleFunc("GET /load/{filename}", h.loadFile)
 	subMux.HandleFunc("GET /search/{root}", h.searchFile)
+	subMux.HandleFunc("GET /archive/{filename}", h.downloadArchive)
+	subMux.HandleFunc("POST /extract/{filename}", h.extractArchive)
 
 	return subMux
 }
 
 const QueryKeyCreate = "create"
 const QueryKeyDownload = "download"
+const QueryKeyFormat = "format"
+
+// maxArchiveUpload the largest archive accepted by extract
+const maxArchiveUpload = 4 << 30
 
 func (h *FileHandler) loadFile(w http.ResponseWriter, r *http.Request) {
 	filename := r.PathValue("filename")
@@ -56,6 +73,10 @@ func (h *FileHandler) loadFile(w http.ResponseWriter, r *http.Request) {
 	reader, modTime, err := h.srv.LoadFilePath(filename, getHost, download)
 	if err != nil {
 		log.Error().Err(err).Str("path", filename).Msg("Error loading file")
+		if errors.Is(err, ErrIsDir) {
+			h.writeArchive(w, r, filename, getHost, ArchiveZip)
+			return
+		}
 		if errors.Is(err, ErrFileNotSupported) {
 			http.Error(w, "binary file detected, it will not be opened", http.StatusConflict)
 			return
@@ -66,7 +87,105 @@ func (h *FileHandler) loadFile(w http.ResponseWriter, r *http.Request) {
 	}
 	defer fu.Close(reader)
 
-	http.ServeContent(w, r, filename, modTime, reader)
+	if download {
+		// streamed as is, downloads can be far too large to buffer
+		http.ServeContent(w, r, filename, modTime, reader)
+		return
+	}
+
+	// the etag is sent back with If-Match on save to detect conflicting edits
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		http.Error(w, "unable to read file", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("ETag", strconv.Quote(HashContents(contents)))
+
+	http.ServeContent(w, r, filename, modTime, bytes.NewReader(contents))
+}
+
+func (h *FileHandler) downloadArchive(w http.ResponseWriter, r *http.Request) {
+	filename := r.PathValue("filename")
+	if filename == "" {
+		http.Error(w, "Filename not provided", http.StatusBadRequest)
+		return
+	}
+	getHost, err := middleware.GetHost(r.Context())
+	if err != nil {
+		http.Error(w, "host not provided", http.StatusBadRequest)
+		return
+	}
+
+	format := ArchiveTarGz
+	if val := r.URL.Query().Get(QueryKeyFormat); val != "" {
+		format, err = ParseArchiveFormat(val)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	h.writeArchive(w, r, filename, getHost, format)
+}
+
+func (h *FileHandler) writeArchive(w http.ResponseWriter, r *http.Request, dirname, host string, format ArchiveFormat) {
+	name := filepath.Base(filepath.Clean(dirname)) + "." + string(format)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+
+	tracked := &writeTracker{w: w}
+	err := h.srv.Archive(dirname, host, format, tracked)
+	if err == nil {
+		return
+	}
+
+	log.Error().Err(err).Str("path", dirname).Msg("Error creating archive")
+	if !tracked.written {
+		w.Header().Del("Content-Disposition")
+		http.Error(w, "unable to create archive: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	// the archive is partially sent, abort so the client does not get a truncated file
+	panic(http.ErrAbortHandler)
+}
+
+// writeTracker records whether anything was written to the response
+type writeTracker struct {
+	w       io.Writer
+	written bool
+}
+
+func (t *writeTracker) Write(p []byte) (int, error) {
+	t.written = true
+	return t.w.Write(p)
+}
+
+func (h *FileHandler) extractArchive(w http.ResponseWriter, r *http.Request) {
+	dest := r.PathValue("filename")
+	if dest == "" {
+		http.Error(w, "target folder not provided", http.StatusBadRequest)
+		return
+	}
+	getHost, err := middleware.GetHost(r.Context())
+	if err != nil {
+		http.Error(w, "host not provided", http.StatusBadRequest)
+		return
+	}
+
+	format, err := ParseArchiveFormat(r.URL.Query().Get(QueryKeyFormat))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxArchiveUpload)
+	defer fu.Close(body)
+
+	if err = h.srv.Extract(dest, getHost, format, body); err != nil {
+		log.Error().Err(err).Str("path", dest).Msg("Error extracting archive")
+		http.Error(w, "unable to extract archive: "+err.Error(), http.StatusBadRequest)
+		return
+	}
 }
 
 func (h *FileHandler) saveFile(w http.ResponseWriter, r *http.Request) {
@@ -107,7 +226,25 @@ func (h *FileHandler) saveFile(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err = h.srv.Save(string(decodedFileName), getHost, createFile, content)
+	mine, err := io.ReadAll(content)
+	if err != nil {
+		http.Error(w, "Error reading file from form", http.StatusBadRequest)
+		return
+	}
+
+	err = h.srv.Save(string(decodedFileName), getHost, createFile, bytes.NewReader(mine), savePrecondition(r))
+	var conflict *ConflictError
+	if errors.As(err, &conflict) {
+		writeConflict(w, SaveConflict{
+			Error:          conflict.Error(),
+			Base:           r.FormValue(fileBaseFormKey),
+			Mine:           string(mine),
+			Theirs:         string(conflict.Current),
+			TheirsHash:     conflict.Hash,
+			TheirsModified: conflict.ModTime,
+		})
+		return
+	}
 	if err != nil {
 		log.Error().Err(err).Msg("Error saving file")
 		http.Error(w, "Error saving file", http.StatusInternalServerError)
@@ -117,6 +254,45 @@ func (h *FileHandler) saveFile(w http.ResponseWriter, r *http.Request) {
 	//log.Debug().Str("filename", meta.Filename).Msg("Successfully saved File")
 }
 
+// savePrecondition reads the version the client edited from If-Match,
+// the etag from load, or from If-Unmodified-Since
+func savePrecondition(r *http.Request) *Precondition {
+	if match := r.Header.Get("If-Match"); match != "" {
+		hash := strings.Trim(strings.TrimPrefix(match, "W/"), `"`)
+		return &Precondition{Hash: hash}
+	}
+	if since := r.Header.Get("If-Unmodified-Since"); since != "" {
+		modTime, err := http.ParseTime(since)
+		if err == nil {
+			return &Precondition{ModTime: modTime}
+		}
+		log.Warn().Err(err).Str("value", since).Msg("invalid If-Unmodified-Since header, ignoring")
+	}
+	return nil
+}
+
+// SaveConflict sent with 409 when the file changed since it was loaded,
+// with all three versions so the client can show a three-way diff
+type SaveConflict struct {
+	Error string `json:"error"`
+	// Base the contents the client started from, if it sent them
+	Base string `json:"base"`
+	// Mine the rejected contents
+	Mine string `json:"mine"`
+	// Theirs the contents now on disk
+	Theirs         string    `json:"theirs"`
+	TheirsHash     string    `json:"theirsHash"`
+	TheirsModified time.Time `json:"theirsModified"`
+}
+
+func writeConflict(w http.ResponseWriter, conflict SaveConflict) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusConflict)
+	if err := json.NewEncoder(w).Encode(conflict); err != nil {
+		log.Warn().Err(err).Msg("Error writing save conflict")
+	}
+}
+
 var upgrader = websocket.Upgrader{
 	CheckOrigin: func(r *http.Request) bool { return true },
 }
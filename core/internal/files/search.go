@@ -0,0 +1,221 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// ContentSearchOpts include and exclude are globs, a glob without a / matches
+// the file name, otherwise the path relative to Root, ** matches any folders
+type ContentSearchOpts struct {
+	// Root folder to search, <alias>/<relpath>
+	Root          string
+	Query         string
+	Regex         bool
+	CaseSensitive bool
+	WholeWord     bool
+	Include       []string
+	Exclude       []string
+	// ContextLines shown before and after every match
+	ContextLines int
+	// MaxMatches stops the search once reached, 0 uses maxContentMatches
+	MaxMatches int
+}
+
+type FileMatches struct {
+	// File <alias>/<relpath>
+	File    string
+	Matches []LineMatch
+}
+
+type LineMatch struct {
+	// Line and Column start at 1, Column and Length count characters
+	Line   int
+	Column int
+	Length int
+	Text   string
+	Before []string
+	After  []string
+}
+
+const (
+	maxContentMatches = 1000
+	maxContextLines   = 10
+	// larger files are most likely logs or dumps, not config
+	maxSearchFileSize = 5 << 20
+)
+
+// folders skipped unless an include pattern asks for them
+var searchSkipDirs = []string{".git", "node_modules"}
+
+// ErrSearchLimit the search stopped early because it found MaxMatches
+var ErrSearchLimit = errors.New("search stopped after reaching the match limit")
+
+// SearchContents walks Root and emits the matches in every text file,
+// one file at a time. Binary files are skipped with CheckFileType
+func (s *Service) SearchContents(ctx context.Context, opts ContentSearchOpts, hostname string, emit func(FileMatches) error) error {
+	pattern, err := compileSearch(opts)
+	if err != nil {
+		return err
+	}
+	for _, glob := range slices.Concat(opts.Include, opts.Exclude) {
+		if !doublestar.ValidatePattern(glob) {
+			return fmt.Errorf("invalid glob %q", glob)
+		}
+	}
+	limit := opts.MaxMatches
+	if limit <= 0 || limit > maxContentMatches {
+		limit = maxContentMatches
+	}
+	contextLines := min(max(opts.ContextLines, 0), maxContextLines)
+
+	fsCli, relpath, _, err := s.LoadFs(opts.Root, hostname)
+	if err != nil {
+		return err
+	}
+	// WalkDir reports full paths
+	root, err := fsCli.Abs(relpath)
+	if err != nil {
+		return err
+	}
+	display := path.Clean(filepath.ToSlash(opts.Root))
+
+	found := 0
+	err = fsCli.WalkDir(relpath, func(fullpath string, d fs.DirEntry, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			log.Debug().Err(err).Str("path", fullpath).Msg("skipping unreadable path in search")
+			return nil
+		}
+		rel := strings.TrimPrefix(filepath.ToSlash(strings.TrimPrefix(fullpath, root)), "/")
+		if rel == "" {
+			return nil
+		}
+
+		if d.IsDir() {
+			if matchesGlob(opts.Exclude, rel) ||
+				(slices.Contains(searchSkipDirs, d.Name()) && !includesDir(opts.Include, rel)) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || matchesGlob(opts.Exclude, rel) ||
+			(len(opts.Include) > 0 && !matchesGlob(opts.Include, rel)) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil || info.Size() > maxSearchFileSize {
+			return nil
+		}
+		contents, err := fsCli.ReadFile(joinRel(relpath, rel))
+		if err != nil {
+			log.Debug().Err(err).Str("path", fullpath).Msg("unable to read file for search")
+			return nil
+		}
+		if len(contents) == 0 || CheckFileType(bytes.NewReader(contents)) != nil {
+			return nil
+		}
+
+		matches := searchLines(pattern, string(contents), contextLines, limit-found)
+		if len(matches) == 0 {
+			return nil
+		}
+		found += len(matches)
+		if err = emit(FileMatches{File: path.Join(display, rel), Matches: matches}); err != nil {
+			return err
+		}
+		if found >= limit {
+			return ErrSearchLimit
+		}
+		return nil
+	})
+	return err
+}
+
+func compileSearch(opts ContentSearchOpts) (*regexp.Regexp, error) {
+	if opts.Query == "" {
+		return nil, fmt.Errorf("search query is empty")
+	}
+	expr := opts.Query
+	if !opts.Regex {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if opts.WholeWord {
+		expr = `\b(?:` + expr + `)\b`
+	}
+	if !opts.CaseSensitive {
+		expr = `(?i)` + expr
+	}
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex: %w", err)
+	}
+	return pattern, nil
+}
+
+// searchLines returns up to limit matches in contents, empty matches are ignored
+func searchLines(pattern *regexp.Regexp, contents string, contextLines, limit int) []LineMatch {
+	lines := strings.Split(strings.ReplaceAll(contents, "\r\n", "\n"), "\n")
+
+	var matches []LineMatch
+	for i, line := range lines {
+		for _, loc := range pattern.FindAllStringIndex(line, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+			matches = append(matches, LineMatch{
+				Line:   i + 1,
+				Column: utf8.RuneCountInString(line[:loc[0]]) + 1,
+				Length: utf8.RuneCountInString(line[loc[0]:loc[1]]),
+				Text:   line,
+				Before: lines[max(i-contextLines, 0):i],
+				After:  lines[i+1 : min(i+1+contextLines, len(lines))],
+			})
+			if len(matches) >= limit {
+				return matches
+			}
+		}
+	}
+	return matches
+}
+
+// matchesGlob reports whether rel matches any of globs, globs without
+// a / are matched against the name alone
+func matchesGlob(globs []string, rel string) bool {
+	for _, glob := range globs {
+		target := rel
+		if !strings.Contains(glob, "/") {
+			target = path.Base(rel)
+		}
+		if ok, _ := doublestar.Match(glob, target); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// includesDir reports whether an include pattern explicitly
+// points into dir, such as .git/config
+func includesDir(globs []string, dir string) bool {
+	for _, glob := range globs {
+		if strings.HasPrefix(glob, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -1,22 +1,28 @@
 package files
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 	"slices"
 	"strings"
-	"text/template"
-	"text/template/parse"
+	"sync"
 	"time"
 
 	"github.com/RA341/dockman/internal/dockyaml"
 	"github.com/RA341/dockman/internal/files/utils"
 	"github.com/RA341/dockman/internal/host/filesystem"
 	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/RA341/dockman/pkg/syncmap"
 	"github.com/gabriel-vasile/mimetype"
 	"github.com/sahilm/fuzzy"
 )
@@ -24,21 +30,34 @@ import (
 type FSProvider func(host, alias string) (filesystem.FileSystem, error)
 type DockyamlProvider func(host string) *dockyaml.DockmanYaml
 
+// SaveHook is called with the new contents of a file after every successful save
+type SaveHook func(hostname, alias, relpath string, contents []byte)
+
 type Service struct {
-	Fs             FSProvider
-	dockYml        DockyamlProvider
-	templateFolder string
+	Fs      FSProvider
+	dockYml DockyamlProvider
+	// saveLocks serializes saves to the same file, files share a lock by the hash of their path
+	saveLocks [saveLockCount]sync.Mutex
+
+	// templateCache where git template sources are cloned to
+	templateCache string
+	// templateLocks serializes fetches of the same git source
+	templateLocks syncmap.Map[string, *sync.Mutex]
+
+	onSave SaveHook
 }
 
 func New(
 	fs FSProvider,
 	dockYml DockyamlProvider,
+	templateCache string,
+	onSave SaveHook,
 ) *Service {
 	return &Service{
-		Fs:      fs,
-		dockYml: dockYml,
-		// todo load from env
-		templateFolder: "templates",
+		Fs:            fs,
+		dockYml:       dockYml,
+		templateCache: templateCache,
+		onSave:        onSave,
 	}
 }
 
@@ -147,33 +166,15 @@ func (s *Service) Create(filename string, dir bool, hostname string) error {
 	return nil
 }
 
-func (s *Service) Copy(source, dest, hostname string, isDir bool) error {
-	if isDir {
-		return fmt.Errorf("directory copying is unimplemented")
-	}
-
-	cliFs, sourceFile, _, err := s.LoadFs(source, hostname)
-	if err != nil {
-		return err
-	}
-
-	_, destFile, _, err := s.LoadFs(dest, hostname)
-	if err != nil {
-		return err
-	}
-
-	sourceReader, err := cliFs.OpenFile(sourceFile, os.O_RDONLY, os.ModePerm)
-	if err != nil {
-		return err
-	}
-
-	destWriter, err := cliFs.OpenFile(destFile, os.O_RDWR|os.O_TRUNC|os.O_CREATE, os.ModePerm)
-	if err != nil {
-		return err
-	}
-
-	_, err = io.Copy(destWriter, sourceReader)
-	return err
+// Copy copies a file or directory on the same host, overwriting existing files
+func (s *Service) Copy(source, dest, hostname string) error {
+	return s.Transfer(context.Background(), TransferOpts{
+		Source:     source,
+		SourceHost: hostname,
+		Dest:       dest,
+		DestHost:   hostname,
+		OnConflict: ConflictOverwrite,
+	}, nil)
 }
 
 func (s *Service) Exists(filename string, hostname string) error {
@@ -201,222 +202,142 @@ func (s *Service) Delete(filename string, hostname string) error {
 	return sfCli.RemoveAll(fullpath)
 }
 
-// Rename todo refactor this
+// Rename moves a file or directory, across aliases the contents are moved with Transfer.
+// It fails with fs.ErrExist if something already exists at newFilename
 func (s *Service) Rename(oldFileName, newFilename, hostname string) error {
-	cliFs, oldFullPath, _, err := s.LoadFs(oldFileName, hostname)
+	cliFs, oldFullPath, oldAlias, err := s.LoadFs(oldFileName, hostname)
 	if err != nil {
 		return err
 	}
 
-	_, newFullPath, _, err := s.LoadFs(newFilename, hostname)
+	newFs, newFullPath, newAlias, err := s.LoadFs(newFilename, hostname)
 	if err != nil {
 		return err
 	}
 
+	if dstStat, err := newFs.Stat(newFullPath); err == nil {
+		// changing only the case of a name on a case-insensitive filesystem
+		srcStat, srcErr := cliFs.Stat(oldFullPath)
+		if oldAlias != newAlias || srcErr != nil || !os.SameFile(srcStat, dstStat) {
+			return fmt.Errorf("%s: %w", newFilename, fs.ErrExist)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if oldAlias != newAlias {
+		return s.Transfer(context.Background(), TransferOpts{
+			Source:     oldFileName,
+			SourceHost: hostname,
+			Dest:       newFilename,
+			DestHost:   hostname,
+			Move:       true,
+			OnConflict: ConflictSkip,
+		}, nil)
+	}
+
 	oldFileName = filepath.ToSlash(oldFullPath)
 	newFilename = filepath.ToSlash(newFullPath)
 
 	return cliFs.Rename(oldFileName, newFilename)
 }
 
-type Template struct {
-	name string
-	vars map[string]string
+// Precondition the version of a file an editor started from,
+// a save is rejected if the file no longer matches it
+type Precondition struct {
+	// Hash sha256 of the contents as returned by HashContents, checked if set
+	Hash string
+	// ModTime checked if set and Hash is empty, to the second
+	// since that is all sftp and http dates keep
+	ModTime time.Time
 }
 
-const TemplateFolder = "templates"
-
-func (s *Service) WriteTemplate(hostname string, dest string, tpl *Template) error {
-	fsCli, rel, _, err := s.LoadFs(tpl.name, hostname)
-	if err != nil {
-		return err
-	}
-	contents, err := fsCli.ReadFile(rel)
-	if err != nil {
-		return err
-	}
-
-	destFsCli, destRel, _, err := s.LoadFs(dest, hostname)
-	if err != nil {
-		return err
-	}
-
-	const selfName = "sd"
-	vf := template.New(selfName).Funcs(s.getTmplFuncs())
-	tmpl, err := vf.Parse(string(contents))
-	if err != nil {
-		return err
-	}
-
-	for _, subTmpl := range tmpl.Templates() {
-		filename := subTmpl.Name()
-		if filename == selfName {
-			continue
-		}
+var ErrConflict = errors.New("file was changed since it was loaded")
 
-		vars := parseFilename(filename)
-		newFilename := filename
-		for _, replaceVal := range vars {
-			newVal := tpl.vars[replaceVal]
-			if newVal == "" {
-				return fmt.Errorf("template variable %s is missing",
-					replaceVal,
-				)
-			}
-			newFilename = strings.Replace(filename, replaceVal, newVal, -1)
-		}
+// ConflictError the current state of a file that failed its Precondition
+type ConflictError struct {
+	Current []byte
+	Hash    string
+	ModTime time.Time
+}
 
-		err := s.createTmplFile(
-			tmpl,
-			tpl.vars,
-			destFsCli,
-			destRel,
-			filename,
-			newFilename,
-		)
-		if err != nil {
-			return err
-		}
-	}
+func (c *ConflictError) Error() string {
+	return ErrConflict.Error()
+}
 
-	return nil
+func (c *ConflictError) Unwrap() error {
+	return ErrConflict
 }
 
-func (s *Service) createTmplFile(
-	tmpl *template.Template,
-	data map[string]string,
-	destFsCli filesystem.FileSystem,
-	destRel string,
-	tmplName string,
-	filename string,
-) error {
-	fpath := destFsCli.Join(destRel, filename)
-
-	err := destFsCli.MkdirAll(filepath.Dir(fpath), os.ModePerm)
-	if err != nil {
-		return err
-	}
+func HashContents(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
 
-	file, err := destFsCli.OpenFile(fpath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, os.ModePerm)
+// Save writes source to filename through a temp file, so the file is replaced
+// in one step. If pre is set the save fails with a *ConflictError when the file changed
+func (s *Service) Save(filename, hostname string, create bool, source io.Reader, pre *Precondition) error {
+	sfCli, relpath, alias, err := s.LoadFs(filename, hostname)
 	if err != nil {
 		return err
 	}
-	defer fileutil.Close(file)
-
-	return tmpl.ExecuteTemplate(file, tmplName, data)
-}
 
-func parseFilename(filename string) []string {
-	var vars []string
-
-	acc := ""
-	for _, ch := range filename {
-		if ch == '$' {
-			if acc != "" {
-				acc = acc + string(ch) // add the last $
-				vars = append(vars, acc)
-				acc = ""
-				continue
-			}
+	// the check and the write must not interleave with another save of the same file
+	lock := s.saveLock(hostname, alias, relpath)
+	lock.Lock()
+	defer lock.Unlock()
 
-			acc = acc + string(ch)
-			continue
+	stat, err := sfCli.Stat(relpath)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) || !create {
+			return err
 		}
-		if acc != "" {
-			acc = acc + string(ch)
+	} else if pre != nil {
+		if err = checkPrecondition(sfCli, relpath, stat.ModTime(), pre); err != nil {
+			return err
 		}
 	}
 
-	return vars
-}
-
-// custom functions for now
-func (s *Service) getTmplFuncs() template.FuncMap {
-	return template.FuncMap{}
-}
-
-func (s *Service) GetTemplates(fPath string, hostname string) ([]Template, error) {
-	fsCli, rel, parsedAlias, err := s.LoadFs(fPath, hostname)
-	if err != nil {
-		return nil, err
+	if s.onSave == nil {
+		return filesystem.WriteAtomic(sfCli, relpath, source, 0644)
 	}
-	// always root it to alias we dont need to check sub paths
-	rel = ""
 
-	templateBase := fsCli.Join(rel, TemplateFolder)
-	templates, err := fsCli.ReadDir(templateBase)
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return nil, nil
-		}
-		return nil, err
+	var written bytes.Buffer
+	if err = filesystem.WriteAtomic(sfCli, relpath, io.TeeReader(source, &written), 0644); err != nil {
+		return err
 	}
+	s.onSave(hostname, alias, relpath, written.Bytes())
+	return nil
+}
 
-	var tmpls []Template
-
-	for _, tpl := range templates {
-		relBase := fsCli.Join(templateBase, tpl.Name())
-		content, err := fsCli.ReadFile(relBase)
-		if err != nil {
-			return nil, err
-		}
-
-		trees, err := parse.Parse(
-			"stack", string(content),
-			"", "",
-			s.getTmplFuncs(),
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		name := filepath.Join(parsedAlias, relBase)
-		elems := Template{
-			name: name,
-			vars: make(map[string]string),
-		}
-
-		for key := range trees {
-			vars := parseFilename(key)
-			for _, v := range vars {
-				elems.vars[v] = ""
-			}
-
-			tree := trees[key]
-			// get var names in tmpl
-			for _, node := range tree.Root.Nodes {
-				if action, ok := node.(*parse.ActionNode); ok {
-					cleanKey := strings.TrimPrefix(action.Pipe.String(), ".")
-					elems.vars[cleanKey] = ""
-				}
-			}
-		}
-
-		tmpls = append(tmpls, elems)
-	}
+const saveLockCount = 64
 
-	return tmpls, nil
+// saveLock returns the lock of a file, different spellings of the same path share one
+func (s *Service) saveLock(hostname, alias, relpath string) *sync.Mutex {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(hostname + "/" + alias + "/" + path.Clean(filepath.ToSlash(relpath))))
+	return &s.saveLocks[h.Sum32()%saveLockCount]
 }
 
-func (s *Service) Save(filename, hostname string, create bool, source io.Reader) error {
-	sfCli, filename, _, err := s.LoadFs(filename, hostname)
+func checkPrecondition(fsCli filesystem.FileSystem, relpath string, modTime time.Time, pre *Precondition) error {
+	current, err := fsCli.ReadFile(relpath)
 	if err != nil {
 		return err
 	}
+	hash := HashContents(current)
 
-	flag := os.O_RDWR | os.O_TRUNC
-	if create {
-		flag |= os.O_CREATE
+	changed := false
+	switch {
+	case pre.Hash != "":
+		changed = pre.Hash != hash
+	case !pre.ModTime.IsZero():
+		changed = !pre.ModTime.Truncate(time.Second).Equal(modTime.Truncate(time.Second))
 	}
-
-	dest, err := sfCli.OpenFile(filename, flag, os.ModePerm)
-	if err != nil {
-		return err
+	if !changed {
+		return nil
 	}
-	defer fileutil.Close(dest)
 
-	_, err = io.Copy(dest, source)
-	return err
+	return &ConflictError{Current: current, Hash: hash, ModTime: modTime}
 }
 
 func (s *Service) getFileContents(filename, hostname string) ([]byte, error) {
@@ -445,11 +366,10 @@ func (s *Service) LoadFilePath(filename, hostname string, download bool) (io.Rea
 		}
 
 		if stat.IsDir() {
-			// convert to zip
-			// todo
-		} else {
-			return cliFs.LoadFile(relpath)
+			// folders are downloaded with Archive
+			return nil, time.Time{}, ErrIsDir
 		}
+		return cliFs.LoadFile(relpath)
 	}
 
 	file, t, err := cliFs.LoadFile(relpath)
@@ -542,13 +462,7 @@ func (s *Service) Format(filename string, hostname string) ([]byte, error) {
 		return nil, fmt.Errorf("unable to read file %w", err)
 	}
 
-	ext := filepath.Ext(filename)
-	formatter, ok := availableFormatters[ext]
-	if ok {
-		return formatter(contents)
-	}
-
-	return contents, nil
+	return FormatContents(filename, contents)
 }
 
 type SearchResult struct {
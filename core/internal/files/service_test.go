@@ -1,13 +1,19 @@
 package files
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
 	"fmt"
+	"io/fs"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/RA341/dockman/internal/dockyaml"
 	"github.com/RA341/dockman/internal/host/filesystem"
 	"github.com/stretchr/testify/require"
 )
@@ -28,31 +34,97 @@ func TestList(t *testing.T) {
 	//t.Log(list)
 }
 
-func TestTemplateRead(t *testing.T) {
-	root := "./tmp/compose"
-	root, err := filepath.Abs(root)
+func TestTemplates(t *testing.T) {
+	root := t.TempDir()
+	conf := dockyaml.DockmanYaml{Templates: dockyaml.TemplatesConfig{
+		Folder: "templates",
+		Sources: []dockyaml.TemplateSource{
+			{Name: "shared", Host: "nas", Alias: "stacks", Path: "gallery"},
+		},
+	}}
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(filepath.Join(root, host, alias)), nil
+	}, func(string) *dockyaml.DockmanYaml { return &conf }, t.TempDir(), nil)
+
+	write := func(name, contents string) {
+		full := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+		require.NoError(t, os.WriteFile(full, []byte(contents), 0644))
+	}
+	write("local/compose/templates/single", `{{define "$stack$/compose.yaml"}}image: {{.image}}{{end}}`)
+	write("nas/stacks/gallery/postgres/template.yaml", `
+name: Postgres
+vars:
+  - name: stack
+    required: true
+    pattern: "^[a-z]+$"
+  - name: port
+    type: int
+    default: "5432"
+  - name: POSTGRES_PASSWORD
+    secret: true
+    generate: true
+hooks:
+  - type: env
+    vars: [POSTGRES_PASSWORD]
+  - type: mkdir
+    paths: [data]
+`)
+	write("nas/stacks/gallery/postgres/compose.yaml", "ports:\n  - {{.port}}:5432\n{{if eq .tls \"yes\"}}tls: true\n{{end}}{{if ne .port 5432}}# custom port\n{{end}}")
+
+	tmpls, err := srv.GetTemplates("compose", "local", false)
 	require.NoError(t, err)
+	require.Len(t, tmpls, 2)
+	require.Equal(t, "compose/templates/single", tmpls[0].Name)
+	require.ElementsMatch(t, []string{"stack", "image"}, []string{tmpls[0].Vars[0].Name, tmpls[0].Vars[1].Name})
 
-	lfs := filesystem.NewLocal(root)
+	pg := tmpls[1]
+	require.Equal(t, "shared", pg.Source)
+	require.Equal(t, "Postgres", pg.Title)
+	require.Equal(t, []string{"compose.yaml"}, pg.Files)
+	// tls is used by compose.yaml but not declared
+	require.Equal(t, "tls", pg.Vars[len(pg.Vars)-1].Name)
 
-	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
-		return lfs, nil
-	}, nil)
+	ref := TemplateRef{Source: "shared", Name: "postgres"}
+	_, err = srv.PreviewTemplate("local", "compose/db", ref, map[string]string{"stack": "DB", "port": "x"})
+	require.ErrorContains(t, err, "stack must match")
+	require.ErrorContains(t, err, "port must be a number")
 
-	tmpls, err := srv.GetTemplates("compose", "test")
+	preview, err := srv.PreviewTemplate("local", "compose/db", ref, map[string]string{"stack": "db", "POSTGRES_PASSWORD": "hunter2"})
 	require.NoError(t, err)
+	require.Len(t, preview.Files, 2)
+	require.Equal(t, "compose/db/compose.yaml", preview.Files[0].Path)
+	require.Equal(t, "ports:\n  - 5432:5432\n", string(preview.Files[0].Contents))
 
-	for _, tmpl := range tmpls {
-		for ke := range tmpl.vars {
-			delete(tmpl.vars, ke)
-			prefix := strings.TrimPrefix(ke, ".")
-			tmpl.vars[prefix] = ".dyn" + ke
-		}
+	// builtins like eq work in templates
+	preview, err = srv.PreviewTemplate("local", "compose/db", ref, map[string]string{"stack": "db", "port": "6543", "tls": "yes"})
+	require.NoError(t, err)
+	require.Equal(t, "ports:\n  - 6543:5432\ntls: true\n# custom port\n", string(preview.Files[0].Contents))
+	require.Equal(t, "POSTGRES_PASSWORD=********\n", string(preview.Files[1].Contents))
+	require.Equal(t, []string{"compose/db/data"}, preview.Dirs)
 
-		err := srv.WriteTemplate("test", "compose/base", &tmpl)
-		require.NoError(t, err)
-		break
-	}
+	written, err := srv.WriteTemplate("local", "compose/db", ref, map[string]string{"stack": "db", "tls": "yes"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"compose/db/compose.yaml", "compose/db/.env"}, written)
+	env, err := os.ReadFile(filepath.Join(root, "local/compose/db/.env"))
+	require.NoError(t, err)
+	require.Regexp(t, "^POSTGRES_PASSWORD=[A-Z2-7]{26}\n$", string(env))
+	require.DirExists(t, filepath.Join(root, "local/compose/db/data"))
+
+	_, err = srv.WriteTemplate("local", "compose/db", ref, map[string]string{"stack": "db"}, false)
+	require.ErrorContains(t, err, "already exist")
+
+	single := TemplateRef{Name: "compose/templates/single"}
+	_, err = srv.WriteTemplate("local", "compose", single, map[string]string{"$stack$": "../escape", "image": "nginx"}, false)
+	require.ErrorContains(t, err, "outside the destination")
+	_, err = srv.WriteTemplate("local", "compose", single, map[string]string{"$stack$": "web", "image": "nginx"}, false)
+	require.NoError(t, err)
+	contents, err := os.ReadFile(filepath.Join(root, "local/compose/web/compose.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "image: nginx", string(contents))
+
+	_, err = srv.PreviewTemplate("local", "compose", TemplateRef{Name: "compose/web/compose.yaml"}, nil)
+	require.ErrorContains(t, err, "not in the templates folder")
 }
 
 func CreateRandomDirStructure(rootDir string, maxDepth int) (string, error) {
@@ -88,3 +160,403 @@ func CreateRandomDirStructure(rootDir string, maxDepth int) (string, error) {
 
 	return rootDir, nil
 }
+
+func TestTransfer(t *testing.T) {
+	roots := map[string]string{
+		"local/compose": t.TempDir(),
+		"remote/stacks": t.TempDir(),
+	}
+	// an alias pointing inside another one
+	roots["local/media"] = filepath.Join(roots["local/compose"], "media")
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		root, ok := roots[host+"/"+alias]
+		if !ok {
+			return nil, fmt.Errorf("unknown alias %s on %s", alias, host)
+		}
+		return filesystem.NewLocal(root), nil
+	}, nil, t.TempDir(), nil)
+
+	write := func(root, name, contents string) {
+		full := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+		require.NoError(t, os.WriteFile(full, []byte(contents), 0644))
+	}
+	write(roots["local/compose"], "media/compose.yaml", "services: {}")
+	write(roots["local/compose"], "media/config/app.conf", "a=b")
+	write(roots["remote/stacks"], "media/compose.yaml", "existing")
+
+	var last TransferProgress
+	var states []TransferState
+	err := srv.Transfer(t.Context(), TransferOpts{
+		Source:     "compose/media",
+		SourceHost: "local",
+		Dest:       "stacks/media",
+		DestHost:   "remote",
+		Move:       true,
+		OnConflict: ConflictRename,
+	}, func(progress TransferProgress) {
+		last = progress
+		states = append(states, progress.State)
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 2, last.FilesTotal)
+	require.Equal(t, 2, last.FilesDone)
+	require.Equal(t, last.BytesTotal, last.BytesDone)
+	require.Contains(t, states, TransferRenamed)
+
+	existing, err := os.ReadFile(filepath.Join(roots["remote/stacks"], "media/compose.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "existing", string(existing))
+	renamed, err := os.ReadFile(filepath.Join(roots["remote/stacks"], "media/compose (1).yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "services: {}", string(renamed))
+	require.FileExists(t, filepath.Join(roots["remote/stacks"], "media/config/app.conf"))
+
+	// moved files and the emptied folders are removed from the source
+	require.NoDirExists(t, filepath.Join(roots["local/compose"], "media"))
+
+	// copying back skips what already exists
+	write(roots["local/compose"], "media/compose.yaml", "kept")
+	err = srv.Transfer(t.Context(), TransferOpts{
+		Source:     "stacks/media",
+		SourceHost: "remote",
+		Dest:       "compose/media",
+		DestHost:   "local",
+	}, nil)
+	require.NoError(t, err)
+	kept, err := os.ReadFile(filepath.Join(roots["local/compose"], "media/compose.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "kept", string(kept))
+	require.FileExists(t, filepath.Join(roots["local/compose"], "media/config/app.conf"))
+
+	// a failed overwrite keeps the existing file
+	cancelled, cancel := context.WithCancel(t.Context())
+	cancel()
+	err = srv.Transfer(cancelled, TransferOpts{
+		Source:     "stacks/media/compose.yaml",
+		SourceHost: "remote",
+		Dest:       "compose/media/compose.yaml",
+		DestHost:   "local",
+		OnConflict: ConflictOverwrite,
+	}, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	kept, err = os.ReadFile(filepath.Join(roots["local/compose"], "media/compose.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "kept", string(kept))
+	entries, err := os.ReadDir(filepath.Join(roots["local/compose"], "media"))
+	require.NoError(t, err)
+	require.Len(t, entries, 3, "no temp file should be left behind")
+
+	err = srv.Copy("compose/media", "compose/media/nested", "local")
+	require.ErrorContains(t, err, "into itself")
+
+	err = srv.Transfer(t.Context(), TransferOpts{
+		Source:     "compose/media/compose.yaml",
+		SourceHost: "local",
+		Dest:       "media/compose.yaml",
+		DestHost:   "local",
+		Move:       true,
+		OnConflict: ConflictOverwrite,
+	}, nil)
+	require.ErrorContains(t, err, "into itself")
+	kept, err = os.ReadFile(filepath.Join(roots["local/compose"], "media/compose.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "kept", string(kept))
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	root := t.TempDir()
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(filepath.Join(root, alias)), nil
+	}, nil, t.TempDir(), nil)
+
+	src := filepath.Join(root, "compose", "media")
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "config"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "compose.yaml"), []byte("services: {}"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "config", "app.conf"), []byte("a=b"), 0644))
+
+	for _, format := range []ArchiveFormat{ArchiveTarGz, ArchiveZip} {
+		var buf bytes.Buffer
+		require.NoError(t, srv.Archive("compose/media", "local", format, &buf))
+
+		dest := "backup/" + string(format)
+		require.NoError(t, srv.Extract(dest, "local", format, &buf))
+
+		contents, err := os.ReadFile(filepath.Join(root, dest, "media", "config", "app.conf"))
+		require.NoError(t, err)
+		require.Equal(t, "a=b", string(contents))
+		require.FileExists(t, filepath.Join(root, dest, "media", "compose.yaml"))
+	}
+}
+
+func TestExtractPathTraversal(t *testing.T) {
+	for _, name := range []string{"../evil", "a/../../evil", "/etc/passwd", ""} {
+		_, err := extractPath("media", name)
+		require.Error(t, err, name)
+	}
+
+	target, err := extractPath("media", "./config/app.conf")
+	require.NoError(t, err)
+	require.Equal(t, "media/config/app.conf", target)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	_, err = zw.Create("../../outside.txt")
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	root := t.TempDir()
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(filepath.Join(root, alias)), nil
+	}, nil, t.TempDir(), nil)
+	err = srv.Extract("compose/media", "local", ArchiveZip, &buf)
+	require.ErrorContains(t, err, "outside the target folder")
+	require.NoFileExists(t, filepath.Join(root, "outside.txt"))
+}
+
+func TestExtractSymlinkAndSize(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(filepath.Join(root, alias)), nil
+	}, nil, t.TempDir(), nil)
+
+	zipOf := func(name string, contents []byte) *bytes.Buffer {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(contents)
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		return &buf
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "compose", "media"), 0755))
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "compose", "media", "data")))
+	err := srv.Extract("compose/media", "local", ArchiveZip, zipOf("data/evil.txt", []byte("x")))
+	require.ErrorContains(t, err, "is a symlink")
+	require.NoFileExists(t, filepath.Join(outside, "evil.txt"))
+
+	ex := &extractor{fsCli: filesystem.NewLocal(root), dest: "compose", remaining: 4}
+	require.NoError(t, ex.zip(zipOf("small.txt", []byte("abcd"))))
+	ex.remaining = 4
+	require.ErrorContains(t, ex.zip(zipOf("big.txt", bytes.Repeat([]byte("a"), 1024))), "unpacks to more than")
+}
+
+func TestSaveConflict(t *testing.T) {
+	root := t.TempDir()
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(filepath.Join(root, alias)), nil
+	}, nil, t.TempDir(), nil)
+
+	file := filepath.Join(root, "compose", "compose.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+	require.NoError(t, os.WriteFile(file, []byte("v1"), 0640))
+	base := HashContents([]byte("v1"))
+
+	lock := srv.saveLock("local", "compose", "compose.yaml")
+	require.Same(t, lock, srv.saveLock("local", "compose", "./compose.yaml"))
+	require.Same(t, lock, srv.saveLock("local", "compose", "app//../compose.yaml"))
+
+	err := srv.Save("compose/compose.yaml", "local", false, strings.NewReader("v2"), &Precondition{Hash: base})
+	require.NoError(t, err)
+
+	// a second tab still editing v1
+	err = srv.Save("compose/compose.yaml", "local", false, strings.NewReader("v3"), &Precondition{Hash: base})
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+	require.ErrorIs(t, err, ErrConflict)
+	require.Equal(t, "v2", string(conflict.Current))
+	require.Equal(t, HashContents([]byte("v2")), conflict.Hash)
+
+	stat, err := os.Stat(file)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0640), stat.Mode().Perm())
+
+	err = srv.Save("compose/compose.yaml", "local", false, strings.NewReader("v4"), &Precondition{ModTime: stat.ModTime()})
+	require.NoError(t, err)
+
+	contents, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.Equal(t, "v4", string(contents))
+
+	// no temp files left behind
+	entries, err := os.ReadDir(filepath.Dir(file))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestDiffStates(t *testing.T) {
+	at := time.Unix(1700000000, 0)
+	prev := map[string]fileState{
+		"compose":              {isDir: true},
+		"compose/a.yaml":       {size: 1, modTime: at},
+		"compose/b.yaml":       {size: 2, modTime: at},
+		"compose/old":          {isDir: true},
+		"compose/old/c.yaml":   {size: 3, modTime: at},
+		"compose/gone.yaml":    {size: 4, modTime: at},
+		"compose/renamed.yaml": {size: 5, modTime: at},
+	}
+	next := map[string]fileState{
+		"compose":            {isDir: true},
+		"compose/a.yaml":     {size: 1, modTime: at},
+		"compose/b.yaml":     {size: 7, modTime: at.Add(time.Second)},
+		"compose/new":        {isDir: true},
+		"compose/new/c.yaml": {size: 3, modTime: at},
+		"compose/moved.yaml": {size: 5, modTime: at},
+		"compose/d.yaml":     {size: 6, modTime: at},
+	}
+
+	var got []string
+	for _, event := range diffStates(prev, next) {
+		got = append(got, strings.TrimSpace(fmt.Sprintf("%s %s %s", event.Op, event.OldPath, event.Path)))
+	}
+	require.ElementsMatch(t, []string{
+		"modify  compose/b.yaml",
+		"delete  compose/gone.yaml",
+		"rename compose/old compose/new",
+		"rename compose/renamed.yaml compose/moved.yaml",
+		"create  compose/d.yaml",
+	}, got)
+}
+
+func TestWatchLocal(t *testing.T) {
+	root := t.TempDir()
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(filepath.Join(root, alias)), nil
+	}, nil, t.TempDir(), nil)
+
+	dir := filepath.Join(root, "compose", "stack")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "compose.yaml"), []byte("v1"), 0644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan WatchEvent, 16)
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Watch(ctx, WatchOpts{
+			Dirs:  []string{"compose/stack"},
+			Files: []string{"compose/stack/compose.yaml"},
+			Host:  "local",
+		}, func(batch []WatchEvent) error {
+			for _, event := range batch {
+				events <- event
+			}
+			return nil
+		})
+	}()
+
+	next := func() WatchEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event received")
+		}
+		return WatchEvent{}
+	}
+
+	// give the watcher time to scan before writing
+	time.Sleep(200 * time.Millisecond)
+	require.NoError(t, srv.Save("compose/stack/compose.yaml", "local", false, strings.NewReader("v2"), nil))
+	event := next()
+	require.Equal(t, WatchModify, event.Op)
+	require.Equal(t, "compose/stack/compose.yaml", event.Path)
+	require.Equal(t, HashContents([]byte("v2")), event.Hash)
+
+	require.NoError(t, os.Rename(filepath.Join(dir, "compose.yaml"), filepath.Join(dir, "compose.yml")))
+	event = next()
+	require.Equal(t, WatchRename, event.Op)
+	require.Equal(t, "compose/stack/compose.yaml", event.OldPath)
+	require.Equal(t, "compose/stack/compose.yml", event.Path)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestSearchContents(t *testing.T) {
+	root := t.TempDir()
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(filepath.Join(root, alias)), nil
+	}, nil, t.TempDir(), nil)
+
+	write := func(name, contents string) {
+		full := filepath.Join(root, "compose", name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+		require.NoError(t, os.WriteFile(full, []byte(contents), 0644))
+	}
+	write("db/compose.yaml", "services:\n  db:\n    image: postgres:16\n    environment:\n      POSTGRES_PASSWORD: ${POSTGRES_PASSWORD}\n")
+	write("db/.env", "POSTGRES_PASSWORD=hunter2\n")
+	write("app/compose.yaml", "services:\n  app:\n    image: ghcr.io/app:latest\n")
+	write("app/logo.png", "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR POSTGRES_PASSWORD")
+	write(".git/config", "POSTGRES_PASSWORD\n")
+
+	search := func(opts ContentSearchOpts) map[string][]LineMatch {
+		opts.Root = "compose"
+		res := map[string][]LineMatch{}
+		err := srv.SearchContents(context.Background(), opts, "local", func(file FileMatches) error {
+			res[file.File] = file.Matches
+			return nil
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	res := search(ContentSearchOpts{Query: "postgres_password", ContextLines: 1})
+	require.Len(t, res, 2)
+	require.Len(t, res["compose/db/compose.yaml"], 2)
+	match := res["compose/db/compose.yaml"][0]
+	require.Equal(t, 5, match.Line)
+	require.Equal(t, 7, match.Column)
+	require.Equal(t, len("POSTGRES_PASSWORD"), match.Length)
+	require.Equal(t, []string{"    environment:"}, match.Before)
+
+	res = search(ContentSearchOpts{Query: "postgres_password", CaseSensitive: true})
+	require.Empty(t, res)
+
+	res = search(ContentSearchOpts{Query: `image: \S+:latest`, Regex: true, Include: []string{"**/compose.yaml"}})
+	require.Len(t, res, 1)
+	require.Contains(t, res, "compose/app/compose.yaml")
+
+	res = search(ContentSearchOpts{Query: "POSTGRES", Exclude: []string{".env"}})
+	require.Len(t, res, 1)
+
+	err := srv.SearchContents(context.Background(), ContentSearchOpts{Root: "compose", Query: "POSTGRES", MaxMatches: 1}, "local",
+		func(FileMatches) error { return nil })
+	require.ErrorIs(t, err, ErrSearchLimit)
+}
+
+func TestRenameExisting(t *testing.T) {
+	root := t.TempDir()
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(filepath.Join(root, alias)), nil
+	}, nil, t.TempDir(), nil)
+
+	write := func(name, contents string) {
+		full := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+		require.NoError(t, os.WriteFile(full, []byte(contents), 0644))
+	}
+	write("compose/app/compose.yaml", "app")
+	write("compose/web/compose.yaml", "web")
+	write("stacks/app/compose.yaml", "other")
+
+	err := srv.Rename("compose/app/compose.yaml", "compose/web/compose.yaml", "local")
+	require.ErrorIs(t, err, fs.ErrExist)
+	err = srv.Rename("compose/app", "stacks/app", "local")
+	require.ErrorIs(t, err, fs.ErrExist)
+
+	contents, err := os.ReadFile(filepath.Join(root, "compose/web/compose.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "web", string(contents))
+	contents, err = os.ReadFile(filepath.Join(root, "stacks/app/compose.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "other", string(contents))
+
+	require.NoError(t, srv.Rename("compose/app", "stacks/moved", "local"))
+	require.FileExists(t, filepath.Join(root, "stacks/moved/compose.yaml"))
+	require.NoDirExists(t, filepath.Join(root, "compose/app"))
+}
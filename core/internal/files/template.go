@@ -0,0 +1,889 @@
+package files
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/RA341/dockman/internal/dockyaml"
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/goccy/go-yaml"
+	"github.com/rs/zerolog/log"
+)
+
+// Template a stack template, either a folder holding the files of the stack
+// and an optional template.yaml describing it, or a single file made of
+// {{define "<filename>"}} blocks. Filenames can use vars as $name$
+type Template struct {
+	// Source name of the configured source, empty for the templates folder of an alias
+	Source string
+	// Name of the template in its source, for the templates
+	// folder of an alias this is its <alias>/<relpath>
+	Name string
+	TemplateMeta
+	// Files written by the template relative to the destination, before vars are filled in
+	Files []string
+
+	// single a template made of define blocks in one file
+	single bool
+}
+
+// TemplateMeta the contents of template.yaml
+type TemplateMeta struct {
+	// Title shown in the gallery, defaults to the folder name
+	Title       string         `yaml:"name"`
+	Description string         `yaml:"description"`
+	Vars        []TemplateVar  `yaml:"vars"`
+	Hooks       []TemplateHook `yaml:"hooks"`
+	// Raw globs of files copied as is, for files that use {{ }} themselves
+	Raw []string `yaml:"raw"`
+}
+
+type TemplateVarType string
+
+const (
+	VarString TemplateVarType = "string"
+	VarInt    TemplateVarType = "int"
+	VarBool   TemplateVarType = "bool"
+	// VarEnum only allows one of Options
+	VarEnum TemplateVarType = "enum"
+)
+
+type TemplateVar struct {
+	Name        string          `yaml:"name"`
+	Description string          `yaml:"description"`
+	Type        TemplateVarType `yaml:"type"`
+	Default     string          `yaml:"default"`
+	Required    bool            `yaml:"required"`
+	// Pattern regex the value must match
+	Pattern string   `yaml:"pattern"`
+	Options []string `yaml:"options"`
+	// Secret values are masked in previews and written to env files with 0600
+	Secret bool `yaml:"secret"`
+	// Generate a random value if none is given
+	Generate bool `yaml:"generate"`
+}
+
+type TemplateHookType string
+
+const (
+	// HookEnv writes Vars to File as KEY=value, File defaults to .env
+	HookEnv TemplateHookType = "env"
+	// HookMkdir creates Paths, such as data folders mounted by the stack
+	HookMkdir TemplateHookType = "mkdir"
+)
+
+// TemplateHook runs after the template files are written, paths are relative
+// to the destination and can use vars as $name$
+type TemplateHook struct {
+	Type  TemplateHookType `yaml:"type"`
+	File  string           `yaml:"file"`
+	Vars  []string         `yaml:"vars"`
+	Paths []string         `yaml:"paths"`
+}
+
+// TemplateRef identifies a template, see Template
+type TemplateRef struct {
+	Source string
+	Name   string
+}
+
+type TemplatePreview struct {
+	Files []PreviewFile
+	// Dirs created by mkdir hooks, <alias>/<relpath>
+	Dirs []string
+}
+
+type PreviewFile struct {
+	// Path <alias>/<relpath>
+	Path string
+	// Contents with secret values masked
+	Contents []byte
+	// Exists the write fails unless overwrite is set
+	Exists bool
+	Binary bool
+}
+
+const templateMetaFile = "template.yaml"
+
+// GetTemplates lists the templates in the templates folder of alias and the
+// configured sources, a source that fails to load is skipped.
+// Refresh fetches git sources even if the cached clone is recent
+func (s *Service) GetTemplates(alias string, hostname string, refresh bool) ([]Template, error) {
+	conf := s.dockYml(hostname).Templates
+
+	fsCli, _, parsedAlias, err := s.LoadFs(alias, hostname)
+	if err != nil {
+		return nil, err
+	}
+	// always rooted at the alias, sub paths do not have their own templates
+	tmpls, err := listTemplates(fsCli, conf.Folder)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tmpls {
+		tmpls[i].Name = path.Join(parsedAlias, conf.Folder, tmpls[i].Name)
+	}
+
+	for _, src := range conf.Sources {
+		srcFs, root, err := s.templateSource(src, hostname, refresh)
+		if err != nil {
+			log.Warn().Err(err).Str("source", src.Name).Msg("unable to load template source")
+			continue
+		}
+		found, err := listTemplates(srcFs, root)
+		if err != nil {
+			log.Warn().Err(err).Str("source", src.Name).Msg("unable to list templates")
+			continue
+		}
+		for i := range found {
+			found[i].Source = src.Name
+		}
+		tmpls = append(tmpls, found...)
+	}
+
+	return tmpls, nil
+}
+
+// PreviewTemplate renders a template without writing it
+func (s *Service) PreviewTemplate(hostname, dest string, ref TemplateRef, values map[string]string) (*TemplatePreview, error) {
+	out, destFs, destRel, destDisplay, err := s.renderTemplateTo(hostname, dest, ref, values)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &TemplatePreview{}
+	for _, file := range out.files {
+		_, statErr := destFs.Stat(joinRel(destRel, file.path))
+		preview.Files = append(preview.Files, PreviewFile{
+			Path:     path.Join(destDisplay, file.path),
+			Contents: out.mask(file.contents),
+			Exists:   statErr == nil,
+			Binary:   file.binary,
+		})
+	}
+	for _, dir := range out.dirs {
+		preview.Dirs = append(preview.Dirs, path.Join(destDisplay, dir))
+	}
+	return preview, nil
+}
+
+// WriteTemplate renders a template into dest and runs its hooks, returns the
+// written files. Existing files are only replaced if overwrite is set
+func (s *Service) WriteTemplate(hostname, dest string, ref TemplateRef, values map[string]string, overwrite bool) ([]string, error) {
+	out, destFs, destRel, destDisplay, err := s.renderTemplateTo(hostname, dest, ref, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if !overwrite {
+		var existing []string
+		for _, file := range out.files {
+			if _, err = destFs.Stat(joinRel(destRel, file.path)); err == nil {
+				existing = append(existing, file.path)
+			}
+		}
+		if len(existing) > 0 {
+			return nil, fmt.Errorf("files already exist: %s", strings.Join(existing, ", "))
+		}
+	}
+
+	var written []string
+	for _, file := range out.files {
+		target := joinRel(destRel, file.path)
+		if err = destFs.MkdirAll(path.Dir(target), 0755); err != nil {
+			return written, err
+		}
+		if err = filesystem.WriteAtomic(destFs, target, bytes.NewReader(file.contents), file.perm); err != nil {
+			return written, fmt.Errorf("unable to write %s: %w", file.path, err)
+		}
+		written = append(written, path.Join(destDisplay, file.path))
+	}
+	for _, dir := range out.dirs {
+		if err = destFs.MkdirAll(joinRel(destRel, dir), 0755); err != nil {
+			return written, fmt.Errorf("unable to create %s: %w", dir, err)
+		}
+	}
+	return written, nil
+}
+
+func (s *Service) renderTemplateTo(hostname, dest string, ref TemplateRef, values map[string]string) (
+	out *templateOutput,
+	destFs filesystem.FileSystem,
+	destRel string,
+	destDisplay string,
+	err error,
+) {
+	srcFs, rel, err := s.templatePath(ref, hostname)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+	tpl, err := readTemplate(srcFs, rel)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+	out, err = renderTemplate(srcFs, rel, tpl, values)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+
+	destFs, destRel, _, err = s.LoadFs(dest, hostname)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+	return out, destFs, filepath.ToSlash(destRel), path.Clean(filepath.ToSlash(dest)), nil
+}
+
+// templatePath returns where the template ref points to
+func (s *Service) templatePath(ref TemplateRef, hostname string) (filesystem.FileSystem, string, error) {
+	conf := s.dockYml(hostname).Templates
+
+	if ref.Source == "" {
+		fsCli, rel, _, err := s.LoadFs(ref.Name, hostname)
+		if err != nil {
+			return nil, "", err
+		}
+		if rel = filepath.ToSlash(rel); !isWithin(rel, conf.Folder) || path.Clean(rel) == path.Clean(conf.Folder) {
+			return nil, "", fmt.Errorf("%s is not in the %s folder", ref.Name, conf.Folder)
+		}
+		return fsCli, rel, nil
+	}
+
+	idx := slices.IndexFunc(conf.Sources, func(src dockyaml.TemplateSource) bool {
+		return src.Name == ref.Source
+	})
+	if idx == -1 {
+		return nil, "", fmt.Errorf("unknown template source %q", ref.Source)
+	}
+	if !filepath.IsLocal(filepath.FromSlash(ref.Name)) {
+		return nil, "", fmt.Errorf("invalid template name %q", ref.Name)
+	}
+	srcFs, root, err := s.templateSource(conf.Sources[idx], hostname, false)
+	if err != nil {
+		return nil, "", err
+	}
+	return srcFs, joinRel(root, ref.Name), nil
+}
+
+// templateSource returns the filesystem of a source and the folder holding its templates
+func (s *Service) templateSource(src dockyaml.TemplateSource, hostname string, refresh bool) (filesystem.FileSystem, string, error) {
+	root := path.Clean(filepath.ToSlash(cmp.Or(src.Path, ".")))
+	if !filepath.IsLocal(filepath.FromSlash(root)) {
+		return nil, "", fmt.Errorf("template source %s: path %q must be inside the source", src.Name, src.Path)
+	}
+
+	if src.Git != "" {
+		dir, err := s.fetchTemplateRepo(src, refresh)
+		if err != nil {
+			return nil, "", err
+		}
+		return filesystem.NewLocal(dir), root, nil
+	}
+
+	if src.Alias == "" {
+		return nil, "", fmt.Errorf("template source %s needs either git or alias", src.Name)
+	}
+	fsCli, err := s.Fs(cmp.Or(src.Host, hostname), src.Alias)
+	if err != nil {
+		return nil, "", err
+	}
+	return fsCli, root, nil
+}
+
+// listTemplates reads every template in dir, names are relative to dir
+func listTemplates(fsCli filesystem.FileSystem, dir string) ([]Template, error) {
+	entries, err := fsCli.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var tmpls []Template
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		tpl, err := readTemplate(fsCli, joinRel(filepath.ToSlash(dir), entry.Name()))
+		if err != nil {
+			log.Warn().Err(err).Str("template", entry.Name()).Msg("skipping invalid template")
+			continue
+		}
+		tpl.Name = entry.Name()
+		tmpls = append(tmpls, *tpl)
+	}
+	return tmpls, nil
+}
+
+// readTemplate loads the template at rel, vars used by the files
+// but not declared in template.yaml are added as strings
+func readTemplate(fsCli filesystem.FileSystem, rel string) (*Template, error) {
+	stat, err := fsCli.Stat(rel)
+	if err != nil {
+		return nil, err
+	}
+	tpl := &Template{Name: path.Base(rel)}
+
+	used := map[string]bool{}
+	if !stat.IsDir() {
+		contents, err := fsCli.ReadFile(rel)
+		if err != nil {
+			return nil, err
+		}
+		trees, err := parseTemplate(rel, contents)
+		if err != nil {
+			return nil, err
+		}
+		tpl.single = true
+		for name, tree := range trees {
+			if name == rel {
+				continue
+			}
+			tpl.Files = append(tpl.Files, name)
+			collectVars(tree.Root, used)
+		}
+	} else {
+		if err = readTemplateMeta(fsCli, rel, &tpl.TemplateMeta); err != nil {
+			return nil, err
+		}
+		files, err := templateFiles(fsCli, rel)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			tpl.Files = append(tpl.Files, file)
+			if tpl.isRaw(file) {
+				continue
+			}
+			contents, err := fsCli.ReadFile(joinRel(rel, file))
+			if err != nil {
+				return nil, err
+			}
+			if CheckFileType(bytes.NewReader(contents)) != nil {
+				continue
+			}
+			trees, err := parseTemplate(file, contents)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w, add it to raw in %s if it is not a template", file, err, templateMetaFile)
+			}
+			for _, tree := range trees {
+				collectVars(tree.Root, used)
+			}
+		}
+	}
+	slices.Sort(tpl.Files)
+
+	for _, file := range tpl.Files {
+		for _, name := range parseFilename(file) {
+			used[name] = true
+		}
+	}
+	for _, hook := range tpl.Hooks {
+		for _, p := range append(slices.Clone(hook.Paths), hook.File) {
+			for _, name := range parseFilename(p) {
+				used[name] = true
+			}
+		}
+		for _, name := range hook.Vars {
+			used[name] = true
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(used)) {
+		if !slices.ContainsFunc(tpl.Vars, func(v TemplateVar) bool { return v.Name == name }) {
+			tpl.Vars = append(tpl.Vars, TemplateVar{Name: name, Type: VarString})
+		}
+	}
+	tpl.Title = cmp.Or(tpl.Title, tpl.Name)
+	return tpl, nil
+}
+
+func readTemplateMeta(fsCli filesystem.FileSystem, dir string, meta *TemplateMeta) error {
+	contents, err := fsCli.ReadFile(joinRel(dir, templateMetaFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err = yaml.Unmarshal(contents, meta); err != nil {
+		return fmt.Errorf("invalid %s: %w", templateMetaFile, err)
+	}
+
+	for i, v := range meta.Vars {
+		if v.Name == "" {
+			return fmt.Errorf("invalid %s: var %d has no name", templateMetaFile, i+1)
+		}
+		meta.Vars[i].Type = cmp.Or(v.Type, VarString)
+		switch meta.Vars[i].Type {
+		case VarString, VarInt, VarBool:
+		case VarEnum:
+			if len(v.Options) == 0 {
+				return fmt.Errorf("invalid %s: enum var %s has no options", templateMetaFile, v.Name)
+			}
+		default:
+			return fmt.Errorf("invalid %s: var %s has unknown type %q", templateMetaFile, v.Name, v.Type)
+		}
+		if _, err = regexp.Compile(v.Pattern); err != nil {
+			return fmt.Errorf("invalid %s: var %s: %w", templateMetaFile, v.Name, err)
+		}
+	}
+	for _, hook := range meta.Hooks {
+		if hook.Type != HookEnv && hook.Type != HookMkdir {
+			return fmt.Errorf("invalid %s: unknown hook %q", templateMetaFile, hook.Type)
+		}
+	}
+	return nil
+}
+
+// templateFiles lists the files of a folder template relative to it
+func templateFiles(fsCli filesystem.FileSystem, dir string) ([]string, error) {
+	entries, err := listTransfer(fsCli, dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.isDir || entry.rel == templateMetaFile || strings.HasPrefix(entry.rel, ".git/") {
+			continue
+		}
+		files = append(files, entry.rel)
+	}
+	return files, nil
+}
+
+func (t *Template) isRaw(file string) bool {
+	return matchesGlob(t.Raw, file)
+}
+
+// parseTemplate returns the trees of contents and of the templates it defines,
+// parsed with the same funcs and builtins as when it is rendered
+func parseTemplate(name string, contents []byte) (map[string]*parse.Tree, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs()).Parse(string(contents))
+	if err != nil {
+		return nil, err
+	}
+
+	trees := map[string]*parse.Tree{}
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			trees[t.Name()] = t.Tree
+		}
+	}
+	return trees, nil
+}
+
+// collectVars adds the names of the fields used by node such as .name
+func collectVars(node parse.Node, used map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectVars(child, used)
+		}
+	case *parse.ActionNode:
+		collectVars(n.Pipe, used)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectVars(cmd, used)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectVars(arg, used)
+		}
+	case *parse.FieldNode:
+		used[n.Ident[0]] = true
+	case *parse.IfNode:
+		collectBranch(&n.BranchNode, used)
+	case *parse.RangeNode:
+		collectBranch(&n.BranchNode, used)
+	case *parse.WithNode:
+		collectBranch(&n.BranchNode, used)
+	case *parse.TemplateNode:
+		collectVars(n.Pipe, used)
+	}
+}
+
+func collectBranch(n *parse.BranchNode, used map[string]bool) {
+	collectVars(n.Pipe, used)
+	collectVars(n.List, used)
+	collectVars(n.ElseList, used)
+}
+
+type renderedFile struct {
+	// path relative to the destination
+	path     string
+	contents []byte
+	perm     os.FileMode
+	binary   bool
+}
+
+type templateOutput struct {
+	files []renderedFile
+	dirs  []string
+	// secrets values of secret vars, masked in previews
+	secrets []string
+}
+
+func (o *templateOutput) mask(contents []byte) []byte {
+	for _, secret := range o.secrets {
+		contents = bytes.ReplaceAll(contents, []byte(secret), []byte("********"))
+	}
+	return contents
+}
+
+// renderTemplate fills in the template at rel with values
+func renderTemplate(fsCli filesystem.FileSystem, rel string, tpl *Template, values map[string]string) (*templateOutput, error) {
+	resolved, data, err := resolveVars(tpl.Vars, values)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &templateOutput{}
+	for _, v := range tpl.Vars {
+		if v.Secret && resolved[v.Name] != "" {
+			out.secrets = append(out.secrets, resolved[v.Name])
+		}
+	}
+
+	if tpl.single {
+		err = out.renderSingle(fsCli, rel, tpl, data, resolved)
+	} else {
+		err = out.renderFolder(fsCli, rel, tpl, data, resolved)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hook := range tpl.Hooks {
+		switch hook.Type {
+		case HookEnv:
+			err = out.addEnv(tpl, hook, resolved)
+		case HookMkdir:
+			for _, dir := range hook.Paths {
+				name, err := fillFilename(dir, resolved)
+				if err != nil {
+					return nil, err
+				}
+				out.dirs = append(out.dirs, name)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// renderSingle executes every define block of a single file template
+func (o *templateOutput) renderSingle(fsCli filesystem.FileSystem, rel string, tpl *Template, data map[string]any, values map[string]string) error {
+	contents, err := fsCli.ReadFile(rel)
+	if err != nil {
+		return err
+	}
+	tmpl, err := template.New(rel).Funcs(templateFuncs()).Parse(string(contents))
+	if err != nil {
+		return err
+	}
+	for _, file := range tpl.Files {
+		var buf bytes.Buffer
+		if err = tmpl.ExecuteTemplate(&buf, file, data); err != nil {
+			return err
+		}
+		if err = o.add(file, buf.Bytes(), 0644, false, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderFolder executes every file of a folder template, binary and raw files are copied
+func (o *templateOutput) renderFolder(fsCli filesystem.FileSystem, rel string, tpl *Template, data map[string]any, values map[string]string) error {
+	for _, file := range tpl.Files {
+		contents, err := fsCli.ReadFile(joinRel(rel, file))
+		if err != nil {
+			return err
+		}
+		binary := CheckFileType(bytes.NewReader(contents)) != nil
+		if !binary && !tpl.isRaw(file) {
+			tmpl, err := template.New(file).Funcs(templateFuncs()).Parse(string(contents))
+			if err != nil {
+				return err
+			}
+			var buf bytes.Buffer
+			if err = tmpl.Execute(&buf, data); err != nil {
+				return fmt.Errorf("%s: %w", file, err)
+			}
+			contents = buf.Bytes()
+		}
+		if err = o.add(file, contents, 0644, binary, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *templateOutput) add(file string, contents []byte, perm os.FileMode, binary bool, values map[string]string) error {
+	name, err := fillFilename(file, values)
+	if err != nil {
+		return err
+	}
+	o.files = append(o.files, renderedFile{path: name, contents: contents, perm: perm, binary: binary})
+	return nil
+}
+
+// addEnv writes the hook vars as KEY=value, appended to
+// the env file if the template has one already
+func (o *templateOutput) addEnv(tpl *Template, hook TemplateHook, values map[string]string) error {
+	names := hook.Vars
+	if len(names) == 0 {
+		for _, v := range tpl.Vars {
+			names = append(names, v.Name)
+		}
+	}
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		if !envNamePattern.MatchString(name) {
+			return fmt.Errorf("env hook: %q is not a valid variable name", name)
+		}
+		value, ok := values[name]
+		if !ok {
+			return fmt.Errorf("env hook: unknown var %s", name)
+		}
+		buf.WriteString(name + "=" + quoteEnv(value) + "\n")
+	}
+
+	name, err := fillFilename(cmp.Or(hook.File, ".env"), values)
+	if err != nil {
+		return err
+	}
+	for i, file := range o.files {
+		if file.path == name {
+			contents := slices.Clone(file.contents)
+			if len(contents) > 0 && !bytes.HasSuffix(contents, []byte("\n")) {
+				contents = append(contents, '\n')
+			}
+			o.files[i].contents = append(contents, buf.Bytes()...)
+			o.files[i].perm = 0600
+			return nil
+		}
+	}
+	// env files tend to hold secrets
+	o.files = append(o.files, renderedFile{path: name, contents: buf.Bytes(), perm: 0600})
+	return nil
+}
+
+var (
+	envNamePattern   = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+	plainEnvValue    = regexp.MustCompile(`^[a-zA-Z0-9_./:@+-]*$`)
+	envEscapeReplace = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", "$$")
+)
+
+// quoteEnv quotes value so compose reads it back unchanged
+func quoteEnv(value string) string {
+	if plainEnvValue.MatchString(value) {
+		return value
+	}
+	// single quotes are taken literally
+	if !strings.ContainsAny(value, "'\n") {
+		return "'" + value + "'"
+	}
+	return `"` + envEscapeReplace.Replace(value) + `"`
+}
+
+// resolveVars applies defaults, generates secrets and validates values,
+// returning them as given and typed for the templates
+func resolveVars(vars []TemplateVar, values map[string]string) (map[string]string, map[string]any, error) {
+	resolved := map[string]string{}
+	data := map[string]any{}
+	for key, value := range values {
+		// older clients send filename vars as $name$
+		key = strings.Trim(key, "$")
+		resolved[key] = value
+		data[key] = value
+	}
+
+	var errs []error
+	for _, v := range vars {
+		value := cmp.Or(resolved[v.Name], v.Default)
+		if value == "" && v.Generate {
+			value = rand.Text()
+		}
+		resolved[v.Name] = value
+		data[v.Name] = value
+		if value == "" {
+			if v.Required {
+				errs = append(errs, fmt.Errorf("%s is required", v.Name))
+			}
+			continue
+		}
+
+		switch v.Type {
+		case VarInt:
+			num, err := strconv.Atoi(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s must be a number", v.Name))
+				continue
+			}
+			data[v.Name] = num
+		case VarBool:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s must be true or false", v.Name))
+				continue
+			}
+			data[v.Name] = b
+		case VarEnum:
+			if !slices.Contains(v.Options, value) {
+				errs = append(errs, fmt.Errorf("%s must be one of %s", v.Name, strings.Join(v.Options, ", ")))
+				continue
+			}
+		}
+		if v.Pattern != "" {
+			if ok, _ := regexp.MatchString(v.Pattern, value); !ok {
+				errs = append(errs, fmt.Errorf("%s must match %s", v.Name, v.Pattern))
+			}
+		}
+	}
+	return resolved, data, errors.Join(errs...)
+}
+
+// fillFilename replaces the $name$ vars in filename, the
+// result must stay inside the destination
+func fillFilename(filename string, values map[string]string) (string, error) {
+	for _, name := range parseFilename(filename) {
+		value := values[name]
+		if value == "" {
+			return "", fmt.Errorf("template variable %s is missing", name)
+		}
+		filename = strings.ReplaceAll(filename, "$"+name+"$", value)
+	}
+	if !filepath.IsLocal(filepath.FromSlash(filename)) {
+		return "", fmt.Errorf("%s is outside the destination folder", filename)
+	}
+	return path.Clean(filename), nil
+}
+
+// parseFilename returns the names of the $name$ vars in filename
+func parseFilename(filename string) []string {
+	var vars []string
+	for {
+		start := strings.IndexByte(filename, '$')
+		if start == -1 {
+			return vars
+		}
+		end := strings.IndexByte(filename[start+1:], '$')
+		if end == -1 {
+			return vars
+		}
+		if name := filename[start+1 : start+1+end]; name != "" {
+			vars = append(vars, name)
+		}
+		filename = filename[start+end+2:]
+	}
+}
+
+// custom functions for now
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{}
+}
+
+// templateRefreshInterval how long a clone of a git source is used before it is fetched again
+const templateRefreshInterval = 15 * time.Minute
+
+// fetchTemplateRepo returns a shallow clone of a git source, cloned again once
+// it is older than templateRefreshInterval. If that fails the old clone is kept
+func (s *Service) fetchTemplateRepo(src dockyaml.TemplateSource, refresh bool) (string, error) {
+	if s.templateCache == "" {
+		return "", fmt.Errorf("git template sources are not available")
+	}
+
+	key := HashContents([]byte(src.Git + "#" + src.Ref))[:16]
+	dir := filepath.Join(s.templateCache, key)
+	mu, _ := s.templateLocks.LoadOrStore(key, &sync.Mutex{})
+	mu.Lock()
+	defer mu.Unlock()
+
+	// the mod time of the clone marks the last fetch
+	stat, statErr := os.Stat(dir)
+	if statErr == nil && !refresh && time.Since(stat.ModTime()) < templateRefreshInterval {
+		return dir, nil
+	}
+
+	tmp := dir + ".tmp"
+	err := cloneTemplateRepo(src, tmp)
+	if err != nil {
+		if rmErr := os.RemoveAll(tmp); rmErr != nil {
+			log.Warn().Err(rmErr).Str("dir", tmp).Msg("unable to remove failed clone")
+		}
+		if statErr == nil {
+			log.Warn().Err(err).Str("source", src.Name).Msg("unable to refresh template repo, using the last clone")
+			return dir, nil
+		}
+		return "", fmt.Errorf("unable to clone %s: %w", src.Git, err)
+	}
+
+	if err = os.RemoveAll(dir); err != nil {
+		return "", err
+	}
+	if err = os.Rename(tmp, dir); err != nil {
+		return "", err
+	}
+	now := time.Now()
+	return dir, os.Chtimes(dir, now, now)
+}
+
+func cloneTemplateRepo(src dockyaml.TemplateSource, dir string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	opts := &git.CloneOptions{URL: src.Git, Depth: 1, SingleBranch: true}
+	if src.Ref == "" {
+		_, err := git.PlainCloneContext(ctx, dir, false, opts)
+		return err
+	}
+
+	// ref can be a branch or a tag
+	var err error
+	for _, ref := range []plumbing.ReferenceName{
+		plumbing.NewBranchReferenceName(src.Ref),
+		plumbing.NewTagReferenceName(src.Ref),
+	} {
+		opts.ReferenceName = ref
+		if _, err = git.PlainCloneContext(ctx, dir, false, opts); err == nil {
+			return nil
+		}
+		if rmErr := os.RemoveAll(dir); rmErr != nil {
+			return rmErr
+		}
+	}
+	return err
+}
@@ -0,0 +1,403 @@
+package files
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/rs/zerolog/log"
+)
+
+// ConflictPolicy decides what happens when a file already exists at the destination
+type ConflictPolicy int
+
+const (
+	ConflictSkip ConflictPolicy = iota
+	ConflictOverwrite
+	// ConflictRename writes the file next to the existing one as "name (1).ext"
+	ConflictRename
+)
+
+type TransferState string
+
+const (
+	TransferCopying     TransferState = "copying"
+	TransferCopied      TransferState = "copied"
+	TransferSkipped     TransferState = "skipped"
+	TransferOverwritten TransferState = "overwritten"
+	TransferRenamed     TransferState = "renamed"
+)
+
+// TransferOpts source and dest are <alias>/<relpath>,
+// an empty host means the host of the request
+type TransferOpts struct {
+	Source     string
+	SourceHost string
+	Dest       string
+	DestHost   string
+	// Move removes the source files once they are copied,
+	// skipped files are left in place
+	Move       bool
+	OnConflict ConflictPolicy
+}
+
+type TransferProgress struct {
+	// File being transferred, relative to the source
+	File string
+	// Dest where File is written to, <alias>/<relpath>
+	Dest  string
+	State TransferState
+
+	BytesDone  int64
+	BytesTotal int64
+	FilesDone  int
+	FilesTotal int
+}
+
+type ProgressFunc func(progress TransferProgress)
+
+// report progress every progressInterval bytes while copying a file
+const progressInterval = 1 << 20
+
+// transferEntry a file or directory under the transfer source
+type transferEntry struct {
+	// rel path relative to the source, empty for the source itself
+	rel   string
+	isDir bool
+	size  int64
+	mode  fs.FileMode
+}
+
+// Transfer recursively copies or moves a file or directory, the source and
+// destination can be on different aliases and hosts, in which case the
+// contents are streamed between the two filesystems
+func (s *Service) Transfer(ctx context.Context, opts TransferOpts, progress ProgressFunc) error {
+	if progress == nil {
+		progress = func(TransferProgress) {}
+	}
+
+	srcFs, srcRel, srcAlias, err := s.LoadFs(opts.Source, opts.SourceHost)
+	if err != nil {
+		return err
+	}
+	dstFs, dstRel, dstAlias, err := s.LoadFs(opts.Dest, opts.DestHost)
+	if err != nil {
+		return err
+	}
+
+	sameHost := opts.SourceHost == opts.DestHost
+	// aliases can share or nest folders, so compare where they point to
+	if sameHost && isWithin(resolvedPath(dstFs, dstRel), resolvedPath(srcFs, srcRel)) {
+		return fmt.Errorf("cannot %s %s into itself", transferVerb(opts.Move), opts.Source)
+	}
+	sameFs := sameHost && srcAlias == dstAlias
+
+	entries, err := listTransfer(srcFs, srcRel)
+	if err != nil {
+		return err
+	}
+
+	if sameFs && opts.Move {
+		if _, err = dstFs.Stat(dstRel); errors.Is(err, fs.ErrNotExist) {
+			// nothing to merge with, a rename is all that is needed
+			if err = dstFs.MkdirAll(path.Dir(filepath.ToSlash(dstRel)), 0755); err == nil {
+				if err = srcFs.Rename(srcRel, dstRel); err == nil {
+					progress(movedProgress(opts.Dest, entries))
+					return nil
+				}
+			}
+			log.Debug().Err(err).Str("source", opts.Source).Msg("rename failed, falling back to copy")
+		}
+	}
+
+	t := transfer{
+		ctx:    ctx,
+		opts:   opts,
+		srcFs:  srcFs,
+		dstFs:  dstFs,
+		alias:  dstAlias,
+		report: progress,
+	}
+	for _, entry := range entries {
+		if !entry.isDir {
+			t.progress.BytesTotal += entry.size
+			t.progress.FilesTotal++
+		}
+	}
+
+	var moved []string
+	for _, entry := range entries {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		src := joinRel(srcRel, entry.rel)
+		dst := joinRel(dstRel, entry.rel)
+		if entry.isDir {
+			if err = dstFs.MkdirAll(dst, entry.mode.Perm()|0700); err != nil {
+				return fmt.Errorf("unable to create %s: %w", dst, err)
+			}
+			continue
+		}
+
+		copied, err := t.copyFile(entry, src, dst)
+		if err != nil {
+			return fmt.Errorf("unable to %s %s: %w", transferVerb(opts.Move), joinRel(opts.Source, entry.rel), err)
+		}
+		if copied {
+			moved = append(moved, src)
+		}
+	}
+
+	if opts.Move {
+		return removeMoved(srcFs, srcRel, moved, entries)
+	}
+	return nil
+}
+
+type transfer struct {
+	ctx    context.Context
+	opts   TransferOpts
+	srcFs  filesystem.FileSystem
+	dstFs  filesystem.FileSystem
+	alias  string
+	report ProgressFunc
+
+	progress TransferProgress
+}
+
+// copyFile copies a single file applying the conflict policy,
+// returns false if the file was skipped
+func (t *transfer) copyFile(entry transferEntry, src, dst string) (bool, error) {
+	state := TransferCopied
+	if _, err := t.dstFs.Stat(dst); err == nil {
+		switch t.opts.OnConflict {
+		case ConflictOverwrite:
+			state = TransferOverwritten
+		case ConflictRename:
+			state = TransferRenamed
+			if dst, err = freeName(t.dstFs, dst); err != nil {
+				return false, err
+			}
+		default:
+			t.progress.FilesDone++
+			t.progress.BytesDone += entry.size
+			t.send(entry, dst, TransferSkipped)
+			return false, nil
+		}
+	}
+
+	if err := t.dstFs.MkdirAll(path.Dir(filepath.ToSlash(dst)), 0755); err != nil {
+		return false, err
+	}
+
+	reader, err := t.srcFs.OpenFile(src, os.O_RDONLY, 0)
+	if err != nil {
+		return false, err
+	}
+	defer fileutil.Close(reader)
+
+	t.send(entry, dst, TransferCopying)
+	counter := &progressWriter{w: io.Discard, every: progressInterval, report: func(n int64) {
+		t.progress.BytesDone += n
+		t.send(entry, dst, TransferCopying)
+	}}
+	// written to a temp file first, a failed overwrite keeps the existing file
+	err = filesystem.WriteAtomic(
+		t.dstFs,
+		dst,
+		io.TeeReader(&contextReader{ctx: t.ctx, r: reader}, counter),
+		entry.mode.Perm(),
+	)
+	t.progress.BytesDone += counter.pending
+	if err != nil {
+		return false, err
+	}
+
+	t.progress.FilesDone++
+	t.send(entry, dst, state)
+	return true, nil
+}
+
+func (t *transfer) send(entry transferEntry, dst string, state TransferState) {
+	p := t.progress
+	p.File = entry.rel
+	if p.File == "" {
+		p.File = path.Base(filepath.ToSlash(t.opts.Source))
+	}
+	p.Dest = path.Join(t.alias, filepath.ToSlash(dst))
+	p.State = state
+	t.report(p)
+}
+
+// listTransfer returns root and everything under it, directories before their contents
+func listTransfer(fsCli filesystem.FileSystem, root string) ([]transferEntry, error) {
+	stat, err := fsCli.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []transferEntry{{isDir: stat.IsDir(), size: stat.Size(), mode: stat.Mode()}}
+	if !stat.IsDir() {
+		return entries, nil
+	}
+
+	var walk func(rel string) error
+	walk = func(rel string) error {
+		children, err := fsCli.ReadDir(joinRel(root, rel))
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			info, err := child.Info()
+			if err != nil {
+				return err
+			}
+			if info.Mode()&fs.ModeSymlink != 0 {
+				log.Warn().Str("path", joinRel(root, path.Join(rel, child.Name()))).Msg("skipping symlink")
+				continue
+			}
+
+			childRel := path.Join(rel, child.Name())
+			entries = append(entries, transferEntry{
+				rel:   childRel,
+				isDir: child.IsDir(),
+				size:  info.Size(),
+				mode:  info.Mode(),
+			})
+			if child.IsDir() {
+				if err = walk(childRel); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return entries, walk("")
+}
+
+// removeMoved removes the moved files from the source,
+// along with every directory that is empty afterward
+func removeMoved(fsCli filesystem.FileSystem, root string, moved []string, entries []transferEntry) error {
+	for _, file := range moved {
+		if err := fsCli.RemoveAll(file); err != nil {
+			return fmt.Errorf("copied but unable to remove %s: %w", file, err)
+		}
+	}
+
+	// deepest directories first
+	for _, entry := range slices.Backward(entries) {
+		if !entry.isDir {
+			continue
+		}
+		dir := joinRel(root, entry.rel)
+		children, err := fsCli.ReadDir(dir)
+		if err != nil || len(children) > 0 {
+			continue
+		}
+		if err = fsCli.RemoveAll(dir); err != nil {
+			return fmt.Errorf("copied but unable to remove %s: %w", dir, err)
+		}
+	}
+	return nil
+}
+
+// freeName returns the first "name (n).ext" next to filename that does not exist
+func freeName(fsCli filesystem.FileSystem, filename string) (string, error) {
+	ext := path.Ext(filename)
+	base := strings.TrimSuffix(filename, ext)
+	for i := 1; i < 1000; i++ {
+		candidate := fmt.Sprintf("%s (%d)%s", base, i, ext)
+		if _, err := fsCli.Stat(candidate); errors.Is(err, fs.ErrNotExist) {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("unable to find a free name for %s", filename)
+}
+
+func movedProgress(dest string, entries []transferEntry) TransferProgress {
+	p := TransferProgress{Dest: dest, File: path.Base(dest), State: TransferCopied}
+	for _, entry := range entries {
+		if !entry.isDir {
+			p.FilesTotal++
+			p.BytesTotal += entry.size
+		}
+	}
+	p.FilesDone = p.FilesTotal
+	p.BytesDone = p.BytesTotal
+	return p
+}
+
+// resolvedPath is rel as a full path on the host of fsCli
+func resolvedPath(fsCli filesystem.FileSystem, rel string) string {
+	full := fsCli.Join(fsCli.Root(), rel)
+	if _, ok := fsCli.(*filesystem.LocalFileSystem); ok {
+		if abs, err := filepath.Abs(full); err == nil {
+			return abs
+		}
+	}
+	return full
+}
+
+func joinRel(root, rel string) string {
+	if rel == "" {
+		return root
+	}
+	return path.Join(filepath.ToSlash(root), rel)
+}
+
+// isWithin reports whether child is parent or inside it
+func isWithin(child, parent string) bool {
+	child = path.Clean(filepath.ToSlash(child))
+	parent = path.Clean(filepath.ToSlash(parent))
+	return child == parent || parent == "." || strings.HasPrefix(child, parent+"/")
+}
+
+func transferVerb(move bool) string {
+	if move {
+		return "move"
+	}
+	return "copy"
+}
+
+// progressWriter calls report every time another `every` bytes are written
+type progressWriter struct {
+	w      io.Writer
+	every  int64
+	report func(n int64)
+
+	// pending bytes written since the last report
+	pending int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.pending += int64(n)
+	if p.pending >= p.every {
+		p.report(p.pending)
+		p.pending = 0
+	}
+	return n, err
+}
+
+// contextReader stops a copy once ctx is done
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(b []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(b)
+}
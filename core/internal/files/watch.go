@@ -0,0 +1,464 @@
+package files
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"io/fs"
+	"maps"
+	"path"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog/log"
+)
+
+type WatchOp string
+
+const (
+	WatchCreate WatchOp = "create"
+	WatchModify WatchOp = "modify"
+	WatchDelete WatchOp = "delete"
+	WatchRename WatchOp = "rename"
+)
+
+type WatchEvent struct {
+	Op WatchOp
+	// Path <alias>/<relpath>
+	Path string
+	// OldPath the previous path of a renamed file
+	OldPath string
+	IsDir   bool
+	Size    int64
+	ModTime time.Time
+	// Hash of the contents, only set for watched files
+	Hash string
+}
+
+// WatchOpts dirs are watched recursively, files are single files such as the
+// ones open in the editor, both are <alias>/<relpath>
+type WatchOpts struct {
+	Dirs  []string
+	Files []string
+	Host  string
+}
+
+type WatchFunc func(events []WatchEvent) error
+
+const (
+	// pollInterval how often filesystems without change notifications are rescanned
+	pollInterval = 2 * time.Second
+	// settleDelay batches the burst of notifications a single save causes
+	settleDelay = 150 * time.Millisecond
+)
+
+// folders that are not watched, they change constantly and are not shown in the editor
+var unwatchedDirs = []string{".git", "node_modules"}
+
+// Watch reports changes to opts until ctx is done. Local aliases are watched
+// with fsnotify, others such as sftp are polled with Stat and ReadDir.
+// Both are diffed against the last scan, so they report the same events
+func (s *Service) Watch(ctx context.Context, opts WatchOpts, emit WatchFunc) error {
+	var targets []*watchTarget
+	for _, dir := range opts.Dirs {
+		target, err := s.watchTarget(dir, opts.Host, true)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, target)
+	}
+	for _, file := range opts.Files {
+		target, err := s.watchTarget(file, opts.Host, false)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, target)
+	}
+
+	var notify *fsnotify.Watcher
+	var ticker *time.Ticker
+	for _, target := range targets {
+		target.scan()
+		if !target.local {
+			if ticker == nil {
+				ticker = time.NewTicker(pollInterval)
+				defer ticker.Stop()
+			}
+			continue
+		}
+
+		if notify == nil {
+			var err error
+			if notify, err = fsnotify.NewWatcher(); err != nil {
+				return err
+			}
+			defer func() {
+				if err := notify.Close(); err != nil {
+					log.Warn().Err(err).Msg("unable to close file watcher")
+				}
+			}()
+		}
+		target.syncWatches(notify)
+	}
+
+	// nil channels block forever, so unused backends never fire
+	var notifyEvents <-chan fsnotify.Event
+	var notifyErrors <-chan error
+	if notify != nil {
+		notifyEvents, notifyErrors = notify.Events, notify.Errors
+	}
+	var tick <-chan time.Time
+	if ticker != nil {
+		tick = ticker.C
+	}
+
+	settle := time.NewTimer(settleDelay)
+	settle.Stop()
+	defer settle.Stop()
+
+	for {
+		var rescan []*watchTarget
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-notifyEvents:
+			if !ok {
+				return nil
+			}
+			if filesystem.IsAtomicTemp(event.Name) {
+				continue
+			}
+			for _, target := range targets {
+				if target.local && target.covers(event.Name) {
+					target.dirty = true
+				}
+			}
+			settle.Reset(settleDelay)
+			continue
+		case err, ok := <-notifyErrors:
+			if !ok {
+				return nil
+			}
+			log.Warn().Err(err).Msg("file watcher error")
+			continue
+		case <-settle.C:
+			for _, target := range targets {
+				if target.local && target.dirty {
+					rescan = append(rescan, target)
+				}
+			}
+		case <-tick:
+			for _, target := range targets {
+				if !target.local {
+					rescan = append(rescan, target)
+				}
+			}
+		}
+
+		var events []WatchEvent
+		for _, target := range rescan {
+			events = append(events, target.scan()...)
+			if target.local {
+				target.syncWatches(notify)
+			}
+		}
+		events = dedupeEvents(events)
+		if len(events) == 0 {
+			continue
+		}
+		if err := emit(events); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Service) watchTarget(filename, hostname string, recursive bool) (*watchTarget, error) {
+	fsCli, relpath, _, err := s.LoadFs(filename, hostname)
+	if err != nil {
+		return nil, err
+	}
+	abs, err := fsCli.Abs(relpath)
+	if err != nil {
+		return nil, err
+	}
+	_, local := fsCli.(*filesystem.LocalFileSystem)
+
+	return &watchTarget{
+		fsCli:     fsCli,
+		rel:       relpath,
+		abs:       abs,
+		display:   path.Clean(filepath.ToSlash(filename)),
+		recursive: recursive,
+		local:     local,
+		state:     map[string]fileState{},
+		watched:   map[string]bool{},
+	}, nil
+}
+
+type fileState struct {
+	isDir   bool
+	size    int64
+	modTime time.Time
+}
+
+// watchTarget a watched folder or file and what it looked like on the last scan
+type watchTarget struct {
+	fsCli filesystem.FileSystem
+	rel   string
+	// abs the full path on the host, fsnotify reports these
+	abs       string
+	display   string
+	recursive bool
+	local     bool
+
+	// state keyed by display path
+	state map[string]fileState
+	// dirty a notification arrived since the last scan
+	dirty bool
+	// watched local folders added to fsnotify
+	watched map[string]bool
+}
+
+// scan reads the target again and returns what changed since the last scan
+func (t *watchTarget) scan() []WatchEvent {
+	t.dirty = false
+
+	next := map[string]fileState{}
+	stat, err := t.fsCli.Stat(t.rel)
+	if err == nil {
+		next[t.display] = fileState{isDir: stat.IsDir(), size: stat.Size(), modTime: stat.ModTime()}
+		if stat.IsDir() && t.recursive {
+			t.walk(t.rel, t.display, next)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		// keep the last state, a flaky connection is not a delete
+		log.Debug().Err(err).Str("path", t.display).Msg("unable to scan watched path")
+		return nil
+	}
+
+	events := diffStates(t.state, next)
+	t.state = next
+
+	if !t.recursive {
+		for i, event := range events {
+			if event.Op == WatchCreate || event.Op == WatchModify || event.Op == WatchRename {
+				events[i].Hash = t.hash()
+			}
+		}
+	}
+	return events
+}
+
+func (t *watchTarget) walk(rel, display string, into map[string]fileState) {
+	entries, err := t.fsCli.ReadDir(rel)
+	if err != nil {
+		log.Debug().Err(err).Str("path", display).Msg("unable to read watched folder")
+		return
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if filesystem.IsAtomicTemp(name) || (entry.IsDir() && slices.Contains(unwatchedDirs, name)) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+
+		childRel, childDisplay := joinRel(rel, name), path.Join(display, name)
+		into[childDisplay] = fileState{isDir: entry.IsDir(), size: info.Size(), modTime: info.ModTime()}
+		if entry.IsDir() {
+			t.walk(childRel, childDisplay, into)
+		}
+	}
+}
+
+func (t *watchTarget) hash() string {
+	contents, err := t.fsCli.ReadFile(t.rel)
+	if err != nil {
+		return ""
+	}
+	return HashContents(contents)
+}
+
+// covers reports whether a notification for fullpath concerns this target
+func (t *watchTarget) covers(fullpath string) bool {
+	if t.recursive {
+		return fullpath == t.abs || isWithin(fullpath, t.abs)
+	}
+	return fullpath == t.abs
+}
+
+// syncWatches points fsnotify at the folders seen in the last scan, fsnotify
+// is not recursive. Files are watched through their folder, since saves
+// replace the file and a watch on the old file would stop firing
+func (t *watchTarget) syncWatches(notify *fsnotify.Watcher) {
+	want := map[string]bool{}
+	if t.recursive {
+		for display, state := range t.state {
+			if !state.isDir {
+				continue
+			}
+			rel := joinRel(t.abs, display[len(t.display):])
+			want[rel] = true
+		}
+	}
+	// also catches the target being created or deleted
+	want[filepath.Dir(t.abs)] = true
+
+	for dir := range want {
+		if t.watched[dir] {
+			continue
+		}
+		if err := notify.Add(dir); err != nil {
+			log.Debug().Err(err).Str("path", dir).Msg("unable to watch folder")
+			continue
+		}
+		t.watched[dir] = true
+	}
+	for dir := range t.watched {
+		if !want[dir] {
+			// fsnotify drops watches of deleted folders by itself
+			_ = notify.Remove(dir)
+			delete(t.watched, dir)
+		}
+	}
+}
+
+// diffStates returns the events that turn prev into next, a file that
+// disappeared and one that appeared with the same size and mod time is a rename
+func diffStates(prev, next map[string]fileState) []WatchEvent {
+	var created, deleted []string
+	var events []WatchEvent
+	for _, name := range slices.Sorted(maps.Keys(next)) {
+		now := next[name]
+		before, ok := prev[name]
+		switch {
+		case !ok:
+			created = append(created, name)
+		case before.isDir != now.isDir:
+			deleted = append(deleted, name)
+			created = append(created, name)
+		case !now.isDir && (before.size != now.size || !before.modTime.Equal(now.modTime)):
+			events = append(events, stateEvent(WatchModify, name, now))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(prev)) {
+		if _, ok := next[name]; !ok {
+			deleted = append(deleted, name)
+		}
+	}
+
+	// folders first, the contents of a renamed folder move along with it
+	renamed := map[string]bool{}
+	var renames []WatchEvent
+	for _, dirs := range []bool{true, false} {
+		for _, from := range deleted {
+			if renamed[from] || prev[from].isDir != dirs {
+				continue
+			}
+			for _, to := range created {
+				if renamed[to] || !sameContents(prev, next, from, to) {
+					continue
+				}
+				renamed[from], renamed[to] = true, true
+				event := stateEvent(WatchRename, to, next[to])
+				event.OldPath = from
+				renames = append(renames, event)
+
+				if dirs {
+					markWithin(renamed, deleted, from)
+					markWithin(renamed, created, to)
+				}
+				break
+			}
+		}
+	}
+
+	for _, name := range deleted {
+		if !renamed[name] {
+			events = append(events, stateEvent(WatchDelete, name, prev[name]))
+		}
+	}
+	events = append(events, renames...)
+	for _, name := range created {
+		if !renamed[name] {
+			events = append(events, stateEvent(WatchCreate, name, next[name]))
+		}
+	}
+	return events
+}
+
+// sameContents reports whether from in prev and to in next look like the
+// same file, folders match when everything in them does
+func sameContents(prev, next map[string]fileState, from, to string) bool {
+	a, b := prev[from], next[to]
+	if a.isDir != b.isDir {
+		return false
+	}
+	if !a.isDir {
+		return a.size == b.size && a.modTime.Equal(b.modTime)
+	}
+
+	children := 0
+	for name, state := range prev {
+		if name == from || !isWithin(name, from) {
+			continue
+		}
+		moved, ok := next[to+name[len(from):]]
+		if !ok || moved.isDir != state.isDir || (!state.isDir && (moved.size != state.size || !moved.modTime.Equal(state.modTime))) {
+			return false
+		}
+		children++
+	}
+	for name := range next {
+		if name != to && isWithin(name, to) {
+			children--
+		}
+	}
+	// an empty folder could be anything
+	return children == 0 && hasChildren(prev, from)
+}
+
+func markWithin(marked map[string]bool, names []string, dir string) {
+	for _, name := range names {
+		if isWithin(name, dir) {
+			marked[name] = true
+		}
+	}
+}
+
+func hasChildren(state map[string]fileState, dir string) bool {
+	for name := range state {
+		if name != dir && isWithin(name, dir) {
+			return true
+		}
+	}
+	return false
+}
+
+func stateEvent(op WatchOp, name string, state fileState) WatchEvent {
+	return WatchEvent{Op: op, Path: name, IsDir: state.isDir, Size: state.size, ModTime: state.modTime}
+}
+
+// dedupeEvents drops repeats caused by a file being in more than one target
+func dedupeEvents(events []WatchEvent) []WatchEvent {
+	seen := map[WatchOp]map[string]int{}
+	res := events[:0]
+	for _, event := range events {
+		if seen[event.Op] == nil {
+			seen[event.Op] = map[string]int{}
+		}
+		if i, ok := seen[event.Op][event.Path]; ok {
+			// keep the hash of the file target
+			res[i].Hash = cmp.Or(res[i].Hash, event.Hash)
+			continue
+		}
+		seen[event.Op][event.Path] = len(res)
+		res = append(res, event)
+	}
+	return res
+}
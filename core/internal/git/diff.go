@@ -0,0 +1,77 @@
+package git
+
+import (
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	fdiff "github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/utils/diff"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// lines of context around every change
+const diffContext = 3
+
+// unifiedDiff returns the changes from old to new as a unified diff,
+// nil contents mean the file does not exist on that side
+func unifiedDiff(name string, old, new []byte) (string, error) {
+	patch := filePatch{name: name, old: old, new: new}
+	for _, d := range diff.Do(string(old), string(new)) {
+		var op fdiff.Operation
+		switch d.Type {
+		case diffmatchpatch.DiffInsert:
+			op = fdiff.Add
+		case diffmatchpatch.DiffDelete:
+			op = fdiff.Delete
+		default:
+			op = fdiff.Equal
+		}
+		patch.chunks = append(patch.chunks, chunk{content: d.Text, op: op})
+	}
+
+	var out strings.Builder
+	err := fdiff.NewUnifiedEncoder(&out, diffContext).Encode(patch)
+	return out.String(), err
+}
+
+// filePatch implements fdiff.Patch and fdiff.FilePatch for a single file
+type filePatch struct {
+	name     string
+	old, new []byte
+	chunks   []fdiff.Chunk
+}
+
+func (p filePatch) FilePatches() []fdiff.FilePatch { return []fdiff.FilePatch{p} }
+func (p filePatch) Message() string                { return "" }
+func (p filePatch) IsBinary() bool                 { return false }
+func (p filePatch) Chunks() []fdiff.Chunk          { return p.chunks }
+
+func (p filePatch) Files() (from, to fdiff.File) {
+	if p.old != nil {
+		from = diffFile{name: p.name, contents: p.old}
+	}
+	if p.new != nil {
+		to = diffFile{name: p.name, contents: p.new}
+	}
+	return from, to
+}
+
+type diffFile struct {
+	name     string
+	contents []byte
+}
+
+func (f diffFile) Hash() plumbing.Hash {
+	return plumbing.ComputeHash(plumbing.BlobObject, f.contents)
+}
+func (f diffFile) Mode() filemode.FileMode { return filemode.Regular }
+func (f diffFile) Path() string            { return f.name }
+
+type chunk struct {
+	content string
+	op      fdiff.Operation
+}
+
+func (c chunk) Content() string       { return c.content }
+func (c chunk) Type() fdiff.Operation { return c.op }
@@ -1,39 +1,50 @@
 package git
 
 import (
-	"connectrpc.com/connect"
 	"context"
+	"errors"
 	"fmt"
-	v1 "github.com/RA341/dockman/generated/git/v1"
+	"net/http"
+	"path"
 	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/git/v1"
+	"github.com/RA341/dockman/generated/git/v1/v1connect"
+	"github.com/RA341/dockman/internal/auth"
+	"github.com/RA341/dockman/internal/docker/jobs"
+	"github.com/RA341/dockman/internal/host/middleware"
+	"github.com/RA341/dockman/pkg/listutils"
 )
 
 type Handler struct {
-	srv *Service
+	srv  *Service
+	sync *SyncService
 }
 
-func NewConnectHandler(srv *Service) *Handler {
-	return &Handler{srv: srv}
+func NewConnectHandler(srv *Service, sync *SyncService) (string, http.Handler) {
+	h := &Handler{srv: srv, sync: sync}
+	return v1connect.NewGitServiceHandler(h)
 }
 
-func (h *Handler) ListCommits(_ context.Context, c *connect.Request[v1.File]) (*connect.Response[v1.CommitList], error) {
-	//err := h.srv.ListFiles()
-	//if err != nil {
-	//	return nil, err
-	//}
+func (h *Handler) ListCommits(ctx context.Context, c *connect.Request[v1.File]) (*connect.Response[v1.CommitList], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	file, err := h.srv.ListCommitByFile(c.Msg.Name)
+	commits, err := h.srv.History(hostname, c.Msg.Name)
 	if err != nil {
 		return nil, err
 	}
 
 	var result []*v1.Commit
-	for _, fi := range file {
+	for _, fi := range commits {
 		result = append(result, &v1.Commit{
-			Hash:    fi.Hash.String(),
-			Author:  fi.Author.Name,
-			Email:   fi.Author.Email,
-			When:    fi.Author.When.Format(time.RFC3339),
+			Hash:    fi.Hash,
+			Author:  fi.Author,
+			Email:   fi.Email,
+			When:    fi.When.Format(time.RFC3339),
 			Message: fi.Message,
 		})
 	}
@@ -41,12 +52,28 @@ func (h *Handler) ListCommits(_ context.Context, c *connect.Request[v1.File]) (*
 	return connect.NewResponse(&v1.CommitList{Commits: result}), nil
 }
 
-func (h *Handler) Commit(_ context.Context, c *connect.Request[v1.CommitQuery]) (*connect.Response[v1.Empty], error) {
+func (h *Handler) Commit(ctx context.Context, c *connect.Request[v1.CommitQuery]) (*connect.Response[v1.Empty], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if c.Msg.Message == "" {
 		return nil, fmt.Errorf("commit message is empty")
 	}
 
-	err := h.srv.CommitFileGroup(c.Msg.Message, c.Msg.File.Name)
+	alias := c.Msg.Alias
+	var relpaths []string
+	if name := c.Msg.File.GetName(); name != "" {
+		var rel string
+		alias, rel, err = splitFilename(name)
+		if err != nil {
+			return nil, err
+		}
+		relpaths = append(relpaths, rel)
+	}
+
+	err = h.srv.Commit(ctx, hostname, alias, c.Msg.Message, author(ctx), relpaths...)
 	if err != nil {
 		return nil, err
 	}
@@ -54,29 +81,238 @@ func (h *Handler) Commit(_ context.Context, c *connect.Request[v1.CommitQuery])
 	return connect.NewResponse(&v1.Empty{}), nil
 }
 
-func (h *Handler) ListBranches(context.Context, *connect.Request[v1.ListBranchesRequest]) (*connect.Response[v1.ListBranchesResponse], error) {
-	branches, err := h.srv.ListBranches()
+// author returns the logged-in user to commit as,
+// empty when auth is disabled
+func author(ctx context.Context) string {
+	user, err := auth.GetUser(ctx)
+	if err != nil {
+		return ""
+	}
+	return user.Username
+}
+
+func (h *Handler) Status(ctx context.Context, req *connect.Request[v1.StatusRequest]) (*connect.Response[v1.StatusResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := h.srv.Status(ctx, hostname, req.Msg.Alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.StatusResponse{
+		Files: listutils.ToMap(status, func(file FileStatus) *v1.FileStatus {
+			return &v1.FileStatus{
+				Filename: path.Join(req.Msg.Alias, file.Path),
+				Staging:  string(file.Staging),
+				Worktree: string(file.Worktree),
+			}
+		}),
+	}), nil
+}
+
+func (h *Handler) Stage(ctx context.Context, req *connect.Request[v1.StageRequest]) (*connect.Response[v1.Empty], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.srv.Stage(ctx, hostname, req.Msg.Alias, req.Msg.Files...); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) Unstage(ctx context.Context, req *connect.Request[v1.StageRequest]) (*connect.Response[v1.Empty], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.srv.Unstage(ctx, hostname, req.Msg.Alias, req.Msg.Files...); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) Push(ctx context.Context, req *connect.Request[v1.PushRequest], responseStream *connect.ServerStream[v1.GitProgress]) error {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return err
+	}
+
+	return h.sync.Push(ctx, hostname, req.Msg.Alias, progressWriter{responseStream})
+}
+
+func (h *Handler) Pull(ctx context.Context, req *connect.Request[v1.PullRequest], responseStream *connect.ServerStream[v1.GitProgress]) error {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.sync.Pull(ctx, hostname, req.Msg.Alias, progressWriter{responseStream})
+	if err != nil {
+		return err
+	}
+	return responseStream.Send(&v1.GitProgress{Result: result.ToProto()})
+}
+
+// progressWriter sends git transfer progress to the client
+type progressWriter struct {
+	stream *connect.ServerStream[v1.GitProgress]
+}
+
+func (p progressWriter) Write(b []byte) (int, error) {
+	if err := p.stream.Send(&v1.GitProgress{Output: string(b)}); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (h *Handler) Rollback(ctx context.Context, req *connect.Request[v1.RollbackRequest], responseStream *connect.ServerStream[v1.RollbackProgress]) error {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return err
+	}
+
+	type rolledBack struct {
+		res Rollback
+		err error
+	}
+	done := make(chan rolledBack, 1)
+	job, err := h.sync.Rollback(hostname, req.Msg.Filename, req.Msg.Commit, author(ctx), req.Msg.Deploy, func(res Rollback, err error) {
+		done <- rolledBack{res, err}
+	})
+	if err != nil {
+		if errors.Is(err, jobs.ErrStackBusy) {
+			return connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return err
+	}
+
+	var result rolledBack
+	select {
+	case result = <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if result.err != nil {
+		return result.err
+	}
+
+	alias := aliasOf(req.Msg.Filename)
+	err = responseStream.Send(&v1.RollbackProgress{
+		Commit: result.res.Hash,
+		Files: listutils.ToMap(result.res.Files, func(rel string) string {
+			return path.Join(alias, rel)
+		}),
+	})
+	if err != nil || !req.Msg.Deploy {
+		return err
+	}
+	if err = responseStream.Send(&v1.RollbackProgress{JobId: job.ID}); err != nil {
+		return err
+	}
+
+	err = job.Follow(ctx, false, rollbackWriter{responseStream})
+	if err != nil {
+		return err
+	}
+	status, err := job.Status()
+	if status == jobs.StatusRunning {
+		// client detached, the deploy keeps running
+		return nil
+	}
+	return err
+}
+
+// rollbackWriter sends compose up output of a rollback to the client
+type rollbackWriter struct {
+	stream *connect.ServerStream[v1.RollbackProgress]
+}
+
+func (p rollbackWriter) Write(b []byte) (int, error) {
+	if err := p.stream.Send(&v1.RollbackProgress{Output: string(b)}); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (h *Handler) GetIgnore(ctx context.Context, req *connect.Request[v1.GetIgnoreRequest]) (*connect.Response[v1.IgnoreFile], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := h.srv.Ignore(hostname, req.Msg.Alias)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.IgnoreFile{Alias: req.Msg.Alias, Contents: contents}), nil
+}
+
+func (h *Handler) SaveIgnore(ctx context.Context, req *connect.Request[v1.IgnoreFile]) (*connect.Response[v1.Empty], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.srv.SaveIgnore(hostname, req.Msg.Alias, req.Msg.Contents); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) Diff(ctx context.Context, c *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := h.srv.Diff(hostname, c.Msg.Filename, c.Msg.From, c.Msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.DiffResponse{Diff: diff}), nil
+}
+
+func (h *Handler) ListBranches(ctx context.Context, req *connect.Request[v1.ListBranchesRequest]) (*connect.Response[v1.ListBranchesResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	branches, err := h.srv.Branches(hostname, req.Msg.Alias)
 	if err != nil {
 		return nil, err
 	}
 	return connect.NewResponse(&v1.ListBranchesResponse{Branches: branches}), nil
 }
 
-func (h *Handler) SyncFile(_ context.Context, req *connect.Request[v1.FileRequest]) (*connect.Response[v1.Empty], error) {
-	branch := req.Msg.GetBranch()
-	filepath := req.Msg.GetFilepath()
+func (h *Handler) SyncFile(ctx context.Context, req *connect.Request[v1.FileRequest]) (*connect.Response[v1.Empty], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := h.srv.SyncFile(filepath, branch); err != nil {
+	err = h.srv.SyncFiles(hostname, req.Msg.GetAlias(), req.Msg.GetBranch(), req.Msg.GetFilepath()...)
+	if err != nil {
 		return nil, err
 	}
 
 	return connect.NewResponse(&v1.Empty{}), nil
 }
 
-func (h *Handler) ListFileFromBranch(_ context.Context, req *connect.Request[v1.BranchListFileRequest]) (*connect.Response[v1.BranchListFileResponse], error) {
-	branch := req.Msg.GetBranch()
+func (h *Handler) ListFileFromBranch(ctx context.Context, req *connect.Request[v1.BranchListFileRequest]) (*connect.Response[v1.BranchListFileResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	inBranch, err := h.srv.ListFilesInBranch(branch)
+	inBranch, err := h.srv.BranchFiles(hostname, req.Msg.GetAlias(), req.Msg.GetBranch())
 	if err != nil {
 		return nil, err
 	}
@@ -1,10 +1,10 @@
 package git
 
 import (
-	"github.com/rs/zerolog/log"
 	"net/http"
-	"path/filepath"
-	"strings"
+
+	"github.com/RA341/dockman/internal/host/middleware"
+	"github.com/rs/zerolog/log"
 )
 
 const fileContentsFormKey = "contents"
@@ -20,7 +20,7 @@ func NewFileHandler(service *Service) http.Handler {
 
 func (h *FileHandler) registerPaths() http.Handler {
 	subMux := http.NewServeMux()
-	subMux.HandleFunc("GET /load/{filename}/{commitId}", h.LoadFileAtCommit)
+	subMux.HandleFunc("GET /load/{commitId}/{filename...}", h.LoadFileAtCommit)
 	return subMux
 }
 
@@ -32,20 +32,23 @@ func (h *FileHandler) LoadFileAtCommit(w http.ResponseWriter, r *http.Request) {
 	}
 	commitID := r.PathValue("commitId")
 	if commitID == "" {
-		http.Error(w, "Filename not provided", http.StatusBadRequest)
+		http.Error(w, "Commit not provided", http.StatusBadRequest)
+		return
+	}
+	hostname, err := middleware.GetHost(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	cleanPath := filepath.Clean(fileName)
-	cleanPath = strings.Replace(cleanPath, "\\", "/", -1)
 
-	content, err := h.srv.LoadFileAtCommit(cleanPath, commitID)
+	content, err := h.srv.FileAt(hostname, fileName, commitID)
 	if err != nil {
-		log.Error().Err(err).Str("path", cleanPath).Msg("Error loading file")
+		log.Error().Err(err).Str("path", fileName).Msg("Error loading file")
 		http.Error(w, "Filename or commit not found", http.StatusBadRequest)
 		return
 	}
 
-	_, err = w.Write([]byte(content))
+	_, err = w.Write(content)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to write response")
 		return
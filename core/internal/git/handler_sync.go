@@ -0,0 +1,72 @@
+package git
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/git/v1"
+	"github.com/RA341/dockman/internal/host/middleware"
+	"github.com/RA341/dockman/pkg/listutils"
+)
+
+func (h *Handler) GetSync(ctx context.Context, req *connect.Request[v1.GetSyncRequest]) (*connect.Response[v1.SyncConfig], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := h.sync.GetConfig(hostname, req.Msg.Alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(conf.ToProto()), nil
+}
+
+func (h *Handler) SaveSync(ctx context.Context, req *connect.Request[v1.SyncConfig]) (*connect.Response[v1.Empty], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var conf SyncConfig
+	conf.FromProto(req.Msg)
+	conf.Host = hostname
+
+	if err = h.sync.SaveConfig(&conf); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) RunSync(ctx context.Context, req *connect.Request[v1.RunSyncRequest]) (*connect.Response[v1.SyncRun], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// deploys keep running when the client disconnects
+	result, err := h.sync.Run(context.WithoutCancel(ctx), hostname, req.Msg.Alias)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(result.ToProto()), nil
+}
+
+func (h *Handler) ListSyncHistory(ctx context.Context, req *connect.Request[v1.ListSyncHistoryRequest]) (*connect.Response[v1.ListSyncHistoryResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := h.sync.History(hostname, req.Msg.Alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ListSyncHistoryResponse{
+		Runs: listutils.ToMap(results, func(res SyncResult) *v1.SyncRun {
+			return res.ToProto()
+		}),
+	}), nil
+}
@@ -0,0 +1,636 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker/container"
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/rs/zerolog/log"
+)
+
+// Repo the git repo holding the history of an alias
+type Repo struct {
+	username             string
+	authToken            string
+	repoPath             string
+	repo                 *git.Repository
+	chownComposeRootFunc func()
+
+	// prefix of the alias inside the worktree, set when the alias is a sub folder of a repo
+	prefix string
+	// shadow repos live on the dockman side and mirror the files saved
+	// through dockman, for aliases that are not on this machine
+	shadow bool
+	// mu serializes worktree changes, shared by all aliases in the repo
+	mu *sync.Mutex
+}
+
+const DockmanRemoteFolder = ".dockman.remote"
+
+func NewMigrator(root string) error {
+	return migrator(root)
+}
+
+func migrator(root string) error {
+	dockmanBranchFolder := filepath.Join(root, DockmanRemoteFolder)
+	if fileutil.FileExists(dockmanBranchFolder) {
+		log.Info().Msg("Branch folder found")
+		return nil
+	}
+
+	err := os.MkdirAll(dockmanBranchFolder, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	repo, err := initializeGit(root)
+	if err != nil {
+		return fmt.Errorf("failed to get git repo: %w", err)
+	}
+	if repo == nil {
+		log.Info().Msg("No git repo found, nothing to migrate")
+		return nil
+	}
+
+	srv := Repo{
+		repo:                 repo,
+		repoPath:             root,
+		chownComposeRootFunc: func() {},
+		mu:                   &sync.Mutex{},
+	}
+
+	branches, err := srv.ListBranches()
+	if err != nil {
+		return err
+	}
+
+	for _, branch := range branches {
+		if branch == container.LocalClient {
+			log.Info().Msg("skipping migrating local branch")
+			continue
+		}
+
+		branchDestPath := filepath.Join(dockmanBranchFolder, branch)
+		branchDestPath, err = filepath.Abs(branchDestPath)
+		if err != nil {
+			return err
+		}
+		err = os.MkdirAll(branchDestPath, os.ModePerm)
+		if err != nil {
+			return err
+		}
+
+		// list all files
+		files, err := srv.ListFilesInBranch(branch)
+		if err != nil {
+			return err
+		}
+
+		err = srv.copyFilesFromBranch(files, branch, branchDestPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// returns an error instead of fataling useful for testing
+func newSrv(root string, chownFunc func()) (*Repo, error) {
+	repo, err := initializeGit(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init git repo: %w", err)
+	}
+
+	chownFunc()
+
+	srv := &Repo{
+		repo:                 repo,
+		repoPath:             root,
+		chownComposeRootFunc: chownFunc,
+		mu:                   &sync.Mutex{},
+	}
+
+	log.Debug().Msg("Git service loaded successfully")
+	return srv, err
+}
+
+func initializeGit(root string) (*git.Repository, error) {
+	// Check if the repository already exists
+	existingRepo, err := git.PlainOpen(root)
+	if err == nil {
+		log.Debug().Msg("Loaded existing git repository")
+		return existingRepo, nil
+	}
+
+	if !errors.Is(err, git.ErrRepositoryNotExists) {
+		return nil, fmt.Errorf("failed to open git repository: %w", err)
+	}
+
+	return nil, nil
+
+	// PlainOpen returns an error, implies the directory doesn't exist,
+	// or it's not a git repository, initialize
+	//newRepo, err := git.PlainInitWithOptions(root, &git.PlainInitOptions{
+	//	InitOptions: git.InitOptions{
+	//		DefaultBranch: "refs/heads/local",
+	//	},
+	//	Bare: false,
+	//})
+	//if err != nil {
+	//	return nil, fmt.Errorf("error initializing repository: %s\n", err)
+	//}
+	//
+	//dir, err := os.ReadDir(root)
+	//if err != nil {
+	//	return nil, fmt.Errorf("error reading directory: %s\n", err)
+	//}
+	//// .git will be counted in ReadDir, excluding that
+	//if len(dir) < 2 {
+	//	if err = createSampleFile(root); err != nil {
+	//		return nil, err
+	//	}
+	//}
+	//
+	//log.Info().Str("path", root).Msg("Created new repository")
+	//return newRepo, nil
+}
+
+// an empty git repo will not have any content and will fail to create other branches
+// so we commit an empty compose file
+func createSampleFile(root string) error {
+	log.Debug().Msg("empty repo, adding dummy readme")
+
+	const dummyFileContent = `Hey there! Hello,
+
+Thanks for using Dockman
+
+This file was auto-created because Dockman needs to initialize a Git repo —
+and Git doesn't like empty folders. So here we are, making history with this very first file.
+
+Feel free to delete or replace me. I won't take it personally.
+`
+
+	err := fileutil.CreateSampleFile(filepath.Join(root, "readme.txt"), dummyFileContent)
+	if err != nil {
+		return fmt.Errorf("error writing to dummy readme: %s", err)
+	}
+
+	return nil
+}
+
+// CommitAll stages all changes (new, modified, deleted) and commits them.
+// It uses a generic commit message.
+//
+// Staging a large worktree can take a long time, CommitAll returns
+// as soon as ctx is done, see Repo.locked
+func (s *Repo) CommitAll(ctx context.Context) error {
+	return s.locked(ctx, func(workTree *git.Worktree) error {
+		status, err := workTree.Status()
+		if err != nil {
+			return fmt.Errorf("could not get worktree status: %w", err)
+		}
+		if status.IsClean() {
+			log.Info().Msg("Working directory is clean, no changes to commit")
+			return nil
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		err = workTree.AddWithOptions(&git.AddOptions{All: true})
+		if err != nil {
+			return fmt.Errorf("could not stage changes: %w", err)
+		}
+
+		log.Debug().Msg("Staged all changes")
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		commitHash, err := workTree.Commit("auto commit", &git.CommitOptions{
+			Author: s.signature(s.username),
+		})
+		if err != nil {
+			return fmt.Errorf("could not create commit: %w", err)
+		}
+
+		log.Info().Str("hash", commitHash.String()).Msg("Successfully created commit with hash")
+		return nil
+	})
+}
+
+// locked runs fn with the worktree while holding the repo lock.
+// go-git worktree operations do not take a context, when ctx is done
+// locked returns ctx.Err() right away and fn finishes in the background,
+// still holding the lock so nothing else touches the worktree meanwhile
+func (s *Repo) locked(ctx context.Context, fn func(worktree *git.Worktree) error) error {
+	done := make(chan error, 1)
+	go func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if err := ctx.Err(); err != nil {
+			done <- err
+			return
+		}
+		worktree, err := s.repo.Worktree()
+		if err != nil {
+			done <- fmt.Errorf("unable to get worktree: %w", err)
+			return
+		}
+		done <- fn(worktree)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-done:
+		return err
+	}
+}
+
+// SwitchBranch switches to a different branch.
+// first commits any outstanding changes.
+func (s *Repo) SwitchBranch(ctx context.Context, name string) error {
+	log.Info().Str("branch", name).Msg("Committing all changes before switching to branch")
+	if err := s.CommitAll(ctx); err != nil {
+		return fmt.Errorf("failed to commit changes before switching branch: %w", err)
+	}
+
+	err := s.WithWorkTree(func(worktree *git.Worktree) error {
+		branchRefName := plumbing.NewBranchReferenceName(name)
+
+		log.Debug().Str("branch", name).Msg("Checking if branch exists...")
+		_, err := s.repo.Reference(branchRefName, true)
+		checkoutOpts := &git.CheckoutOptions{
+			Branch: branchRefName,
+		}
+
+		// If the reference is not found, the branch doesn't exist
+		// set the `Create` flag to true
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			log.Debug().Str("branch", name).Msg("Branch does not exist. Creating it.")
+			checkoutOpts.Create = true
+		} else if err != nil {
+			return fmt.Errorf("could not lookup reference for branch '%s': %w", name, err)
+		}
+
+		if err = worktree.Checkout(checkoutOpts); err != nil {
+			return fmt.Errorf("could not switch to branch '%s': %w", name, err)
+		}
+
+		log.Info().Str("branch", name).Msg("Switched to branch...")
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.chownComposeRootFunc()
+
+	return nil
+}
+
+func (s *Repo) copyFilesFromBranch(filepaths []string, branch string, path string) error {
+	return s.WithWorkTree(func(workTree *git.Worktree) error {
+		// Resolve the importingBranch name to a commit hash.
+		importingBranchRefName := plumbing.NewBranchReferenceName(branch)
+		importingRef, err := s.repo.Reference(importingBranchRefName, true)
+		if err != nil {
+			return fmt.Errorf("could not resolve branch '%s': %w", branch, err)
+		}
+
+		commit, err := s.repo.CommitObject(importingRef.Hash())
+		if err != nil {
+			return fmt.Errorf("could not get commit object for branch '%s': %w", branch, err)
+		}
+
+		for _, fPath := range filepaths {
+			file, err := commit.File(fPath)
+			if err != nil {
+				return fmt.Errorf("could not find file '%s' in branch '%s': %w", filepaths, branch, err)
+			}
+
+			content, err := file.Contents()
+			if err != nil {
+				return fmt.Errorf("could not read file contents: %w", err)
+			}
+
+			// Write the content to the file in the worktree's filesystem.
+			// This creates or overwrites the file on disk.
+
+			fullPath := filepath.Join(path, fPath)
+			dir := filepath.Dir(fullPath)
+			err = os.MkdirAll(dir, 0755)
+			if err != nil {
+				return err
+			}
+
+			if err = os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+				return fmt.Errorf("failed to write file to worktree: %w", err)
+			}
+
+			log.Debug().Str("file", fPath).Str("branch", branch).
+				Msg("File moved from branch")
+		}
+
+		return nil
+	})
+
+}
+
+// SyncFile syncs a file's content to the current worktree from the importingBranch,
+// overwriting it if it exists. It then stages the change.
+func (s *Repo) SyncFile(filepaths []string, importingBranch string) error {
+	return s.WithWorkTree(func(workTree *git.Worktree) error {
+		// Resolve the importingBranch name to a commit hash.
+		importingBranchRefName := plumbing.NewBranchReferenceName(importingBranch)
+		importingRef, err := s.repo.Reference(importingBranchRefName, true)
+		if err != nil {
+			return fmt.Errorf("could not resolve branch '%s': %w", importingBranch, err)
+		}
+
+		commit, err := s.repo.CommitObject(importingRef.Hash())
+		if err != nil {
+			return fmt.Errorf("could not get commit object for branch '%s': %w", importingBranch, err)
+		}
+
+		for _, f := range filepaths {
+			file, err := commit.File(f)
+			if err != nil {
+				return fmt.Errorf("could not find file '%s' in branch '%s': %w", filepaths, importingBranch, err)
+			}
+
+			content, err := file.Contents()
+			if err != nil {
+				return fmt.Errorf("could not read file contents: %w", err)
+			}
+
+			// Write the content to the file in the worktree's filesystem.
+			// This creates or overwrites the file on disk.
+			fullPath := workTree.Filesystem.Join(workTree.Filesystem.Root(), f)
+			if err = os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+				return fmt.Errorf("failed to write file to worktree: %w", err)
+			}
+
+			log.Debug().Str("file", f).Str("branch", importingBranch).
+				Msg("File synced to worktree from branch")
+
+			// Stage the newly created/updated file.
+			if _, err = workTree.Add(f); err != nil {
+				return fmt.Errorf("failed to stage file '%s': %w", f, err)
+			}
+		}
+
+		return nil
+	})
+}
+func (s *Repo) ListBranches() ([]string, error) {
+	branches, err := s.repo.Branches()
+	if err != nil {
+		return nil, fmt.Errorf("could not get list of branches: %w", err)
+	}
+
+	var branchesStrs []string
+	_ = branches.ForEach(func(ref *plumbing.Reference) error {
+		branchesStrs = append(branchesStrs, ref.Name().Short())
+		return nil
+	})
+
+	return branchesStrs, nil
+}
+
+// ListFilesInBranch lists all files tracked in the given branch.
+func (s *Repo) ListFilesInBranch(branch string) ([]string, error) {
+	branchRefName := plumbing.NewBranchReferenceName(branch)
+	ref, err := s.repo.Reference(branchRefName, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve branch '%s': %w", branch, err)
+	}
+
+	commit, err := s.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not get commit object for branch '%s': %w", branch, err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get tree for commit '%s': %w", commit.Hash, err)
+	}
+
+	var files []string
+	fileIter := tree.Files()
+	err = fileIter.ForEach(func(f *object.File) error {
+		files = append(files, f.Name)
+		return nil // continue iteration
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed while iterating files in branch '%s': %w", branch, err)
+	}
+
+	return files, nil
+}
+
+// Commit stages fileList and commits everything staged,
+// an empty author uses the author in the repo config
+func (s *Repo) Commit(commitMessage, author string, fileList ...string) error {
+	tree, err := s.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get worktree: %w", err)
+	}
+
+	if len(fileList) != 0 {
+		status, err := tree.Status()
+		if err != nil {
+			return err
+		}
+
+		for _, file := range fileList {
+			_, ok := status[file]
+			if !ok {
+				log.Debug().Str("file", file).Msg("File not found in status probably ignored, skipping...")
+				continue
+			}
+
+			if _, err := tree.Add(file); err != nil {
+				return fmt.Errorf("failed to add file: %w", err)
+			}
+		}
+	}
+
+	commit, err := tree.Commit(commitMessage, &git.CommitOptions{
+		Author: s.signature(author),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit changes: %w", err)
+	}
+
+	log.Debug().
+		Str("hash", commit.String()).
+		Strs("committed-files", fileList).
+		Msg("committed for file with its group")
+
+	return nil
+}
+
+// signature returns the commit author, falling back to the
+// author in the repo config when author is empty
+func (s *Repo) signature(author string) *object.Signature {
+	sig := &object.Signature{Name: author, When: time.Now()}
+	if author != "" {
+		return sig
+	}
+
+	gitConfig, err := s.repo.Config()
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to read repo config")
+	} else {
+		sig.Name = gitConfig.Author.Name
+		sig.Email = gitConfig.Author.Email
+	}
+	if sig.Name == "" {
+		sig.Name = defaultAuthor
+	}
+	return sig
+}
+
+func (s *Repo) CommitFileGroup(commitMessage string, filename string) error {
+	//fileList, err := s.fileMan.GetFileGroup(filename)
+	//if err != nil {
+	//	return err
+	//}
+
+	err := s.Commit(commitMessage, "", filename)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Repo) LoadFileAtCommit(filePath, commitId string) (string, error) {
+	hash := plumbing.NewHash(commitId)
+	commit, err := s.repo.CommitObject(hash)
+	if err != nil {
+		return "", fmt.Errorf("failed to get commit %s: %w", commitId, err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return "", fmt.Errorf("failed to get tree from commit: %w", err)
+	}
+
+	file, err := tree.File(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to get file %s from commit %s: %w", filePath, commitId, err)
+	}
+
+	content, err := file.Contents()
+	if err != nil {
+		return "", fmt.Errorf("failed to read file contents: %w", err)
+	}
+
+	return content, nil
+}
+
+func (s *Repo) WithWorkTree(execFn func(worktree *git.Worktree) error) error {
+	worktree, err := s.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("unable to get worktree: %w", err)
+	}
+
+	return execFn(worktree)
+}
+func (s *Repo) ListFiles() error {
+	err := s.WithWorkTree(func(worktree *git.Worktree) error {
+		status, err := worktree.Status()
+		if err != nil {
+			return err
+		}
+
+		for filename, stat := range status {
+			//stat.Staging
+			//stat.Worktree
+			log.Debug().Msgf("File %s stats: %v", filename, stat)
+		}
+		//ps, err := gitignore.NewMatcher(fs, nil)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Repo) ListCommitByFile(filePath string) ([]*object.Commit, error) {
+	opts := &git.LogOptions{
+		FileName: &filePath,
+	}
+
+	var commitList []*object.Commit
+
+	cIter, err := s.repo.Log(opts)
+	if err != nil {
+		log.Debug().Err(err).Str("file", filePath).Msg("could not get commit iterator for file")
+		return commitList, nil
+	}
+	defer cIter.Close()
+
+	err = cIter.ForEach(func(c *object.Commit) error {
+		commitList = append(commitList, c)
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		log.Warn().Err(err).Str("file", filePath).Msg("error while iterating commits")
+		return commitList, nil
+	}
+
+	return commitList, nil
+}
+
+// EditUserConfig updates user configuration, skipping empty parameters
+func (s *Repo) EditUserConfig(name, email string) error {
+	conf, err := s.repo.Config()
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	// Only update name if provided
+	if name != "" {
+		conf.Author.Name = name
+	}
+
+	// Only update email if provided
+	if email != "" {
+		conf.Author.Email = email
+	}
+
+	// Save the configuration back to the repository
+	return s.repo.Storer.SetConfig(conf)
+}
+
+func (s *Repo) EditRemote(remoteNickname string, repoUrl string) error {
+	_, err := s.repo.CreateRemote(
+		&config.RemoteConfig{
+			Name: remoteNickname,
+			URLs: []string{repoUrl},
+		})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
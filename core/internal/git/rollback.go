@@ -0,0 +1,226 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type Rollback struct {
+	// Hash of the rollback commit, empty if the folder already matched
+	Hash string
+	// Files that were restored or removed, relative to the alias
+	Files []string
+}
+
+// Rollback restores the folder of a stack to how it was at commitId and
+// commits the result, files added after commitId are removed.
+// Uncommitted changes in the folder are committed first so the rollback can be undone,
+// untracked files only if the rollback would overwrite them. Anything else that was
+// already staged ends up in the same commit. filename is the compose file as <alias>/<relpath>,
+// stacks at the root of the repo have no folder of their own and are refused
+func (s *Service) Rollback(ctx context.Context, host, filename, commitId, author string) (Rollback, error) {
+	repo, rel, err := s.load(host, filename, false)
+	if err != nil {
+		return Rollback{}, err
+	}
+	fsCli, err := s.fs(host, aliasOf(filename))
+	if err != nil {
+		return Rollback{}, err
+	}
+
+	dir := path.Dir(repo.repoRel(rel))
+	if dir == "." {
+		return Rollback{}, fmt.Errorf("%s is at the root of the repo, only stacks in their own folder can be rolled back", filename)
+	}
+
+	var res Rollback
+	err = repo.locked(ctx, func(worktree *git.Worktree) error {
+		hash, err := repo.repo.ResolveRevision(plumbing.Revision(commitId))
+		if err != nil {
+			return fmt.Errorf("unable to find commit %s: %w", commitId, err)
+		}
+		target, err := repo.filesIn(*hash, dir)
+		if err != nil {
+			return err
+		}
+		err = repo.saveDirty(worktree, dir, target, fmt.Sprintf("save %s before rollback", path.Dir(filename)), author)
+		if err != nil {
+			return err
+		}
+		head, err := repo.repo.Head()
+		if err != nil {
+			return err
+		}
+		current, err := repo.filesIn(head.Hash(), dir)
+		if err != nil {
+			return err
+		}
+
+		var changed []string
+		for name, file := range target {
+			contents, err := file.Contents()
+			if err != nil {
+				return err
+			}
+			if err = repo.restoreFile(fsCli, name, []byte(contents)); err != nil {
+				return fmt.Errorf("failed to restore %s: %w", name, err)
+			}
+			changed = append(changed, name)
+		}
+		for name := range current {
+			if _, ok := target[name]; ok {
+				continue
+			}
+			if err = repo.removeFile(fsCli, name); err != nil {
+				return fmt.Errorf("failed to remove %s: %w", name, err)
+			}
+			changed = append(changed, name)
+		}
+
+		for _, name := range changed {
+			if _, err = worktree.Add(name); err != nil {
+				return fmt.Errorf("failed to stage %s: %w", name, err)
+			}
+		}
+
+		status, err := worktree.Status()
+		if err != nil {
+			return err
+		}
+		for _, name := range changed {
+			file, ok := status[name]
+			if !ok || file.Staging == git.Unmodified || file.Staging == git.Untracked {
+				continue
+			}
+			if aliasRel, ok := repo.aliasRel(name); ok {
+				res.Files = append(res.Files, aliasRel)
+			}
+		}
+		if len(res.Files) == 0 {
+			return nil
+		}
+		slices.Sort(res.Files)
+
+		commit, err := worktree.Commit(
+			fmt.Sprintf("rollback %s to %s", path.Dir(filename), hash.String()[:7]),
+			&git.CommitOptions{Author: repo.signature(author)},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to commit rollback: %w", err)
+		}
+		res.Hash = commit.String()
+		return nil
+	})
+	return res, err
+}
+
+// saveDirty commits the uncommitted changes under dir, untracked files are
+// only committed if they are about to be overwritten by a file in target
+func (s *Repo) saveDirty(worktree *git.Worktree, dir string, target map[string]*object.File, msg, author string) error {
+	status, err := worktree.Status()
+	if err != nil {
+		return err
+	}
+
+	var dirty []string
+	for name, file := range status {
+		if !strings.HasPrefix(name, dir+"/") {
+			continue
+		}
+		if file.Worktree == git.Untracked {
+			if _, ok := target[name]; !ok {
+				continue
+			}
+		} else if file.Worktree == git.Unmodified && file.Staging == git.Unmodified {
+			continue
+		}
+		dirty = append(dirty, name)
+	}
+	if len(dirty) == 0 {
+		return nil
+	}
+
+	for _, name := range dirty {
+		if status[name].Worktree == git.Deleted {
+			_, err = worktree.Remove(name)
+		} else {
+			_, err = worktree.Add(name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to stage %s: %w", name, err)
+		}
+	}
+
+	_, err = worktree.Commit(msg, &git.CommitOptions{Author: s.signature(author)})
+	if err != nil {
+		return fmt.Errorf("failed to commit uncommitted changes: %w", err)
+	}
+	return nil
+}
+
+// filesIn lists the files under dir at a commit, keyed by their worktree path
+func (s *Repo) filesIn(hash plumbing.Hash, dir string) (map[string]*object.File, error) {
+	commit, err := s.repo.CommitObject(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit %s: %w", hash, err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree from commit: %w", err)
+	}
+
+	files := map[string]*object.File{}
+	err = tree.Files().ForEach(func(file *object.File) error {
+		if strings.HasPrefix(file.Name, dir+"/") {
+			files[file.Name] = file
+		}
+		return nil
+	})
+	return files, err
+}
+
+// restoreFile writes a worktree file, shadow repos also write it back to the host
+func (s *Repo) restoreFile(fsCli filesystem.FileSystem, name string, contents []byte) error {
+	if s.shadow {
+		rel, _ := s.aliasRel(name)
+		if err := fsCli.MkdirAll(path.Dir(rel), 0o755); err != nil {
+			return err
+		}
+		if err := filesystem.WriteAtomic(fsCli, rel, bytes.NewReader(contents), 0o644); err != nil {
+			return err
+		}
+	}
+
+	full := filepath.Join(s.repoPath, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(full, contents, 0o644)
+}
+
+// removeFile deletes a worktree file, shadow repos also delete it on the host
+func (s *Repo) removeFile(fsCli filesystem.FileSystem, name string) error {
+	if s.shadow {
+		rel, _ := s.aliasRel(name)
+		if err := fsCli.RemoveAll(rel); err != nil {
+			return err
+		}
+	}
+
+	err := os.Remove(filepath.Join(s.repoPath, filepath.FromSlash(name)))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -5,607 +5,470 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/RA341/dockman/internal/docker/container"
-	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/RA341/dockman/internal/files/utils"
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/RA341/dockman/pkg/syncmap"
 	"github.com/go-git/go-git/v5"
-	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/rs/zerolog/log"
 )
 
+type FSProvider func(host, alias string) (filesystem.FileSystem, error)
+
+// Service the version history of every alias on every host.
+// Local aliases use the git repo they are in, aliases on other hosts
+// use a shadow repo on the dockman side that snapshots every save
 type Service struct {
-	username             string
-	authToken            string
-	repoPath             string
-	repo                 *git.Repository
-	chownComposeRootFunc func()
+	fs FSProvider
+	// shadowRoot holds the shadow repos as <host>/<alias>
+	shadowRoot string
+	// repos by worktree path, aliases in the same repo share its lock
+	repos syncmap.Map[string, *Repo]
+	// aliases by <host>/<alias>
+	aliases syncmap.Map[string, *Repo]
 }
 
-const DockmanRemoteFolder = ".dockman.remote"
-
-func NewMigrator(root string) error {
-	return migrator(root)
+func New(fs FSProvider, shadowRoot string) *Service {
+	return &Service{
+		fs:         fs,
+		shadowRoot: shadowRoot,
+	}
 }
 
-func migrator(root string) error {
-	dockmanBranchFolder := filepath.Join(root, DockmanRemoteFolder)
-	if fileutil.FileExists(dockmanBranchFolder) {
-		log.Info().Msg("Branch folder found")
-		return nil
-	}
+// defaultAuthor used for snapshots when the user is unknown
+const defaultAuthor = "dockman"
 
-	err := os.MkdirAll(dockmanBranchFolder, os.ModePerm)
-	if err != nil {
-		return err
-	}
+var ErrNoHistory = errors.New("no history, alias is not in a git repo")
 
-	repo, err := initializeGit(root)
-	if err != nil {
-		return fmt.Errorf("failed to get git repo: %w", err)
-	}
-	if repo == nil {
-		log.Info().Msg("No git repo found, nothing to migrate")
-		return nil
-	}
+// ErrRemoteAlias is returned for operations that need the worktree of a
+// local alias, aliases on other hosts only have a shadow repo
+var ErrRemoteAlias = errors.New("only supported for local aliases")
 
-	srv := Service{
-		repo:                 repo,
-		repoPath:             root,
-		chownComposeRootFunc: func() {},
-	}
+type Commit struct {
+	Hash    string
+	Author  string
+	Email   string
+	When    time.Time
+	Message string
+}
 
-	branches, err := srv.ListBranches()
+// History lists the commits that changed filename, newest first,
+// filename is <alias>/<relpath>
+func (s *Service) History(host, filename string) ([]Commit, error) {
+	repo, rel, err := s.load(host, filename, false)
+	if errors.Is(err, ErrNoHistory) {
+		return nil, nil
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, branch := range branches {
-		if branch == container.LocalClient {
-			log.Info().Msg("skipping migrating local branch")
-			continue
-		}
-
-		branchDestPath := filepath.Join(dockmanBranchFolder, branch)
-		branchDestPath, err = filepath.Abs(branchDestPath)
-		if err != nil {
-			return err
-		}
-		err = os.MkdirAll(branchDestPath, os.ModePerm)
-		if err != nil {
-			return err
-		}
-
-		// list all files
-		files, err := srv.ListFilesInBranch(branch)
-		if err != nil {
-			return err
-		}
-
-		err = srv.copyFilesFromBranch(files, branch, branchDestPath)
-		if err != nil {
-			return err
-		}
+	commits, err := repo.ListCommitByFile(repo.repoRel(rel))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Commit, 0, len(commits))
+	for _, c := range commits {
+		res = append(res, Commit{
+			Hash:    c.Hash.String(),
+			Author:  c.Author.Name,
+			Email:   c.Author.Email,
+			When:    c.Author.When,
+			Message: c.Message,
+		})
 	}
-
-	return nil
+	return res, nil
 }
 
-// returns an error instead of fataling useful for testing
-func newSrv(root string, chownFunc func()) (*Service, error) {
-	repo, err := initializeGit(root)
+// FileAt returns the contents of filename at a commit
+func (s *Service) FileAt(host, filename, commitId string) ([]byte, error) {
+	repo, rel, err := s.load(host, filename, false)
 	if err != nil {
-		return nil, fmt.Errorf("failed to init git repo: %w", err)
+		return nil, err
 	}
-
-	chownFunc()
-
-	srv := &Service{
-		repo:                 repo,
-		repoPath:             root,
-		chownComposeRootFunc: chownFunc,
+	contents, err := repo.LoadFileAtCommit(repo.repoRel(rel), commitId)
+	if err != nil {
+		return nil, err
 	}
-
-	log.Debug().Msg("Git service loaded successfully")
-	return srv, err
-}
-
-func initializeGit(root string) (*git.Repository, error) {
-	// Check if the repository already exists
-	existingRepo, err := git.PlainOpen(root)
-	if err == nil {
-		log.Debug().Msg("Loaded existing git repository")
-		return existingRepo, nil
-	}
-
-	if !errors.Is(err, git.ErrRepositoryNotExists) {
-		return nil, fmt.Errorf("failed to open git repository: %w", err)
-	}
-
-	return nil, nil
-
-	// PlainOpen returns an error, implies the directory doesn't exist,
-	// or it's not a git repository, initialize
-	//newRepo, err := git.PlainInitWithOptions(root, &git.PlainInitOptions{
-	//	InitOptions: git.InitOptions{
-	//		DefaultBranch: "refs/heads/local",
-	//	},
-	//	Bare: false,
-	//})
-	//if err != nil {
-	//	return nil, fmt.Errorf("error initializing repository: %s\n", err)
-	//}
-	//
-	//dir, err := os.ReadDir(root)
-	//if err != nil {
-	//	return nil, fmt.Errorf("error reading directory: %s\n", err)
-	//}
-	//// .git will be counted in ReadDir, excluding that
-	//if len(dir) < 2 {
-	//	if err = createSampleFile(root); err != nil {
-	//		return nil, err
-	//	}
-	//}
-	//
-	//log.Info().Str("path", root).Msg("Created new repository")
-	//return newRepo, nil
+	return []byte(contents), nil
 }
 
-// an empty git repo will not have any content and will fail to create other branches
-// so we commit an empty compose file
-func createSampleFile(root string) error {
-	log.Debug().Msg("empty repo, adding dummy readme")
-
-	const dummyFileContent = `Hey there! Hello,
-
-Thanks for using Dockman
-
-This file was auto-created because Dockman needs to initialize a Git repo —
-and Git doesn't like empty folders. So here we are, making history with this very first file.
-
-Feel free to delete or replace me. I won't take it personally.
-`
-
-	err := fileutil.CreateSampleFile(filepath.Join(root, "readme.txt"), dummyFileContent)
+// Diff returns a unified diff of filename between two commits. An empty to
+// is the file as it is now, an empty from is the commit before to, or the
+// last commit if to is empty as well
+func (s *Service) Diff(host, filename, from, to string) (string, error) {
+	repo, rel, err := s.load(host, filename, false)
 	if err != nil {
-		return fmt.Errorf("error writing to dummy readme: %s", err)
+		return "", err
 	}
+	repoRel := repo.repoRel(rel)
 
-	return nil
-}
-
-var ErrStagingDelay = errors.New(`CODE: [STAGING_LAG] Git timed out waiting for the staging operation, it took more than 5 seconds to add files.
-				this is likely due to large files/folders in your compose root
-				To resolve this, refer to: https://github.com/RA341/dockman?tab=readme-ov-file#staging_lag`)
-
-// CommitAll stages all changes (new, modified, deleted) and commits them.
-// It uses a generic commit message.
-func (s *Service) CommitAll() error {
-	return s.WithWorkTree(func(workTree *git.Worktree) error {
-		status, err := workTree.Status()
+	var newContents []byte
+	if to == "" {
+		fsCli, err := s.fs(host, aliasOf(filename))
 		if err != nil {
-			return fmt.Errorf("could not get worktree status: %w", err)
+			return "", err
 		}
-
-		log.Debug().Msg("Got tree status")
-		// todo add proper checks for directory and length checks for more helpful err message
-		err = runWithTimeout(func() error {
-			if status.IsClean() {
-				log.Info().Msg("Working directory is clean, no changes to commit")
-				return nil
-			}
-
-			return fmt.Errorf("working tree is not clean")
-		}, 5*time.Second)
-
-		if errors.Is(err, context.DeadlineExceeded) {
-			return ErrStagingDelay
+		newContents, err = fsCli.ReadFile(rel)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return "", err
 		}
-		if err == nil {
-			// ran within limit and no changes to commit
-			return nil
+	} else {
+		if newContents, err = repo.contentsAt(repoRel, to); err != nil {
+			return "", err
 		}
+	}
 
-		err = workTree.AddWithOptions(&git.AddOptions{All: true})
-		if err != nil {
-			return fmt.Errorf("could not stage changes: %w", err)
-		}
-
-		log.Debug().Msg("Staged all changes")
-
-		commitMsg := "auto commit"
-		commitOpts := &git.CommitOptions{
-			Author: &object.Signature{
-				Name: s.username,
-				When: time.Now(),
-			},
+	if from == "" {
+		if from, err = repo.previousCommit(repoRel, to); err != nil {
+			return "", err
 		}
-
-		commitHash, err := workTree.Commit(commitMsg, commitOpts)
-		if err != nil {
-			return fmt.Errorf("could not create commit: %w", err)
+	}
+	var oldContents []byte
+	if from != "" {
+		if oldContents, err = repo.contentsAt(repoRel, from); err != nil {
+			return "", err
 		}
+	}
 
-		log.Info().Str("hash", commitHash.String()).Msg("Successfully created commit with hash")
-		return nil
-	})
+	return unifiedDiff(rel, oldContents, newContents)
 }
 
-func runWithTimeout(fn func() error, timeout time.Duration) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-
-	done := make(chan error, 1)
-	go func() {
-		done <- fn()
-	}()
+// Snapshot commits contents as the new version of a file in a shadow repo,
+// local aliases are left alone, their history is what is committed to their repo
+func (s *Service) Snapshot(host, alias, relpath string, contents []byte, author string) error {
+	fsCli, err := s.fs(host, alias)
+	if err != nil {
+		return err
+	}
+	if _, local := fsCli.(*filesystem.LocalFileSystem); local {
+		return nil
+	}
 
-	select {
-	case <-ctx.Done():
-		return ctx.Err() // returns context.DeadlineExceeded
-	case err := <-done:
+	repo, _, err := s.load(host, path.Join(alias, filepath.ToSlash(relpath)), true)
+	if err != nil {
 		return err
 	}
+	return repo.snapshot(filepath.ToSlash(relpath), contents, "update "+filepath.ToSlash(relpath), author)
 }
 
-// SwitchBranch switches to a different branch.
-// first commits any outstanding changes.
-func (s *Service) SwitchBranch(name string) error {
-	log.Info().Str("branch", name).Msg("Committing all changes before switching to branch")
-	if err := s.CommitAll(); err != nil {
-		return fmt.Errorf("failed to commit changes before switching branch: %w", err)
+// Commit commits relpaths of an alias together with everything already staged,
+// without relpaths only the staged changes are committed. For shadow repos
+// this snapshots the files as they are now on the host
+func (s *Service) Commit(ctx context.Context, host, alias, message, author string, relpaths ...string) error {
+	repo, _, err := s.load(host, alias, true)
+	if err != nil {
+		return err
 	}
 
-	err := s.WithWorkTree(func(worktree *git.Worktree) error {
-		branchRefName := plumbing.NewBranchReferenceName(name)
-
-		log.Debug().Str("branch", name).Msg("Checking if branch exists...")
-		_, err := s.repo.Reference(branchRefName, true)
-		checkoutOpts := &git.CheckoutOptions{
-			Branch: branchRefName,
-		}
-
-		// If the reference is not found, the branch doesn't exist
-		// set the `Create` flag to true
-		if errors.Is(err, plumbing.ErrReferenceNotFound) {
-			log.Debug().Str("branch", name).Msg("Branch does not exist. Creating it.")
-			checkoutOpts.Create = true
-		} else if err != nil {
-			return fmt.Errorf("could not lookup reference for branch '%s': %w", name, err)
-		}
-
-		if err = worktree.Checkout(checkoutOpts); err != nil {
-			return fmt.Errorf("could not switch to branch '%s': %w", name, err)
+	if !repo.shadow {
+		paths := make([]string, 0, len(relpaths))
+		for _, rel := range relpaths {
+			paths = append(paths, repo.repoRel(rel))
 		}
+		return repo.locked(ctx, func(*git.Worktree) error {
+			return repo.Commit(message, author, paths...)
+		})
+	}
 
-		log.Info().Str("branch", name).Msg("Switched to branch...")
-		return nil
-	})
+	if len(relpaths) == 0 {
+		return fmt.Errorf("no files to commit")
+	}
+	fsCli, err := s.fs(host, alias)
 	if err != nil {
 		return err
 	}
-
-	s.chownComposeRootFunc()
-
-	return nil
-}
-
-func (s *Service) copyFilesFromBranch(filepaths []string, branch string, path string) error {
-	return s.WithWorkTree(func(workTree *git.Worktree) error {
-		// Resolve the importingBranch name to a commit hash.
-		importingBranchRefName := plumbing.NewBranchReferenceName(branch)
-		importingRef, err := s.repo.Reference(importingBranchRefName, true)
-		if err != nil {
-			return fmt.Errorf("could not resolve branch '%s': %w", branch, err)
-		}
-
-		commit, err := s.repo.CommitObject(importingRef.Hash())
+	for _, rel := range relpaths {
+		contents, err := fsCli.ReadFile(rel)
 		if err != nil {
-			return fmt.Errorf("could not get commit object for branch '%s': %w", branch, err)
+			return err
 		}
-
-		for _, fPath := range filepaths {
-			file, err := commit.File(fPath)
-			if err != nil {
-				return fmt.Errorf("could not find file '%s' in branch '%s': %w", filepaths, branch, err)
-			}
-
-			content, err := file.Contents()
-			if err != nil {
-				return fmt.Errorf("could not read file contents: %w", err)
-			}
-
-			// Write the content to the file in the worktree's filesystem.
-			// This creates or overwrites the file on disk.
-
-			fullPath := filepath.Join(path, fPath)
-			dir := filepath.Dir(fullPath)
-			err = os.MkdirAll(dir, 0755)
-			if err != nil {
-				return err
-			}
-
-			if err = os.WriteFile(fullPath, []byte(content), 0644); err != nil {
-				return fmt.Errorf("failed to write file to worktree: %w", err)
-			}
-
-			log.Debug().Str("file", fPath).Str("branch", branch).
-				Msg("File moved from branch")
+		if err = repo.snapshot(filepath.ToSlash(rel), contents, message, author); err != nil {
+			return err
 		}
-
-		return nil
-	})
-
+	}
+	return nil
 }
 
-// SyncFile syncs a file's content to the current worktree from the importingBranch,
-// overwriting it if it exists. It then stages the change.
-func (s *Service) SyncFile(filepaths []string, importingBranch string) error {
-	return s.WithWorkTree(func(workTree *git.Worktree) error {
-		// Resolve the importingBranch name to a commit hash.
-		importingBranchRefName := plumbing.NewBranchReferenceName(importingBranch)
-		importingRef, err := s.repo.Reference(importingBranchRefName, true)
-		if err != nil {
-			return fmt.Errorf("could not resolve branch '%s': %w", importingBranch, err)
-		}
-
-		commit, err := s.repo.CommitObject(importingRef.Hash())
-		if err != nil {
-			return fmt.Errorf("could not get commit object for branch '%s': %w", importingBranch, err)
-		}
-
-		for _, f := range filepaths {
-			file, err := commit.File(f)
-			if err != nil {
-				return fmt.Errorf("could not find file '%s' in branch '%s': %w", filepaths, importingBranch, err)
-			}
-
-			content, err := file.Contents()
-			if err != nil {
-				return fmt.Errorf("could not read file contents: %w", err)
-			}
-
-			// Write the content to the file in the worktree's filesystem.
-			// This creates or overwrites the file on disk.
-			fullPath := workTree.Filesystem.Join(workTree.Filesystem.Root(), f)
-			if err = os.WriteFile(fullPath, []byte(content), 0644); err != nil {
-				return fmt.Errorf("failed to write file to worktree: %w", err)
-			}
-
-			log.Debug().Str("file", f).Str("branch", importingBranch).
-				Msg("File synced to worktree from branch")
-
-			// Stage the newly created/updated file.
-			if _, err = workTree.Add(f); err != nil {
-				return fmt.Errorf("failed to stage file '%s': %w", f, err)
-			}
-		}
-
-		return nil
-	})
-}
-func (s *Service) ListBranches() ([]string, error) {
-	branches, err := s.repo.Branches()
+// Branches lists the branches of the repo a local alias is in
+func (s *Service) Branches(host, alias string) ([]string, error) {
+	repo, err := s.localRepo(host, alias)
 	if err != nil {
-		return nil, fmt.Errorf("could not get list of branches: %w", err)
+		return nil, err
 	}
-
-	var branchesStrs []string
-	_ = branches.ForEach(func(ref *plumbing.Reference) error {
-		branchesStrs = append(branchesStrs, ref.Name().Short())
-		return nil
-	})
-
-	return branchesStrs, nil
+	return repo.ListBranches()
 }
 
-// ListFilesInBranch lists all files tracked in the given branch.
-func (s *Service) ListFilesInBranch(branch string) ([]string, error) {
-	branchRefName := plumbing.NewBranchReferenceName(branch)
-	ref, err := s.repo.Reference(branchRefName, true)
+// BranchFiles lists the files of an alias tracked in branch, relative to the alias
+func (s *Service) BranchFiles(host, alias, branch string) ([]string, error) {
+	repo, err := s.localRepo(host, alias)
 	if err != nil {
-		return nil, fmt.Errorf("could not resolve branch '%s': %w", branch, err)
+		return nil, err
 	}
-
-	commit, err := s.repo.CommitObject(ref.Hash())
+	files, err := repo.ListFilesInBranch(branch)
 	if err != nil {
-		return nil, fmt.Errorf("could not get commit object for branch '%s': %w", branch, err)
+		return nil, err
+	}
+	if repo.prefix == "" {
+		return files, nil
 	}
 
-	tree, err := commit.Tree()
-	if err != nil {
-		return nil, fmt.Errorf("could not get tree for commit '%s': %w", commit.Hash, err)
+	var res []string
+	for _, file := range files {
+		if rel, ok := strings.CutPrefix(file, repo.prefix+"/"); ok {
+			res = append(res, rel)
+		}
 	}
+	return res, nil
+}
 
-	var files []string
-	fileIter := tree.Files()
-	err = fileIter.ForEach(func(f *object.File) error {
-		files = append(files, f.Name)
-		return nil // continue iteration
-	})
+// SyncFiles copies relpaths of a local alias from branch into the worktree
+func (s *Service) SyncFiles(host, alias, branch string, relpaths ...string) error {
+	repo, err := s.localRepo(host, alias)
 	if err != nil {
-		return nil, fmt.Errorf("failed while iterating files in branch '%s': %w", branch, err)
+		return err
+	}
+	paths := make([]string, 0, len(relpaths))
+	for _, rel := range relpaths {
+		paths = append(paths, repo.repoRel(rel))
 	}
 
-	return files, nil
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return repo.SyncFile(paths, branch)
 }
 
-func (s *Service) Commit(commitMessage string, fileList ...string) error {
-	tree, err := s.repo.Worktree()
+// localRepo returns the repo of a local alias, shadow repos only
+// mirror saved files and have no worktree to operate on
+func (s *Service) localRepo(host, alias string) (*Repo, error) {
+	repo, _, err := s.load(host, alias, false)
 	if err != nil {
-		return fmt.Errorf("failed to get worktree: %w", err)
+		return nil, err
+	}
+	if repo.shadow {
+		return nil, ErrRemoteAlias
 	}
+	return repo, nil
+}
 
-	status, err := tree.Status()
+// load returns the repo holding the alias of filename and the relpath of filename.
+// If create is set a repo is created when there is none
+func (s *Service) load(host, filename string, create bool) (*Repo, string, error) {
+	alias, rel, err := splitFilename(filename)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 
-	for _, file := range fileList {
-		_, ok := status[file]
-		if !ok {
-			log.Debug().Str("file", file).Msg("File not found in status probably ignored, skipping...")
-			continue
-		}
-
-		if _, err := tree.Add(file); err != nil {
-			return fmt.Errorf("failed to add file: %w", err)
-		}
+	key := host + "/" + alias
+	if repo, ok := s.aliases.Load(key); ok {
+		return repo, rel, nil
 	}
 
-	gitConfig, err := s.repo.Config()
+	fsCli, err := s.fs(host, alias)
 	if err != nil {
-		return err
+		return nil, "", err
+	}
+	var repo *Repo
+	if _, local := fsCli.(*filesystem.LocalFileSystem); local {
+		repo, err = openLocalRepo(fsCli.Root(), create)
+	} else {
+		repo, err = s.openShadowRepo(host, alias, create)
 	}
-
-	commit, err := tree.Commit(commitMessage, &git.CommitOptions{
-		Author: &object.Signature{
-			Name:  gitConfig.Author.Name,
-			Email: gitConfig.Author.Email,
-			When:  time.Now(),
-		},
-	})
 	if err != nil {
-		return fmt.Errorf("failed to commit changes: %w", err)
+		return nil, "", err
 	}
 
-	log.Debug().
-		Str("hash", commit.String()).
-		Strs("committed-files", fileList).
-		Msg("committed for file with its group")
+	shared, _ := s.repos.LoadOrStore(repo.repoPath, repo)
+	repo.repo, repo.mu = shared.repo, shared.mu
 
-	return nil
+	repo, _ = s.aliases.LoadOrStore(key, repo)
+	return repo, rel, nil
 }
 
-func (s *Service) CommitFileGroup(commitMessage string, filename string) error {
-	//fileList, err := s.fileMan.GetFileGroup(filename)
-	//if err != nil {
-	//	return err
-	//}
-
-	err := s.Commit(commitMessage, filename)
+// openLocalRepo opens the repo root is in, which may be a parent folder
+func openLocalRepo(root string, create bool) (*Repo, error) {
+	root, err := filepath.Abs(root)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	return nil
-}
-
-func (s *Service) LoadFileAtCommit(filePath, commitId string) (string, error) {
-	hash := plumbing.NewHash(commitId)
-	commit, err := s.repo.CommitObject(hash)
-	if err != nil {
-		return "", fmt.Errorf("failed to get commit %s: %w", commitId, err)
+	// resolved so aliases reaching the repo through a symlink share it
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		return nil, err
 	}
 
-	tree, err := commit.Tree()
+	repo, err := git.PlainOpenWithOptions(root, &git.PlainOpenOptions{DetectDotGit: true})
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		if !create {
+			return nil, ErrNoHistory
+		}
+		repo, err = initRepo(root)
+	}
 	if err != nil {
-		return "", fmt.Errorf("failed to get tree from commit: %w", err)
+		return nil, err
 	}
 
-	file, err := tree.File(filePath)
+	worktree, err := repo.Worktree()
 	if err != nil {
-		return "", fmt.Errorf("failed to get file %s from commit %s: %w", filePath, commitId, err)
+		return nil, err
 	}
-
-	content, err := file.Contents()
+	prefix, err := filepath.Rel(worktree.Filesystem.Root(), root)
 	if err != nil {
-		return "", fmt.Errorf("failed to read file contents: %w", err)
+		return nil, err
+	}
+	if prefix == "." {
+		prefix = ""
 	}
 
-	return content, nil
+	return &Repo{
+		repo:                 repo,
+		repoPath:             worktree.Filesystem.Root(),
+		prefix:               filepath.ToSlash(prefix),
+		chownComposeRootFunc: func() {},
+		mu:                   &sync.Mutex{},
+	}, nil
 }
 
-func (s *Service) WithWorkTree(execFn func(worktree *git.Worktree) error) error {
-	worktree, err := s.repo.Worktree()
+func (s *Service) openShadowRepo(host, alias string, create bool) (*Repo, error) {
+	dir := filepath.Join(s.shadowRoot, url.PathEscape(host), url.PathEscape(alias))
+
+	repo, err := git.PlainOpen(dir)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		if !create {
+			return nil, ErrNoHistory
+		}
+		if err = os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		repo, err = initRepo(dir)
+	}
 	if err != nil {
-		return fmt.Errorf("unable to get worktree: %w", err)
+		return nil, err
 	}
 
-	return execFn(worktree)
+	return &Repo{
+		repo:                 repo,
+		repoPath:             dir,
+		shadow:               true,
+		chownComposeRootFunc: func() {},
+		mu:                   &sync.Mutex{},
+	}, nil
 }
-func (s *Service) ListFiles() error {
-	err := s.WithWorkTree(func(worktree *git.Worktree) error {
-		status, err := worktree.Status()
-		if err != nil {
-			return err
-		}
 
-		for filename, stat := range status {
-			//stat.Staging
-			//stat.Worktree
-			log.Debug().Msgf("File %s stats: %v", filename, stat)
-		}
-		//ps, err := gitignore.NewMatcher(fs, nil)
-		return nil
+func initRepo(root string) (*git.Repository, error) {
+	repo, err := git.PlainInitWithOptions(root, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{
+			DefaultBranch: plumbing.NewBranchReferenceName("main"),
+		},
 	})
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("error initializing repository: %w", err)
 	}
+	log.Info().Str("path", root).Msg("Created new repository")
+	return repo, nil
+}
 
-	return nil
+// repoRel returns the path of an alias relpath inside the worktree
+func (s *Repo) repoRel(rel string) string {
+	return path.Join(s.prefix, filepath.ToSlash(rel))
 }
 
-func (s *Service) ListCommitByFile(filePath string) ([]*object.Commit, error) {
-	opts := &git.LogOptions{
-		FileName: &filePath,
+// snapshot writes contents to the worktree and commits it if it changed
+func (s *Repo) snapshot(rel string, contents []byte, message, author string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	worktree, err := s.repo.Worktree()
+	if err != nil {
+		return err
 	}
 
-	var commitList []*object.Commit
+	full := filepath.Join(s.repoPath, filepath.FromSlash(rel))
+	if err = os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+		return err
+	}
+	if err = os.WriteFile(full, contents, 0o644); err != nil {
+		return err
+	}
+	if _, err = worktree.Add(rel); err != nil {
+		return fmt.Errorf("failed to stage %s: %w", rel, err)
+	}
 
-	cIter, err := s.repo.Log(opts)
+	status, err := worktree.Status()
 	if err != nil {
-		log.Debug().Err(err).Str("file", filePath).Msg("could not get commit iterator for file")
-		return commitList, nil
+		return err
 	}
-	defer cIter.Close()
-
-	err = cIter.ForEach(func(c *object.Commit) error {
-		commitList = append(commitList, c)
+	if status.IsClean() {
 		return nil
-	})
-	if err != nil && err != io.EOF {
-		log.Warn().Err(err).Str("file", filePath).Msg("error while iterating commits")
-		return commitList, nil
 	}
 
-	return commitList, nil
+	_, err = worktree.Commit(message, &git.CommitOptions{
+		Author: s.signature(author),
+	})
+	return err
 }
 
-// EditUserConfig updates user configuration, skipping empty parameters
-func (s *Service) EditUserConfig(name, email string) error {
-	conf, err := s.repo.Config()
+func (s *Repo) contentsAt(rel, commitId string) ([]byte, error) {
+	commit, err := s.repo.CommitObject(plumbing.NewHash(commitId))
 	if err != nil {
-		return fmt.Errorf("failed to get config: %w", err)
+		return nil, fmt.Errorf("failed to get commit %s: %w", commitId, err)
 	}
-
-	// Only update name if provided
-	if name != "" {
-		conf.Author.Name = name
+	file, err := commit.File(rel)
+	if errors.Is(err, object.ErrFileNotFound) {
+		// added or deleted in this commit
+		return nil, nil
 	}
-
-	// Only update email if provided
-	if email != "" {
-		conf.Author.Email = email
+	if err != nil {
+		return nil, err
 	}
-
-	// Save the configuration back to the repository
-	return s.repo.Storer.SetConfig(conf)
+	reader, err := file.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			log.Warn().Err(err).Msg("unable to close blob reader")
+		}
+	}()
+	return io.ReadAll(reader)
 }
 
-func (s *Service) EditRemote(remoteNickname string, repoUrl string) error {
-	_, err := s.repo.CreateRemote(
-		&config.RemoteConfig{
-			Name: remoteNickname,
-			URLs: []string{repoUrl},
-		})
+// previousCommit returns the last commit that changed rel before commitId,
+// or the last commit that changed it at all if commitId is empty
+func (s *Repo) previousCommit(rel, commitId string) (string, error) {
+	commits, err := s.ListCommitByFile(rel)
 	if err != nil {
-		return err
+		return "", err
+	}
+	if commitId == "" {
+		if len(commits) == 0 {
+			return "", nil
+		}
+		return commits[0].Hash.String(), nil
 	}
+	for i, c := range commits {
+		if c.Hash.String() == commitId && i+1 < len(commits) {
+			return commits[i+1].Hash.String(), nil
+		}
+	}
+	return "", nil
+}
 
-	return nil
+func splitFilename(filename string) (alias string, rel string, err error) {
+	rel, alias, err = utils.ExtractMeta(filename)
+	return alias, rel, err
+}
+
+func aliasOf(filename string) string {
+	alias, _, _ := splitFilename(filename)
+	return alias
 }
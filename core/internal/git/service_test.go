@@ -1,14 +1,22 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/RA341/dockman/internal/database"
+	"github.com/RA341/dockman/internal/host/filesystem"
 	"github.com/RA341/dockman/pkg/fileutil"
 	"github.com/RA341/dockman/pkg/logger"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,28 +33,72 @@ func Test_migrator(t *testing.T) {
 	}
 }
 
-func Test_WithStagingDelay(t *testing.T) {
-	root := "./tests"
-	defer os.RemoveAll(root)
-
-	err := os.MkdirAll(root, os.ModePerm)
-	require.NoError(t, err)
+func Test_CommitAllCancel(t *testing.T) {
+	root := t.TempDir()
 	// Create a large garbage files to slow down git operations
 	createComplexDirectoryStructure(t, root)
 
-	_, err = newSrv(root, nil)
-	require.ErrorIs(t, err, ErrStagingDelay, "new srv should fail with a complex dir structure")
+	repo, err := openLocalRepo(root, true)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond)
+	defer cancel()
+	err = repo.CommitAll(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "commit should stop waiting once the context is done")
+
+	// waits for the cancelled commit to let go of the worktree
+	require.NoError(t, repo.locked(t.Context(), func(*git.Worktree) error { return nil }))
+	_, err = repo.repo.Head()
+	require.ErrorIs(t, err, plumbing.ErrReferenceNotFound, "cancelled commit should not commit anything")
+}
+
+func TestStatus(t *testing.T) {
+	root := t.TempDir()
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(root), nil
+	}, t.TempDir())
+	ctx := t.Context()
 
-	err = os.RemoveAll(root)
+	status, err := srv.Status(ctx, "local", "compose")
 	require.NoError(t, err)
+	require.Empty(t, status)
 
-	err = os.MkdirAll(root, os.ModePerm)
+	require.NoError(t, os.WriteFile(filepath.Join(root, "compose.yaml"), []byte("v1"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".env"), []byte("SECRET=1"), 0o644))
+	require.NoError(t, srv.SaveIgnore("local", "compose", ".env\n"))
+	_, err = openLocalRepo(root, true)
 	require.NoError(t, err)
 
-	err = createLargeGarbageFile(t, root, fileSize10MB)
+	require.NoError(t, srv.Stage(ctx, "local", "compose", "compose.yaml"))
+	status, err = srv.Status(ctx, "local", "compose")
+	require.NoError(t, err)
+	require.Equal(t, []FileStatus{
+		{Path: ".gitignore", Staging: FileUntracked, Worktree: FileUntracked},
+		{Path: "compose.yaml", Staging: FileAdded, Worktree: FileUnmodified},
+	}, status, ".env is ignored")
+
+	require.NoError(t, srv.Unstage(ctx, "local", "compose", "compose.yaml"))
+	status, err = srv.Status(ctx, "local", "compose")
+	require.NoError(t, err)
+	require.Equal(t, FileUntracked, status[1].Staging)
 
-	_, err = newSrv(root, nil)
+	require.NoError(t, srv.Stage(ctx, "local", "compose", "compose.yaml"))
+	require.NoError(t, srv.Commit(ctx, "local", "compose", "add compose", "alice"))
+	commits, err := srv.History("local", "compose/compose.yaml")
 	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.Equal(t, "alice", commits[0].Author)
+
+	require.NoError(t, os.Remove(filepath.Join(root, "compose.yaml")))
+	require.NoError(t, srv.Stage(ctx, "local", "compose", "compose.yaml"))
+	status, err = srv.Status(ctx, "local", "compose")
+	require.NoError(t, err)
+	require.Contains(t, status, FileStatus{Path: "compose.yaml", Staging: FileDeleted, Worktree: FileUnmodified})
+
+	require.NoError(t, srv.Unstage(ctx, "local", "compose", "compose.yaml"))
+	status, err = srv.Status(ctx, "local", "compose")
+	require.NoError(t, err)
+	require.Contains(t, status, FileStatus{Path: "compose.yaml", Staging: FileUnmodified, Worktree: FileDeleted})
 }
 
 func createComplexDirectoryStructure(t *testing.T, root string) {
@@ -380,3 +432,288 @@ func createLargeGarbageFile(t *testing.T, root string, fileSize int) error {
 //
 //	return hierarchy
 //}
+
+// remoteFS hides the local filesystem so the service treats it like a remote host
+type remoteFS struct {
+	filesystem.FileSystem
+}
+
+func TestHistory(t *testing.T) {
+	localRoot := t.TempDir()
+	remoteRoot := t.TempDir()
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		if host == "remote" {
+			return remoteFS{filesystem.NewLocal(remoteRoot)}, nil
+		}
+		return filesystem.NewLocal(localRoot), nil
+	}, t.TempDir())
+
+	t.Run("shadow repo", func(t *testing.T) {
+		commits, err := srv.History("remote", "compose/app/compose.yaml")
+		require.NoError(t, err)
+		require.Empty(t, commits)
+
+		for _, contents := range []string{"a\nb\n", "a\nc\n", "a\nc\n"} {
+			require.NoError(t, os.MkdirAll(filepath.Join(remoteRoot, "app"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(remoteRoot, "app", "compose.yaml"), []byte(contents), 0o644))
+			require.NoError(t, srv.Snapshot("remote", "compose", "app/compose.yaml", []byte(contents), ""))
+		}
+
+		commits, err = srv.History("remote", "compose/app/compose.yaml")
+		require.NoError(t, err)
+		require.Len(t, commits, 2, "unchanged contents should not be committed")
+		require.Equal(t, defaultAuthor, commits[0].Author)
+
+		contents, err := srv.FileAt("remote", "compose/app/compose.yaml", commits[1].Hash)
+		require.NoError(t, err)
+		require.Equal(t, "a\nb\n", string(contents))
+
+		diff, err := srv.Diff("remote", "compose/app/compose.yaml", "", commits[0].Hash)
+		require.NoError(t, err)
+		require.Contains(t, diff, "-b\n")
+		require.Contains(t, diff, "+c\n")
+
+		require.NoError(t, os.WriteFile(filepath.Join(remoteRoot, "app", "compose.yaml"), []byte("a\nd\n"), 0o644))
+		diff, err = srv.Diff("remote", "compose/app/compose.yaml", "", "")
+		require.NoError(t, err)
+		require.Contains(t, diff, "-c\n")
+		require.Contains(t, diff, "+d\n")
+
+		_, err = srv.Branches("remote", "compose")
+		require.Error(t, err)
+	})
+
+	t.Run("local repo", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(localRoot, "compose.yaml"), []byte("v1\n"), 0o644))
+		require.NoError(t, srv.Snapshot("local", "compose", "compose.yaml", []byte("v1\n"), ""))
+
+		commits, err := srv.History("local", "compose/compose.yaml")
+		require.NoError(t, err)
+		require.Empty(t, commits, "local aliases are only changed by commits")
+
+		require.NoError(t, srv.Commit(t.Context(), "local", "compose", "first", "", "compose.yaml"))
+		require.NoError(t, os.WriteFile(filepath.Join(localRoot, "compose.yaml"), []byte("v2\n"), 0o644))
+		require.NoError(t, srv.Commit(t.Context(), "local", "compose", "second", "", "compose.yaml"))
+
+		commits, err = srv.History("local", "compose/compose.yaml")
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		require.Equal(t, "second", commits[0].Message)
+
+		diff, err := srv.Diff("local", "compose/compose.yaml", commits[1].Hash, commits[0].Hash)
+		require.NoError(t, err)
+		require.Contains(t, diff, "-v1\n")
+		require.Contains(t, diff, "+v2\n")
+
+		branches, err := srv.Branches("local", "compose")
+		require.NoError(t, err)
+		require.Equal(t, []string{"main"}, branches)
+	})
+}
+
+func TestSharedRepo(t *testing.T) {
+	root := t.TempDir()
+	_, err := openLocalRepo(root, true)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "app"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "other"), 0o755))
+
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(filepath.Join(root, alias)), nil
+	}, t.TempDir())
+
+	app, _, err := srv.load("local", "app/compose.yaml", false)
+	require.NoError(t, err)
+	other, _, err := srv.load("local", "other/compose.yaml", false)
+	require.NoError(t, err)
+
+	require.Equal(t, "app", app.prefix)
+	require.Equal(t, "other", other.prefix)
+	require.Same(t, app.mu, other.mu, "aliases in one repo should share its lock")
+	require.Same(t, app.repo, other.repo)
+}
+
+func TestRollback(t *testing.T) {
+	localRoot := t.TempDir()
+	remoteRoot := t.TempDir()
+	srv := New(func(host, alias string) (filesystem.FileSystem, error) {
+		if host == "remote" {
+			return remoteFS{filesystem.NewLocal(remoteRoot)}, nil
+		}
+		return filesystem.NewLocal(localRoot), nil
+	}, t.TempDir())
+	ctx := t.Context()
+
+	write := func(root, name, contents string) {
+		full := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o755))
+		require.NoError(t, os.WriteFile(full, []byte(contents), 0o644))
+	}
+	read := func(root, name string) string {
+		contents, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(name)))
+		require.NoError(t, err)
+		return string(contents)
+	}
+
+	t.Run("local repo", func(t *testing.T) {
+		_, err := openLocalRepo(localRoot, true)
+		require.NoError(t, err)
+
+		write(localRoot, "app/compose.yaml", "v1")
+		write(localRoot, "app/.env", "TAG=1")
+		write(localRoot, "other/compose.yaml", "v1")
+		require.NoError(t, srv.Commit(ctx, "local", "compose", "first", "", "app/compose.yaml", "app/.env", "other/compose.yaml"))
+
+		write(localRoot, "app/compose.yaml", "v2")
+		write(localRoot, "app/.env", "TAG=2")
+		write(localRoot, "app/extra.conf", "new")
+		write(localRoot, "other/compose.yaml", "v2")
+		require.NoError(t, srv.Commit(ctx, "local", "compose", "second", "", "app/compose.yaml", "app/.env", "app/extra.conf", "other/compose.yaml"))
+
+		commits, err := srv.History("local", "compose/app/compose.yaml")
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+
+		// saved edits that were never committed
+		write(localRoot, "app/compose.yaml", "v3")
+
+		res, err := srv.Rollback(ctx, "local", "compose/app/compose.yaml", commits[1].Hash[:7], "")
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Hash)
+		require.Equal(t, []string{"app/.env", "app/compose.yaml", "app/extra.conf"}, res.Files)
+
+		require.Equal(t, "v1", read(localRoot, "app/compose.yaml"))
+		require.Equal(t, "TAG=1", read(localRoot, "app/.env"))
+		require.NoFileExists(t, filepath.Join(localRoot, "app", "extra.conf"))
+		require.Equal(t, "v2", read(localRoot, "other/compose.yaml"), "other stacks should be left alone")
+
+		commits, err = srv.History("local", "compose/app/compose.yaml")
+		require.NoError(t, err)
+		require.Len(t, commits, 4)
+		require.Contains(t, commits[0].Message, "rollback compose/app to")
+		require.Equal(t, "save compose/app before rollback", commits[1].Message)
+
+		saved, err := srv.FileAt("local", "compose/app/compose.yaml", commits[1].Hash)
+		require.NoError(t, err)
+		require.Equal(t, "v3", string(saved), "uncommitted changes should be kept in history")
+
+		status, err := srv.Status(ctx, "local", "compose")
+		require.NoError(t, err)
+		require.Empty(t, status)
+
+		res, err = srv.Rollback(ctx, "local", "compose/app/compose.yaml", commits[3].Hash, "")
+		require.NoError(t, err)
+		require.Empty(t, res.Hash, "already at the commit")
+
+		write(localRoot, "compose.yaml", "root")
+		require.NoError(t, srv.Commit(ctx, "local", "compose", "root stack", "", "compose.yaml"))
+		commits, err = srv.History("local", "compose/compose.yaml")
+		require.NoError(t, err)
+		_, err = srv.Rollback(ctx, "local", "compose/compose.yaml", commits[0].Hash, "")
+		require.Error(t, err, "a root stack would roll back the whole repo")
+		require.Equal(t, "v1", read(localRoot, "app/compose.yaml"))
+	})
+
+	t.Run("shadow repo", func(t *testing.T) {
+		for _, contents := range []string{"v1", "v2"} {
+			write(remoteRoot, "app/compose.yaml", contents)
+			require.NoError(t, srv.Snapshot("remote", "compose", "app/compose.yaml", []byte(contents), ""))
+		}
+		commits, err := srv.History("remote", "compose/app/compose.yaml")
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+
+		res, err := srv.Rollback(ctx, "remote", "compose/app/compose.yaml", commits[1].Hash, "")
+		require.NoError(t, err)
+		require.Equal(t, []string{"app/compose.yaml"}, res.Files)
+		require.Equal(t, "v1", read(remoteRoot, "app/compose.yaml"))
+	})
+}
+
+func TestSync(t *testing.T) {
+	upstream := t.TempDir()
+	upstreamRepo, err := initRepo(upstream)
+	require.NoError(t, err)
+	commitUpstream := func(files map[string]string) {
+		worktree, err := upstreamRepo.Worktree()
+		require.NoError(t, err)
+		for name, contents := range files {
+			full := filepath.Join(upstream, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o755))
+			require.NoError(t, os.WriteFile(full, []byte(contents), 0o644))
+			_, err = worktree.Add(name)
+			require.NoError(t, err)
+		}
+		_, err = worktree.Commit("upstream", &git.CommitOptions{
+			Author: &object.Signature{Name: "test", When: time.Now()},
+		})
+		require.NoError(t, err)
+	}
+	commitUpstream(map[string]string{"app/compose.yaml": "v1", "README.md": "readme"})
+
+	aliasRoot := t.TempDir()
+	repos := New(func(host, alias string) (filesystem.FileSystem, error) {
+		return filesystem.NewLocal(aliasRoot), nil
+	}, t.TempDir())
+	srv := NewSyncService(repos, NewSyncStore(database.New(t.TempDir(), false)), nil, nil, nil)
+
+	require.NoError(t, srv.SaveConfig(&SyncConfig{
+		Host:   "local",
+		Alias:  "compose",
+		Remote: upstream,
+		Branch: "main",
+	}))
+
+	res, err := srv.Run(t.Context(), "local", "compose")
+	require.NoError(t, err)
+	require.Equal(t, SyncUpdated, res.State, res.Err)
+	require.Equal(t, "README.md\napp/compose.yaml", res.Changed)
+	contents, err := os.ReadFile(filepath.Join(aliasRoot, "app", "compose.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "v1", string(contents))
+
+	res, err = srv.Run(t.Context(), "local", "compose")
+	require.NoError(t, err)
+	require.Equal(t, SyncUpToDate, res.State, res.Err)
+
+	commitUpstream(map[string]string{"other/compose.yaml": "v1", "other/config/app.conf": "conf"})
+	res, err = srv.Run(t.Context(), "local", "compose")
+	require.NoError(t, err)
+	require.Equal(t, SyncUpdated, res.State, res.Err)
+	require.Equal(t, "other/compose.yaml\nother/config/app.conf", res.Changed)
+
+	stacks := changedStacks(
+		"compose",
+		[]string{"compose/app/compose.yaml", "compose/other/compose.yaml"},
+		strings.Split(res.Changed, "\n"),
+	)
+	require.Equal(t, []string{"compose/other/compose.yaml"}, stacks)
+
+	// local edits to tracked files block the fast-forward
+	require.NoError(t, os.WriteFile(filepath.Join(aliasRoot, "app", "compose.yaml"), []byte("local"), 0o644))
+	commitUpstream(map[string]string{"app/compose.yaml": "v2"})
+	res, err = srv.Run(t.Context(), "local", "compose")
+	require.NoError(t, err)
+	require.Equal(t, SyncConflict, res.State)
+
+	history, err := srv.History("local", "compose")
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+	require.Equal(t, SyncConflict, history[0].State)
+
+	// saved tokens are only kept for the same server
+	save := func(remote, username, token string) SyncConfig {
+		require.NoError(t, srv.SaveConfig(&SyncConfig{
+			Host: "local", Alias: "compose", Remote: remote, Branch: "main", Username: username, Token: token,
+		}))
+		conf, err := srv.GetConfig("local", "compose")
+		require.NoError(t, err)
+		return conf
+	}
+	save("https://git.example.com/me/stacks.git", "me", "secret")
+	conf := save("https://git.example.com/me/other.git", "me", "")
+	require.Equal(t, "secret", conf.Token)
+	conf = save("https://evil.example.org/me/other.git", "me", "")
+	require.Empty(t, conf.Token)
+	require.Empty(t, conf.Username)
+}
@@ -0,0 +1,168 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+type FileState string
+
+const (
+	FileUnmodified FileState = "unmodified"
+	FileUntracked  FileState = "untracked"
+	FileModified   FileState = "modified"
+	FileAdded      FileState = "added"
+	FileDeleted    FileState = "deleted"
+	FileRenamed    FileState = "renamed"
+	FileCopied     FileState = "copied"
+	FileUnmerged   FileState = "unmerged"
+)
+
+var fileStates = map[git.StatusCode]FileState{
+	git.Unmodified:         FileUnmodified,
+	git.Untracked:          FileUntracked,
+	git.Modified:           FileModified,
+	git.Added:              FileAdded,
+	git.Deleted:            FileDeleted,
+	git.Renamed:            FileRenamed,
+	git.Copied:             FileCopied,
+	git.UpdatedButUnmerged: FileUnmerged,
+}
+
+// FileStatus the state of a changed file in the index and the worktree
+type FileStatus struct {
+	// Path relative to the alias
+	Path     string
+	Staging  FileState
+	Worktree FileState
+}
+
+const ignoreFile = ".gitignore"
+
+// Status lists the changed files of an alias, files ignored by .gitignore are left out
+func (s *Service) Status(ctx context.Context, host, alias string) ([]FileStatus, error) {
+	repo, err := s.localRepo(host, alias)
+	if errors.Is(err, ErrNoHistory) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res []FileStatus
+	err = repo.locked(ctx, func(worktree *git.Worktree) error {
+		status, err := worktree.Status()
+		if err != nil {
+			return err
+		}
+
+		for name, file := range status {
+			rel, ok := repo.aliasRel(name)
+			if !ok {
+				continue
+			}
+			res = append(res, FileStatus{
+				Path:     rel,
+				Staging:  fileStates[file.Staging],
+				Worktree: fileStates[file.Worktree],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(res, func(a, b FileStatus) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return res, nil
+}
+
+// Stage adds the current contents of relpaths to the index,
+// deleted files are removed from it
+func (s *Service) Stage(ctx context.Context, host, alias string, relpaths ...string) error {
+	repo, err := s.localRepo(host, alias)
+	if err != nil {
+		return err
+	}
+
+	return repo.locked(ctx, func(worktree *git.Worktree) error {
+		for _, rel := range relpaths {
+			path := repo.repoRel(rel)
+			if _, err := worktree.Add(path); err != nil {
+				return fmt.Errorf("failed to stage %s: %w", rel, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Unstage resets relpaths in the index to HEAD, keeping the changes in the worktree
+func (s *Service) Unstage(ctx context.Context, host, alias string, relpaths ...string) error {
+	repo, err := s.localRepo(host, alias)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(relpaths))
+	for _, rel := range relpaths {
+		paths = append(paths, repo.repoRel(rel))
+	}
+
+	return repo.locked(ctx, func(worktree *git.Worktree) error {
+		_, err := repo.repo.Head()
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			// nothing committed yet, unstaging drops the files from the index
+			return repo.removeFromIndex(paths)
+		}
+		if err != nil {
+			return err
+		}
+
+		return worktree.Restore(&git.RestoreOptions{Staged: true, Files: paths})
+	})
+}
+
+func (s *Repo) removeFromIndex(paths []string) error {
+	idx, err := s.repo.Storer.Index()
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if _, err = idx.Remove(path); err != nil {
+			return fmt.Errorf("failed to unstage %s: %w", path, err)
+		}
+	}
+	return s.repo.Storer.SetIndex(idx)
+}
+
+// Ignore returns the .gitignore at the root of an alias, empty if there is none
+func (s *Service) Ignore(host, alias string) (string, error) {
+	fsCli, err := s.fs(host, alias)
+	if err != nil {
+		return "", err
+	}
+	contents, err := fsCli.ReadFile(ignoreFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	return string(contents), err
+}
+
+// SaveIgnore replaces the .gitignore at the root of an alias
+func (s *Service) SaveIgnore(host, alias, contents string) error {
+	fsCli, err := s.fs(host, alias)
+	if err != nil {
+		return err
+	}
+	return filesystem.WriteAtomic(fsCli, ignoreFile, strings.NewReader(contents), 0o644)
+}
@@ -0,0 +1,75 @@
+package git
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type SyncState string
+
+const (
+	SyncUpToDate SyncState = "up-to-date"
+	SyncUpdated  SyncState = "updated"
+	// SyncConflict the alias has local changes or diverged from the remote
+	SyncConflict SyncState = "conflict"
+	SyncFailed   SyncState = "failed"
+)
+
+// SyncConfig an alias that tracks a branch of a remote repo
+type SyncConfig struct {
+	gorm.Model
+	Host  string `gorm:"uniqueIndex:idx_sync_alias"`
+	Alias string `gorm:"uniqueIndex:idx_sync_alias"`
+
+	Enabled  bool
+	Interval time.Duration
+	Remote   string
+	Branch   string
+	// Deploy runs compose up on every stack changed by a sync
+	Deploy bool
+
+	// Username and Token are used for http remotes
+	Username string
+	Token    string
+	// SSHKey name of the key in the ssh key store used for ssh remotes
+	SSHKey string
+	// HostKey of the ssh remote, saved on first connect and verified after
+	HostKey string
+}
+
+// SyncResult a single sync of an alias
+type SyncResult struct {
+	gorm.Model
+	Host  string
+	Alias string
+
+	State SyncState
+	Err   string
+	// From and To commits of the alias before and after the sync
+	From string
+	To   string
+	// Changed files relative to the alias, newline separated
+	Changed string
+
+	Deploys []SyncDeploy
+}
+
+// SyncDeploy the compose up of a stack changed by a sync
+type SyncDeploy struct {
+	gorm.Model
+	SyncResultID uint
+	Filename     string
+	JobID        string
+	State        string
+	Err          string
+}
+
+type SyncStore interface {
+	GetEnabled() ([]SyncConfig, error)
+	GetConfig(host, alias string) (SyncConfig, error)
+	SaveConfig(*SyncConfig) error
+
+	AddResult(*SyncResult) error
+	ListResults(host, alias string) ([]SyncResult, error)
+}
@@ -0,0 +1,99 @@
+package git
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type GormSyncStore struct {
+	db *gorm.DB
+}
+
+func NewSyncStore(db *gorm.DB) *GormSyncStore {
+	return &GormSyncStore{db: db}
+}
+
+func (g *GormSyncStore) GetEnabled() ([]SyncConfig, error) {
+	var results []SyncConfig
+	err := g.db.
+		Where("enabled = ?", true).
+		Find(&results).
+		Error
+
+	return results, err
+}
+
+// GetConfig returns the sync config of an alias, a zero config if it has none
+func (g *GormSyncStore) GetConfig(host, alias string) (SyncConfig, error) {
+	var dest SyncConfig
+	err := g.db.
+		Where("host = ? AND alias = ?", host, alias).
+		First(&dest).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return SyncConfig{Host: host, Alias: alias}, nil
+	}
+	return dest, err
+}
+
+func (g *GormSyncStore) SaveConfig(config *SyncConfig) error {
+	return g.db.Save(config).Error
+}
+
+const maxSyncResults = 50
+
+func (g *GormSyncStore) AddResult(result *SyncResult) error {
+	return g.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(result).Error
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		err = tx.Model(&SyncResult{}).
+			Where("host = ? AND alias = ?", result.Host, result.Alias).
+			Count(&count).Error
+		if err != nil || count <= maxSyncResults {
+			return err
+		}
+
+		var old []SyncResult
+		// Find the oldest results to delete
+		err = tx.
+			Where("host = ? AND alias = ?", result.Host, result.Alias).
+			Order("created_at ASC").
+			Limit(int(count - maxSyncResults)).
+			Find(&old).Error
+		if err != nil {
+			return err
+		}
+
+		for _, res := range old {
+			err = tx.Where("sync_result_id = ?", res.ID).Delete(&SyncDeploy{}).Error
+			if err != nil {
+				return err
+			}
+			err = tx.Delete(&res).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (g *GormSyncStore) ListResults(host, alias string) ([]SyncResult, error) {
+	var result []SyncResult
+	err := g.db.
+		Preload("Deploys").
+		Where("host = ? AND alias = ?", host, alias).
+		Order("created_at DESC").
+		Find(&result).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
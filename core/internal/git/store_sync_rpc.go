@@ -0,0 +1,60 @@
+package git
+
+import (
+	"strings"
+	"time"
+
+	v1 "github.com/RA341/dockman/generated/git/v1"
+	"github.com/RA341/dockman/pkg/listutils"
+)
+
+func (c *SyncConfig) ToProto() *v1.SyncConfig {
+	return &v1.SyncConfig{
+		Alias:             c.Alias,
+		Enabled:           c.Enabled,
+		Remote:            c.Remote,
+		Branch:            c.Branch,
+		IntervalInMinutes: uint32(c.Interval.Minutes()),
+		Deploy:            c.Deploy,
+		Username:          c.Username,
+		HasToken:          c.Token != "",
+		SshKey:            c.SSHKey,
+		HostKey:           c.HostKey,
+	}
+}
+
+func (c *SyncConfig) FromProto(rpcConf *v1.SyncConfig) {
+	c.Alias = rpcConf.Alias
+	c.Enabled = rpcConf.Enabled
+	c.Remote = rpcConf.Remote
+	c.Branch = rpcConf.Branch
+	c.Interval = time.Duration(rpcConf.IntervalInMinutes) * time.Minute
+	c.Deploy = rpcConf.Deploy
+	c.Username = rpcConf.Username
+	c.Token = rpcConf.Token
+	c.SSHKey = rpcConf.SshKey
+}
+
+func (r *SyncResult) ToProto() *v1.SyncRun {
+	var changed []string
+	if r.Changed != "" {
+		changed = strings.Split(r.Changed, "\n")
+	}
+
+	return &v1.SyncRun{
+		Time:    r.CreatedAt.Unix(),
+		State:   string(r.State),
+		Error:   r.Err,
+		From:    r.From,
+		To:      r.To,
+		Changed: changed,
+		Deploys: listutils.ToMap(r.Deploys, func(d SyncDeploy) *v1.SyncDeploy {
+			return &v1.SyncDeploy{
+				Filename: d.Filename,
+				State:    d.State,
+				Error:    d.Err,
+				JobId:    d.JobID,
+			}
+		}),
+	}
+}
@@ -0,0 +1,661 @@
+package git
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/docker/compose"
+	"github.com/RA341/dockman/internal/docker/jobs"
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/RA341/dockman/internal/ssh"
+	"github.com/RA341/dockman/pkg/syncmap"
+	"github.com/go-co-op/gocron/v2"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	ssh2 "golang.org/x/crypto/ssh"
+)
+
+type DockerProvider func(host string) (*docker.Service, error)
+
+// syncRemote the remote synced aliases track, kept separate
+// so it never touches remotes the user set up themselves
+const syncRemote = "dockman"
+
+const minSyncInterval = time.Minute
+
+// SyncService keeps aliases in sync with a branch of a remote repo,
+// fast-forwarding them and deploying the stacks that changed
+type SyncService struct {
+	repos  *Service
+	store  SyncStore
+	keys   ssh.KeyManager
+	docker DockerProvider
+	jobs   *jobs.Service
+	log    zerolog.Logger
+
+	taskList syncmap.Map[string, gocron.Job]
+	schd     gocron.Scheduler
+	// syncLocks serializes syncs of the same alias
+	syncLocks syncmap.Map[string, *sync.Mutex]
+}
+
+func NewSyncService(
+	repos *Service,
+	store SyncStore,
+	keys ssh.KeyManager,
+	docker DockerProvider,
+	jobs *jobs.Service,
+) *SyncService {
+	s := &SyncService{
+		repos:  repos,
+		store:  store,
+		keys:   keys,
+		docker: docker,
+		jobs:   jobs,
+		log:    log.With().Str("service", "git sync").Logger(),
+	}
+
+	schd, err := gocron.NewScheduler()
+	if err != nil {
+		s.log.Fatal().Err(err).Msg("Failed to initialize sync scheduler")
+	}
+	s.schd = schd
+	schd.Start()
+
+	s.StartEnabled()
+
+	return s
+}
+
+func (s *SyncService) StartEnabled() {
+	enabled, err := s.store.GetEnabled()
+	if err != nil {
+		s.log.Warn().Err(err).Msg("Failed to get enabled sync configs")
+		return
+	}
+
+	for _, conf := range enabled {
+		if err = s.schedule(&conf); err != nil {
+			s.log.Warn().Err(err).Str("host", conf.Host).Str("alias", conf.Alias).
+				Msg("Failed to schedule sync")
+		}
+	}
+}
+
+func (s *SyncService) GetConfig(host, alias string) (SyncConfig, error) {
+	return s.store.GetConfig(host, alias)
+}
+
+// SaveConfig updates the sync config of an alias and reschedules it,
+// an empty token keeps the saved one as long as the remote stays on the same server
+func (s *SyncService) SaveConfig(conf *SyncConfig) error {
+	if conf.Enabled && (conf.Remote == "" || conf.Branch == "") {
+		return fmt.Errorf("remote and branch are required")
+	}
+	if conf.Enabled && conf.Interval < minSyncInterval {
+		return fmt.Errorf("interval must be at least %s", minSyncInterval)
+	}
+
+	existing, err := s.store.GetConfig(conf.Host, conf.Alias)
+	if err != nil {
+		return err
+	}
+	conf.ID = existing.ID
+	conf.CreatedAt = existing.CreatedAt
+	if conf.Token == "" {
+		if sameServer(conf.Remote, existing.Remote) {
+			conf.Token = existing.Token
+		} else {
+			// the saved credentials belong to the old server
+			conf.Username = ""
+		}
+	}
+	if conf.Remote != existing.Remote {
+		// a different remote has a different host key
+		conf.HostKey = ""
+	} else {
+		conf.HostKey = existing.HostKey
+	}
+
+	if err = s.store.SaveConfig(conf); err != nil {
+		return err
+	}
+	return s.schedule(conf)
+}
+
+// sameServer reports whether both remotes point to the same host
+func sameServer(remote, other string) bool {
+	if remote == other {
+		return true
+	}
+	endpoint, err := transport.NewEndpoint(remote)
+	if err != nil {
+		return false
+	}
+	otherEndpoint, err := transport.NewEndpoint(other)
+	if err != nil {
+		return false
+	}
+	return endpoint.Protocol == otherEndpoint.Protocol &&
+		strings.EqualFold(endpoint.Host, otherEndpoint.Host) &&
+		endpoint.Port == otherEndpoint.Port
+}
+
+func (s *SyncService) History(host, alias string) ([]SyncResult, error) {
+	return s.store.ListResults(host, alias)
+}
+
+func (s *SyncService) schedule(conf *SyncConfig) error {
+	key := conf.Host + "/" + conf.Alias
+
+	jb, ok := s.taskList.Load(key)
+	if !conf.Enabled {
+		if ok {
+			s.taskList.Delete(key)
+			return s.schd.RemoveJob(jb.ID())
+		}
+		return nil
+	}
+
+	jobDef := gocron.DurationJob(conf.Interval)
+	task := gocron.NewTask(s.scheduled, conf.Host, conf.Alias)
+
+	var err error
+	if ok {
+		jb, err = s.schd.Update(jb.ID(), jobDef, task)
+	} else {
+		jb, err = s.schd.NewJob(jobDef, task, gocron.WithSingletonMode(gocron.LimitModeReschedule))
+	}
+	if err != nil {
+		return err
+	}
+
+	s.taskList.Store(key, jb)
+	return nil
+}
+
+func (s *SyncService) scheduled(ctx context.Context, host, alias string) {
+	if _, err := s.Run(ctx, host, alias); err != nil {
+		s.log.Warn().Err(err).Str("host", host).Str("alias", alias).Msg("sync failed")
+	}
+}
+
+// Run syncs an alias with its remote and deploys the changed stacks,
+// the outcome is saved to the sync history, conflicts and failed
+// deploys are part of the result and not returned as errors
+func (s *SyncService) Run(ctx context.Context, host, alias string) (*SyncResult, error) {
+	return s.Pull(ctx, host, alias, nil)
+}
+
+// Pull is Run with the git transfer progress written to progress, which may be nil
+func (s *SyncService) Pull(ctx context.Context, host, alias string, progress io.Writer) (*SyncResult, error) {
+	conf, err := s.store.GetConfig(host, alias)
+	if err != nil {
+		return nil, err
+	}
+	if conf.Remote == "" || conf.Branch == "" {
+		return nil, fmt.Errorf("alias %s has no remote to sync with", alias)
+	}
+
+	lock, _ := s.syncLocks.LoadOrStore(host+"/"+alias, &sync.Mutex{})
+	lock.Lock()
+	defer lock.Unlock()
+
+	result := &SyncResult{Host: host, Alias: alias}
+	changed, err := s.pull(ctx, &conf, result, progress)
+	switch {
+	case err != nil:
+		result.Err = err.Error()
+	case result.From == result.To:
+		result.State = SyncUpToDate
+	default:
+		result.State = SyncUpdated
+		result.Changed = strings.Join(changed, "\n")
+		if conf.Deploy && len(changed) != 0 {
+			s.deploy(ctx, host, alias, changed, result)
+		}
+	}
+
+	if err = s.store.AddResult(result); err != nil {
+		s.log.Err(err).Msg("Failed to add sync result")
+	}
+	return result, nil
+}
+
+// pull fast-forwards the alias to the remote branch and returns the
+// files that changed relative to the alias. The state of the result
+// is set for conflicts and failures
+func (s *SyncService) pull(ctx context.Context, conf *SyncConfig, result *SyncResult, progress io.Writer) ([]string, error) {
+	result.State = SyncFailed
+
+	auth, err := s.auth(conf)
+	if err != nil {
+		return nil, err
+	}
+	defer s.saveHostKey(conf, conf.HostKey)
+
+	repo, cloned, err := s.open(ctx, conf, auth, progress)
+	if err != nil {
+		return nil, err
+	}
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	branch := plumbing.NewBranchReferenceName(conf.Branch)
+	head, err := repo.repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get HEAD: %w", err)
+	}
+	if cloned {
+		// everything in a fresh clone is new
+		result.To = head.Hash().String()
+		return repo.changedFiles("", result.To)
+	}
+	if head.Name() != branch {
+		result.State = SyncConflict
+		return nil, fmt.Errorf("alias is on %s, expected branch %s", head.Name().Short(), conf.Branch)
+	}
+	result.From = head.Hash().String()
+	result.To = result.From
+
+	worktree, err := repo.repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	err = worktree.PullContext(ctx, &git.PullOptions{
+		RemoteName:    syncRemote,
+		ReferenceName: branch,
+		SingleBranch:  true,
+		Auth:          auth,
+		Progress:      progress,
+	})
+	switch {
+	case errors.Is(err, git.NoErrAlreadyUpToDate):
+		return nil, nil
+	case errors.Is(err, git.ErrNonFastForwardUpdate):
+		result.State = SyncConflict
+		return nil, fmt.Errorf("alias has commits that are not on %s/%s", syncRemote, conf.Branch)
+	case errors.Is(err, git.ErrUnstagedChanges):
+		result.State = SyncConflict
+		return nil, fmt.Errorf("alias has uncommitted changes")
+	case err != nil:
+		return nil, fmt.Errorf("unable to pull %s: %w", conf.Remote, err)
+	}
+
+	head, err = repo.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	result.To = head.Hash().String()
+	result.State = SyncUpdated
+
+	return repo.changedFiles(result.From, result.To)
+}
+
+// Push pushes the synced branch of an alias to its remote,
+// the git transfer progress is written to progress, which may be nil
+func (s *SyncService) Push(ctx context.Context, host, alias string, progress io.Writer) error {
+	conf, err := s.store.GetConfig(host, alias)
+	if err != nil {
+		return err
+	}
+	if conf.Remote == "" || conf.Branch == "" {
+		return fmt.Errorf("alias %s has no remote to push to", alias)
+	}
+
+	lock, _ := s.syncLocks.LoadOrStore(host+"/"+alias, &sync.Mutex{})
+	lock.Lock()
+	defer lock.Unlock()
+
+	auth, err := s.auth(&conf)
+	if err != nil {
+		return err
+	}
+	defer s.saveHostKey(&conf, conf.HostKey)
+
+	repo, err := s.repos.localRepo(host, alias)
+	if err != nil {
+		return err
+	}
+	if err = ensureRemote(repo, conf.Remote); err != nil {
+		return err
+	}
+
+	branch := plumbing.NewBranchReferenceName(conf.Branch)
+	err = repo.repo.PushContext(ctx, &git.PushOptions{
+		RemoteName: syncRemote,
+		RefSpecs:   []config.RefSpec{config.RefSpec(branch + ":" + branch)},
+		Auth:       auth,
+		Progress:   progress,
+	})
+	if errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return nil
+	}
+	return err
+}
+
+// saveHostKey saves conf if a new ssh host key was trusted since it was loaded
+func (s *SyncService) saveHostKey(conf *SyncConfig, loaded string) {
+	if conf.HostKey == loaded {
+		return
+	}
+	if err := s.store.SaveConfig(conf); err != nil {
+		s.log.Warn().Err(err).Msg("unable to save remote host key")
+	}
+}
+
+// open returns the repo of a synced alias, an alias that is not in a repo yet
+// has the remote cloned into it
+func (s *SyncService) open(ctx context.Context, conf *SyncConfig, auth transport.AuthMethod, progress io.Writer) (repo *Repo, cloned bool, err error) {
+	fsCli, err := s.repos.fs(conf.Host, conf.Alias)
+	if err != nil {
+		return nil, false, err
+	}
+	if _, local := fsCli.(*filesystem.LocalFileSystem); !local {
+		return nil, false, ErrRemoteAlias
+	}
+
+	repo, err = s.repos.localRepo(conf.Host, conf.Alias)
+	if errors.Is(err, ErrNoHistory) {
+		if err = clone(ctx, fsCli.Root(), conf, auth, progress); err != nil {
+			return nil, false, err
+		}
+		cloned = true
+		repo, err = s.repos.localRepo(conf.Host, conf.Alias)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return repo, cloned, ensureRemote(repo, conf.Remote)
+}
+
+// ensureRemote points syncRemote of repo at url
+func ensureRemote(repo *Repo, url string) error {
+	remote, err := repo.repo.Remote(syncRemote)
+	if errors.Is(err, git.ErrRemoteNotFound) {
+		return repo.EditRemote(syncRemote, url)
+	}
+	if err != nil {
+		return err
+	}
+	if slices.Equal(remote.Config().URLs, []string{url}) {
+		return nil
+	}
+	if err = repo.repo.DeleteRemote(syncRemote); err != nil {
+		return err
+	}
+	return repo.EditRemote(syncRemote, url)
+}
+
+func clone(ctx context.Context, root string, conf *SyncConfig, auth transport.AuthMethod, progress io.Writer) error {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return err
+	}
+	if len(entries) != 0 {
+		return fmt.Errorf("alias is not a git repo and not empty, unable to clone into it")
+	}
+
+	_, err = git.PlainCloneContext(ctx, root, false, &git.CloneOptions{
+		URL:           conf.Remote,
+		Auth:          auth,
+		RemoteName:    syncRemote,
+		ReferenceName: plumbing.NewBranchReferenceName(conf.Branch),
+		SingleBranch:  true,
+		Progress:      progress,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to clone %s: %w", conf.Remote, err)
+	}
+	log.Info().Str("remote", conf.Remote).Str("path", root).Msg("Cloned repository")
+	return nil
+}
+
+// auth returns the credentials for the remote of conf, a new
+// ssh host key is saved to conf on first connect
+func (s *SyncService) auth(conf *SyncConfig) (transport.AuthMethod, error) {
+	endpoint, err := transport.NewEndpoint(conf.Remote)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote: %w", err)
+	}
+
+	switch endpoint.Protocol {
+	case "http", "https":
+		if conf.Token == "" {
+			return nil, nil
+		}
+		// most forges ignore the username for tokens but it must not be empty
+		return &githttp.BasicAuth{
+			Username: cmp.Or(conf.Username, "dockman"),
+			Password: conf.Token,
+		}, nil
+	case "ssh":
+		key, err := s.keys.GetKey(cmp.Or(conf.SSHKey, ssh.DefaultKeyName))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load ssh key: %w", err)
+		}
+		auth, err := gitssh.NewPublicKeys(cmp.Or(endpoint.User, "git"), key.PrivateKey, "")
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse ssh key: %w", err)
+		}
+		auth.HostKeyCallback = hostKeyCallback(conf)
+		return auth, nil
+	default:
+		return nil, nil
+	}
+}
+
+// hostKeyCallback trusts the host key on first connect and verifies it after
+func hostKeyCallback(conf *SyncConfig) ssh2.HostKeyCallback {
+	if conf.HostKey != "" {
+		pubkey, _, _, _, err := ssh2.ParseAuthorizedKey([]byte(conf.HostKey))
+		if err != nil {
+			return func(string, net.Addr, ssh2.PublicKey) error {
+				return fmt.Errorf("invalid saved host key: %w", err)
+			}
+		}
+		return ssh2.FixedHostKey(pubkey)
+	}
+
+	return func(_ string, _ net.Addr, key ssh2.PublicKey) error {
+		conf.HostKey = strings.TrimSpace(string(ssh2.MarshalAuthorizedKey(key)))
+		return nil
+	}
+}
+
+// changedFiles lists the files changed between two commits relative to the alias,
+// an empty from lists every file in to
+func (s *Repo) changedFiles(from, to string) ([]string, error) {
+	trees := make([]*object.Tree, 0, 2)
+	for _, hash := range []string{from, to} {
+		if hash == "" {
+			// diff against nothing
+			trees = append(trees, nil)
+			continue
+		}
+		commit, err := s.repo.CommitObject(plumbing.NewHash(hash))
+		if err != nil {
+			return nil, err
+		}
+		tree, err := commit.Tree()
+		if err != nil {
+			return nil, err
+		}
+		trees = append(trees, tree)
+	}
+
+	changes, err := object.DiffTree(trees[0], trees[1])
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, change := range changes {
+		for _, name := range []string{change.From.Name, change.To.Name} {
+			rel, ok := s.aliasRel(name)
+			if ok && !slices.Contains(files, rel) {
+				files = append(files, rel)
+			}
+		}
+	}
+	slices.Sort(files)
+	return files, nil
+}
+
+// aliasRel returns the alias relpath of a path in the worktree,
+// false if it is outside the alias
+func (s *Repo) aliasRel(name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+	if s.prefix == "" {
+		return name, true
+	}
+	return strings.CutPrefix(name, s.prefix+"/")
+}
+
+// deploy runs compose up on every stack with changed files in dependency order
+func (s *SyncService) deploy(ctx context.Context, host, alias string, changed []string, result *SyncResult) {
+	dkSrv, err := s.docker(host)
+	if err != nil {
+		result.Err = err.Error()
+		return
+	}
+
+	all, err := dkSrv.Compose.ListStacks(alias, "")
+	if err != nil {
+		result.Err = err.Error()
+		return
+	}
+	stacks := changedStacks(alias, all, changed)
+	if len(stacks) == 0 {
+		return
+	}
+
+	deps := make(map[string][]string, len(stacks))
+	for _, stack := range stacks {
+		deps[stack], err = dkSrv.Compose.Dependencies(stack)
+		if err != nil {
+			result.Err = err.Error()
+			return
+		}
+	}
+
+	deploys := make(map[string]*SyncDeploy, len(stacks))
+	for _, stack := range stacks {
+		deploys[stack] = &SyncDeploy{Filename: stack}
+	}
+	var mu sync.Mutex
+
+	err = compose.RunBulk(
+		ctx,
+		stacks,
+		deps,
+		compose.BulkOptions{},
+		func(bulkCtx context.Context, filename string) error {
+			job, err := s.jobs.Start(host, filename, "up", true, func(jobCtx context.Context, out io.Writer) error {
+				return dkSrv.Compose.Up(jobCtx, filename, out)
+			})
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			deploys[filename].JobID = job.ID
+			mu.Unlock()
+			return job.Wait(bulkCtx)
+		},
+		func(progress compose.BulkProgress) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			deploys[progress.Filename].State = string(progress.State)
+			if progress.Err != nil {
+				deploys[progress.Filename].Err = progress.Err.Error()
+			}
+		},
+	)
+	if err != nil {
+		result.Err = err.Error()
+	}
+
+	for _, stack := range stacks {
+		deploy := deploys[stack]
+		if deploy.State == string(compose.BulkFailed) && result.Err == "" {
+			result.Err = "one or more stacks failed to deploy"
+		}
+		result.Deploys = append(result.Deploys, *deploy)
+	}
+}
+
+// Rollback rolls a stack back in a mutating job, so it can't interleave with
+// other compose operations on the stack. rolledBack is called with the result
+// once the files are restored, with deploy compose up then runs in the same job
+func (s *SyncService) Rollback(host, filename, commitId, author string, deploy bool, rolledBack func(Rollback, error)) (*jobs.Job, error) {
+	var dkSrv *docker.Service
+	if deploy {
+		var err error
+		if dkSrv, err = s.docker(host); err != nil {
+			return nil, err
+		}
+	}
+	return s.jobs.Start(host, filename, "rollback", true, func(jobCtx context.Context, out io.Writer) error {
+		res, err := s.repos.Rollback(jobCtx, host, filename, commitId, author)
+		rolledBack(res, err)
+		if err != nil || !deploy {
+			return err
+		}
+		return dkSrv.Compose.Up(jobCtx, filename, out)
+	})
+}
+
+// changedStacks returns the stacks with changed files, a file belongs to
+// the stacks in the closest folder above it. Stacks that were deleted
+// are not listed anymore and so never deployed
+func changedStacks(alias string, stacks []string, changed []string) []string {
+	dirs := map[string][]string{}
+	for _, stack := range stacks {
+		rel := strings.TrimPrefix(stack, alias+"/")
+		dir := path.Dir(rel)
+		dirs[dir] = append(dirs[dir], stack)
+	}
+
+	var res []string
+	for _, file := range changed {
+		for dir := path.Dir(file); ; dir = path.Dir(dir) {
+			if owners, ok := dirs[dir]; ok {
+				for _, stack := range owners {
+					if !slices.Contains(res, stack) {
+						res = append(res, stack)
+					}
+				}
+				break
+			}
+			if dir == "." || dir == "/" {
+				break
+			}
+		}
+	}
+
+	slices.Sort(res)
+	return res
+}
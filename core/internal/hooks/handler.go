@@ -0,0 +1,95 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/hooks/v1"
+	"github.com/RA341/dockman/generated/hooks/v1/v1connect"
+	"github.com/RA341/dockman/internal/host/middleware"
+	"github.com/RA341/dockman/pkg/listutils"
+)
+
+type Handler struct {
+	srv *Service
+}
+
+func NewHandler(srv *Service) (string, http.Handler) {
+	h := &Handler{srv: srv}
+	return v1connect.NewHooksServiceHandler(h)
+}
+
+func (h *Handler) ListHooks(ctx context.Context, _ *connect.Request[v1.ListHooksRequest]) (*connect.Response[v1.ListHooksResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := h.srv.List(hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ListHooksResponse{
+		Hooks: listutils.ToMap(hooks, func(hook Hook) *v1.Hook {
+			return hook.ToProto()
+		}),
+	}), nil
+}
+
+func (h *Handler) SaveHook(ctx context.Context, req *connect.Request[v1.Hook]) (*connect.Response[v1.Hook], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var hook Hook
+	hook.FromProto(req.Msg)
+	secret, err := h.srv.Save(hostname, &hook)
+	if err != nil {
+		return nil, toConnectErr(err)
+	}
+
+	res := hook.ToProto()
+	res.Secret = secret
+	return connect.NewResponse(res), nil
+}
+
+func (h *Handler) DeleteHook(ctx context.Context, req *connect.Request[v1.DeleteHookRequest]) (*connect.Response[v1.DeleteHookResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.srv.Delete(hostname, uint(req.Msg.Id)); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.DeleteHookResponse{}), nil
+}
+
+func (h *Handler) ListInvocations(ctx context.Context, req *connect.Request[v1.ListInvocationsRequest]) (*connect.Response[v1.ListInvocationsResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invocations, err := h.srv.Invocations(hostname, uint(req.Msg.HookId))
+	if err != nil {
+		return nil, toConnectErr(err)
+	}
+
+	return connect.NewResponse(&v1.ListInvocationsResponse{
+		Invocations: listutils.ToMap(invocations, func(inv Invocation) *v1.Invocation {
+			return inv.ToProto()
+		}),
+	}), nil
+}
+
+func toConnectErr(err error) error {
+	if errors.Is(err, ErrNotFound) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	return err
+}
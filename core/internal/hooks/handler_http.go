@@ -0,0 +1,50 @@
+package hooks
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+)
+
+// maxBodySize matches the largest payload github sends
+const maxBodySize = 25 << 20
+
+type HandlerHttp struct {
+	srv *Service
+}
+
+// NewHandlerHttp the public hook endpoints, requests are
+// authenticated by their signature instead of a session
+func NewHandlerHttp(service *Service) http.Handler {
+	hand := &HandlerHttp{srv: service}
+	return hand.register()
+}
+
+func (h *HandlerHttp) register() http.Handler {
+	subMux := http.NewServeMux()
+	subMux.HandleFunc("POST /{key}", h.receive)
+	return subMux
+}
+
+func (h *HandlerHttp) receive(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "unable to read body: "+err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	_, err = h.srv.Receive(r.PathValue("key"), r.Header, body)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, ErrInvalidSignature):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case err != nil:
+		log.Warn().Err(err).Msg("unable to receive hook")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	default:
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
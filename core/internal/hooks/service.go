@@ -0,0 +1,259 @@
+package hooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/RA341/dockman/internal/cleaner"
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/docker/jobs"
+	"github.com/RA341/dockman/internal/git"
+	"github.com/RA341/dockman/internal/secrets"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type DockerProvider func(host string) (*docker.Service, error)
+
+// Service webhooks that let other systems, like a git forge
+// or a registry, trigger actions on a host
+type Service struct {
+	store   Store
+	docker  DockerProvider
+	jobs    *jobs.Service
+	sync    *git.SyncService
+	cleaner *cleaner.Service
+	secrets *secrets.Service
+	log     zerolog.Logger
+}
+
+func New(
+	store Store,
+	docker DockerProvider,
+	jobs *jobs.Service,
+	sync *git.SyncService,
+	cleaner *cleaner.Service,
+	secrets *secrets.Service,
+) *Service {
+	return &Service{
+		store:   store,
+		docker:  docker,
+		jobs:    jobs,
+		sync:    sync,
+		cleaner: cleaner,
+		secrets: secrets,
+		log:     log.With().Str("service", "hooks").Logger(),
+	}
+}
+
+var (
+	ErrNotFound         = errors.New("hook not found")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+func (s *Service) List(host string) ([]Hook, error) {
+	return s.store.List(host)
+}
+
+// Save creates or updates a hook, an empty secret keeps the saved one.
+// Returns the secret if one was generated for a new hook
+func (s *Service) Save(host string, hook *Hook) (string, error) {
+	if !slices.Contains(actions, hook.Action) {
+		return "", fmt.Errorf("unknown action %q", hook.Action)
+	}
+	if hook.Target == "" && hook.Action != ActionCleaner {
+		return "", fmt.Errorf("action %s needs a target", hook.Action)
+	}
+
+	var generated string
+	if hook.ID != 0 {
+		existing, err := s.get(host, hook.ID)
+		if err != nil {
+			return "", err
+		}
+		hook.Key = existing.Key
+		hook.CreatedAt = existing.CreatedAt
+		hook.SealedSecret = existing.SealedSecret
+		if hook.Secret != "" {
+			if err = s.sealSecret(hook); err != nil {
+				return "", err
+			}
+		}
+	} else {
+		hook.Key = uuid.New().String()
+		if hook.Secret == "" {
+			secret, err := newSecret()
+			if err != nil {
+				return "", err
+			}
+			hook.Secret = secret
+			generated = secret
+		}
+		if err := s.sealSecret(hook); err != nil {
+			return "", err
+		}
+	}
+
+	hook.Host = host
+	return generated, s.store.Save(hook)
+}
+
+func (s *Service) Delete(host string, id uint) error {
+	return s.store.Delete(host, id)
+}
+
+// Invocations lists the requests a hook received, newest first
+func (s *Service) Invocations(host string, id uint) ([]Invocation, error) {
+	if _, err := s.get(host, id); err != nil {
+		return nil, err
+	}
+	return s.store.ListInvocations(id)
+}
+
+func (s *Service) get(host string, id uint) (Hook, error) {
+	hook, err := s.store.Get(host, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Hook{}, ErrNotFound
+	}
+	return hook, err
+}
+
+// Receive verifies a request to the hook with key and starts its action,
+// the action runs in the background since senders only wait a few seconds
+func (s *Service) Receive(key string, header http.Header, body []byte) (*Invocation, error) {
+	hook, err := s.store.GetByKey(key)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && !hook.Enabled) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := s.openSecret(hook)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := verify(secret, header, body)
+	inv := &Invocation{
+		HookID:   hook.ID,
+		Provider: provider,
+		Event:    event(header),
+		State:    InvocationRunning,
+	}
+	if err != nil {
+		inv.State = InvocationRejected
+		inv.Err = err.Error()
+		if err = s.store.AddInvocation(inv); err != nil {
+			s.log.Warn().Err(err).Str("hook", hook.Name).Msg("unable to save invocation")
+		}
+		return nil, ErrInvalidSignature
+	}
+
+	if inv.Event == "ping" {
+		// sent by github when a hook is created
+		inv.State = InvocationSuccess
+		return inv, s.store.AddInvocation(inv)
+	}
+
+	if err = s.store.AddInvocation(inv); err != nil {
+		return nil, err
+	}
+	go s.run(context.Background(), hook, inv)
+	return inv, nil
+}
+
+func (s *Service) run(ctx context.Context, hook Hook, inv *Invocation) {
+	err := s.runAction(ctx, hook, inv)
+	if err != nil {
+		inv.State = InvocationFailed
+		inv.Err = err.Error()
+		s.log.Warn().Err(err).Str("hook", hook.Name).Msg("hook action failed")
+	} else {
+		inv.State = InvocationSuccess
+	}
+
+	if err = s.store.UpdateInvocation(inv); err != nil {
+		s.log.Warn().Err(err).Str("hook", hook.Name).Msg("unable to save invocation")
+	}
+}
+
+func (s *Service) runAction(ctx context.Context, hook Hook, inv *Invocation) error {
+	switch hook.Action {
+	case ActionComposeUpdate:
+		dkSrv, err := s.docker(hook.Host)
+		if err != nil {
+			return err
+		}
+		job, err := s.jobs.Start(hook.Host, hook.Target, "update", true, func(jobCtx context.Context, out io.Writer) error {
+			return dkSrv.Compose.Update(jobCtx, hook.Target, out)
+		})
+		if err != nil {
+			return err
+		}
+
+		inv.JobID = job.ID
+		if err = s.store.UpdateInvocation(inv); err != nil {
+			s.log.Warn().Err(err).Str("hook", hook.Name).Msg("unable to save invocation")
+		}
+		return job.Wait(ctx)
+	case ActionSync:
+		result, err := s.sync.Run(ctx, hook.Host, hook.Target)
+		if err != nil {
+			return err
+		}
+		if result.Err != "" {
+			return errors.New(result.Err)
+		}
+		return nil
+	case ActionCleaner:
+		return s.cleaner.RunWithScheduler(hook.Host, false)
+	case ActionImageUpdate:
+		dkSrv, err := s.docker(hook.Host)
+		if err != nil {
+			return err
+		}
+		return dkSrv.Updater.ContainersUpdateByImage(ctx, hook.Target)
+	default:
+		return fmt.Errorf("unknown action %q", hook.Action)
+	}
+}
+
+// secretScope ties a sealed secret to its hook, so it can not be copied to another one
+func secretScope(hook *Hook) string {
+	return "hook " + hook.Key
+}
+
+// sealSecret sets the sealed secret of hook from its plain text one
+func (s *Service) sealSecret(hook *Hook) error {
+	sealed, err := s.secrets.Seal(secretScope(hook), hook.Secret)
+	if err != nil {
+		return err
+	}
+	hook.SealedSecret = base64.StdEncoding.EncodeToString(sealed)
+	return nil
+}
+
+func (s *Service) openSecret(hook Hook) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(hook.SealedSecret)
+	if err != nil {
+		return "", fmt.Errorf("secret of hook %s is corrupted: %w", hook.Name, err)
+	}
+	return s.secrets.Open(secretScope(&hook), sealed)
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
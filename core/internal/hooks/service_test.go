@@ -0,0 +1,139 @@
+package hooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/RA341/dockman/internal/database"
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/secrets"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceive(t *testing.T) {
+	errNoDocker := errors.New("no docker on this host")
+	dir := t.TempDir()
+	db := database.New(dir, false)
+	secretsSrv, err := secrets.New(secrets.NewStore(db), filepath.Join(dir, "secrets.key"))
+	require.NoError(t, err)
+	store := NewStore(db)
+	srv := New(
+		store,
+		func(host string) (*docker.Service, error) {
+			return nil, errNoDocker
+		},
+		nil, nil, nil, secretsSrv,
+	)
+
+	_, err = srv.Save("local", &Hook{Name: "bad", Action: "reboot"})
+	require.Error(t, err)
+
+	hook := &Hook{Name: "app", Enabled: true, Action: ActionComposeUpdate, Target: "compose/app/compose.yaml"}
+	secret, err := srv.Save("local", hook)
+	require.NoError(t, err)
+	require.NotEmpty(t, secret)
+	require.NotEmpty(t, hook.Key)
+
+	saved, err := store.Get("local", hook.ID)
+	require.NoError(t, err)
+	require.Empty(t, saved.Secret)
+	require.NotContains(t, saved.SealedSecret, secret, "secrets are saved sealed")
+
+	handler := NewHandlerHttp(srv)
+	body := []byte(`{"ref":"refs/heads/main"}`)
+	send := func(header http.Header) int {
+		req := httptest.NewRequest(http.MethodPost, "/"+hook.Key, bytes.NewReader(body))
+		req.Header = header
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	// only the latest rejected request is kept
+	for range 3 {
+		require.Equal(t, http.StatusUnauthorized, send(http.Header{
+			"X-Github-Event":      {"push"},
+			"X-Hub-Signature-256": {"sha256=" + hex.EncodeToString([]byte("forged"))},
+		}))
+	}
+	require.Equal(t, http.StatusAccepted, send(http.Header{
+		"X-Github-Event":      {"ping"},
+		"X-Hub-Signature-256": {"sha256=" + signature},
+	}))
+	require.Equal(t, http.StatusAccepted, send(http.Header{
+		"X-Gitea-Event":     {"push"},
+		"X-Gitea-Signature": {signature},
+	}))
+
+	require.Eventually(t, func() bool {
+		invocations, err := srv.Invocations("local", hook.ID)
+		require.NoError(t, err)
+		return len(invocations) == 3 && invocations[0].State != InvocationRunning
+	}, 5*time.Second, 10*time.Millisecond)
+
+	invocations, err := srv.Invocations("local", hook.ID)
+	require.NoError(t, err)
+	require.Equal(t, ProviderGitea, invocations[0].Provider)
+	require.Equal(t, InvocationFailed, invocations[0].State)
+	require.Equal(t, errNoDocker.Error(), invocations[0].Err)
+	require.Equal(t, InvocationSuccess, invocations[1].State, "ping should not run the action")
+	require.Equal(t, InvocationRejected, invocations[2].State)
+
+	_, err = srv.Invocations("other", hook.ID)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// saving again keeps the secret
+	hook.Secret = ""
+	_, err = srv.Save("local", hook)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, send(http.Header{"X-Gitea-Signature": {signature}}))
+
+	hook.Enabled = false
+	_, err = srv.Save("local", hook)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, send(http.Header{"X-Gitea-Signature": {signature}}))
+}
+
+func TestVerifyGitlab(t *testing.T) {
+	body := []byte(`{"object_kind":"push"}`)
+	header := http.Header{"X-Gitlab-Event": {"Push Hook"}, "X-Gitlab-Token": {"s3cret"}}
+
+	provider, err := verify("s3cret", header, body)
+	require.NoError(t, err)
+	require.Equal(t, ProviderGitlab, provider)
+	_, err = verify("other", header, body)
+	require.Error(t, err)
+
+	key := []byte("signing-key")
+	secret := "whsec_" + base64.StdEncoding.EncodeToString(key)
+	signed := func(ts time.Time) http.Header {
+		timestamp := strconv.FormatInt(ts.Unix(), 10)
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte("msg_1." + timestamp + "." + string(body)))
+		return http.Header{
+			"X-Gitlab-Event":    {"Push Hook"},
+			"Webhook-Id":        {"msg_1"},
+			"Webhook-Timestamp": {timestamp},
+			"Webhook-Signature": {"v1,bogus v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil))},
+		}
+	}
+
+	_, err = verify(secret, signed(time.Now()), body)
+	require.NoError(t, err)
+	_, err = verify(secret, signed(time.Now().Add(-time.Hour)), body)
+	require.Error(t, err, "old signatures should be rejected")
+}
@@ -0,0 +1,75 @@
+package hooks
+
+import (
+	"gorm.io/gorm"
+)
+
+type Action string
+
+const (
+	// ActionComposeUpdate pulls and recreates the stack in Target
+	ActionComposeUpdate Action = "compose-update"
+	// ActionSync runs the GitOps sync of the alias in Target
+	ActionSync Action = "sync"
+	// ActionCleaner runs the cleaner of the host
+	ActionCleaner Action = "cleaner"
+	// ActionImageUpdate updates every container running the image in Target
+	ActionImageUpdate Action = "image-update"
+)
+
+var actions = []Action{ActionComposeUpdate, ActionSync, ActionCleaner, ActionImageUpdate}
+
+type InvocationState string
+
+const (
+	InvocationRunning InvocationState = "running"
+	InvocationSuccess InvocationState = "success"
+	InvocationFailed  InvocationState = "failed"
+	// InvocationRejected the request did not carry a valid signature
+	InvocationRejected InvocationState = "rejected"
+)
+
+// Hook a webhook endpoint that runs an action on a host
+type Hook struct {
+	gorm.Model
+	// Key identifies the hook in its url
+	Key  string `gorm:"uniqueIndex"`
+	Host string `gorm:"index"`
+	Name string
+
+	Enabled bool
+	Action  Action
+	Target  string
+	// Secret used to verify the signature of a request, only set when saving
+	Secret string `gorm:"-"`
+	// SealedSecret the secret sealed with the secrets key, base64 encoded
+	SealedSecret string `gorm:"column:secret"`
+}
+
+// Invocation a single request received by a hook
+type Invocation struct {
+	gorm.Model
+	HookID uint `gorm:"index"`
+
+	Provider string
+	Event    string
+	State    InvocationState
+	Err      string
+	JobID    string
+}
+
+func (*Invocation) TableName() string {
+	return "hook_invocations"
+}
+
+type Store interface {
+	List(host string) ([]Hook, error)
+	Get(host string, id uint) (Hook, error)
+	GetByKey(key string) (Hook, error)
+	Save(*Hook) error
+	Delete(host string, id uint) error
+
+	AddInvocation(*Invocation) error
+	UpdateInvocation(*Invocation) error
+	ListInvocations(hookID uint) ([]Invocation, error)
+}
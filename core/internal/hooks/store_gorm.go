@@ -0,0 +1,117 @@
+package hooks
+
+import (
+	"gorm.io/gorm"
+)
+
+type GormStore struct {
+	db *gorm.DB
+}
+
+func NewStore(db *gorm.DB) *GormStore {
+	return &GormStore{db: db}
+}
+
+func (g *GormStore) List(host string) ([]Hook, error) {
+	var hooks []Hook
+	err := g.db.
+		Where("host = ?", host).
+		Order("name ASC").
+		Find(&hooks).
+		Error
+	return hooks, err
+}
+
+func (g *GormStore) Get(host string, id uint) (Hook, error) {
+	var hook Hook
+	err := g.db.
+		Where("host = ? AND id = ?", host, id).
+		First(&hook).
+		Error
+	return hook, err
+}
+
+func (g *GormStore) GetByKey(key string) (Hook, error) {
+	var hook Hook
+	err := g.db.
+		Where("key = ?", key).
+		First(&hook).
+		Error
+	return hook, err
+}
+
+func (g *GormStore) Save(hook *Hook) error {
+	return g.db.Save(hook).Error
+}
+
+func (g *GormStore) Delete(host string, id uint) error {
+	return g.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("host = ? AND id = ?", host, id).Delete(&Hook{})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		return tx.Unscoped().Where("hook_id = ?", id).Delete(&Invocation{}).Error
+	})
+}
+
+const maxInvocations = 50
+
+// AddInvocation saves inv and trims the history of its hook to maxInvocations,
+// only the latest rejected request is kept since anyone can send those
+func (g *GormStore) AddInvocation(inv *Invocation) error {
+	return g.db.Transaction(func(tx *gorm.DB) error {
+		if inv.State == InvocationRejected {
+			err := tx.Unscoped().
+				Where("hook_id = ? AND state = ?", inv.HookID, InvocationRejected).
+				Delete(&Invocation{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err := tx.Create(inv).Error
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		err = tx.Model(&Invocation{}).
+			Where("hook_id = ?", inv.HookID).
+			Count(&count).Error
+		if err != nil || count <= maxInvocations {
+			return err
+		}
+
+		var old []Invocation
+		// Find the oldest invocations to delete
+		err = tx.
+			Where("hook_id = ?", inv.HookID).
+			Order("created_at ASC").
+			Limit(int(count - maxInvocations)).
+			Find(&old).Error
+		if err != nil {
+			return err
+		}
+
+		for _, o := range old {
+			if err = tx.Unscoped().Delete(&o).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (g *GormStore) UpdateInvocation(inv *Invocation) error {
+	return g.db.Save(inv).Error
+}
+
+func (g *GormStore) ListInvocations(hookID uint) ([]Invocation, error) {
+	var invocations []Invocation
+	err := g.db.
+		Where("hook_id = ?", hookID).
+		Order("created_at DESC").
+		Find(&invocations).
+		Error
+	return invocations, err
+}
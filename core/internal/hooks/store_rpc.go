@@ -0,0 +1,37 @@
+package hooks
+
+import (
+	v1 "github.com/RA341/dockman/generated/hooks/v1"
+)
+
+func (h *Hook) ToProto() *v1.Hook {
+	return &v1.Hook{
+		Id:        uint32(h.ID),
+		Key:       h.Key,
+		Name:      h.Name,
+		Enabled:   h.Enabled,
+		Action:    string(h.Action),
+		Target:    h.Target,
+		HasSecret: h.SealedSecret != "",
+	}
+}
+
+func (h *Hook) FromProto(rpcHook *v1.Hook) {
+	h.ID = uint(rpcHook.Id)
+	h.Name = rpcHook.Name
+	h.Enabled = rpcHook.Enabled
+	h.Action = Action(rpcHook.Action)
+	h.Target = rpcHook.Target
+	h.Secret = rpcHook.Secret
+}
+
+func (i *Invocation) ToProto() *v1.Invocation {
+	return &v1.Invocation{
+		Time:     i.CreatedAt.Unix(),
+		Provider: i.Provider,
+		Event:    i.Event,
+		State:    string(i.State),
+		Error:    i.Err,
+		JobId:    i.JobID,
+	}
+}
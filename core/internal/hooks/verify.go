@@ -0,0 +1,129 @@
+package hooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ProviderGithub = "github"
+	ProviderGitea  = "gitea"
+	ProviderGitlab = "gitlab"
+)
+
+// verify checks a request was signed with secret, returns the provider that sent it.
+//
+//   - github and gitea send a hex HMAC-SHA256 of the body
+//   - gitlab sends the secret token as is, or with a signing token
+//     a standard webhooks signature of "<id>.<timestamp>.<body>"
+func verify(secret string, header http.Header, body []byte) (string, error) {
+	provider := providerOf(header)
+
+	if sig := header.Get("X-Hub-Signature-256"); sig != "" {
+		sig, ok := strings.CutPrefix(sig, "sha256=")
+		if !ok {
+			return provider, errors.New("unsupported signature algorithm")
+		}
+		return provider, checkHex(secret, body, sig)
+	}
+	if sig := header.Get("X-Gitea-Signature"); sig != "" {
+		return provider, checkHex(secret, body, sig)
+	}
+	if sig := header.Get("Webhook-Signature"); sig != "" {
+		return provider, checkStandard(secret, header, body, sig)
+	}
+	if token := header.Get("X-Gitlab-Token"); token != "" {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return provider, errors.New("token does not match")
+		}
+		return provider, nil
+	}
+
+	return provider, errors.New("request is not signed")
+}
+
+func providerOf(header http.Header) string {
+	switch {
+	case header.Get("X-Gitea-Event") != "":
+		return ProviderGitea
+	case header.Get("X-Gitlab-Event") != "":
+		return ProviderGitlab
+	case header.Get("X-GitHub-Event") != "":
+		return ProviderGithub
+	default:
+		return ""
+	}
+}
+
+func event(header http.Header) string {
+	for _, key := range []string{"X-Gitea-Event", "X-Gitlab-Event", "X-GitHub-Event"} {
+		if ev := header.Get(key); ev != "" {
+			return ev
+		}
+	}
+	return ""
+}
+
+func checkHex(secret string, body []byte, sig string) error {
+	want, err := hex.DecodeString(sig)
+	if err != nil {
+		return errors.New("malformed signature")
+	}
+	if !hmac.Equal(sign([]byte(secret), body), want) {
+		return errors.New("signature does not match")
+	}
+	return nil
+}
+
+// checkStandard verifies a standard webhooks signature header,
+// a space separated list of "v1,<base64 signature>"
+func checkStandard(secret string, header http.Header, body []byte, sigs string) error {
+	key := []byte(secret)
+	if encoded, ok := strings.CutPrefix(secret, "whsec_"); ok {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return errors.New("malformed signing token")
+		}
+		key = decoded
+	}
+
+	ts, err := strconv.ParseInt(header.Get("Webhook-Timestamp"), 10, 64)
+	if err != nil {
+		return errors.New("malformed timestamp")
+	}
+	if age := time.Since(time.Unix(ts, 0)); age > signatureTolerance || age < -signatureTolerance {
+		return errors.New("signature timestamp is too old")
+	}
+
+	msg := header.Get("Webhook-Id") + "." + header.Get("Webhook-Timestamp") + "." + string(body)
+	expected := sign(key, []byte(msg))
+	for _, sig := range strings.Fields(sigs) {
+		encoded, ok := strings.CutPrefix(sig, "v1,")
+		if !ok {
+			continue
+		}
+		got, err := base64.StdEncoding.DecodeString(encoded)
+		if err == nil && hmac.Equal(expected, got) {
+			return nil
+		}
+	}
+	return errors.New("signature does not match")
+}
+
+// signatureTolerance how far a signed timestamp may be from now,
+// keeps a captured request from being replayed later
+const signatureTolerance = 5 * time.Minute
+
+func sign(key, body []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
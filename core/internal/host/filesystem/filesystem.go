@@ -1,9 +1,15 @@
 package filesystem
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"io"
 	"io/fs"
 	"os"
+	"path"
+	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -15,10 +21,75 @@ type FileSystem interface {
 	OpenFile(filename string, flag int, perm fs.FileMode) (io.ReadWriteCloser, error)
 	LoadFile(filename string) (io.ReadSeekCloser, time.Time, error)
 	Stat(root string) (os.FileInfo, error)
+	// Lstat same as Stat but does not follow a symlink at name
+	Lstat(name string) (os.FileInfo, error)
 	RemoveAll(fullpath string) error
+	// Rename replaces newPath if it exists
 	Rename(oldPath string, newPath string) error
+	Chmod(path string, mode os.FileMode) error
 	ReadFile(fullpath string) ([]byte, error)
 	WalkDir(root string, f func(path string, d fs.DirEntry, err error) error) error
 	Abs(path string) (string, error)
 	Join(elem ...string) string
 }
+
+const (
+	atomicTempExt = ".tmp"
+	// random bytes in a temp file name
+	atomicTempRand = 6
+)
+
+// IsAtomicTemp reports whether name looks like a temp file left by WriteAtomic,
+// these only exist while a save is in progress
+func IsAtomicTemp(name string) bool {
+	name = path.Base(filepath.ToSlash(name))
+	if !strings.HasPrefix(name, ".") || !strings.HasSuffix(name, atomicTempExt) {
+		return false
+	}
+	rest := strings.TrimSuffix(name, atomicTempExt)
+	dot := strings.LastIndexByte(rest, '.')
+	if dot <= 0 {
+		return false
+	}
+	_, err := hex.DecodeString(rest[dot+1:])
+	return err == nil && len(rest[dot+1:]) == hex.EncodedLen(atomicTempRand)
+}
+
+// WriteAtomic writes contents to a temp file next to filename and renames it
+// over filename once fully written, so an interrupted write
+// never leaves a truncated file behind. The mode of an existing file is kept
+func WriteAtomic(fsys FileSystem, filename string, contents io.Reader, perm os.FileMode) (err error) {
+	if stat, statErr := fsys.Stat(filename); statErr == nil {
+		perm = stat.Mode().Perm()
+	}
+
+	suffix := make([]byte, atomicTempRand)
+	if _, err = rand.Read(suffix); err != nil {
+		return err
+	}
+	dir, base := path.Split(filepath.ToSlash(filename))
+	tmp := path.Join(dir, "."+base+"."+hex.EncodeToString(suffix)+atomicTempExt)
+
+	file, err := fsys.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, fsys.RemoveAll(tmp))
+		}
+	}()
+
+	_, err = io.Copy(file, contents)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = fsys.Chmod(tmp, perm); err != nil {
+		return err
+	}
+	return fsys.Rename(tmp, filename)
+}
@@ -59,6 +59,10 @@ func (l *LocalFileSystem) Stat(name string) (os.FileInfo, error) {
 	return os.Stat(path)
 }
 
+func (l *LocalFileSystem) Lstat(name string) (os.FileInfo, error) {
+	return os.Lstat(l.fullPath(name))
+}
+
 func (l *LocalFileSystem) RemoveAll(path string) error {
 	return os.RemoveAll(l.fullPath(path))
 }
@@ -67,6 +71,10 @@ func (l *LocalFileSystem) Rename(oldName string, newName string) error {
 	return os.Rename(l.fullPath(oldName), l.fullPath(newName))
 }
 
+func (l *LocalFileSystem) Chmod(path string, mode os.FileMode) error {
+	return os.Chmod(l.fullPath(path), mode)
+}
+
 func (l *LocalFileSystem) ReadFile(path string) ([]byte, error) {
 	return os.ReadFile(l.fullPath(path))
 }
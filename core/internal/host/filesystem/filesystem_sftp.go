@@ -77,12 +77,39 @@ func (s *SftpFileSystem) Stat(filename string) (os.FileInfo, error) {
 	return s.client.Stat(s.fullPath(filename))
 }
 
+func (s *SftpFileSystem) Lstat(filename string) (os.FileInfo, error) {
+	return s.client.Lstat(s.fullPath(filename))
+}
+
 func (s *SftpFileSystem) RemoveAll(path string) error {
 	return s.client.RemoveAll(s.fullPath(path))
 }
 
 func (s *SftpFileSystem) Rename(name string, filename string) error {
-	return s.client.Rename(name, s.fullPath(filename))
+	// plain sftp rename fails if the target exists,
+	// the openssh extension replaces it atomically
+	src, dst := s.fullPath(name), s.fullPath(filename)
+	if _, ok := s.client.HasExtension("posix-rename@openssh.com"); ok {
+		return s.client.PosixRename(src, dst)
+	}
+
+	err := s.client.Rename(src, dst)
+	if err == nil {
+		return nil
+	}
+	// without the extension an existing file is replaced by removing it first,
+	// which is not atomic but still only happens once the source is fully written
+	if stat, statErr := s.client.Stat(dst); statErr != nil || stat.IsDir() {
+		return err
+	}
+	if err = s.client.Remove(dst); err != nil {
+		return err
+	}
+	return s.client.Rename(src, dst)
+}
+
+func (s *SftpFileSystem) Chmod(path string, mode os.FileMode) error {
+	return s.client.Chmod(s.fullPath(path), mode)
 }
 
 func (s *SftpFileSystem) ReadFile(fullpath string) ([]byte, error) {
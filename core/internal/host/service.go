@@ -9,7 +9,8 @@ import (
 
 	"github.com/RA341/dockman/internal/docker"
 	"github.com/RA341/dockman/internal/docker/compose"
-	fUtil "github.com/RA341/dockman/internal/files/utils"
+	"github.com/RA341/dockman/internal/docker/container"
+	"github.com/RA341/dockman/internal/dockyaml"
 	"github.com/RA341/dockman/internal/host/filesystem"
 	"github.com/RA341/dockman/internal/ssh"
 	"github.com/RA341/dockman/pkg/fileutil"
@@ -22,18 +23,29 @@ import (
 
 //const Default
 
+type DockyamlProvider func(host string) *dockyaml.DockmanYaml
+
+// SecretProvider returns the secrets passed to compose for a compose file
+type SecretProvider func(host, filename string) ([]compose.Secret, error)
+
 type Service struct {
-	store Store
-	ssh   *ssh.Service
+	store   Store
+	ssh     *ssh.Service
+	dockYml DockyamlProvider
+	secrets SecretProvider
 
 	activeClients syncmap.Map[string, *ActiveHost]
 	aliasStore    AliasStore
+	// volumes browsed through volume aliases, keyed by host/volume
+	volumes syncmap.Map[string, *container.VolumeFs]
 }
 
 func NewService(
 	store Store,
 	aliasStore AliasStore,
 	ssh *ssh.Service,
+	dockYml DockyamlProvider,
+	secrets SecretProvider,
 	composeRoot string,
 	machineAddr string,
 ) *Service {
@@ -41,6 +53,8 @@ func NewService(
 		store:      store,
 		aliasStore: aliasStore,
 		ssh:        ssh,
+		dockYml:    dockYml,
+		secrets:    secrets,
 
 		activeClients: syncmap.Map[string, *ActiveHost]{},
 	}
@@ -116,6 +130,9 @@ func (s *Service) GetAlias(host, alias string) (filesystem.FileSystem, error) {
 	if !ok {
 		return nil, fmt.Errorf("host %s is not found in connected clients", host)
 	}
+	if volume, ok := strings.CutPrefix(alias, VolumeAliasPrefix); ok {
+		return s.volumeFs(host, volume)
+	}
 	return val.As.LoadAlias(alias)
 }
 
@@ -145,22 +162,9 @@ func (s *Service) GetDockerService(name string) (*docker.Service, error) {
 		localAddr,
 		val.DockerClient,
 		val.SSHClient,
-		func(filename string, host string) (compose.Host, error) {
-			strings.Split(filename, "/")
-			filename, pathAlias, err := fUtil.ExtractMeta(filename)
-			if err != nil {
-				return compose.Host{}, err
-			}
-
-			fs, err := val.As.LoadAlias(pathAlias)
-			if err != nil {
-				return compose.Host{}, err
-			}
-
-			return compose.Host{
-				Fs:      fs,
-				Relpath: filename,
-			}, nil
+		s.composeParser(val),
+		func(host, folder, tag string) ([]string, error) {
+			return s.listStacks(val, host, folder, tag)
 		},
 	)
 
@@ -234,6 +238,7 @@ func (s *Service) Toggle(hostname string, enabled bool) error {
 	if !enabled {
 		val, ok := s.activeClients.LoadAndDelete(hostname)
 		if ok {
+			s.closeVolumes(hostname)
 			fileutil.Close(val)
 		}
 	}
@@ -300,6 +305,7 @@ func (s *Service) Add(config *Config, create bool) (err error) {
 
 	val, ok := s.activeClients.LoadAndDelete(config.Name)
 	if ok {
+		s.closeVolumes(config.Name)
 		fileutil.Close(val)
 	}
 
@@ -405,6 +411,7 @@ func (s *Service) Delete(hostname string) error {
 
 	val, ok := s.activeClients.LoadAndDelete(config.Name)
 	if ok {
+		s.closeVolumes(config.Name)
 		fileutil.Close(val)
 	}
 
@@ -0,0 +1,123 @@
+package host
+
+import (
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/RA341/dockman/internal/docker/compose"
+	fUtil "github.com/RA341/dockman/internal/files/utils"
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/RA341/dockman/internal/info"
+)
+
+func (s *Service) composeParser(val *ActiveHost) compose.FilenameParser {
+	return func(filename string, host string) (compose.Host, error) {
+		stack := s.dockYml(host).GetStack(filename)
+		secrets, err := s.secrets(host, filename)
+		if err != nil {
+			return compose.Host{}, fmt.Errorf("unable to load secrets: %w", err)
+		}
+
+		filename, pathAlias, err := fUtil.ExtractMeta(filename)
+		if err != nil {
+			return compose.Host{}, err
+		}
+
+		fs, err := val.As.LoadAlias(pathAlias)
+		if err != nil {
+			return compose.Host{}, err
+		}
+
+		return compose.Host{
+			Fs:      fs,
+			HostFs:  hostFs(val),
+			Relpath: filename,
+			Secrets: secrets,
+			Stack: compose.Stack{
+				Files:       stack.Files,
+				Profiles:    stack.Profiles,
+				ProjectName: stack.ProjectName,
+				DependsOn:   stack.DependsOn,
+			},
+		}, nil
+	}
+}
+
+// hostFs returns a filesystem rooted at "/" of the docker host,
+// nil when running in docker since the container does not see the host filesystem
+func hostFs(val *ActiveHost) filesystem.FileSystem {
+	if val.Kind == LOCAL && info.IsDocker() {
+		return nil
+	}
+
+	root, _ := val.As.LoadDirect("/")
+	return root
+}
+
+// listStacks returns all compose files under folder and/or with tag in dockman.yml,
+// if both are set only stacks matching both are returned
+func (s *Service) listStacks(val *ActiveHost, host, folder, tag string) ([]string, error) {
+	if folder == "" && tag == "" {
+		return nil, fmt.Errorf("folder or tag is required")
+	}
+
+	var tagged []string
+	if tag != "" {
+		for name, stack := range s.dockYml(host).Stacks {
+			if slices.Contains(stack.Tags, tag) {
+				tagged = append(tagged, name)
+			}
+		}
+		if folder == "" {
+			slices.Sort(tagged)
+			return tagged, nil
+		}
+	}
+
+	relpath, pathAlias, err := fUtil.ExtractMeta(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	aliasFs, err := val.As.LoadAlias(pathAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	var stacks []string
+	err = aliasFs.WalkDir(relpath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !isComposeFile(d.Name()) {
+			return nil
+		}
+
+		rel := strings.TrimPrefix(strings.TrimPrefix(path, aliasFs.Root()), "/")
+		name := pathAlias + "/" + rel
+		if tag == "" || slices.Contains(tagged, name) {
+			stacks = append(stacks, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list stacks in %s: %w", folder, err)
+	}
+
+	slices.Sort(stacks)
+	return stacks, nil
+}
+
+// composeFileNames file names docker compose picks up by default
+var composeFileNames = []string{
+	"compose.yaml",
+	"compose.yml",
+	"docker-compose.yaml",
+	"docker-compose.yml",
+}
+
+func isComposeFile(name string) bool {
+	return slices.Contains(composeFileNames, name)
+}
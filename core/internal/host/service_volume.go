@@ -0,0 +1,54 @@
+package host
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/RA341/dockman/internal/docker/container"
+	"github.com/RA341/dockman/internal/host/filesystem"
+	"github.com/rs/zerolog/log"
+)
+
+// VolumeAliasPrefix aliases starting with it browse the named volume in the rest of the alias,
+// so volume:app_data/config.yml is config.yml in the app_data volume
+const VolumeAliasPrefix = "volume:"
+
+func IsVolumeAlias(alias string) bool {
+	return strings.HasPrefix(alias, VolumeAliasPrefix)
+}
+
+// volumeFs returns the filesystem of a named volume, it is kept
+// per host so the helper container is shared between requests
+func (s *Service) volumeFs(host, volume string) (filesystem.FileSystem, error) {
+	if volume == "" {
+		return nil, fmt.Errorf("volume name is required")
+	}
+
+	key := host + "/" + volume
+	if vfs, ok := s.volumes.Load(key); ok {
+		return vfs, nil
+	}
+
+	dkSrv, err := s.GetDockerService(host)
+	if err != nil {
+		return nil, err
+	}
+	vfs, _ := s.volumes.LoadOrStore(key, container.NewVolumeFs(dkSrv.Container, volume))
+	return vfs, nil
+}
+
+// closeVolumes removes the helper containers of a host before its clients are closed
+func (s *Service) closeVolumes(host string) {
+	for _, key := range s.volumes.Keys() {
+		if !strings.HasPrefix(key, host+"/") {
+			continue
+		}
+		vfs, ok := s.volumes.LoadAndDelete(key)
+		if !ok {
+			continue
+		}
+		if err := vfs.Close(); err != nil {
+			log.Warn().Err(err).Str("host", host).Str("volume", key).Msg("unable to remove volume browser container")
+		}
+	}
+}
@@ -0,0 +1,658 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/RA341/dockman/internal/docker/updater"
+	"github.com/RA341/dockman/internal/files"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/token"
+	"github.com/rs/zerolog/log"
+	"go.lsp.dev/protocol"
+	"go.lsp.dev/uri"
+)
+
+// CodeAction offers rewrites for the service under the cursor
+func (s *Server) CodeAction(ctx context.Context, params *protocol.CodeActionParams) ([]protocol.CommandOrCodeAction, error) {
+	doc, ok := s.documents.Load(params.TextDocument.URI)
+	if !ok || doc.kind != kindCompose || doc.root() == nil {
+		return nil, nil
+	}
+
+	line := params.Range.Start.Line
+	service := serviceAt(doc.root(), line)
+	if service == nil {
+		return nil, nil
+	}
+
+	candidates := []*protocol.CodeAction{
+		s.pinDigestAction(ctx, doc, service, line),
+		portLongSyntaxAction(doc, service, line),
+	}
+	candidates = append(candidates, s.extractEnvActions(doc, service, line)...)
+	candidates = append(candidates,
+		healthcheckAction(doc, service),
+		labelsAction(doc, service),
+	)
+
+	actions := []protocol.CommandOrCodeAction{}
+	for _, action := range candidates {
+		if action != nil {
+			actions = append(actions, action)
+		}
+	}
+	return actions, nil
+}
+
+// Formatting formats the document with the same formatter used when saving files
+func (s *Server) Formatting(ctx context.Context, params *protocol.DocumentFormattingParams) ([]protocol.TextEdit, error) {
+	doc, ok := s.documents.Load(params.TextDocument.URI)
+	if !ok {
+		return nil, nil
+	}
+
+	formatted, err := files.FormatContents(doc.filename, []byte(doc.text))
+	if err != nil {
+		return nil, err
+	}
+	if string(formatted) == doc.text {
+		return []protocol.TextEdit{}, nil
+	}
+
+	return []protocol.TextEdit{{
+		Range:   protocol.Range{End: endOf(doc.text)},
+		NewText: string(formatted),
+	}}, nil
+}
+
+// pinDigestAction pins the image of service to the digest of the local image
+func (s *Server) pinDigestAction(ctx context.Context, doc *document, service *ast.MappingValueNode, line uint32) *protocol.CodeAction {
+	if s.docker == nil {
+		return nil
+	}
+
+	tk, img, ok := scalar(valueOf(service.Value, "image"))
+	if !ok || lineOf(tk) != line || strings.ContainsAny(img, "@$") {
+		return nil
+	}
+
+	inspect, err := s.docker.Container.Cli().ImageInspect(ctx, img)
+	if err != nil {
+		log.Debug().Err(err).Str("image", img).Msg("unable to inspect image for digest")
+		return nil
+	}
+	digest := repoDigest(img, inspect.RepoDigests)
+	if digest == "" {
+		return nil
+	}
+
+	return editAction(
+		fmt.Sprintf("Pin %s to %s", img, digest),
+		protocol.CodeActionKindRefactorRewrite,
+		doc.uri,
+		protocol.TextEdit{Range: nameRange(tk, img), NewText: img + "@" + digest},
+	)
+}
+
+// repoDigest picks the digest for the repository of img from the
+// RepoDigests of an image, entries look like <repo>@sha256:<hash>
+func repoDigest(img string, repoDigests []string) string {
+	repo := imageRepo(img)
+	for _, rd := range repoDigests {
+		name, digest, found := strings.Cut(rd, "@")
+		if found && normalizeRepo(name) == normalizeRepo(repo) {
+			return digest
+		}
+	}
+	return ""
+}
+
+// imageRepo strips the tag and digest from an image reference
+func imageRepo(img string) string {
+	img, _, _ = strings.Cut(img, "@")
+	if idx := strings.LastIndex(img, ":"); idx > strings.LastIndex(img, "/") {
+		img = img[:idx]
+	}
+	return img
+}
+
+func normalizeRepo(repo string) string {
+	repo = strings.TrimPrefix(repo, "docker.io/")
+	return strings.TrimPrefix(repo, "library/")
+}
+
+// portLongSyntaxAction rewrites the short syntax port on line to long syntax
+func portLongSyntaxAction(doc *document, service *ast.MappingValueNode, line uint32) *protocol.CodeAction {
+	for _, item := range sequenceValues(valueOf(service.Value, "ports")) {
+		tk := unwrap(item).GetToken()
+		if tk == nil || tk.Position == nil || lineOf(tk) != line {
+			continue
+		}
+
+		var spec string
+		switch n := unwrap(item).(type) {
+		case *ast.StringNode:
+			spec = n.Value
+		case *ast.IntegerNode:
+			spec = n.Token.Value
+		default:
+			return nil
+		}
+
+		fields, ok := longPort(spec)
+		if !ok {
+			return nil
+		}
+
+		text := doc.line(line)
+		indent, _, _ := splitLine(text)
+		var sb strings.Builder
+		for i, field := range fields {
+			if i == 0 {
+				sb.WriteString(strings.Repeat(" ", indent) + "- ")
+			} else {
+				sb.WriteString("\n" + strings.Repeat(" ", indent+2))
+			}
+			sb.WriteString(field)
+		}
+
+		return editAction(
+			"Convert port to long syntax",
+			protocol.CodeActionKindRefactorRewrite,
+			doc.uri,
+			protocol.TextEdit{
+				Range: protocol.Range{
+					Start: protocol.Position{Line: line},
+					End:   protocol.Position{Line: line, Character: uint32(utf8.RuneCountInString(text))},
+				},
+				NewText: sb.String(),
+			},
+		)
+	}
+	return nil
+}
+
+// longPort splits a short syntax port, [host_ip:][published:]target[/protocol],
+// into long syntax fields
+func longPort(spec string) ([]string, bool) {
+	if spec == "" || strings.Contains(spec, "$") {
+		return nil, false
+	}
+
+	spec, proto, _ := strings.Cut(spec, "/")
+	idx := strings.LastIndex(spec, ":")
+	target := spec[idx+1:]
+	if target == "" || strings.Contains(target, "-") {
+		// long syntax only takes a single target port
+		return nil, false
+	}
+
+	fields := []string{"target: " + target}
+	if idx >= 0 {
+		rest := spec[:idx]
+		hostIP := ""
+		if i := strings.LastIndex(rest, ":"); i >= 0 {
+			hostIP, rest = rest[:i], rest[i+1:]
+		}
+		if rest != "" {
+			fields = append(fields, fmt.Sprintf("published: %q", rest))
+		}
+		if hostIP != "" {
+			fields = append(fields, "host_ip: "+strings.Trim(hostIP, "[]"))
+		}
+	}
+	if proto != "" {
+		fields = append(fields, "protocol: "+proto)
+	}
+	return fields, true
+}
+
+// envEntry a single line environment variable of a service
+type envEntry struct {
+	service string
+	key     string
+	value   string
+	line    uint32
+	// mapped the entry uses `KEY: VALUE` instead of `- KEY=VALUE`
+	mapped bool
+	// valueRange the text to replace to reference a variable instead
+	valueRange protocol.Range
+}
+
+// envEntries returns the single line environment variables of every service
+func envEntries(root ast.Node) map[string][]envEntry {
+	entries := map[string][]envEntry{}
+	for _, service := range mappingValues(valueOf(root, "services")) {
+		name := service.Key.GetToken().Value
+		env := valueOf(service.Value, "environment")
+
+		for _, item := range sequenceValues(env) {
+			tk, val, ok := scalar(item)
+			if !ok {
+				continue
+			}
+			key, value, found := strings.Cut(val, "=")
+			if !found || strings.Contains(value, "\n") {
+				continue
+			}
+
+			start := nameRange(tk, val).Start
+			start.Character += uint32(utf8.RuneCountInString(key) + 1)
+			end := start
+			end.Character += uint32(utf8.RuneCountInString(value))
+
+			entries[name] = append(entries[name], envEntry{
+				service:    name,
+				key:        key,
+				value:      value,
+				line:       lineOf(tk),
+				valueRange: protocol.Range{Start: start, End: end},
+			})
+		}
+
+		for _, kv := range mappingValues(env) {
+			keyTk := kv.Key.GetToken()
+			value, ok := unwrap(kv.Value).(ast.ScalarNode)
+			if !ok || kv.Value.Type() == ast.NullType {
+				continue
+			}
+			tk := value.GetToken()
+			if tk == nil || tk.Position == nil || lineOf(tk) != lineOf(keyTk) || strings.Contains(tk.Value, "\n") {
+				continue
+			}
+
+			entries[name] = append(entries[name], envEntry{
+				service:    name,
+				key:        keyTk.Value,
+				value:      tk.Value,
+				line:       lineOf(keyTk),
+				mapped:     true,
+				valueRange: tokenRange(tk),
+			})
+		}
+	}
+	return entries
+}
+
+// repeatedEnv returns the variables shared by every service that sets
+// the variable on line to the same value, grouped by service
+func repeatedEnv(root ast.Node, line uint32, service string) (map[string][]envEntry, []string) {
+	entries := envEntries(root)
+
+	var target *envEntry
+	for i, entry := range entries[service] {
+		if entry.line == line {
+			target = &entries[service][i]
+		}
+	}
+	if target == nil || strings.Contains(target.value, "$") {
+		return nil, nil
+	}
+
+	hasVar := func(service, key, value string) bool {
+		return slices.ContainsFunc(entries[service], func(e envEntry) bool {
+			return e.key == key && e.value == value
+		})
+	}
+
+	var services []string
+	for name := range entries {
+		if hasVar(name, target.key, target.value) {
+			services = append(services, name)
+		}
+	}
+	if len(services) < 2 {
+		return nil, nil
+	}
+	slices.Sort(services)
+
+	shared := map[string][]envEntry{}
+	for _, entry := range entries[service] {
+		if strings.Contains(entry.value, "$") {
+			continue
+		}
+		inAll := true
+		for _, name := range services {
+			inAll = inAll && hasVar(name, entry.key, entry.value)
+		}
+		if !inAll {
+			continue
+		}
+
+		for _, name := range services {
+			idx := slices.IndexFunc(entries[name], func(e envEntry) bool {
+				return e.key == entry.key && e.value == entry.value
+			})
+			shared[name] = append(shared[name], entries[name][idx])
+		}
+	}
+	return shared, services
+}
+
+// extractEnvActions moves variables repeated across services
+// into an x- anchor merged into each service, or into .env
+func (s *Server) extractEnvActions(doc *document, service *ast.MappingValueNode, line uint32) []*protocol.CodeAction {
+	shared, services := repeatedEnv(doc.root(), line, service.Key.GetToken().Value)
+	if len(services) == 0 {
+		return nil
+	}
+
+	return []*protocol.CodeAction{
+		anchorEnvAction(doc, shared, services),
+		s.dotEnvAction(doc, shared, services),
+	}
+}
+
+// anchorEnvAction is only possible if every service uses the mapping
+// syntax for environment, since merge keys do not work on lists
+func anchorEnvAction(doc *document, shared map[string][]envEntry, services []string) *protocol.CodeAction {
+	for _, name := range services {
+		for _, entry := range shared[name] {
+			if !entry.mapped {
+				return nil
+			}
+		}
+	}
+
+	servicesKey := nodeAt(doc.root(), []string{"services"})
+	if servicesKey == nil {
+		return nil
+	}
+
+	anchor := "common-env"
+	for i := 2; valueOf(doc.root(), "x-"+anchor) != nil; i++ {
+		anchor = fmt.Sprintf("common-env-%d", i)
+	}
+
+	var block strings.Builder
+	fmt.Fprintf(&block, "x-%s: &%s\n", anchor, anchor)
+	for _, entry := range shared[services[0]] {
+		fmt.Fprintf(&block, "  %s\n", strings.TrimSpace(doc.line(entry.line)))
+	}
+	block.WriteString("\n")
+
+	servicesLine := lineOf(servicesKey.(*ast.MappingValueNode).Key.GetToken())
+	edits := []protocol.TextEdit{{
+		Range:   lineRange(servicesLine, servicesLine),
+		NewText: block.String(),
+	}}
+	for _, name := range services {
+		for i, entry := range shared[name] {
+			text := ""
+			if i == 0 {
+				indent, _, _ := splitLine(doc.line(entry.line))
+				text = fmt.Sprintf("%s<<: *%s\n", strings.Repeat(" ", indent), anchor)
+			}
+			edits = append(edits, protocol.TextEdit{
+				Range:   lineRange(entry.line, entry.line+1),
+				NewText: text,
+			})
+		}
+	}
+
+	return editAction(
+		fmt.Sprintf("Extract shared environment of %s into x-%s", strings.Join(services, ", "), anchor),
+		protocol.CodeActionKindRefactorExtract,
+		doc.uri,
+		edits...,
+	)
+}
+
+// dotEnvAction moves the values into the .env next to the compose file
+// and references them as variables
+func (s *Server) dotEnvAction(doc *document, shared map[string][]envEntry, services []string) *protocol.CodeAction {
+	envFile := path.Join(path.Dir(doc.filename), ".env")
+	envURI := fileURI(envFile)
+	existing, exists := s.fileText(envFile)
+
+	var lines strings.Builder
+	if exists && existing != "" && !strings.HasSuffix(existing, "\n") {
+		lines.WriteString("\n")
+	}
+	for _, entry := range shared[services[0]] {
+		fmt.Fprintf(&lines, "%s=%s\n", entry.key, dotEnvValue(entry.value))
+	}
+
+	var composeEdits []protocol.TextDocumentEditElement
+	for _, name := range services {
+		for _, entry := range shared[name] {
+			composeEdits = append(composeEdits, &protocol.TextEdit{
+				Range:   entry.valueRange,
+				NewText: "${" + entry.key + "}",
+			})
+		}
+	}
+
+	var changes []protocol.DocumentChange
+	if !exists {
+		changes = append(changes, &protocol.CreateFile{
+			Kind:    "create",
+			URI:     envURI,
+			Options: &protocol.CreateFileOptions{IgnoreIfExists: new(true)},
+		})
+	}
+	end := endOf(existing)
+	changes = append(changes,
+		&protocol.TextDocumentEdit{
+			TextDocument: protocol.OptionalVersionedTextDocumentIdentifier{
+				TextDocumentIdentifier: protocol.TextDocumentIdentifier{URI: envURI},
+			},
+			Edits: []protocol.TextDocumentEditElement{&protocol.TextEdit{
+				Range:   protocol.Range{Start: end, End: end},
+				NewText: lines.String(),
+			}},
+		},
+		&protocol.TextDocumentEdit{
+			TextDocument: protocol.OptionalVersionedTextDocumentIdentifier{
+				TextDocumentIdentifier: protocol.TextDocumentIdentifier{URI: doc.uri},
+			},
+			Edits: composeEdits,
+		},
+	)
+
+	kind := protocol.CodeActionKindRefactorExtract
+	return &protocol.CodeAction{
+		Title: fmt.Sprintf("Extract shared environment of %s into .env", strings.Join(services, ", ")),
+		Kind:  &kind,
+		Edit:  &protocol.WorkspaceEdit{DocumentChanges: changes},
+	}
+}
+
+// dotEnvValue quotes values the .env parser would otherwise change
+func dotEnvValue(value string) string {
+	if strings.ContainsAny(value, " \t#'\"") {
+		return fmt.Sprintf("%q", value)
+	}
+	return value
+}
+
+// healthcheckAction adds a healthcheck template to a service without one
+func healthcheckAction(doc *document, service *ast.MappingValueNode) *protocol.CodeAction {
+	if valueOf(service.Value, "healthcheck") != nil {
+		return nil
+	}
+	indent, unit, ok := fieldIndent(service)
+	if !ok {
+		return nil
+	}
+
+	pad := strings.Repeat(" ", indent)
+	inner := strings.Repeat(" ", indent+unit)
+	template := pad + "healthcheck:\n" +
+		inner + `test: ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]` + "\n" +
+		inner + "interval: 30s\n" +
+		inner + "timeout: 10s\n" +
+		inner + "retries: 3\n" +
+		inner + "start_period: 10s\n"
+
+	return insertAfter(doc, service, "Add healthcheck", template)
+}
+
+// dockman labels and the value inserted for each
+var dockmanLabels = []struct {
+	label string
+	value string
+}{
+	{updater.DockmanOptInUpdateLabel, "true"},
+	{updater.DockmanHealthCheckUptimeLabel, "30s"},
+}
+
+// labelsAction adds the labels the dockman updater looks for
+func labelsAction(doc *document, service *ast.MappingValueNode) *protocol.CodeAction {
+	labels := valueOf(service.Value, "labels")
+
+	existing := map[string]bool{}
+	for _, item := range sequenceValues(labels) {
+		if _, val, ok := scalar(item); ok {
+			key, _, _ := strings.Cut(val, "=")
+			existing[key] = true
+		}
+	}
+	for _, kv := range mappingValues(labels) {
+		existing[kv.Key.GetToken().Value] = true
+	}
+
+	var missing []int
+	for i, l := range dockmanLabels {
+		if !existing[l.label] {
+			missing = append(missing, i)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if labels == nil {
+		indent, unit, ok := fieldIndent(service)
+		if !ok {
+			return nil
+		}
+		text := strings.Repeat(" ", indent) + "labels:\n"
+		for _, i := range missing {
+			l := dockmanLabels[i]
+			text += fmt.Sprintf("%s%s: %q\n", strings.Repeat(" ", indent+unit), l.label, l.value)
+		}
+		return insertAfter(doc, service, "Add dockman update labels", text)
+	}
+
+	indent, ok := blockIndent(labels)
+	if !ok {
+		return nil
+	}
+	_, isList := unwrap(labels).(*ast.SequenceNode)
+
+	text := ""
+	for _, i := range missing {
+		l := dockmanLabels[i]
+		if isList {
+			text += fmt.Sprintf("%s- %s=%s\n", strings.Repeat(" ", indent), l.label, l.value)
+		} else {
+			text += fmt.Sprintf("%s%s: %q\n", strings.Repeat(" ", indent), l.label, l.value)
+		}
+	}
+
+	labelsKey := nodeAt(service.Value, []string{"labels"})
+	if labelsKey == nil {
+		return nil
+	}
+	return insertAfter(doc, labelsKey.(*ast.MappingValueNode), "Add dockman update labels", text)
+}
+
+// serviceAt returns the service whose definition contains line
+func serviceAt(root ast.Node, line uint32) *ast.MappingValueNode {
+	sections := mappingValues(root)
+	for i, section := range sections {
+		if section.Key.GetToken().Value != "services" {
+			continue
+		}
+		if i+1 < len(sections) && line >= lineOf(sections[i+1].Key.GetToken()) {
+			return nil
+		}
+
+		var found *ast.MappingValueNode
+		for _, service := range mappingValues(section.Value) {
+			if lineOf(service.Key.GetToken()) > line {
+				break
+			}
+			found = service
+		}
+		return found
+	}
+	return nil
+}
+
+// fieldIndent returns the indentation of the fields of a mapping entry
+// and the indentation step used by the document
+func fieldIndent(kv *ast.MappingValueNode) (indent int, unit int, ok bool) {
+	indent, ok = blockIndent(kv.Value)
+	if !ok {
+		return 0, 0, false
+	}
+	keyIndent := kv.Key.GetToken().Position.Column - 1
+	return indent, max(indent-keyIndent, 1), indent > keyIndent
+}
+
+// blockIndent returns the indentation of the entries of a block mapping or sequence
+func blockIndent(node ast.Node) (int, bool) {
+	switch n := unwrap(node).(type) {
+	case *ast.MappingNode:
+		if n.IsFlowStyle || len(n.Values) == 0 {
+			return 0, false
+		}
+		return n.Values[0].Key.GetToken().Position.Column - 1, true
+	case *ast.MappingValueNode:
+		return n.Key.GetToken().Position.Column - 1, true
+	case *ast.SequenceNode:
+		if n.IsFlowStyle {
+			return 0, false
+		}
+		return n.Start.Position.Column - 1, true
+	}
+	return 0, false
+}
+
+// insertAfter inserts text on the line after the key of kv
+func insertAfter(doc *document, kv *ast.MappingValueNode, title string, text string) *protocol.CodeAction {
+	line := lineOf(kv.Key.GetToken()) + 1
+	return editAction(title, protocol.CodeActionKindRefactorRewrite, doc.uri, protocol.TextEdit{
+		Range:   lineRange(line, line),
+		NewText: text,
+	})
+}
+
+func editAction(title string, kind protocol.CodeActionKind, u uri.URI, edits ...protocol.TextEdit) *protocol.CodeAction {
+	return &protocol.CodeAction{
+		Title: title,
+		Kind:  &kind,
+		Edit: &protocol.WorkspaceEdit{
+			Changes: map[uri.URI][]protocol.TextEdit{u: edits},
+		},
+	}
+}
+
+// lineRange covers the start of line start to the start of line end
+func lineRange(start, end uint32) protocol.Range {
+	return protocol.Range{
+		Start: protocol.Position{Line: start},
+		End:   protocol.Position{Line: end},
+	}
+}
+
+func lineOf(tk *token.Token) uint32 {
+	return uint32(max(tk.Position.Line-1, 0))
+}
+
+// endOf returns the position after the last character of text
+func endOf(text string) protocol.Position {
+	lines := strings.Split(text, "\n")
+	last := lines[len(lines)-1]
+	return protocol.Position{
+		Line:      uint32(len(lines) - 1),
+		Character: uint32(utf8.RuneCountInString(last)),
+	}
+}
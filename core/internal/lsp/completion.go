@@ -0,0 +1,244 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"go.lsp.dev/protocol"
+)
+
+func (s *Server) Completion(ctx context.Context, params *protocol.CompletionParams) (protocol.CompletionResult, error) {
+	items := []protocol.CompletionItem{}
+
+	doc, ok := s.documents.Load(params.TextDocument.URI)
+	if !ok {
+		return &protocol.CompletionList{Items: items}, nil
+	}
+
+	cur := cursorAt(doc, params.Position.Line, params.Position.Character)
+	switch {
+	case cur.variable:
+		items = append(items, s.completeVariables(doc)...)
+	case doc.kind == kindCompose:
+		items = append(items, s.completeCompose(ctx, doc, cur)...)
+	case doc.schema != nil:
+		items = append(items, completeSchema(doc, cur)...)
+	}
+
+	return &protocol.CompletionList{Items: items}, nil
+}
+
+// completeVariables completes ${VAR} from the .env chain of the document
+func (s *Server) completeVariables(doc *document) []protocol.CompletionItem {
+	if s.docker == nil {
+		return nil
+	}
+
+	values, sources, err := s.docker.Compose.Env(doc.filename)
+	if err != nil {
+		log.Debug().Err(err).Str("file", doc.filename).Msg("unable to load env for completion")
+		return nil
+	}
+
+	var items []protocol.CompletionItem
+	for _, name := range sortedKeys(values) {
+		items = append(items, protocol.CompletionItem{
+			Label:  name,
+			Kind:   protocol.CompletionItemKindVariable,
+			Detail: protocol.NewOptional(fmt.Sprintf("from %s", sources[name])),
+		})
+	}
+	return items
+}
+
+func (s *Server) completeCompose(ctx context.Context, doc *document, cur cursor) []protocol.CompletionItem {
+	items := completeSchema(doc, cur)
+
+	// references can be written as list items or as mapping keys
+	path := cur.valuePath()
+	if !cur.inValue {
+		path = cur.path
+	}
+	return append(items, s.referenceItems(ctx, doc, path)...)
+}
+
+// completeSchema completes the keys and values allowed by the schema of doc
+func completeSchema(doc *document, cur cursor) []protocol.CompletionItem {
+	sch, err := doc.schema()
+	if err != nil {
+		log.Error().Err(err).Str("file", doc.filename).Msg("unable to load schema")
+		return nil
+	}
+
+	var items []protocol.CompletionItem
+	if !cur.inValue || (cur.inItem && cur.key == "") {
+		items = append(items, keyItems(sch, cur.path)...)
+	}
+
+	if cur.inValue {
+		for _, val := range sch.values(sch.at(cur.valuePath())) {
+			items = append(items, protocol.CompletionItem{
+				Label: val,
+				Kind:  protocol.CompletionItemKindValue,
+			})
+		}
+	}
+	return items
+}
+
+// keyItems completes the keys allowed by the schema at path
+func keyItems(sch *jsonSchema, path []string) []protocol.CompletionItem {
+	props := sch.properties(sch.at(path))
+
+	var items []protocol.CompletionItem
+	for _, key := range sortedKeys(props) {
+		item := protocol.CompletionItem{
+			Label:      key,
+			Kind:       protocol.CompletionItemKindProperty,
+			InsertText: protocol.NewOptional(key + ": "),
+		}
+		if desc := props[key]; desc != "" {
+			item.Documentation = &protocol.MarkupContent{
+				Kind:  protocol.MarkupKindMarkdown,
+				Value: desc,
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// referenceItems completes values that refer to something
+// defined elsewhere in the file or on the host
+func (s *Server) referenceItems(ctx context.Context, doc *document, path []string) []protocol.CompletionItem {
+	if len(path) != 3 || path[0] != "services" {
+		return nil
+	}
+
+	switch path[2] {
+	case "image":
+		return s.hostItems(ctx, "image", s.images)
+	case "depends_on", "links":
+		var items []protocol.CompletionItem
+		for _, name := range doc.mapKeys("services") {
+			if name == path[1] {
+				continue
+			}
+			items = append(items, protocol.CompletionItem{
+				Label:  name,
+				Kind:   protocol.CompletionItemKindReference,
+				Detail: protocol.NewOptional("service"),
+			})
+		}
+		return items
+	case "networks":
+		return append(
+			fileItems(doc, "networks"),
+			s.hostItems(ctx, "network", s.networks)...,
+		)
+	case "volumes":
+		return append(
+			fileItems(doc, "volumes"),
+			s.hostItems(ctx, "volume", s.volumes)...,
+		)
+	}
+	return nil
+}
+
+// fileItems completes names declared under a top level section of the document
+func fileItems(doc *document, section string) []protocol.CompletionItem {
+	names := doc.mapKeys(section)
+	slices.Sort(names)
+
+	var items []protocol.CompletionItem
+	for _, name := range names {
+		items = append(items, protocol.CompletionItem{
+			Label:    name,
+			Kind:     protocol.CompletionItemKindReference,
+			Detail:   protocol.NewOptional(fmt.Sprintf("declared in %s", section)),
+			SortText: protocol.NewOptional("0" + name),
+		})
+	}
+	return items
+}
+
+func (s *Server) hostItems(ctx context.Context, what string, list func(ctx context.Context) ([]string, error)) []protocol.CompletionItem {
+	if s.docker == nil {
+		return nil
+	}
+
+	names, err := list(ctx)
+	if err != nil {
+		log.Debug().Err(err).Str("kind", what).Msg("unable to list host resources for completion")
+		return nil
+	}
+
+	var items []protocol.CompletionItem
+	for _, name := range names {
+		items = append(items, protocol.CompletionItem{
+			Label:    name,
+			Kind:     protocol.CompletionItemKindValue,
+			Detail:   protocol.NewOptional(fmt.Sprintf("%s on %s", what, s.docker.Host)),
+			SortText: protocol.NewOptional("1" + name),
+		})
+	}
+	return items
+}
+
+func (s *Server) images(ctx context.Context) ([]string, error) {
+	images, err := s.docker.Container.ImageList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, img := range images {
+		for _, tag := range img.RepoTags {
+			if !strings.HasPrefix(tag, "<none>") {
+				names = append(names, tag)
+			}
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names), nil
+}
+
+func (s *Server) networks(ctx context.Context) ([]string, error) {
+	networks, err := s.docker.Container.NetworksList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, nw := range networks {
+		names = append(names, nw.Name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (s *Server) volumes(ctx context.Context) ([]string, error) {
+	volumes, err := s.docker.Container.VolumesList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, vol := range volumes {
+		names = append(names, vol.Name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,143 @@
+package lsp
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// cursor describes where in the yaml structure a position is
+//
+// it is worked out from indentation alone so it keeps working
+// while the document is being typed and does not parse
+type cursor struct {
+	// path mapping keys from the root to the mapping containing the cursor,
+	// sequences are not part of the path
+	path []string
+	// key on the cursor line, empty if the line has no key yet
+	key string
+	// inValue the cursor is after `key:` or in a `- ` sequence item
+	inValue bool
+	// inItem the cursor line is a sequence item
+	inItem bool
+	// prefix the text typed before the cursor in the current key or value
+	prefix string
+	// variable set if the cursor is inside an unclosed ${
+	variable bool
+}
+
+// valuePath the path of the value being edited
+func (c cursor) valuePath() []string {
+	if c.key == "" {
+		return c.path
+	}
+	return append(append([]string{}, c.path...), c.key)
+}
+
+func cursorAt(doc *document, line, character uint32) cursor {
+	text := doc.line(line)
+	before := text[:byteOffset(text, character)]
+
+	c := cursor{}
+	if idx := strings.LastIndex(before, "${"); idx >= 0 && !strings.Contains(before[idx:], "}") {
+		c.variable = true
+		c.prefix = before[idx+2:]
+	}
+
+	indent, content, inItem := splitLine(before)
+	if strings.TrimSpace(text) == "" {
+		// nothing typed yet, the cursor decides the level
+		indent = len(before)
+	}
+	c.inItem = inItem
+
+	if key, value, found := strings.Cut(content, ":"); found && !strings.Contains(key, " ") {
+		c.key = strings.TrimSpace(key)
+		c.inValue = true
+		if !c.variable {
+			c.prefix = strings.TrimLeft(value, " ")
+		}
+	} else {
+		c.inValue = inItem
+		if !c.variable {
+			c.prefix = content
+		}
+	}
+
+	c.path = parentKeys(doc, line, indent)
+	return c
+}
+
+// keyAt returns the path of the key under the position,
+// nil if the position is not on a key
+func keyAt(doc *document, line, character uint32) []string {
+	text := doc.line(line)
+	indent, content, _ := splitLine(text)
+
+	key, _, found := strings.Cut(content, ":")
+	if !found {
+		return nil
+	}
+	start := len(text) - len(content)
+	offset := byteOffset(text, character)
+	if offset < start || offset > start+len(key) {
+		return nil
+	}
+
+	return append(parentKeys(doc, line, indent), strings.Trim(strings.TrimSpace(key), `"'`))
+}
+
+// parentKeys walks up from line collecting the keys of each enclosing mapping
+func parentKeys(doc *document, line uint32, indent int) []string {
+	var path []string
+	for i := int(line) - 1; i >= 0 && indent > 0; i-- {
+		text := doc.line(uint32(i))
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		lineIndent, content, inItem := splitLine(text)
+		contentIndent := len(text) - len(strings.TrimLeft(text[lineIndent:], "- "))
+		if inItem && contentIndent == indent {
+			// sibling key in the same sequence item mapping,
+			// continue from the sequence itself
+			indent = lineIndent
+			continue
+		}
+		if lineIndent >= indent {
+			continue
+		}
+
+		indent = lineIndent
+		if key, _, found := strings.Cut(content, ":"); found {
+			path = append([]string{strings.Trim(strings.TrimSpace(key), `"'`)}, path...)
+		}
+	}
+	return path
+}
+
+// splitLine returns the indentation of a line and its content
+// without the indentation and sequence item marker
+func splitLine(text string) (indent int, content string, inItem bool) {
+	content = strings.TrimLeft(text, " ")
+	indent = len(text) - len(content)
+
+	if content == "-" || strings.HasPrefix(content, "- ") {
+		inItem = true
+		content = strings.TrimLeft(strings.TrimPrefix(content, "-"), " ")
+	}
+	return indent, content, inItem
+}
+
+// byteOffset converts an lsp character offset into a byte offset in text
+func byteOffset(text string, character uint32) int {
+	offset := 0
+	for range character {
+		if offset >= len(text) {
+			break
+		}
+		_, size := utf8.DecodeRuneInString(text[offset:])
+		offset += size
+	}
+	return offset
+}
@@ -0,0 +1,204 @@
+package lsp
+
+import (
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/goccy/go-yaml/token"
+	"go.lsp.dev/protocol"
+	"go.lsp.dev/uri"
+)
+
+type docKind int
+
+const (
+	kindYaml docKind = iota
+	kindCompose
+	// kindDockman dockman.yml
+	kindDockman
+	// kindEnv .env and its per host overlays, not yaml
+	kindEnv
+)
+
+var composeFilePattern = regexp.MustCompile(`^(docker-)?compose([.-].*)?\.ya?ml$`)
+
+// document an open file, re-parsed on every change
+type document struct {
+	uri uri.URI
+	// filename as used by the files service: <alias>/<relpath>
+	filename string
+	kind     docKind
+	// schema the document is validated and completed against, nil if it has none
+	schema func() (*jsonSchema, error)
+	text   string
+	lines  []string
+
+	// ast is nil if the document could not be parsed
+	ast *ast.File
+	// parseErr is set if the document could not be parsed
+	parseErr error
+	// values the decoded document, kept from the last
+	// successful parse so completions work while typing
+	values map[string]any
+}
+
+// filenameFromURI maps a document uri to a dockman filename,
+// clients open files as file:///<alias>/<relpath>
+func filenameFromURI(u uri.URI) string {
+	return strings.TrimPrefix(u.Path(), "/")
+}
+
+func newDocument(u uri.URI, text string, prev *document) *document {
+	filename := filenameFromURI(u)
+	doc := &document{
+		uri:      u,
+		filename: filename,
+		kind:     kindOf(filename),
+		text:     text,
+		lines:    strings.Split(text, "\n"),
+	}
+	doc.schema = schemaOf(doc.kind)
+	if prev != nil {
+		doc.values = prev.values
+	}
+	if doc.kind == kindEnv {
+		return doc
+	}
+
+	doc.ast, doc.parseErr = parser.ParseBytes([]byte(text), 0)
+	if doc.parseErr != nil {
+		doc.ast = nil
+		return doc
+	}
+
+	var values map[string]any
+	if err := yaml.Unmarshal([]byte(text), &values); err == nil {
+		doc.values = values
+	}
+	return doc
+}
+
+func kindOf(filename string) docKind {
+	base := filepath.Base(filename)
+	switch {
+	case composeFilePattern.MatchString(base):
+		return kindCompose
+	case base == "dockman.yml" || base == "dockman.yaml":
+		return kindDockman
+	case base == ".env" || strings.HasPrefix(base, ".env.") || strings.HasSuffix(base, ".env"):
+		return kindEnv
+	}
+	return kindYaml
+}
+
+// root returns the body of the first yaml document
+func (d *document) root() ast.Node {
+	if d.ast == nil || len(d.ast.Docs) == 0 {
+		return nil
+	}
+	return d.ast.Docs[0].Body
+}
+
+// line returns the text of line, empty if out of range
+func (d *document) line(line uint32) string {
+	if int(line) >= len(d.lines) {
+		return ""
+	}
+	return strings.TrimSuffix(d.lines[line], "\r")
+}
+
+// mapKeys returns the keys of the top level mapping under section
+func (d *document) mapKeys(section string) []string {
+	m, ok := d.values[section].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// nodeAt follows path from the document root, path elements are
+// mapping keys or sequence indexes, for mapping keys the key-value
+// pair is returned so the key can be highlighted
+//
+// if the full path does not exist the closest parent is returned
+func nodeAt(root ast.Node, path []string) ast.Node {
+	var found ast.Node
+	node := root
+	for _, elem := range path {
+		next := childNode(node, elem)
+		if next == nil {
+			break
+		}
+		found = next
+		if kv, ok := next.(*ast.MappingValueNode); ok {
+			node = kv.Value
+		} else {
+			node = next
+		}
+	}
+	return found
+}
+
+func childNode(node ast.Node, elem string) ast.Node {
+	switch n := node.(type) {
+	case *ast.MappingNode:
+		for _, value := range n.Values {
+			if value.Key.GetToken().Value == elem {
+				return value
+			}
+		}
+	case *ast.MappingValueNode:
+		if n.Key.GetToken().Value == elem {
+			return n
+		}
+	case *ast.SequenceNode:
+		idx, err := strconv.Atoi(elem)
+		if err == nil && idx >= 0 && idx < len(n.Values) {
+			return n.Values[idx]
+		}
+	}
+	return nil
+}
+
+// rangeOf returns the range of a node, for key-value pairs the range of the key
+func rangeOf(node ast.Node) protocol.Range {
+	if node == nil {
+		return protocol.Range{}
+	}
+
+	tk := node.GetToken()
+	if kv, ok := node.(*ast.MappingValueNode); ok {
+		tk = kv.Key.GetToken()
+	}
+	return tokenRange(tk)
+}
+
+func tokenRange(tk *token.Token) protocol.Range {
+	if tk == nil || tk.Position == nil {
+		return protocol.Range{}
+	}
+
+	start := protocol.Position{
+		Line:      uint32(max(tk.Position.Line-1, 0)),
+		Character: uint32(max(tk.Position.Column-1, 0)),
+	}
+	length := utf8.RuneCountInString(tk.Value)
+	if tk.Type == token.DoubleQuoteType || tk.Type == token.SingleQuoteType {
+		length += 2
+	}
+
+	end := start
+	end.Character += uint32(length)
+	return protocol.Range{Start: start, End: end}
+}
@@ -0,0 +1,217 @@
+package lsp
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/compose-spec/compose-go/v2/template"
+	"github.com/goccy/go-yaml"
+	"github.com/rs/zerolog/log"
+	"go.lsp.dev/protocol"
+	"go.lsp.dev/uri"
+)
+
+// names compose can substitute, same as template.DefaultPattern
+var envNamePattern = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+
+// compose files picked up without -f, checked for variables the .env is missing
+var siblingComposeFiles = []string{
+	"compose.yaml",
+	"compose.yml",
+	"docker-compose.yaml",
+	"docker-compose.yml",
+}
+
+// envVar a variable set in a .env file
+type envVar struct {
+	name string
+	line uint32
+	// rng covers the name
+	rng protocol.Range
+}
+
+// parseEnv returns the variables set in an env file and
+// diagnostics for lines that are not KEY=VALUE
+func parseEnv(doc *document) ([]envVar, []protocol.Diagnostic) {
+	var vars []envVar
+	var diags []protocol.Diagnostic
+
+	for i := 0; i < len(doc.lines); i++ {
+		text := doc.line(uint32(i))
+		content := strings.TrimSpace(text)
+		if content == "" || strings.HasPrefix(content, "#") {
+			continue
+		}
+
+		start := uint32(utf8.RuneCountInString(text[:strings.Index(text, content)]))
+		if rest, found := strings.CutPrefix(content, "export "); found {
+			start += uint32(utf8.RuneCountInString(content) - utf8.RuneCountInString(rest))
+			content = rest
+		}
+
+		name, value, found := strings.Cut(content, "=")
+		name = strings.TrimSpace(name)
+		rng := protocol.Range{
+			Start: protocol.Position{Line: uint32(i), Character: start},
+			End:   protocol.Position{Line: uint32(i), Character: start + uint32(utf8.RuneCountInString(name))},
+		}
+		if !found {
+			rng.End.Character = start + uint32(utf8.RuneCountInString(content))
+			diags = append(diags, envDiagnostic(rng, protocol.DiagnosticSeverityError, "expected KEY=VALUE"))
+			continue
+		}
+		vars = append(vars, envVar{name: name, line: uint32(i), rng: rng})
+
+		// quoted values can span multiple lines
+		value = strings.TrimSpace(value)
+		if value == "" || (value[0] != '"' && value[0] != '\'') {
+			continue
+		}
+		quote := value[0]
+		if closesQuote(value[1:], quote) {
+			continue
+		}
+		for i+1 < len(doc.lines) {
+			i++
+			if closesQuote(doc.line(uint32(i)), quote) {
+				break
+			}
+		}
+	}
+	return vars, diags
+}
+
+// closesQuote reports whether text contains an unescaped quote
+func closesQuote(text string, quote byte) bool {
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case quote:
+			return true
+		}
+	}
+	return false
+}
+
+// envDiagnostics reports invalid and duplicate variable names, and
+// variables used by the compose files next to a .env that it does not set
+func (s *Server) envDiagnostics(doc *document) []protocol.Diagnostic {
+	vars, diags := parseEnv(doc)
+
+	set := map[string]envVar{}
+	for _, v := range vars {
+		if v.name == "" {
+			diags = append(diags, envDiagnostic(v.rng, protocol.DiagnosticSeverityError, "missing variable name"))
+			continue
+		}
+		if !envNamePattern.MatchString(v.name) {
+			diags = append(diags, envDiagnostic(v.rng, protocol.DiagnosticSeverityWarning, fmt.Sprintf(
+				"%q can not be used in compose files, names may only contain letters, digits and _ and can not start with a digit",
+				v.name,
+			)))
+		}
+		if first, ok := set[v.name]; ok {
+			diags = append(diags, envDiagnostic(v.rng, protocol.DiagnosticSeverityWarning, fmt.Sprintf(
+				"%s is already set on line %d, this value overrides it", v.name, first.line+1,
+			)))
+			continue
+		}
+		set[v.name] = v
+	}
+
+	if path.Base(doc.filename) == ".env" {
+		diags = append(diags, s.missingVariables(doc, set)...)
+	}
+	return diags
+}
+
+// missingVariables reports variables without a default that are used
+// by the compose files in the same folder but set by no env file
+func (s *Server) missingVariables(doc *document, set map[string]envVar) []protocol.Diagnostic {
+	dir := path.Dir(doc.filename)
+	files := map[string]bool{}
+	for _, name := range siblingComposeFiles {
+		files[path.Join(dir, name)] = true
+	}
+	s.documents.Range(func(_ uri.URI, open *document) bool {
+		if open.kind == kindCompose && path.Dir(open.filename) == dir {
+			files[open.filename] = true
+		}
+		return true
+	})
+
+	usedBy := map[string][]string{}
+	required := map[string]bool{}
+	for _, filename := range sortedKeys(files) {
+		text, ok := s.fileText(filename)
+		if !ok {
+			continue
+		}
+		var config map[string]any
+		if err := yaml.Unmarshal([]byte(text), &config); err != nil {
+			continue
+		}
+
+		setElsewhere := s.envElsewhere(doc, filename)
+		for name, v := range template.ExtractVariables(config, template.DefaultPattern) {
+			if _, ok := set[name]; ok || setElsewhere[name] {
+				continue
+			}
+			if v.DefaultValue != "" || v.PresenceValue != "" {
+				continue
+			}
+			usedBy[name] = append(usedBy[name], path.Base(filename))
+			required[name] = required[name] || v.Required
+		}
+	}
+
+	end := endOf(doc.text)
+	var diags []protocol.Diagnostic
+	for _, name := range sortedKeys(usedBy) {
+		severity := protocol.DiagnosticSeverityWarning
+		if required[name] {
+			severity = protocol.DiagnosticSeverityError
+		}
+		diags = append(diags, envDiagnostic(
+			protocol.Range{Start: end, End: end},
+			severity,
+			fmt.Sprintf("%s is used in %s but not set", name, strings.Join(slices.Compact(usedBy[name]), ", ")),
+		))
+	}
+	return diags
+}
+
+// envElsewhere returns the variables the compose file gets from
+// env files other than doc, such as parent folders or host overlays
+func (s *Server) envElsewhere(doc *document, composeFile string) map[string]bool {
+	res := map[string]bool{}
+	if s.docker == nil {
+		return res
+	}
+
+	_, sources, err := s.docker.Compose.Env(composeFile)
+	if err != nil {
+		log.Debug().Err(err).Str("file", composeFile).Msg("unable to load env chain")
+		return res
+	}
+	for name, source := range sources {
+		if !strings.HasSuffix("/"+doc.filename, "/"+strings.TrimPrefix(source, "/")) {
+			res[name] = true
+		}
+	}
+	return res
+}
+
+func envDiagnostic(rng protocol.Range, severity protocol.DiagnosticSeverity, msg string) protocol.Diagnostic {
+	return protocol.Diagnostic{
+		Range:    rng,
+		Severity: severity,
+		Source:   protocol.NewOptional(diagnosticSource),
+		Message:  protocol.String(msg),
+	}
+}
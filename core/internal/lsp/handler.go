@@ -1,13 +1,22 @@
 package lsp
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/RA341/dockman/internal/docker"
+	hm "github.com/RA341/dockman/internal/host/middleware"
 	"github.com/RA341/dockman/pkg/fileutil"
 	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog/log"
-	"net/http"
+	"go.lsp.dev/jsonrpc2"
 )
 
+type DockerProvider func(host string) (*docker.Service, error)
+
 var DefaultUpgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -15,11 +24,22 @@ var DefaultUpgrader = websocket.Upgrader{
 }
 
 // WebSocketHandler returns an http.Handler that upgrades the connection
-// to a WebSocket and starts an LSP session.
-func WebSocketHandler(up websocket.Upgrader) http.HandlerFunc {
+// to a WebSocket and starts an LSP session for the host in the request.
+func WebSocketHandler(up websocket.Upgrader, dockerProvider DockerProvider, readFile FileReader) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log.Debug().Msg("starting lsp")
 
+		hostname, err := hm.GetHost(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		dkSrv, err := dockerProvider(hostname)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("unable to load host %s: %v", hostname, err), http.StatusInternalServerError)
+			return
+		}
+
 		conn, err := up.Upgrade(w, r, nil)
 		if err != nil {
 			http.Error(w, fmt.Sprintf("Failed to upgrade connection: %v", err), http.StatusInternalServerError)
@@ -29,7 +49,7 @@ func WebSocketHandler(up websocket.Upgrader) http.HandlerFunc {
 		defer fileutil.Close(conn)
 
 		stream := &WebSocketStream{conn: conn}
-		if err = StartLSP(WithStream(stream), WithZapLogger()); err != nil {
+		if err = StartLSP(WithStream(stream), WithDocker(dkSrv), WithFiles(readFile)); err != nil {
 			log.Error().Err(err).Msg("Failed to start LSP server")
 			// Optionally send close message with error
 			_ = conn.WriteMessage(
@@ -43,33 +63,44 @@ func WebSocketHandler(up websocket.Upgrader) http.HandlerFunc {
 	}
 }
 
-// WebSocketStream wraps a websocket connection to implement io.ReadWriteCloser
-// The websocket.Conn satisfies the io.ReadWriteCloser interface,
-// But we need to wrap it to handle message types
-//
-// Why: When the jsonrpc2 library calls stream.Read(),
-// it would be calling the low-level websocket.Conn.Read() method
+// WebSocketStream implements jsonrpc2.Stream over a websocket connection,
+// each websocket text message carries exactly one JSON-RPC message
+// without the Content-Length header used on stdio
 //
-// This method is not guaranteed to return a complete WebSocket message. It might just return the first few bytes of the message frame.
-// This would confuse the parser, which is expecting a full header and JSON body.
+// Why: framing the websocket as a byte stream breaks as soon as a
+// message is larger than the read buffer, the rest of the frame is lost
 type WebSocketStream struct {
 	conn *websocket.Conn
+	// gorilla allows only one concurrent writer
+	writeMu sync.Mutex
 }
 
-func (s *WebSocketStream) Read(p []byte) (n int, err error) {
-	_, r, err := s.conn.NextReader()
+func (s *WebSocketStream) Read(_ context.Context) (jsonrpc2.Message, int64, error) {
+	_, data, err := s.conn.ReadMessage()
 	if err != nil {
-		return 0, err
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			// client closed the editor
+			return nil, 0, io.EOF
+		}
+		return nil, 0, err
 	}
-	return r.Read(p)
+
+	msg, err := jsonrpc2.DecodeMessage(data)
+	return msg, int64(len(data)), err
 }
 
-func (s *WebSocketStream) Write(p []byte) (n int, err error) {
-	err = s.conn.WriteMessage(websocket.TextMessage, p)
+func (s *WebSocketStream) Write(_ context.Context, msg jsonrpc2.Message) (int64, error) {
+	data, err := jsonrpc2.EncodeMessage(msg)
 	if err != nil {
 		return 0, err
 	}
-	return len(p), nil
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if err = s.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+		return 0, err
+	}
+	return int64(len(data)), nil
 }
 
 func (s *WebSocketStream) Close() error {
@@ -0,0 +1,76 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/docker/compose/v5/pkg/api"
+	"github.com/rs/zerolog/log"
+	"go.lsp.dev/protocol"
+)
+
+func (s *Server) Hover(ctx context.Context, params *protocol.HoverParams) (*protocol.Hover, error) {
+	doc, ok := s.documents.Load(params.TextDocument.URI)
+	if !ok || doc.schema == nil {
+		return nil, nil
+	}
+
+	path := keyAt(doc, params.Position.Line, params.Position.Character)
+	if len(path) == 0 {
+		return nil, nil
+	}
+
+	var sections []string
+	if sch, err := doc.schema(); err == nil {
+		if desc := sch.description(sch.at(path)); desc != "" {
+			sections = append(sections, fmt.Sprintf("**%s**\n\n%s", path[len(path)-1], desc))
+		}
+	}
+	if doc.kind == kindCompose && len(path) == 2 && path[0] == "services" {
+		if state := s.serviceState(ctx, doc, path[1]); state != "" {
+			sections = append(sections, state)
+		}
+	}
+	if len(sections) == 0 {
+		return nil, nil
+	}
+
+	return &protocol.Hover{
+		Contents: &protocol.MarkupContent{
+			Kind:  protocol.MarkupKindMarkdown,
+			Value: strings.Join(sections, "\n\n---\n\n"),
+		},
+	}, nil
+}
+
+// serviceState describes the containers running for service on the host
+func (s *Server) serviceState(ctx context.Context, doc *document, service string) string {
+	if s.docker == nil {
+		return ""
+	}
+
+	containers, err := s.docker.Compose.List(ctx, doc.filename)
+	if err != nil {
+		log.Debug().Err(err).Str("file", doc.filename).Msg("unable to list containers for hover")
+		return ""
+	}
+
+	var lines []string
+	for _, cont := range containers {
+		if cont.Labels[api.ServiceLabel] != service {
+			continue
+		}
+
+		name := cont.ID
+		if len(cont.Names) > 0 {
+			name = strings.TrimPrefix(cont.Names[0], "/")
+		}
+		lines = append(lines, fmt.Sprintf("- `%s` %s, %s", name, cont.State, cont.Status))
+	}
+
+	if len(lines) == 0 {
+		return fmt.Sprintf("no containers for **%s** on %s", service, s.docker.Host)
+	}
+	return fmt.Sprintf("containers on %s\n\n%s", s.docker.Host, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,312 @@
+package lsp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.lsp.dev/protocol"
+	"go.lsp.dev/uri"
+)
+
+const testCompose = `services:
+  web:
+    image: nginx
+    restart: always
+    ports:
+      - target: 80
+        published: 8080
+    imag: typo
+  db:
+    image: postgres
+    ports: "5432"
+`
+
+func testDoc(text string) *document {
+	return newDocument(uri.File("/compose/app/compose.yaml"), text, nil)
+}
+
+func TestDocumentKind(t *testing.T) {
+	doc := testDoc(testCompose)
+	require.Equal(t, "compose/app/compose.yaml", doc.filename)
+	require.Equal(t, kindCompose, doc.kind)
+	require.Equal(t, kindYaml, kindOf("compose/app/config.yaml"))
+	require.Equal(t, kindCompose, kindOf("docker-compose.prod.yml"))
+}
+
+func TestCursorAt(t *testing.T) {
+	doc := testDoc(testCompose)
+
+	cur := cursorAt(doc, 3, 15)
+	require.Equal(t, []string{"services", "web"}, cur.path)
+	require.Equal(t, "restart", cur.key)
+	require.True(t, cur.inValue)
+	require.Equal(t, "al", cur.prefix)
+
+	cur = cursorAt(doc, 6, 19)
+	require.Equal(t, []string{"services", "web", "ports"}, cur.path)
+	require.Equal(t, "published", cur.key)
+
+	// still typing the key
+	cur = cursorAt(doc, 7, 8)
+	require.Equal(t, []string{"services", "web"}, cur.path)
+	require.Empty(t, cur.key)
+	require.False(t, cur.inValue)
+	require.Equal(t, "imag", cur.prefix)
+
+	doc = testDoc("services:\n  web:\n    environment:\n      - URL=${HO")
+	cur = cursorAt(doc, 3, 17)
+	require.True(t, cur.variable)
+	require.Equal(t, "HO", cur.prefix)
+}
+
+func TestKeyAt(t *testing.T) {
+	doc := testDoc(testCompose)
+	require.Equal(t, []string{"services", "web", "ports", "target"}, keyAt(doc, 5, 10))
+	require.Equal(t, []string{"services", "db"}, keyAt(doc, 8, 3))
+	// on the value
+	require.Nil(t, keyAt(doc, 2, 12))
+}
+
+func TestSchemaCompletionAndHover(t *testing.T) {
+	sch, err := composeSchema()
+	require.NoError(t, err)
+
+	props := sch.properties(sch.at([]string{"services", "web"}))
+	require.Contains(t, props, "image")
+	require.Contains(t, props, "healthcheck")
+	require.NotEmpty(t, props["image"])
+
+	props = sch.properties(sch.at([]string{"services", "web", "ports"}))
+	require.Contains(t, props, "published")
+
+	values := sch.values(sch.at([]string{"services", "web", "depends_on", "db", "condition"}))
+	require.Contains(t, values, "service_healthy")
+}
+
+func TestSchemaDiagnostics(t *testing.T) {
+	sch, err := composeSchema()
+	require.NoError(t, err)
+
+	diags := sch.validate(testDoc(testCompose))
+	require.Len(t, diags, 2)
+
+	byLine := map[uint32]protocol.Diagnostic{}
+	for _, diag := range diags {
+		byLine[diag.Range.Start.Line] = diag
+	}
+
+	typo := byLine[7]
+	require.Equal(t, uint32(4), typo.Range.Start.Character)
+	require.Equal(t, uint32(8), typo.Range.End.Character)
+	require.Equal(t, protocol.String(`property "imag" is not allowed`), typo.Message)
+
+	// ports must be a list
+	require.Contains(t, byLine, uint32(10))
+
+	// values with variables are only checked after interpolation
+	diags = sch.validate(testDoc("services:\n  web:\n    image: nginx\n    ports: ${PORTS}\n"))
+	require.Empty(t, diags)
+}
+
+func TestRenameAcrossIncludes(t *testing.T) {
+	s := NewServer(nil, nil)
+	main := testDoc(`include:
+  - db.yaml
+services:
+  web:
+    image: nginx
+    depends_on:
+      - db
+    volumes:
+      - "data:/var/www"
+volumes:
+  data:
+`)
+	db := newDocument(uri.File("/compose/app/db.yaml"), `services:
+  db:
+    image: postgres
+    links:
+      - web:frontend
+`, nil)
+	s.documents.Store(main.uri, main)
+	s.documents.Store(db.uri, db)
+
+	def, err := s.Definition(t.Context(), &protocol.DefinitionParams{
+		TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: main.uri},
+			Position:     protocol.Position{Line: 6, Character: 9},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, protocol.LocationSlice{{
+		URI:   db.uri,
+		Range: protocol.Range{Start: protocol.Position{Line: 1, Character: 2}, End: protocol.Position{Line: 1, Character: 4}},
+	}}, def)
+
+	edit, err := s.Rename(t.Context(), &protocol.RenameParams{
+		TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: main.uri},
+			Position:     protocol.Position{Line: 3, Character: 3},
+		},
+		NewName: "app",
+	})
+	require.NoError(t, err)
+	require.Len(t, edit.Changes[main.uri], 1)
+	require.Len(t, edit.Changes[db.uri], 1)
+	require.Equal(t, uint32(8), edit.Changes[db.uri][0].Range.Start.Character)
+
+	// quoted short syntax volume
+	refs, err := s.References(t.Context(), &protocol.ReferenceParams{
+		TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: main.uri},
+			Position:     protocol.Position{Line: 10, Character: 3},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	require.Equal(t, uint32(9), refs[0].Range.Start.Character)
+
+	_, err = s.Rename(t.Context(), &protocol.RenameParams{
+		TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: main.uri},
+			Position:     protocol.Position{Line: 3, Character: 3},
+		},
+		NewName: "db",
+	})
+	require.Error(t, err)
+}
+
+func TestLongPort(t *testing.T) {
+	fields, ok := longPort("127.0.0.1:8080:80/udp")
+	require.True(t, ok)
+	require.Equal(t, []string{"target: 80", `published: "8080"`, "host_ip: 127.0.0.1", "protocol: udp"}, fields)
+
+	fields, ok = longPort("80")
+	require.True(t, ok)
+	require.Equal(t, []string{"target: 80"}, fields)
+
+	_, ok = longPort("8000-8010:80-90")
+	require.False(t, ok)
+
+	require.Equal(t, "sha256:abc", repoDigest("nginx:1.27", []string{"docker.io/library/nginx@sha256:abc"}))
+	require.Equal(t, "sha256:def", repoDigest("registry:5000/app", []string{"registry:5000/app@sha256:def"}))
+}
+
+func codeActions(t *testing.T, s *Server, doc *document, line uint32) map[string]*protocol.CodeAction {
+	res, err := s.CodeAction(t.Context(), &protocol.CodeActionParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: doc.uri},
+		Range:        protocol.Range{Start: protocol.Position{Line: line}, End: protocol.Position{Line: line}},
+	})
+	require.NoError(t, err)
+
+	actions := map[string]*protocol.CodeAction{}
+	for _, r := range res {
+		action := r.(*protocol.CodeAction)
+		actions[action.Title] = action
+	}
+	return actions
+}
+
+func TestCodeActions(t *testing.T) {
+	s := NewServer(nil, nil)
+	doc := testDoc(`services:
+  web:
+    image: nginx
+    ports:
+      - "8080:80"
+    environment:
+      TZ: Europe/Berlin
+      MODE: web
+  worker:
+    image: app
+    labels:
+      - dockman.update=true
+    environment:
+      MODE: worker
+      TZ: Europe/Berlin
+`)
+	s.documents.Store(doc.uri, doc)
+
+	actions := codeActions(t, s, doc, 4)
+	port := actions["Convert port to long syntax"]
+	require.NotNil(t, port)
+	require.Equal(t, "      - target: 80\n        published: \"8080\"", port.Edit.Changes[doc.uri][0].NewText)
+
+	health := actions["Add healthcheck"]
+	require.NotNil(t, health)
+	require.Equal(t, uint32(2), health.Edit.Changes[doc.uri][0].Range.Start.Line)
+	require.Contains(t, health.Edit.Changes[doc.uri][0].NewText, "    healthcheck:\n      test:")
+
+	labels := actions["Add dockman update labels"]
+	require.Equal(t, "    labels:\n      dockman.update: \"true\"\n      dockman.update.healthcheck.uptime: \"30s\"\n",
+		labels.Edit.Changes[doc.uri][0].NewText)
+
+	// only the missing label is added to the existing list
+	actions = codeActions(t, s, doc, 9)
+	labels = actions["Add dockman update labels"]
+	require.Equal(t, uint32(11), labels.Edit.Changes[doc.uri][0].Range.Start.Line)
+	require.Equal(t, "      - dockman.update.healthcheck.uptime=30s\n", labels.Edit.Changes[doc.uri][0].NewText)
+
+	actions = codeActions(t, s, doc, 6)
+	anchor := actions["Extract shared environment of web, worker into x-common-env"]
+	require.NotNil(t, anchor)
+	edits := anchor.Edit.Changes[doc.uri]
+	require.Len(t, edits, 3)
+	require.Equal(t, "x-common-env: &common-env\n  TZ: Europe/Berlin\n\n", edits[0].NewText)
+	require.Equal(t, "      <<: *common-env\n", edits[1].NewText)
+
+	dotEnv := actions["Extract shared environment of web, worker into .env"]
+	require.NotNil(t, dotEnv)
+	require.Len(t, dotEnv.Edit.DocumentChanges, 3)
+	envEdit := dotEnv.Edit.DocumentChanges[1].(*protocol.TextDocumentEdit)
+	require.Equal(t, "TZ=Europe/Berlin\n", envEdit.Edits[0].(*protocol.TextEdit).NewText)
+
+	// MODE differs between services
+	actions = codeActions(t, s, doc, 7)
+	require.NotContains(t, actions, "Extract shared environment of web, worker into .env")
+}
+
+func TestDockmanYamlSchema(t *testing.T) {
+	doc := newDocument(uri.File("/dockman.yml"), "searchLimit: 10\nvolumes:\n  sort:\n    order: up\npinnedFile: {}\n", nil)
+	require.Equal(t, kindDockman, doc.kind)
+
+	sch, err := doc.schema()
+	require.NoError(t, err)
+
+	diags := sch.validate(doc)
+	require.Len(t, diags, 2)
+
+	props := sch.properties(sch.at([]string{"containers", "sort"}))
+	require.Contains(t, props, "field")
+	require.Equal(t, []string{"asc", "desc"}, sch.values(sch.at([]string{"images", "sort", "order"})))
+	require.Contains(t, sch.properties(sch.at([]string{"stacks", "compose/app/compose.yaml"})), "dependsOn")
+}
+
+func TestEnvDiagnostics(t *testing.T) {
+	s := NewServer(nil, nil)
+	compose := testDoc("services:\n  web:\n    image: nginx:${TAG}\n    environment:\n      - A=${A:-x}\n      - DB=${DB:?db is required}\n")
+	s.documents.Store(compose.uri, compose)
+
+	env := newDocument(uri.File("/compose/app/.env"), "TAG=1\nTAG=2\nexport 1BAD=x\nMULTI=\"a\nb=c\"\nnot a var\n", nil)
+	require.Equal(t, kindEnv, env.kind)
+	require.Nil(t, env.parseErr)
+
+	byMessage := map[string]protocol.Diagnostic{}
+	for _, diag := range s.envDiagnostics(env) {
+		byMessage[string(diag.Message.(protocol.String))] = diag
+	}
+	require.Len(t, byMessage, 4)
+
+	dup := byMessage["TAG is already set on line 1, this value overrides it"]
+	require.Equal(t, uint32(1), dup.Range.Start.Line)
+
+	for msg, diag := range byMessage {
+		if strings.HasPrefix(msg, `"1BAD"`) {
+			require.Equal(t, uint32(7), diag.Range.Start.Character)
+		}
+	}
+	require.Contains(t, byMessage, "expected KEY=VALUE")
+	missing := byMessage["DB is used in compose.yaml but not set"]
+	require.Equal(t, protocol.DiagnosticSeverityError, missing.Severity)
+}
@@ -0,0 +1,110 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"go.lsp.dev/protocol"
+	"go.lsp.dev/uri"
+)
+
+// same pattern the compose-spec uses for service, network and volume names
+var symbolNamePattern = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+func (s *Server) Definition(ctx context.Context, params *protocol.DefinitionParams) (protocol.DefinitionResult, error) {
+	target, symbols, ok := s.symbolAt(params.TextDocument.URI, params.Position)
+	if !ok {
+		return protocol.LocationSlice{}, nil
+	}
+
+	locations := protocol.LocationSlice{}
+	for _, occ := range symbols {
+		if occ.decl && occ.kind == target.kind && occ.name == target.name {
+			locations = append(locations, occ.location())
+		}
+	}
+	return locations, nil
+}
+
+func (s *Server) References(ctx context.Context, params *protocol.ReferenceParams) ([]protocol.Location, error) {
+	target, symbols, ok := s.symbolAt(params.TextDocument.URI, params.Position)
+	if !ok {
+		return []protocol.Location{}, nil
+	}
+
+	locations := []protocol.Location{}
+	for _, occ := range symbols {
+		if occ.kind != target.kind || occ.name != target.name {
+			continue
+		}
+		if occ.decl && !params.Context.IncludeDeclaration {
+			continue
+		}
+		locations = append(locations, occ.location())
+	}
+	return locations, nil
+}
+
+func (s *Server) PrepareRename(ctx context.Context, params *protocol.PrepareRenameParams) (protocol.PrepareRenameResult, error) {
+	target, _, ok := s.symbolAt(params.TextDocument.URI, params.Position)
+	if !ok {
+		return nil, fmt.Errorf("nothing to rename at this position")
+	}
+
+	return &protocol.PrepareRenamePlaceholder{
+		Range:       target.rng,
+		Placeholder: target.name,
+	}, nil
+}
+
+// Rename renames a service, network, volume, secret or config
+// along with every reference to it in the project
+func (s *Server) Rename(ctx context.Context, params *protocol.RenameParams) (*protocol.WorkspaceEdit, error) {
+	target, symbols, ok := s.symbolAt(params.TextDocument.URI, params.Position)
+	if !ok {
+		return nil, fmt.Errorf("nothing to rename at this position")
+	}
+	if !symbolNamePattern.MatchString(params.NewName) {
+		return nil, fmt.Errorf("invalid %s name %q, only letters, digits and . _ - are allowed", target.kind, params.NewName)
+	}
+
+	changes := map[uri.URI][]protocol.TextEdit{}
+	for _, occ := range symbols {
+		if occ.kind != target.kind {
+			continue
+		}
+		if occ.decl && occ.name == params.NewName {
+			return nil, fmt.Errorf("a %s named %q already exists", target.kind, params.NewName)
+		}
+		if occ.name != target.name {
+			continue
+		}
+
+		changes[occ.uri] = append(changes[occ.uri], protocol.TextEdit{
+			Range:   occ.rng,
+			NewText: params.NewName,
+		})
+	}
+	return &protocol.WorkspaceEdit{Changes: changes}, nil
+}
+
+// symbolAt returns the symbol under pos and every symbol in its project
+func (s *Server) symbolAt(u uri.URI, pos protocol.Position) (occurrence, []occurrence, bool) {
+	doc, ok := s.documents.Load(u)
+	if !ok || doc.kind != kindCompose || doc.root() == nil {
+		return occurrence{}, nil, false
+	}
+
+	var symbols []occurrence
+	for _, file := range s.project(doc) {
+		symbols = append(symbols, collectSymbols(file.uri, file.root)...)
+	}
+
+	for _, occ := range symbols {
+		if occ.uri == doc.uri && occ.contains(pos) {
+			return occ, symbols, true
+		}
+	}
+	return occurrence{}, nil, false
+}
@@ -1,14 +1,14 @@
 package lsp
 
 import (
-	"go.uber.org/zap"
-	"io"
-	"log"
+	"github.com/RA341/dockman/internal/docker"
+	"go.lsp.dev/jsonrpc2"
 )
 
 type Config struct {
-	stream io.ReadWriteCloser
-	logger *zap.Logger
+	stream   jsonrpc2.Stream
+	docker   *docker.Service
+	readFile FileReader
 }
 
 type Opts func(config *Config)
@@ -21,24 +21,23 @@ func ParseOpts(opts ...Opts) *Config {
 	return config
 }
 
-func WithStream(rwc io.ReadWriteCloser) Opts {
+func WithStream(stream jsonrpc2.Stream) Opts {
 	return func(config *Config) {
-		config.stream = rwc
+		config.stream = stream
 	}
 }
 
-func WithZapLogger() Opts {
+// WithDocker sets the host used for live completions and hover,
+// without it only schema based features are available
+func WithDocker(dkSrv *docker.Service) Opts {
 	return func(config *Config) {
-		logger, err := zap.NewProduction()
-		if err != nil {
-			log.Fatalf("failed to create logger: %v", err)
-		}
-		config.logger = logger
+		config.docker = dkSrv
 	}
 }
 
-func WithLogger(logger *zap.Logger) Opts {
+// WithFiles sets how files that are not open in the editor are read
+func WithFiles(readFile FileReader) Opts {
 	return func(config *Config) {
-		config.logger = logger
+		config.readFile = readFile
 	}
 }
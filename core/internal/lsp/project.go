@@ -0,0 +1,132 @@
+package lsp
+
+import (
+	"path"
+	"strings"
+
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/rs/zerolog/log"
+	"go.lsp.dev/uri"
+)
+
+// FileReader reads a file on the host, filename is <alias>/<relpath>
+type FileReader func(filename, host string) ([]byte, error)
+
+// projectFile a compose file that is part of the same project as an open document
+type projectFile struct {
+	uri      uri.URI
+	filename string
+	root     ast.Node
+}
+
+// project returns doc along with every file it pulls in through
+// `include` and `extends.file`, open documents are used over the file on disk
+func (s *Server) project(doc *document) []projectFile {
+	files := []projectFile{{uri: doc.uri, filename: doc.filename, root: doc.root()}}
+	seen := map[string]bool{doc.filename: true}
+
+	// files grows as references are found, seen guards against include cycles
+	for i := 0; i < len(files); i++ {
+		for _, ref := range referencedFiles(files[i].root) {
+			filename := path.Join(path.Dir(files[i].filename), ref)
+			if seen[filename] {
+				continue
+			}
+			seen[filename] = true
+
+			file, ok := s.loadFile(filename)
+			if !ok {
+				continue
+			}
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+func (s *Server) loadFile(filename string) (projectFile, bool) {
+	if open := s.openDocument(filename); open != nil {
+		return projectFile{uri: open.uri, filename: filename, root: open.root()}, true
+	}
+
+	contents, ok := s.fileText(filename)
+	if !ok {
+		return projectFile{}, false
+	}
+	parsed, err := parser.ParseBytes([]byte(contents), 0)
+	if err != nil || len(parsed.Docs) == 0 {
+		return projectFile{}, false
+	}
+
+	return projectFile{
+		uri:      fileURI(filename),
+		filename: filename,
+		root:     parsed.Docs[0].Body,
+	}, true
+}
+
+// openDocument returns the open document for filename, nil if it is not open
+func (s *Server) openDocument(filename string) *document {
+	var open *document
+	s.documents.Range(func(_ uri.URI, doc *document) bool {
+		if doc.filename == filename {
+			open = doc
+			return false
+		}
+		return true
+	})
+	return open
+}
+
+// fileText returns the contents of filename, from the editor if it is open
+func (s *Server) fileText(filename string) (string, bool) {
+	if open := s.openDocument(filename); open != nil {
+		return open.text, true
+	}
+
+	if s.readFile == nil || s.docker == nil {
+		return "", false
+	}
+	contents, err := s.readFile(filename, s.docker.Host)
+	if err != nil {
+		log.Debug().Err(err).Str("file", filename).Msg("unable to read file")
+		return "", false
+	}
+	return string(contents), true
+}
+
+// fileURI is the inverse of filenameFromURI
+func fileURI(filename string) uri.URI {
+	return uri.File("/" + filename)
+}
+
+// referencedFiles returns the relative paths of files pulled in by
+// `include` and `extends.file`, remote and absolute paths are skipped
+func referencedFiles(root ast.Node) []string {
+	var paths []string
+	addPath := func(node ast.Node) {
+		if _, val, ok := scalar(node); ok && isLocalPath(val) {
+			paths = append(paths, val)
+		}
+	}
+
+	for _, item := range sequenceValues(valueOf(root, "include")) {
+		addPath(item)
+
+		includePath := valueOf(item, "path")
+		addPath(includePath)
+		for _, p := range sequenceValues(includePath) {
+			addPath(p)
+		}
+	}
+
+	for _, service := range mappingValues(valueOf(root, "services")) {
+		addPath(valueOf(valueOf(service.Value, "extends"), "file"))
+	}
+	return paths
+}
+
+func isLocalPath(p string) bool {
+	return p != "" && !path.IsAbs(p) && !strings.Contains(p, "://") && !strings.Contains(p, "$")
+}
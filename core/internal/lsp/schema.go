@@ -0,0 +1,371 @@
+package lsp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RA341/dockman/internal/dockyaml"
+	"github.com/compose-spec/compose-go/v2/schema"
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"go.lsp.dev/protocol"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+const diagnosticSource = "dockman"
+
+// jsonSchema a json schema used for validation, completion and hover docs
+type jsonSchema struct {
+	compiled *jsonschema.Schema
+	// raw decoded schema, walked for completion and hover
+	raw map[string]any
+}
+
+// composeSchema the compose-spec schema bundled with compose-go,
+// the same one used by docker compose
+var composeSchema = sync.OnceValues(func() (*jsonSchema, error) {
+	return compileSchema("compose-spec.json", schema.Schema)
+})
+
+// dockmanSchema generated from the DockmanYaml struct
+var dockmanSchema = sync.OnceValues(func() (*jsonSchema, error) {
+	source, err := dockyaml.JSONSchema()
+	if err != nil {
+		return nil, err
+	}
+	return compileSchema("dockman.json", string(source))
+})
+
+func schemaOf(kind docKind) func() (*jsonSchema, error) {
+	switch kind {
+	case kindCompose:
+		return composeSchema
+	case kindDockman:
+		return dockmanSchema
+	}
+	return nil
+}
+
+func compileSchema(name, source string) (*jsonSchema, error) {
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(source))
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err = compiler.AddResource(name, doc); err != nil {
+		return nil, err
+	}
+	compiler.RegisterFormat(&jsonschema.Format{
+		Name: "duration",
+		Validate: func(v any) error {
+			value, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("expected string")
+			}
+			_, err := time.ParseDuration(value)
+			return err
+		},
+	})
+
+	compiled, err := compiler.Compile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]any
+	if err = json.Unmarshal([]byte(source), &raw); err != nil {
+		return nil, err
+	}
+	return &jsonSchema{compiled: compiled, raw: raw}, nil
+}
+
+// at returns the schema for the value at path, path elements are
+// mapping keys, sequences are stepped into without an index
+func (s *jsonSchema) at(path []string) map[string]any {
+	node := s.resolve(s.raw)
+	for _, key := range path {
+		node = s.child(node, key)
+		if node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+func (s *jsonSchema) child(node map[string]any, key string) map[string]any {
+	for _, variant := range s.variants(node) {
+		if props, ok := variant["properties"].(map[string]any); ok {
+			if sub, ok := props[key].(map[string]any); ok {
+				return s.resolve(sub)
+			}
+		}
+
+		if patterns, ok := variant["patternProperties"].(map[string]any); ok {
+			for pattern, sub := range patterns {
+				re, err := regexp.Compile(pattern)
+				if err != nil || !re.MatchString(key) {
+					continue
+				}
+				if sub, ok := sub.(map[string]any); ok {
+					return s.resolve(sub)
+				}
+			}
+		}
+
+		if items, ok := variant["items"].(map[string]any); ok {
+			if sub := s.child(s.resolve(items), key); sub != nil {
+				return sub
+			}
+		}
+
+		if sub, ok := variant["additionalProperties"].(map[string]any); ok {
+			return s.resolve(sub)
+		}
+	}
+	return nil
+}
+
+// properties returns the known keys of node and their descriptions
+func (s *jsonSchema) properties(node map[string]any) map[string]string {
+	res := map[string]string{}
+	for _, variant := range s.variants(node) {
+		if items, ok := variant["items"].(map[string]any); ok {
+			for key, desc := range s.properties(s.resolve(items)) {
+				res[key] = desc
+			}
+		}
+
+		props, ok := variant["properties"].(map[string]any)
+		if !ok {
+			continue
+		}
+		for key, sub := range props {
+			sub, _ := sub.(map[string]any)
+			res[key] = s.description(s.resolve(sub))
+		}
+	}
+	return res
+}
+
+func (s *jsonSchema) description(node map[string]any) string {
+	for _, variant := range s.variants(node) {
+		if desc, ok := variant["description"].(string); ok && desc != "" {
+			return desc
+		}
+	}
+	return ""
+}
+
+// values returns the allowed scalar values for node, from enums and booleans
+func (s *jsonSchema) values(node map[string]any) []string {
+	var res []string
+	for _, variant := range s.variants(node) {
+		if enum, ok := variant["enum"].([]any); ok {
+			for _, val := range enum {
+				res = append(res, fmt.Sprint(val))
+			}
+		}
+
+		types := variant["type"]
+		if types == "boolean" || (slices.Contains(asSlice(types), any("boolean"))) {
+			res = append(res, "true", "false")
+		}
+	}
+
+	slices.Sort(res)
+	return slices.Compact(res)
+}
+
+// variants returns node along with every oneOf, anyOf and allOf branch
+func (s *jsonSchema) variants(node map[string]any) []map[string]any {
+	if node == nil {
+		return nil
+	}
+
+	res := []map[string]any{node}
+	for _, key := range []string{"oneOf", "anyOf", "allOf"} {
+		for _, branch := range asSlice(node[key]) {
+			if branch, ok := branch.(map[string]any); ok {
+				res = append(res, s.variants(s.resolve(branch))...)
+			}
+		}
+	}
+	return res
+}
+
+// resolve follows local $refs
+func (s *jsonSchema) resolve(node map[string]any) map[string]any {
+	for range 10 {
+		ref, ok := node["$ref"].(string)
+		if !ok {
+			return node
+		}
+
+		next := any(s.raw)
+		for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			m, ok := next.(map[string]any)
+			if !ok {
+				return node
+			}
+			next = m[part]
+		}
+
+		resolved, ok := next.(map[string]any)
+		if !ok {
+			return node
+		}
+		node = resolved
+	}
+	return node
+}
+
+func asSlice(val any) []any {
+	s, _ := val.([]any)
+	return s
+}
+
+// validate checks the document against the schema,
+// every error is positioned at the value it refers to
+func (s *jsonSchema) validate(doc *document) []protocol.Diagnostic {
+	root := doc.root()
+	if root == nil {
+		return nil
+	}
+
+	var decoded any
+	if err := yaml.Unmarshal([]byte(doc.text), &decoded); err != nil || decoded == nil {
+		return nil
+	}
+	// the validator only understands values decoded from json
+	marshaled, err := json.Marshal(decoded)
+	if err != nil {
+		return nil
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(marshaled))
+	if err != nil {
+		return nil
+	}
+
+	err = s.compiled.Validate(instance)
+	var verr *jsonschema.ValidationError
+	if !errors.As(err, &verr) {
+		return nil
+	}
+
+	printer := message.NewPrinter(language.English)
+	var diags []protocol.Diagnostic
+	for _, leaf := range leafErrors(verr) {
+		if interpolated(instance, leaf.InstanceLocation) {
+			// the value is only known once variables are substituted
+			continue
+		}
+
+		switch k := leaf.ErrorKind.(type) {
+		case *kind.AdditionalProperties:
+			for _, prop := range k.Properties {
+				diags = append(diags, newDiagnostic(
+					nodeAt(root, append(slices.Clone(leaf.InstanceLocation), prop)),
+					protocol.DiagnosticSeverityError,
+					fmt.Sprintf("property %q is not allowed", prop),
+				))
+			}
+		default:
+			msg := leaf.ErrorKind.LocalizedString(printer)
+			if len(leaf.InstanceLocation) > 0 {
+				msg = strings.Join(leaf.InstanceLocation, ".") + ": " + msg
+			}
+			diags = append(diags, newDiagnostic(
+				nodeAt(root, leaf.InstanceLocation),
+				protocol.DiagnosticSeverityError,
+				msg,
+			))
+		}
+	}
+	return diags
+}
+
+// leafErrors flattens a validation error into the errors that caused it,
+// for oneOf and anyOf only the closest branch is reported, same as compose
+func leafErrors(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+
+	switch err.ErrorKind.(type) {
+	case *kind.OneOf, *kind.AnyOf:
+		return []*jsonschema.ValidationError{mostSpecific(err)}
+	}
+
+	var res []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		res = append(res, leafErrors(cause)...)
+	}
+	return res
+}
+
+func mostSpecific(err *jsonschema.ValidationError) *jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return err
+	}
+
+	var best *jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		cause = mostSpecific(cause)
+		if specificity(cause) > specificity(best) {
+			best = cause
+		}
+	}
+	return best
+}
+
+func specificity(err *jsonschema.ValidationError) int {
+	if err == nil {
+		return -1
+	}
+	if _, ok := err.ErrorKind.(*kind.AdditionalProperties); ok {
+		return len(err.InstanceLocation) + 1
+	}
+	return len(err.InstanceLocation)
+}
+
+// interpolated reports whether the value at path contains a variable
+func interpolated(instance any, path []string) bool {
+	for _, elem := range path {
+		switch val := instance.(type) {
+		case map[string]any:
+			instance = val[elem]
+		case []any:
+			idx, err := strconv.Atoi(elem)
+			if err != nil || idx >= len(val) {
+				return false
+			}
+			instance = val[idx]
+		default:
+			return false
+		}
+	}
+
+	str, ok := instance.(string)
+	return ok && strings.Contains(str, "$")
+}
+
+func newDiagnostic(node ast.Node, severity protocol.DiagnosticSeverity, msg string) protocol.Diagnostic {
+	return protocol.Diagnostic{
+		Range:    rangeOf(node),
+		Severity: severity,
+		Source:   protocol.NewOptional(diagnosticSource),
+		Message:  protocol.String(msg),
+	}
+}
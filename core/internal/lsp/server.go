@@ -2,17 +2,26 @@ package lsp
 
 import (
 	"context"
+	"errors"
+
+	"github.com/RA341/dockman/internal/docker"
 	"github.com/RA341/dockman/pkg/syncmap"
 	"github.com/goccy/go-yaml"
 	"github.com/rs/zerolog/log"
-	"go.lsp.dev/jsonrpc2"
 	"go.lsp.dev/protocol"
+	"go.lsp.dev/uri"
 )
 
 type Server struct {
-	conn jsonrpc2.Conn
+	protocol.UnimplementedServer
+
+	client protocol.Client
+	// docker host the documents belong to, nil if not connected to a host
+	docker *docker.Service
+	// readFile loads files that are not open, such as included compose files
+	readFile FileReader
 	// documents stores the content of open files.
-	documents syncmap.Map[protocol.DocumentURI, string]
+	documents syncmap.Map[uri.URI, *document]
 }
 
 // StartLSP starts an LSP session on the given stream.
@@ -20,23 +29,22 @@ type Server struct {
 func StartLSP(opts ...Opts) error {
 	config := ParseOpts(opts...)
 
-	jsonStream := jsonrpc2.NewStream(config.stream)
-
-	s := NewServer()
-	ctx, conn, _ := protocol.NewServer(context.Background(), s, jsonStream, config.logger)
-	s.conn = conn
+	s := NewServer(config.docker, config.readFile)
+	_, conn, client := protocol.NewServer(context.Background(), s, config.stream)
+	s.client = client
 
 	log.Info().Msg("LSP Server Connected and Listening.")
-	config.logger.Info("Starting lsp server")
-	<-ctx.Done()
-	config.logger.Info("Closing lsp server")
+	<-conn.Done()
+	log.Info().Msg("Closing lsp server")
 
-	return nil
+	return conn.Err()
 }
 
-func NewServer() *Server {
+func NewServer(dkSrv *docker.Service, readFile FileReader) *Server {
 	return &Server{
-		documents: syncmap.Map[protocol.DocumentURI, string]{},
+		docker:    dkSrv,
+		readFile:  readFile,
+		documents: syncmap.Map[uri.URI, *document]{},
 	}
 }
 
@@ -46,70 +54,28 @@ func (s *Server) Initialize(ctx context.Context, params *protocol.InitializePara
 	return &protocol.InitializeResult{
 		Capabilities: protocol.ServerCapabilities{
 			TextDocumentSync: protocol.TextDocumentSyncKindFull,
-		},
-	}, nil
-}
-
-func (s *Server) DidOpen(ctx context.Context, params *protocol.DidOpenTextDocumentParams) (err error) {
-	log.Debug().Msg("DidOpen")
-	s.documents.Store(params.TextDocument.URI, params.TextDocument.Text)
-
-	// After opening, we should immediately analyze the document for errors.
-	s.analyzeAndPublishDiagnostics(ctx, params.TextDocument.URI, params.TextDocument.Text)
-	return nil
-}
-
-func (s *Server) DidChange(ctx context.Context, params *protocol.DidChangeTextDocumentParams) (err error) {
-	log.Debug().Msg("DidChange")
-	// The ContentChanges array contains the full text of the document
-	// because we requested TextDocumentSyncKindFull.
-	content := params.ContentChanges[0].Text
-	s.documents.Store(params.TextDocument.URI, content)
-	s.analyzeAndPublishDiagnostics(ctx, params.TextDocument.URI, content)
-	return nil
-}
-
-func (s *Server) analyzeAndPublishDiagnostics(ctx context.Context, uri protocol.DocumentURI, content string) {
-	var diagnostics []protocol.Diagnostic
-
-	log.Debug().Msg("analyzing diagnostics")
-
-	// Try to parse the YAML content.
-	var a any
-	err := yaml.Unmarshal([]byte(content), &a)
-
-	if err != nil {
-		// If there's a parsing error, create a diagnostic.
-		diagnostic := protocol.Diagnostic{
-			Range: protocol.Range{ // A default range for the top of the file
-				Start: protocol.Position{Line: 0, Character: 0},
-				End:   protocol.Position{Line: 0, Character: 1},
+			CompletionProvider: &protocol.CompletionOptions{
+				TriggerCharacters: []string{"{", ":", "-"},
 			},
-			Severity: protocol.DiagnosticSeverityError,
-			Source:   "Compose LSP",
-			Message:  "YAML Parse Error: " + err.Error(),
-		}
-		diagnostics = append(diagnostics, diagnostic)
-	}
-
-	// Send the diagnostics to the client.
-	// This will show underlines in the editor.
-	err = s.conn.Notify(ctx, protocol.MethodTextDocumentPublishDiagnostics,
-		&protocol.PublishDiagnosticsParams{
-			URI:         uri,
-			Diagnostics: diagnostics,
+			HoverProvider:      protocol.Boolean(true),
+			DefinitionProvider: protocol.Boolean(true),
+			ReferencesProvider: protocol.Boolean(true),
+			RenameProvider: &protocol.RenameOptions{
+				PrepareProvider: new(true),
+			},
+			CodeActionProvider: &protocol.CodeActionOptions{
+				CodeActionKinds: []protocol.CodeActionKind{
+					protocol.CodeActionKindRefactorRewrite,
+					protocol.CodeActionKindRefactorExtract,
+				},
+			},
+			DocumentFormattingProvider: protocol.Boolean(true),
 		},
-	)
-	if err != nil {
-		log.Error().Err(err).Msg("Error publishing diagnostics: ")
-		return
-	}
+	}, nil
 }
 
 // Initialized is a notification from the client that the handshake is complete.
 func (s *Server) Initialized(ctx context.Context, params *protocol.InitializedParams) error {
-	// We can do any post-initialization setup here.
-	// For now, we'll do nothing.
 	return nil
 }
 
@@ -123,272 +89,88 @@ func (s *Server) Exit(ctx context.Context) error {
 	return nil
 }
 
-func (s *Server) WorkDoneProgressCancel(ctx context.Context, params *protocol.WorkDoneProgressCancelParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) LogTrace(ctx context.Context, params *protocol.LogTraceParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) SetTrace(ctx context.Context, params *protocol.SetTraceParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) CodeAction(ctx context.Context, params *protocol.CodeActionParams) (result []protocol.CodeAction, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) CodeLens(ctx context.Context, params *protocol.CodeLensParams) (result []protocol.CodeLens, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) CodeLensResolve(ctx context.Context, params *protocol.CodeLens) (result *protocol.CodeLens, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) ColorPresentation(ctx context.Context, params *protocol.ColorPresentationParams) (result []protocol.ColorPresentation, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) Completion(ctx context.Context, params *protocol.CompletionParams) (result *protocol.CompletionList, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) CompletionResolve(ctx context.Context, params *protocol.CompletionItem) (result *protocol.CompletionItem, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) Declaration(ctx context.Context, params *protocol.DeclarationParams) (result []protocol.Location, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) Definition(ctx context.Context, params *protocol.DefinitionParams) (result []protocol.Location, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DidChangeConfiguration(ctx context.Context, params *protocol.DidChangeConfigurationParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DidChangeWatchedFiles(ctx context.Context, params *protocol.DidChangeWatchedFilesParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DidChangeWorkspaceFolders(ctx context.Context, params *protocol.DidChangeWorkspaceFoldersParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DidClose(ctx context.Context, params *protocol.DidCloseTextDocumentParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DidSave(ctx context.Context, params *protocol.DidSaveTextDocumentParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DocumentColor(ctx context.Context, params *protocol.DocumentColorParams) (result []protocol.ColorInformation, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DocumentHighlight(ctx context.Context, params *protocol.DocumentHighlightParams) (result []protocol.DocumentHighlight, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DocumentLink(ctx context.Context, params *protocol.DocumentLinkParams) (result []protocol.DocumentLink, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DocumentLinkResolve(ctx context.Context, params *protocol.DocumentLink) (result *protocol.DocumentLink, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DocumentSymbol(ctx context.Context, params *protocol.DocumentSymbolParams) (result []interface{}, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) ExecuteCommand(ctx context.Context, params *protocol.ExecuteCommandParams) (result interface{}, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) FoldingRanges(ctx context.Context, params *protocol.FoldingRangeParams) (result []protocol.FoldingRange, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) Formatting(ctx context.Context, params *protocol.DocumentFormattingParams) (result []protocol.TextEdit, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) Hover(ctx context.Context, params *protocol.HoverParams) (result *protocol.Hover, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) Implementation(ctx context.Context, params *protocol.ImplementationParams) (result []protocol.Location, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) OnTypeFormatting(ctx context.Context, params *protocol.DocumentOnTypeFormattingParams) (result []protocol.TextEdit, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) PrepareRename(ctx context.Context, params *protocol.PrepareRenameParams) (result *protocol.Range, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) RangeFormatting(ctx context.Context, params *protocol.DocumentRangeFormattingParams) (result []protocol.TextEdit, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) References(ctx context.Context, params *protocol.ReferenceParams) (result []protocol.Location, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) Rename(ctx context.Context, params *protocol.RenameParams) (result *protocol.WorkspaceEdit, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) SignatureHelp(ctx context.Context, params *protocol.SignatureHelpParams) (result *protocol.SignatureHelp, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) Symbols(ctx context.Context, params *protocol.WorkspaceSymbolParams) (result []protocol.SymbolInformation, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) TypeDefinition(ctx context.Context, params *protocol.TypeDefinitionParams) (result []protocol.Location, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) WillSave(ctx context.Context, params *protocol.WillSaveTextDocumentParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) WillSaveWaitUntil(ctx context.Context, params *protocol.WillSaveTextDocumentParams) (result []protocol.TextEdit, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) ShowDocument(ctx context.Context, params *protocol.ShowDocumentParams) (result *protocol.ShowDocumentResult, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) WillCreateFiles(ctx context.Context, params *protocol.CreateFilesParams) (result *protocol.WorkspaceEdit, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DidCreateFiles(ctx context.Context, params *protocol.CreateFilesParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) WillRenameFiles(ctx context.Context, params *protocol.RenameFilesParams) (result *protocol.WorkspaceEdit, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DidRenameFiles(ctx context.Context, params *protocol.RenameFilesParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) WillDeleteFiles(ctx context.Context, params *protocol.DeleteFilesParams) (result *protocol.WorkspaceEdit, err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DidDeleteFiles(ctx context.Context, params *protocol.DeleteFilesParams) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) CodeLensRefresh(ctx context.Context) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) PrepareCallHierarchy(ctx context.Context, params *protocol.CallHierarchyPrepareParams) (result []protocol.CallHierarchyItem, err error) {
-	//TODO implement me
-	panic("implement me")
-}
+func (s *Server) DidOpen(ctx context.Context, params *protocol.DidOpenTextDocumentParams) error {
+	log.Debug().Msg("DidOpen")
+	doc := newDocument(params.TextDocument.URI, params.TextDocument.Text, nil)
+	s.documents.Store(doc.uri, doc)
 
-func (s *Server) IncomingCalls(ctx context.Context, params *protocol.CallHierarchyIncomingCallsParams) (result []protocol.CallHierarchyIncomingCall, err error) {
-	//TODO implement me
-	panic("implement me")
+	// After opening, we should immediately analyze the document for errors.
+	s.analyzeAndPublishDiagnostics(ctx, doc)
+	return nil
 }
 
-func (s *Server) OutgoingCalls(ctx context.Context, params *protocol.CallHierarchyOutgoingCallsParams) (result []protocol.CallHierarchyOutgoingCall, err error) {
-	//TODO implement me
-	panic("implement me")
-}
+func (s *Server) DidChange(ctx context.Context, params *protocol.DidChangeTextDocumentParams) error {
+	log.Debug().Msg("DidChange")
+	if len(params.ContentChanges) == 0 {
+		return nil
+	}
 
-func (s *Server) SemanticTokensFull(ctx context.Context, params *protocol.SemanticTokensParams) (result *protocol.SemanticTokens, err error) {
-	//TODO implement me
-	panic("implement me")
-}
+	// we requested TextDocumentSyncKindFull,
+	// so the last change contains the full text of the document
+	change, ok := params.ContentChanges[len(params.ContentChanges)-1].(*protocol.TextDocumentContentChangeWholeDocument)
+	if !ok {
+		log.Warn().Msg("lsp client sent an incremental change, ignoring")
+		return nil
+	}
 
-func (s *Server) SemanticTokensFullDelta(ctx context.Context, params *protocol.SemanticTokensDeltaParams) (result interface{}, err error) {
-	//TODO implement me
-	panic("implement me")
+	prev, _ := s.documents.Load(params.TextDocument.URI)
+	doc := newDocument(params.TextDocument.URI, change.Text, prev)
+	s.documents.Store(doc.uri, doc)
+	s.analyzeAndPublishDiagnostics(ctx, doc)
+	return nil
 }
 
-func (s *Server) SemanticTokensRange(ctx context.Context, params *protocol.SemanticTokensRangeParams) (result *protocol.SemanticTokens, err error) {
-	//TODO implement me
-	panic("implement me")
+func (s *Server) DidClose(ctx context.Context, params *protocol.DidCloseTextDocumentParams) error {
+	s.documents.Delete(params.TextDocument.URI)
+	return nil
 }
 
-func (s *Server) SemanticTokensRefresh(ctx context.Context) (err error) {
-	//TODO implement me
-	panic("implement me")
-}
+func (s *Server) analyzeAndPublishDiagnostics(ctx context.Context, doc *document) {
+	log.Debug().Str("file", doc.filename).Msg("analyzing diagnostics")
+
+	// an empty list clears previous diagnostics
+	diagnostics := []protocol.Diagnostic{}
+	switch {
+	case doc.parseErr != nil:
+		diagnostics = append(diagnostics, parseDiagnostic(doc.parseErr))
+	case doc.kind == kindEnv:
+		diagnostics = append(diagnostics, s.envDiagnostics(doc)...)
+	case doc.schema != nil:
+		sch, err := doc.schema()
+		if err != nil {
+			log.Error().Err(err).Str("file", doc.filename).Msg("unable to load schema")
+		} else {
+			diagnostics = append(diagnostics, sch.validate(doc)...)
+		}
+	}
 
-func (s *Server) LinkedEditingRange(ctx context.Context, params *protocol.LinkedEditingRangeParams) (result *protocol.LinkedEditingRanges, err error) {
-	//TODO implement me
-	panic("implement me")
+	// Send the diagnostics to the client.
+	// This will show underlines in the editor.
+	err := s.client.PublishDiagnostics(ctx, &protocol.PublishDiagnosticsParams{
+		URI:         doc.uri,
+		Diagnostics: diagnostics,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Error publishing diagnostics: ")
+		return
+	}
 }
 
-func (s *Server) Moniker(ctx context.Context, params *protocol.MonikerParams) (result []protocol.Moniker, err error) {
-	//TODO implement me
-	panic("implement me")
-}
+func parseDiagnostic(err error) protocol.Diagnostic {
+	diag := protocol.Diagnostic{
+		Range: protocol.Range{ // A default range for the top of the file
+			Start: protocol.Position{Line: 0, Character: 0},
+			End:   protocol.Position{Line: 0, Character: 1},
+		},
+		Severity: protocol.DiagnosticSeverityError,
+		Source:   protocol.NewOptional(diagnosticSource),
+		Message:  protocol.String("YAML Parse Error: " + err.Error()),
+	}
 
-func (s *Server) Request(ctx context.Context, method string, params interface{}) (result interface{}, err error) {
-	//TODO implement me
-	panic("implement me")
+	var synErr *yaml.SyntaxError
+	if errors.As(err, &synErr) && synErr.Token != nil {
+		diag.Range = tokenRange(synErr.Token)
+		diag.Message = protocol.String("YAML Parse Error: " + synErr.Message)
+	}
+	return diag
 }
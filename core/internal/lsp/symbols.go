@@ -0,0 +1,229 @@
+package lsp
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/token"
+	"go.lsp.dev/protocol"
+	"go.lsp.dev/uri"
+)
+
+type symbolKind string
+
+const (
+	symbolService symbolKind = "service"
+	symbolNetwork symbolKind = "network"
+	symbolVolume  symbolKind = "volume"
+	symbolSecret  symbolKind = "secret"
+	symbolConfig  symbolKind = "config"
+)
+
+// top level section each kind is declared in
+var symbolSections = map[string]symbolKind{
+	"services": symbolService,
+	"networks": symbolNetwork,
+	"volumes":  symbolVolume,
+	"secrets":  symbolSecret,
+	"configs":  symbolConfig,
+}
+
+// occurrence a declaration of, or reference to, a named compose object
+type occurrence struct {
+	kind symbolKind
+	name string
+	decl bool
+	uri  uri.URI
+	// rng covers only the name, so it can be replaced on rename
+	rng protocol.Range
+}
+
+func (o occurrence) location() protocol.Location {
+	return protocol.Location{URI: o.uri, Range: o.rng}
+}
+
+func (o occurrence) contains(pos protocol.Position) bool {
+	return pos.Line == o.rng.Start.Line &&
+		pos.Character >= o.rng.Start.Character &&
+		pos.Character <= o.rng.End.Character
+}
+
+// collectSymbols returns every declaration and reference in a compose file
+func collectSymbols(u uri.URI, root ast.Node) []occurrence {
+	var res []occurrence
+	add := func(kind symbolKind, decl bool, tk *token.Token, name string) {
+		if tk == nil || tk.Position == nil || name == "" {
+			return
+		}
+		res = append(res, occurrence{
+			kind: kind,
+			name: name,
+			decl: decl,
+			uri:  u,
+			rng:  nameRange(tk, name),
+		})
+	}
+
+	for _, section := range mappingValues(root) {
+		kind, ok := symbolSections[section.Key.GetToken().Value]
+		if !ok {
+			continue
+		}
+
+		for _, entry := range mappingValues(section.Value) {
+			add(kind, true, entry.Key.GetToken(), entry.Key.GetToken().Value)
+			if kind == symbolService {
+				serviceReferences(entry, add)
+			}
+		}
+	}
+	return res
+}
+
+type addFunc func(kind symbolKind, decl bool, tk *token.Token, name string)
+
+// serviceReferences reports the references made by a service definition through add
+func serviceReferences(service *ast.MappingValueNode, add addFunc) {
+	for _, field := range mappingValues(service.Value) {
+		switch field.Key.GetToken().Value {
+		case "depends_on":
+			listOrKeys(field.Value, func(tk *token.Token, name string) {
+				add(symbolService, false, tk, name)
+			})
+		case "links":
+			for _, item := range sequenceValues(field.Value) {
+				if tk, val, ok := scalar(item); ok {
+					name, _, _ := strings.Cut(val, ":")
+					add(symbolService, false, tk, name)
+				}
+			}
+		case "extends":
+			if tk, val, ok := scalar(field.Value); ok {
+				add(symbolService, false, tk, val)
+				continue
+			}
+			if tk, val, ok := scalar(valueOf(field.Value, "service")); ok {
+				add(symbolService, false, tk, val)
+			}
+		case "networks":
+			listOrKeys(field.Value, func(tk *token.Token, name string) {
+				add(symbolNetwork, false, tk, name)
+			})
+		case "volumes":
+			for _, item := range sequenceValues(field.Value) {
+				if tk, val, ok := scalar(item); ok {
+					if name := namedVolume(val); name != "" {
+						add(symbolVolume, false, tk, name)
+					}
+					continue
+				}
+
+				_, volType, _ := scalar(valueOf(item, "type"))
+				if tk, val, ok := scalar(valueOf(item, "source")); ok && (volType == "" || volType == "volume") && namedVolume(val) != "" {
+					add(symbolVolume, false, tk, val)
+				}
+			}
+		case "secrets", "configs":
+			kind := symbolSecret
+			if field.Key.GetToken().Value == "configs" {
+				kind = symbolConfig
+			}
+			for _, item := range sequenceValues(field.Value) {
+				if tk, val, ok := scalar(item); ok {
+					add(kind, false, tk, val)
+				} else if tk, val, ok := scalar(valueOf(item, "source")); ok {
+					add(kind, false, tk, val)
+				}
+			}
+		}
+	}
+}
+
+// namedVolume returns the volume name of a short syntax volume,
+// empty if the source is a host path
+func namedVolume(spec string) string {
+	source, _, found := strings.Cut(spec, ":")
+	if !found {
+		// anonymous volume
+		return ""
+	}
+	if source == "" || strings.ContainsAny(source[:1], "./~$") || strings.Contains(source, "/") {
+		return ""
+	}
+	return source
+}
+
+// listOrKeys calls fn for each scalar list item or mapping key of node
+func listOrKeys(node ast.Node, fn func(tk *token.Token, name string)) {
+	for _, item := range sequenceValues(node) {
+		if tk, val, ok := scalar(item); ok {
+			fn(tk, val)
+		}
+	}
+	for _, kv := range mappingValues(node) {
+		fn(kv.Key.GetToken(), kv.Key.GetToken().Value)
+	}
+}
+
+// unwrap skips anchors and tags to the underlying value
+func unwrap(node ast.Node) ast.Node {
+	for {
+		switch n := node.(type) {
+		case *ast.AnchorNode:
+			node = n.Value
+		case *ast.TagNode:
+			node = n.Value
+		default:
+			return node
+		}
+	}
+}
+
+func mappingValues(node ast.Node) []*ast.MappingValueNode {
+	switch n := unwrap(node).(type) {
+	case *ast.MappingNode:
+		return n.Values
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{n}
+	}
+	return nil
+}
+
+func sequenceValues(node ast.Node) []ast.Node {
+	if n, ok := unwrap(node).(*ast.SequenceNode); ok {
+		return n.Values
+	}
+	return nil
+}
+
+// valueOf returns the value of key in a mapping node
+func valueOf(node ast.Node, key string) ast.Node {
+	for _, kv := range mappingValues(node) {
+		if kv.Key.GetToken().Value == key {
+			return kv.Value
+		}
+	}
+	return nil
+}
+
+// scalar returns the token and value of a string node
+func scalar(node ast.Node) (*token.Token, string, bool) {
+	n, ok := unwrap(node).(*ast.StringNode)
+	if !ok {
+		return nil, "", false
+	}
+	return n.Token, n.Value, true
+}
+
+// nameRange returns the range of name at the start of a token,
+// skipping the opening quote of quoted strings
+func nameRange(tk *token.Token, name string) protocol.Range {
+	rng := tokenRange(tk)
+	if tk.Type == token.DoubleQuoteType || tk.Type == token.SingleQuoteType {
+		rng.Start.Character++
+	}
+	rng.End = rng.Start
+	rng.End.Character += uint32(utf8.RuneCountInString(name))
+	return rng
+}
@@ -3,10 +3,13 @@ package notifications
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
-	"github.com/RA341/dockman/pkg/syncmap"
 	"github.com/nikoksr/notify"
+	nhttp "github.com/nikoksr/notify/service/http"
 	"github.com/nikoksr/notify/service/telegram"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
@@ -34,147 +37,90 @@ Available Providers
 
 */
 
-var std *Service
-
-func Send(mes *NotifMessage) {
-	std.send(mes)
-}
-
-func InitNotificationService(store Store) {
-	std = newService(store)
-}
-
-// NotifQueueSize max 100 notifs allowed to be queued
-const NotifQueueSize = 100
-
-type NotifMessage struct {
-	level         Level
-	Subject, Body string
-}
+// sendTimeout how long delivering a message to every provider of a level may take
+const sendTimeout = 30 * time.Second
 
 type Service struct {
 	store Store
-
-	posts syncmap.Map[Level, chan NotifMessage]
-
-	notifChan chan *NotifMessage
+	log   zerolog.Logger
 }
 
-func newService(store Store) *Service {
+func New(store Store) *Service {
 	return &Service{
-		store:     store,
-		notifChan: make(chan *NotifMessage, NotifQueueSize),
+		store: store,
+		log:   log.With().Str("service", "notifications").Logger(),
 	}
 }
 
-func (srv *Service) send(notifs *NotifMessage) {
-	configs, err := srv.store.GetAllByLevel(notifs.level)
-	if err != nil {
-		log.Warn().Err(err).Msg("unable to get notif configs")
+// Send delivers a message to every provider configured for level in the background,
+// failures are only logged. A nil service sends nothing
+func (srv *Service) Send(level Level, subject, body string) {
+	if srv == nil {
 		return
 	}
+	go srv.send(level, subject, body)
+}
 
-	for _, config := range configs {
-		cal, ok := config.Config["sds"]
-		loadExternalService(config.Config)
-
+func (srv *Service) send(level Level, subject, body string) {
+	configs, err := srv.store.GetAllByLevel(level)
+	if err != nil {
+		srv.log.Warn().Err(err).Msg("unable to get notif configs")
+		return
 	}
 
-	val, ok := srv.posts.Load(notifs.level)
-	if !ok {
-		srv.posts.Load()
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	for _, conf := range configs {
+		notifier, err := newNotifier(conf.Config)
+		if err != nil {
+			srv.log.Warn().Err(err).Uint("id", conf.ID).Msg("invalid notification config")
+			continue
+		}
+		if err = notifier.Send(ctx, subject, body); err != nil {
+			srv.log.Warn().Err(err).Uint("id", conf.ID).Msg("failed to send notification")
+		}
 	}
 }
 
 type notificationProviders string
 
 const (
-	TelegramProvider = "telegram"
-	DiscordProvider  = "discord"
-	SlackProvider    = "slack"
-	EmailProvider    = "email"
-	WebhookProvider  = "webhook"
+	TelegramProvider notificationProviders = "telegram"
+	WebhookProvider  notificationProviders = "webhook"
 )
 
 type providerInit func(conf Config) (notify.Notifier, error)
 
 var supportedNotifs = map[notificationProviders]providerInit{
 	TelegramProvider: func(conf Config) (notify.Notifier, error) {
-		val, ok := conf["apiToken"].(string)
-		if !ok {
-			return nil, fmt.Errorf("unable to get apitoken")
-		}
-
-		t, err := telegram.New(val)
+		t, err := telegram.New(conf.String("apiToken"))
 		if err != nil {
 			return nil, err
 		}
 
-		r, ok := conf["receivers"].([]int64)
-		if ok {
-			t.AddReceivers(r...)
+		for _, receiver := range conf.List("receivers") {
+			chatID, err := strconv.ParseInt(receiver, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid telegram chat id %q", receiver)
+			}
+			t.AddReceivers(chatID)
 		}
-
 		return t, nil
 	},
-	DiscordProvider: func(conf Config) (notify.Notifier, error) {
-
+	WebhookProvider: func(conf Config) (notify.Notifier, error) {
+		h := nhttp.New()
+		h.AddReceiversURLs(conf.List("receivers")...)
+		return h, nil
 	},
 }
 
-func loadExternalService(config Config) error {
-	val, ok := config["type"].(string)
+// newNotifier creates the provider named by the type key of conf
+func newNotifier(conf Config) (notify.Notifier, error) {
+	provider := notificationProviders(conf.String("type"))
+	create, ok := supportedNotifs[provider]
 	if !ok {
-		return nil
-	}
-
-	switch val {
-	case "telegram":
-		return loadTelegram(config)
-	default:
-		return nil
-	}
-}
-
-func createNewLevelWorker(level Level) chan NotifMessage {
-	levelChan := make(chan NotifMessage, 50)
-	go notifWorker(levelChan, notifiers)
-}
-
-func notifWorker(stream chan NotifMessage, srv ...notify.Notifier) {
-
-	for {
-		select {
-		case notifMsg, ok := <-stream:
-			if !ok {
-				return
-			}
-
-		}
+		return nil, fmt.Errorf("unsupported notification provider %q", provider)
 	}
-}
-
-// blocking function must be run a go routine
-func sendNotifs() {
-	//// Create a telegram service. Ignoring error for demo simplicity.
-	//telegramService, _ := telegram.New("your_telegram_api_token")
-	//
-	//// Passing a telegram chat id as receiver for our messages.
-	//// Basically where should our message be sent?
-	//telegramService.AddReceivers(-1234567890)
-
-	//notify.UseServices(telegramService)
-}
-
-func send(subject, body string) {
-	err := notify.Send(context.Background(), subject, body)
-	if err != nil {
-		log.Error().Err(err).Msg("failed to send notification")
-	}
-}
-func (srv *Service) loadNotifiers() {
-
-}
-
-func loadTelegram(config Config) error {
+	return create(conf)
 }
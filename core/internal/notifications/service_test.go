@@ -0,0 +1,41 @@
+package notifications
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RA341/dockman/internal/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- string(body)
+	}))
+	defer server.Close()
+
+	store := NewStore(database.New(t.TempDir(), false))
+	require.NoError(t, store.Save(&Notification{
+		Level:  LevelBackup,
+		Config: Config{"type": "webhook", "receivers": []any{server.URL}},
+	}))
+	require.NoError(t, store.Save(&Notification{
+		Level:  LevelUpdate,
+		Config: Config{"type": "webhook", "receivers": []any{server.URL + "/update"}},
+	}))
+
+	New(store).send(LevelBackup, "Backup of app failed", "disk full")
+	require.Contains(t, <-received, "disk full")
+	require.Empty(t, received, "only providers of the level are used")
+
+	_, err := newNotifier(Config{"type": "carrier-pigeon"})
+	require.Error(t, err)
+	require.Equal(t, []string{"-1234567890", "abc"}, Config{"receivers": []any{-1234567890.0, "abc"}}.List("receivers"))
+
+	var nilSrv *Service
+	nilSrv.Send(LevelBackup, "ignored", "")
+}
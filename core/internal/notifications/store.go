@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"strconv"
 
 	"gorm.io/gorm"
 )
@@ -34,7 +35,29 @@ type Notification struct {
 
 type Config map[string]interface{}
 
-func (c *Config) Value() (driver.Value, error) {
+// String returns the value of key if it is a string
+func (c Config) String(key string) string {
+	val, _ := c[key].(string)
+	return val
+}
+
+// List returns the value of key as strings, numbers are formatted
+// since json decodes every number as a float
+func (c Config) List(key string) []string {
+	items, _ := c[key].([]interface{})
+	res := make([]string, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case string:
+			res = append(res, v)
+		case float64:
+			res = append(res, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+	}
+	return res
+}
+
+func (c Config) Value() (driver.Value, error) {
 	return json.Marshal(c)
 }
 
@@ -0,0 +1,36 @@
+package notifications
+
+import (
+	"gorm.io/gorm"
+)
+
+type GormStore struct {
+	db *gorm.DB
+}
+
+func NewStore(db *gorm.DB) *GormStore {
+	return &GormStore{db: db}
+}
+
+func (g *GormStore) Save(notif *Notification) error {
+	return g.db.Save(notif).Error
+}
+
+func (g *GormStore) Get(id uint) (*Notification, error) {
+	var notif Notification
+	err := g.db.First(&notif, id).Error
+	return &notif, err
+}
+
+func (g *GormStore) GetAllByLevel(level Level) ([]Notification, error) {
+	var notifs []Notification
+	err := g.db.
+		Where("level = ?", level).
+		Find(&notifs).
+		Error
+	return notifs, err
+}
+
+func (g *GormStore) Delete(id uint) error {
+	return g.db.Unscoped().Delete(&Notification{}, id).Error
+}
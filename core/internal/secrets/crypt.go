@@ -0,0 +1,109 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+const keySize = 32
+
+// loadKey reads the hex encoded key at path, generating one if there is none.
+// Secrets can not be decrypted without it, it is backed up together with the database
+func loadKey(path string) ([]byte, error) {
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return generateKey(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read secrets key: %w", err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil || len(key) != keySize {
+		return nil, fmt.Errorf("secrets key at %s is not a %d byte hex key", path, keySize)
+	}
+	return key, nil
+}
+
+func generateKey(path string) ([]byte, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	// O_EXCL so a key is never overwritten
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create secrets key: %w", err)
+	}
+	_, err = file.WriteString(hex.EncodeToString(key))
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to write secrets key: %w", err)
+	}
+
+	log.Info().Str("path", path).Msg("Generated new secrets key")
+	return key, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts value, the row identity is authenticated
+// so a sealed value can not be copied to another secret
+func seal(aead cipher.AEAD, secret *Secret, value string) error {
+	sealed, err := sealWith(aead, []byte(value), additionalData(secret))
+	if err != nil {
+		return err
+	}
+	secret.Value = sealed
+	return nil
+}
+
+func open(aead cipher.AEAD, secret *Secret) (string, error) {
+	value, err := openWith(aead, secret.Value, additionalData(secret))
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt secret %s: %w", secret.Name, err)
+	}
+	return string(value), nil
+}
+
+func additionalData(secret *Secret) []byte {
+	return []byte(secret.Host + "\x00" + secret.Stack + "\x00" + secret.Name)
+}
+
+// sealWith encrypts value with a random nonce, the nonce is prepended to the result
+func sealWith(aead cipher.AEAD, value, additional []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, value, additional), nil
+}
+
+func openWith(aead cipher.AEAD, sealed, additional []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("sealed value is corrupted")
+	}
+	nonce, sealed := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, additional)
+}
@@ -0,0 +1,64 @@
+package secrets
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/secrets/v1"
+	"github.com/RA341/dockman/generated/secrets/v1/v1connect"
+	"github.com/RA341/dockman/internal/host/middleware"
+	"github.com/RA341/dockman/pkg/listutils"
+)
+
+type Handler struct {
+	srv *Service
+}
+
+func NewHandler(srv *Service) (string, http.Handler) {
+	h := &Handler{srv: srv}
+	return v1connect.NewSecretsServiceHandler(h)
+}
+
+func (h *Handler) ListSecrets(ctx context.Context, req *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := h.srv.List(hostname, req.Msg.Stack)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ListSecretsResponse{
+		Secrets: listutils.ToMap(secrets, func(secret Secret) *v1.Secret {
+			return secret.ToProto()
+		}),
+	}), nil
+}
+
+func (h *Handler) SaveSecret(ctx context.Context, req *connect.Request[v1.SaveSecretRequest]) (*connect.Response[v1.Empty], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.srv.Save(hostname, req.Msg.Stack, req.Msg.Name, Mode(req.Msg.Mode), req.Msg.Value)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) DeleteSecret(ctx context.Context, req *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.Empty], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.srv.Delete(hostname, req.Msg.Stack, req.Msg.Name); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.Empty{}), nil
+}
@@ -0,0 +1,125 @@
+package secrets
+
+import (
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/RA341/dockman/internal/docker/compose"
+	"gorm.io/gorm"
+)
+
+// Service secrets for compose stacks, kept encrypted in the database
+// and only decrypted when a compose command runs. Values are write only,
+// nothing outside of compose ever reads them back
+type Service struct {
+	store Store
+	aead  cipher.AEAD
+}
+
+// New loads the encryption key at keyPath, a new key is generated if there is none
+func New(store Store, keyPath string) (*Service, error) {
+	key, err := loadKey(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{store: store, aead: aead}, nil
+}
+
+// names are used as environment variables
+var validName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// List returns the secrets of a host without their values,
+// of a single stack if stack is set
+func (s *Service) List(host, stack string) ([]Secret, error) {
+	secrets, err := s.store.List(host, stack)
+	for i := range secrets {
+		secrets[i].Value = nil
+	}
+	return secrets, err
+}
+
+// Save creates or updates a secret, an empty value keeps the saved one
+func (s *Service) Save(host, stack, name string, mode Mode, value string) error {
+	if !validName.MatchString(name) {
+		return fmt.Errorf("invalid secret name %q, only letters, digits and _ are allowed", name)
+	}
+	if !slices.Contains([]Mode{ModeEnv, ModeFile}, mode) {
+		return fmt.Errorf("unknown secret mode %q", mode)
+	}
+
+	secret, err := s.store.Get(host, stack, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if value == "" {
+			return fmt.Errorf("secret %s needs a value", name)
+		}
+		secret = Secret{Host: host, Stack: stack, Name: name}
+	} else if err != nil {
+		return err
+	}
+
+	secret.Mode = mode
+	if value != "" {
+		if err = seal(s.aead, &secret, value); err != nil {
+			return err
+		}
+	}
+	return s.store.Save(&secret)
+}
+
+// Seal encrypts a value another service keeps, like a hook secret. scope names
+// what the value belongs to, it is authenticated and has to be passed to Open again
+func (s *Service) Seal(scope, value string) ([]byte, error) {
+	return sealWith(s.aead, []byte(value), []byte(scope))
+}
+
+// Open decrypts a value from Seal
+func (s *Service) Open(scope string, sealed []byte) (string, error) {
+	value, err := openWith(s.aead, sealed, []byte(scope))
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt %s: %w", scope, err)
+	}
+	return string(value), nil
+}
+
+func (s *Service) Delete(host, stack, name string) error {
+	return s.store.Delete(host, stack, name)
+}
+
+// ForStack decrypts the secrets for a compose file,
+// a secret of the stack replaces one with the same name for every stack
+func (s *Service) ForStack(host, filename string) ([]compose.Secret, error) {
+	secrets, err := s.store.ForStack(host, filename)
+	if err != nil {
+		return nil, err
+	}
+	// host wide secrets first so the stack ones overwrite them
+	slices.SortStableFunc(secrets, func(a, b Secret) int {
+		return len(a.Stack) - len(b.Stack)
+	})
+
+	byName := map[string]int{}
+	var res []compose.Secret
+	for _, secret := range secrets {
+		value, err := open(s.aead, &secret)
+		if err != nil {
+			return nil, err
+		}
+
+		decrypted := compose.Secret{Name: secret.Name, Value: value, File: secret.Mode == ModeFile}
+		if i, ok := byName[secret.Name]; ok {
+			res[i] = decrypted
+			continue
+		}
+		byName[secret.Name] = len(res)
+		res = append(res, decrypted)
+	}
+	return res, nil
+}
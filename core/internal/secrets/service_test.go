@@ -0,0 +1,68 @@
+package secrets
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/RA341/dockman/internal/database"
+	"github.com/RA341/dockman/internal/docker/compose"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecrets(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(database.New(dir, false))
+	keyPath := filepath.Join(dir, "secrets.key")
+	srv, err := New(store, keyPath)
+	require.NoError(t, err)
+
+	const stack = "compose/app/compose.yaml"
+	require.Error(t, srv.Save("local", stack, "DB-PASS", ModeEnv, "x"), "names must be valid env vars")
+	require.Error(t, srv.Save("local", stack, "DB_PASS", ModeEnv, ""), "new secrets need a value")
+
+	require.NoError(t, srv.Save("local", "", "DB_PASS", ModeEnv, "host-wide"))
+	require.NoError(t, srv.Save("local", "", "TOKEN", ModeEnv, "token"))
+	require.NoError(t, srv.Save("local", stack, "DB_PASS", ModeFile, "hunter2"))
+	require.NoError(t, srv.Save("remote", stack, "DB_PASS", ModeEnv, "other host"))
+
+	list, err := srv.List("local", stack)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Nil(t, list[0].Value, "values are write only")
+
+	raw, err := store.Get("local", stack, "DB_PASS")
+	require.NoError(t, err)
+	require.NotContains(t, string(raw.Value), "hunter2")
+
+	// empty value keeps the saved one
+	require.NoError(t, srv.Save("local", stack, "DB_PASS", ModeFile, ""))
+
+	got, err := srv.ForStack("local", stack)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []compose.Secret{
+		{Name: "DB_PASS", Value: "hunter2", File: true},
+		{Name: "TOKEN", Value: "token"},
+	}, got)
+
+	got, err = srv.ForStack("local", "compose/other/compose.yaml")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []compose.Secret{
+		{Name: "DB_PASS", Value: "host-wide"},
+		{Name: "TOKEN", Value: "token"},
+	}, got)
+
+	// a sealed value is bound to its secret
+	raw.Name = "TOKEN"
+	raw.Stack = ""
+	_, err = open(srv.aead, &raw)
+	require.Error(t, err)
+
+	// the key is reused on restart
+	srv, err = New(store, keyPath)
+	require.NoError(t, err)
+	require.NoError(t, srv.Delete("local", stack, "DB_PASS"))
+	got, err = srv.ForStack("local", stack)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.NoError(t, srv.Save("local", stack, "DB_PASS", ModeEnv, "again"), "deleted names can be reused")
+}
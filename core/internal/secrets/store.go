@@ -0,0 +1,36 @@
+package secrets
+
+import (
+	"gorm.io/gorm"
+)
+
+type Mode string
+
+const (
+	// ModeEnv the secret is passed to compose as an environment variable
+	ModeEnv Mode = "env"
+	// ModeFile the secret is also declared as a compose secret,
+	// services can mount it as /run/secrets/<name>
+	ModeFile Mode = "file"
+)
+
+// Secret a value for the stacks on a host, an empty Stack applies to every stack
+type Secret struct {
+	gorm.Model
+	Host  string `gorm:"uniqueIndex:idx_secret_name"`
+	Stack string `gorm:"uniqueIndex:idx_secret_name"`
+	Name  string `gorm:"uniqueIndex:idx_secret_name"`
+	Mode  Mode
+	// Value the AES-GCM sealed value prefixed with its nonce
+	Value []byte
+}
+
+type Store interface {
+	// List returns the secrets of host, of a single stack if stack is set
+	List(host, stack string) ([]Secret, error)
+	// ForStack returns the secrets of a stack and those for every stack
+	ForStack(host, stack string) ([]Secret, error)
+	Get(host, stack, name string) (Secret, error)
+	Save(*Secret) error
+	Delete(host, stack, name string) error
+}
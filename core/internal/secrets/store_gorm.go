@@ -0,0 +1,58 @@
+package secrets
+
+import (
+	"gorm.io/gorm"
+)
+
+type GormStore struct {
+	db *gorm.DB
+}
+
+func NewStore(db *gorm.DB) *GormStore {
+	return &GormStore{db: db}
+}
+
+func (g *GormStore) List(host, stack string) ([]Secret, error) {
+	query := g.db.Where("host = ?", host)
+	if stack != "" {
+		query = query.Where("stack = ?", stack)
+	}
+
+	var secrets []Secret
+	err := query.
+		Order("stack ASC, name ASC").
+		Find(&secrets).
+		Error
+	return secrets, err
+}
+
+func (g *GormStore) ForStack(host, stack string) ([]Secret, error) {
+	var secrets []Secret
+	err := g.db.
+		Where("host = ? AND stack IN ?", host, []string{"", stack}).
+		Find(&secrets).
+		Error
+	return secrets, err
+}
+
+func (g *GormStore) Get(host, stack, name string) (Secret, error) {
+	var secret Secret
+	err := g.db.
+		Where("host = ? AND stack = ? AND name = ?", host, stack, name).
+		First(&secret).
+		Error
+	return secret, err
+}
+
+func (g *GormStore) Save(secret *Secret) error {
+	return g.db.Save(secret).Error
+}
+
+// Delete removes the row for good, the sealed value should not linger around
+func (g *GormStore) Delete(host, stack, name string) error {
+	return g.db.
+		Unscoped().
+		Where("host = ? AND stack = ? AND name = ?", host, stack, name).
+		Delete(&Secret{}).
+		Error
+}
@@ -0,0 +1,14 @@
+package secrets
+
+import (
+	v1 "github.com/RA341/dockman/generated/secrets/v1"
+)
+
+func (s *Secret) ToProto() *v1.Secret {
+	return &v1.Secret{
+		Stack:     s.Stack,
+		Name:      s.Name,
+		Mode:      string(s.Mode),
+		UpdatedAt: s.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,40 @@
+package volumestats
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/volumestats/v1"
+	"github.com/RA341/dockman/generated/volumestats/v1/v1connect"
+	"github.com/RA341/dockman/internal/host/middleware"
+	"github.com/RA341/dockman/pkg/listutils"
+)
+
+type Handler struct {
+	srv *Service
+}
+
+func NewHandler(srv *Service) (string, http.Handler) {
+	h := &Handler{srv: srv}
+	return v1connect.NewVolumeStatsServiceHandler(h)
+}
+
+func (h *Handler) SizeHistory(ctx context.Context, req *connect.Request[v1.SizeHistoryRequest]) (*connect.Response[v1.SizeHistoryResponse], error) {
+	hostname, err := middleware.GetHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	samples, err := h.srv.History(hostname, req.Msg.Volume, time.Unix(req.Msg.Since, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.SizeHistoryResponse{
+		Samples: listutils.ToMap(samples, func(s Sample) *v1.SizeSample {
+			return s.ToProto()
+		}),
+	}), nil
+}
@@ -0,0 +1,106 @@
+package volumestats
+
+import (
+	"context"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/go-co-op/gocron/v2"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	sampleInterval = time.Hour
+	// retention how long samples are kept
+	retention = 30 * 24 * time.Hour
+	// sampleTimeout per host, disk usage walks every volume so it can be slow
+	sampleTimeout = 5 * time.Minute
+)
+
+type DockerProvider func(host string) (*docker.Service, error)
+
+// HostLister returns the hosts that are currently connected
+type HostLister func() []string
+
+// Service samples the size of every volume on the connected hosts
+// so growth over time can be tracked
+type Service struct {
+	store  Store
+	hosts  HostLister
+	docker DockerProvider
+	log    zerolog.Logger
+
+	schd gocron.Scheduler
+}
+
+func New(store Store, hosts HostLister, docker DockerProvider) *Service {
+	s := &Service{
+		store:  store,
+		hosts:  hosts,
+		docker: docker,
+		log:    log.With().Str("service", "volumestats").Logger(),
+	}
+
+	schd, err := gocron.NewScheduler()
+	if err != nil {
+		s.log.Fatal().Err(err).Msg("Failed to initialize volume stats scheduler")
+	}
+	s.schd = schd
+	schd.Start()
+
+	_, err = schd.NewJob(
+		gocron.DurationJob(sampleInterval),
+		gocron.NewTask(s.sample),
+		gocron.WithStartAt(gocron.WithStartImmediately()),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+	if err != nil {
+		s.log.Warn().Err(err).Msg("Failed to schedule volume size sampling")
+	}
+
+	return s
+}
+
+func (s *Service) History(host, volume string, since time.Time) ([]Sample, error) {
+	return s.store.History(host, volume, since)
+}
+
+// sample records the current size of every volume on every connected host
+func (s *Service) sample() {
+	for _, host := range s.hosts() {
+		samples, err := s.sampleHost(host)
+		if err != nil {
+			s.log.Warn().Err(err).Str("host", host).Msg("Failed to sample volume sizes")
+			continue
+		}
+		if err = s.store.Add(samples); err != nil {
+			s.log.Warn().Err(err).Str("host", host).Msg("Failed to save volume sizes")
+		}
+	}
+
+	if err := s.store.Prune(time.Now().Add(-retention)); err != nil {
+		s.log.Warn().Err(err).Msg("Failed to prune old volume sizes")
+	}
+}
+
+func (s *Service) sampleHost(host string) ([]Sample, error) {
+	dkSrv, err := s.docker(host)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sampleTimeout)
+	defer cancel()
+
+	sizes, err := dkSrv.Container.VolumesSizes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]Sample, 0, len(sizes))
+	for name, size := range sizes {
+		samples = append(samples, Sample{Host: host, Volume: name, Size: size})
+	}
+	return samples, nil
+}
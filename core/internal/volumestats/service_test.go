@@ -0,0 +1,43 @@
+package volumestats
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RA341/dockman/internal/database"
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	store := NewStore(database.New(t.TempDir(), false))
+	srv := &Service{
+		store: store,
+		hosts: func() []string { return []string{"local", "remote"} },
+		docker: func(host string) (*docker.Service, error) {
+			return nil, errors.New("not connected")
+		},
+	}
+
+	old := Sample{Host: "local", Volume: "db", Size: 10}
+	old.CreatedAt = time.Now().Add(-retention - time.Hour)
+	require.NoError(t, store.Add([]Sample{old}))
+	require.NoError(t, store.Add([]Sample{
+		{Host: "local", Volume: "db", Size: 20},
+		{Host: "local", Volume: "cache", Size: 5},
+		{Host: "remote", Volume: "db", Size: 30},
+	}))
+
+	// hosts that can't be reached are skipped, old samples are pruned
+	srv.sample()
+
+	samples, err := srv.History("local", "db", time.Time{})
+	require.NoError(t, err)
+	require.Len(t, samples, 1)
+	require.Equal(t, int64(20), samples[0].Size)
+
+	samples, err = srv.History("local", "db", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Empty(t, samples)
+}
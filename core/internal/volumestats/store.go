@@ -0,0 +1,28 @@
+package volumestats
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Sample size of a volume at the time it was created
+type Sample struct {
+	gorm.Model
+	Host   string `gorm:"index:idx_volume_sample"`
+	Volume string `gorm:"index:idx_volume_sample"`
+	// Size in bytes
+	Size int64
+}
+
+func (*Sample) TableName() string {
+	return "volume_size_samples"
+}
+
+type Store interface {
+	Add(samples []Sample) error
+	// History samples of a volume taken after since, oldest first
+	History(host, volume string, since time.Time) ([]Sample, error)
+	// Prune deletes samples taken before the given time
+	Prune(before time.Time) error
+}
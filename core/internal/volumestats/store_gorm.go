@@ -0,0 +1,40 @@
+package volumestats
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type GormStore struct {
+	db *gorm.DB
+}
+
+func NewStore(db *gorm.DB) *GormStore {
+	return &GormStore{db: db}
+}
+
+func (g *GormStore) Add(samples []Sample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	return g.db.CreateInBatches(samples, 100).Error
+}
+
+func (g *GormStore) History(host, volume string, since time.Time) ([]Sample, error) {
+	var samples []Sample
+	err := g.db.
+		Where("host = ? AND volume = ? AND created_at > ?", host, volume, since).
+		Order("created_at ASC").
+		Find(&samples).
+		Error
+	return samples, err
+}
+
+func (g *GormStore) Prune(before time.Time) error {
+	return g.db.
+		Unscoped().
+		Where("created_at < ?", before).
+		Delete(&Sample{}).
+		Error
+}
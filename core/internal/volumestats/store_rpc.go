@@ -0,0 +1,12 @@
+package volumestats
+
+import (
+	v1 "github.com/RA341/dockman/generated/volumestats/v1"
+)
+
+func (s *Sample) ToProto() *v1.SizeSample {
+	return &v1.SizeSample{
+		Time: s.CreatedAt.Unix(),
+		Size: s.Size,
+	}
+}
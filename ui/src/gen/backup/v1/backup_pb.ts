@@ -0,0 +1,357 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file backup/v1/backup.proto (package backup.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file backup/v1/backup.proto.
+ */
+export const file_backup_v1_backup: GenFile = /*@__PURE__*/
+  fileDesc("ChZiYWNrdXAvdjEvYmFja3VwLnByb3RvEgliYWNrdXAudjEiJAoQR2V0Q29uZmlnUmVxdWVzdBIQCghmaWxlbmFtZRgBIAEoCSLCAQoGQ29uZmlnEhAKCGZpbGVuYW1lGAEgASgJEg8KB2VuYWJsZWQYAiABKAgSEAoIc2NoZWR1bGUYAyABKAkSFAoMc3RvcFNlcnZpY2VzGAQgASgIEg8KB3ZvbHVtZXMYBSABKAgSEgoKYmluZE1vdW50cxgGIAEoCBIQCghkZXN0SG9zdBgHIAEoCRIRCglkZXN0QWxpYXMYCCABKAkSEAoIZGVzdFBhdGgYCSABKAkSEQoJcmV0ZW50aW9uGAogASgNIhQKElNhdmVDb25maWdSZXNwb25zZSIkChBSdW5CYWNrdXBSZXF1ZXN0EhAKCGZpbGVuYW1lGAEgASgJIhwKC0pvYlJlc3BvbnNlEg0KBWpvYklkGAEgASgJIiMKD0xpc3RSdW5zUmVxdWVzdBIQCghmaWxlbmFtZRgBIAEoCSIwChBMaXN0UnVuc1Jlc3BvbnNlEhwKBHJ1bnMYASADKAsyDi5iYWNrdXAudjEuUnVuIpUBCgNSdW4SCgoCaWQYASABKA0SDAoEdGltZRgCIAEoAxINCgVzdGF0ZRgDIAEoCRINCgVlcnJvchgEIAEoCRIQCghkZXN0SG9zdBgFIAEoCRIRCglkZXN0QWxpYXMYBiABKAkSCwoDZGlyGAcgASgJEiQKCGFyY2hpdmVzGAggAygLMhIuYmFja3VwLnYxLkFyY2hpdmUiQwoHQXJjaGl2ZRIMCgRraW5kGAEgASgJEg4KBnNvdXJjZRgCIAEoCRIMCgRuYW1lGAMgASgJEgwKBHNpemUYBCABKAMiMAoOUmVzdG9yZVJlcXVlc3QSDQoFcnVuSWQYASABKA0SDwoHc291cmNlcxgCIAMoCTLbAgoNQmFja3VwU2VydmljZRI9CglHZXRDb25maWcSGy5iYWNrdXAudjEuR2V0Q29uZmlnUmVxdWVzdBoRLmJhY2t1cC52MS5Db25maWciABJACgpTYXZlQ29uZmlnEhEuYmFja3VwLnYxLkNvbmZpZxodLmJhY2t1cC52MS5TYXZlQ29uZmlnUmVzcG9uc2UiABJCCglSdW5CYWNrdXASGy5iYWNrdXAudjEuUnVuQmFja3VwUmVxdWVzdBoWLmJhY2t1cC52MS5Kb2JSZXNwb25zZSIAEkUKCExpc3RSdW5zEhouYmFja3VwLnYxLkxpc3RSdW5zUmVxdWVzdBobLmJhY2t1cC52MS5MaXN0UnVuc1Jlc3BvbnNlIgASPgoHUmVzdG9yZRIZLmJhY2t1cC52MS5SZXN0b3JlUmVxdWVzdBoWLmJhY2t1cC52MS5Kb2JSZXNwb25zZSIAQo8BCg1jb20uYmFja3VwLnYxQgtCYWNrdXBQcm90b1ABWixnaXRodWIuY29tL1JBMzQxL2RvY2ttYW4vZ2VuZXJhdGVkL2JhY2t1cC92MaICA0JYWKoCCUJhY2t1cC5WMcoCCUJhY2t1cFxWMeICFUJhY2t1cFxWMVxHUEJNZXRhZGF0YeoCCkJhY2t1cDo6VjFiBnByb3RvMw");
+
+/**
+ * @generated from message backup.v1.GetConfigRequest
+ */
+export type GetConfigRequest = Message<"backup.v1.GetConfigRequest"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+};
+
+/**
+ * Describes the message backup.v1.GetConfigRequest.
+ * Use `create(GetConfigRequestSchema)` to create a new message.
+ */
+export const GetConfigRequestSchema: GenMessage<GetConfigRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 0);
+
+/**
+ * @generated from message backup.v1.Config
+ */
+export type Config = Message<"backup.v1.Config"> & {
+  /**
+   * compose file of the stack
+   *
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * cron expression
+   *
+   * @generated from field: string schedule = 3;
+   */
+  schedule: string;
+
+  /**
+   * stop the running containers of the stack while archiving
+   *
+   * @generated from field: bool stopServices = 4;
+   */
+  stopServices: boolean;
+
+  /**
+   * @generated from field: bool volumes = 5;
+   */
+  volumes: boolean;
+
+  /**
+   * @generated from field: bool bindMounts = 6;
+   */
+  bindMounts: boolean;
+
+  /**
+   * host and alias archives are written to over sftp,
+   * leave destHost empty to write to destPath on the machine running dockman
+   *
+   * @generated from field: string destHost = 7;
+   */
+  destHost: string;
+
+  /**
+   * @generated from field: string destAlias = 8;
+   */
+  destAlias: string;
+
+  /**
+   * @generated from field: string destPath = 9;
+   */
+  destPath: string;
+
+  /**
+   * successful backups kept, 0 keeps all
+   *
+   * @generated from field: uint32 retention = 10;
+   */
+  retention: number;
+};
+
+/**
+ * Describes the message backup.v1.Config.
+ * Use `create(ConfigSchema)` to create a new message.
+ */
+export const ConfigSchema: GenMessage<Config> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 1);
+
+/**
+ * @generated from message backup.v1.SaveConfigResponse
+ */
+export type SaveConfigResponse = Message<"backup.v1.SaveConfigResponse"> & {
+};
+
+/**
+ * Describes the message backup.v1.SaveConfigResponse.
+ * Use `create(SaveConfigResponseSchema)` to create a new message.
+ */
+export const SaveConfigResponseSchema: GenMessage<SaveConfigResponse> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 2);
+
+/**
+ * @generated from message backup.v1.RunBackupRequest
+ */
+export type RunBackupRequest = Message<"backup.v1.RunBackupRequest"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+};
+
+/**
+ * Describes the message backup.v1.RunBackupRequest.
+ * Use `create(RunBackupRequestSchema)` to create a new message.
+ */
+export const RunBackupRequestSchema: GenMessage<RunBackupRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 3);
+
+/**
+ * @generated from message backup.v1.JobResponse
+ */
+export type JobResponse = Message<"backup.v1.JobResponse"> & {
+  /**
+   * @generated from field: string jobId = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message backup.v1.JobResponse.
+ * Use `create(JobResponseSchema)` to create a new message.
+ */
+export const JobResponseSchema: GenMessage<JobResponse> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 4);
+
+/**
+ * @generated from message backup.v1.ListRunsRequest
+ */
+export type ListRunsRequest = Message<"backup.v1.ListRunsRequest"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+};
+
+/**
+ * Describes the message backup.v1.ListRunsRequest.
+ * Use `create(ListRunsRequestSchema)` to create a new message.
+ */
+export const ListRunsRequestSchema: GenMessage<ListRunsRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 5);
+
+/**
+ * @generated from message backup.v1.ListRunsResponse
+ */
+export type ListRunsResponse = Message<"backup.v1.ListRunsResponse"> & {
+  /**
+   * @generated from field: repeated backup.v1.Run runs = 1;
+   */
+  runs: Run[];
+};
+
+/**
+ * Describes the message backup.v1.ListRunsResponse.
+ * Use `create(ListRunsResponseSchema)` to create a new message.
+ */
+export const ListRunsResponseSchema: GenMessage<ListRunsResponse> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 6);
+
+/**
+ * @generated from message backup.v1.Run
+ */
+export type Run = Message<"backup.v1.Run"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 time = 2;
+   */
+  time: bigint;
+
+  /**
+   * running, success, failed
+   *
+   * @generated from field: string state = 3;
+   */
+  state: string;
+
+  /**
+   * @generated from field: string error = 4;
+   */
+  error: string;
+
+  /**
+   * @generated from field: string destHost = 5;
+   */
+  destHost: string;
+
+  /**
+   * @generated from field: string destAlias = 6;
+   */
+  destAlias: string;
+
+  /**
+   * directory of the archives in the destination
+   *
+   * @generated from field: string dir = 7;
+   */
+  dir: string;
+
+  /**
+   * @generated from field: repeated backup.v1.Archive archives = 8;
+   */
+  archives: Archive[];
+};
+
+/**
+ * Describes the message backup.v1.Run.
+ * Use `create(RunSchema)` to create a new message.
+ */
+export const RunSchema: GenMessage<Run> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 7);
+
+/**
+ * @generated from message backup.v1.Archive
+ */
+export type Archive = Message<"backup.v1.Archive"> & {
+  /**
+   * volume, bind
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * volume name or host path of the bind mount
+   *
+   * @generated from field: string source = 2;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * bytes
+   *
+   * @generated from field: int64 size = 4;
+   */
+  size: bigint;
+};
+
+/**
+ * Describes the message backup.v1.Archive.
+ * Use `create(ArchiveSchema)` to create a new message.
+ */
+export const ArchiveSchema: GenMessage<Archive> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 8);
+
+/**
+ * @generated from message backup.v1.RestoreRequest
+ */
+export type RestoreRequest = Message<"backup.v1.RestoreRequest"> & {
+  /**
+   * @generated from field: uint32 runId = 1;
+   */
+  runId: number;
+
+  /**
+   * sources to restore, empty restores every archive of the run
+   *
+   * @generated from field: repeated string sources = 2;
+   */
+  sources: string[];
+};
+
+/**
+ * Describes the message backup.v1.RestoreRequest.
+ * Use `create(RestoreRequestSchema)` to create a new message.
+ */
+export const RestoreRequestSchema: GenMessage<RestoreRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 9);
+
+/**
+ * @generated from service backup.v1.BackupService
+ */
+export const BackupService: GenService<{
+  /**
+   * @generated from rpc backup.v1.BackupService.GetConfig
+   */
+  getConfig: {
+    methodKind: "unary";
+    input: typeof GetConfigRequestSchema;
+    output: typeof ConfigSchema;
+  },
+  /**
+   * @generated from rpc backup.v1.BackupService.SaveConfig
+   */
+  saveConfig: {
+    methodKind: "unary";
+    input: typeof ConfigSchema;
+    output: typeof SaveConfigResponseSchema;
+  },
+  /**
+   * RunBackup starts a backup job, follow it through the jobs service
+   *
+   * @generated from rpc backup.v1.BackupService.RunBackup
+   */
+  runBackup: {
+    methodKind: "unary";
+    input: typeof RunBackupRequestSchema;
+    output: typeof JobResponseSchema;
+  },
+  /**
+   * @generated from rpc backup.v1.BackupService.ListRuns
+   */
+  listRuns: {
+    methodKind: "unary";
+    input: typeof ListRunsRequestSchema;
+    output: typeof ListRunsResponseSchema;
+  },
+  /**
+   * Restore starts a job extracting the archives of a run back into
+   * their volumes and bind mounts, replacing what is there
+   *
+   * @generated from rpc backup.v1.BackupService.Restore
+   */
+  restore: {
+    methodKind: "unary";
+    input: typeof RestoreRequestSchema;
+    output: typeof JobResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_backup_v1_backup, 0);
+
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file docker/v1/docker.proto.
  */
 export const file_docker_v1_docker: GenFile = /*@__PURE__*/
-  fileDesc("ChZkb2NrZXIvdjEvZG9ja2VyLnByb3RvEglkb2NrZXIudjEiKQoYQ29tcG9zZUZpbGVTdGF0dXNSZXF1ZXN0Eg0KBWZpbGVzGAEgAygJImYKBlN0YXR1cxISCgpzZXJ2aWNlc1VwGAEgASgFEhQKDHNlcnZpY2VzRG93bhgCIAEoBRIXCg9zZXJ2aWNlc0hlYWx0aHkYAyABKAUSGQoRc2VydmljZXNVbkhlYWx0aHkYBCABKAUinwEKGUNvbXBvc2VGaWxlU3RhdHVzUmVzcG9uc2USQAoGc3RhdHVzGAEgAygLMjAuZG9ja2VyLnYxLkNvbXBvc2VGaWxlU3RhdHVzUmVzcG9uc2UuU3RhdHVzRW50cnkaQAoLU3RhdHVzRW50cnkSCwoDa2V5GAEgASgJEiAKBXZhbHVlGAIgASgLMhEuZG9ja2VyLnYxLlN0YXR1czoCOAEiKgoTQ29udGFpbmVyVG9wUmVxdWVzdBITCgtjb250YWluZXJJZBgBIAEoCSIzChRDb250YWluZXJUb3BSZXNwb25zZRIbCgN0b3AYASABKAsyDi5kb2NrZXIudjEuVG9wIhwKB1Byb2Nlc3MSEQoJUHJvY2Vzc2VzGAEgAygJIjcKA1RvcBIgCgRwcm9jGAEgAygLMhIuZG9ja2VyLnYxLlByb2Nlc3MSDgoGVGl0bGVzGAIgAygJIssBChdDb250YWluZXJJbnNwZWN0TWVzc2FnZRIMCgROYW1lGAEgASgJEgoKAklEGAIgASgJEgwKBFBhdGgYAyABKAkSDwoHQ3JlYXRlZBgHIAEoCRINCgVJbWFnZRgEIAEoCRIRCglIb3N0c1BhdGgYBSABKAkSKQoGbW91bnRzGAYgAygLMhkuZG9ja2VyLnYxLkNvbnRhaW5lck1vdW50EioKBmNvbmZpZxgIIAEoCzIaLmRvY2tlci52MS5Db250YWluZXJDb25maWcirQMKD0NvbnRhaW5lckNvbmZpZxIQCghIb3N0bmFtZRgBIAEoCRISCgpEb21haW5uYW1lGAIgASgJEgwKBFVzZXIYAyABKAkSEwoLQXR0YWNoU3RkaW4YBCABKAgSFAoMQXR0YWNoU3Rkb3V0GAUgASgIEhQKDEF0dGFjaFN0ZGVychgGIAEoCBILCgNUdHkYByABKAgSEQoJT3BlblN0ZGluGAggASgIEhEKCVN0ZGluT25jZRgJIAEoCBITCgtBcmdzRXNjYXBlZBgKIAEoCBINCgVJbWFnZRgLIAEoCRILCgNFbnYYDCADKAkSCwoDQ21kGA0gAygJEg8KB1ZvbHVtZXMYDiADKAkSEgoKV29ya2luZ0RpchgPIAEoCRISCgpFbnRyeXBvaW50GBAgAygJEjYKBkxhYmVscxgRIAMoCzImLmRvY2tlci52MS5Db250YWluZXJDb25maWcuTGFiZWxzRW50cnkSFAoMRXhwb3NlZFBvcnRzGBIgAygJGi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiewoOQ29udGFpbmVyTW91bnQSDAoEVHlwZRgBIAEoCRIMCgROYW1lGAIgASgJEg4KBlNvdXJjZRgDIAEoCRITCgtEZXN0aW5hdGlvbhgEIAEoCRIOCgZEcml2ZXIYBSABKAkSDAoETW9kZRgGIAEoCRIKCgJSVxgHIAEoCCIWChRDb250YWluZXJMaXN0UmVxdWVzdCIqChVOZXR3b3JrSW5zcGVjdFJlcXVlc3QSEQoJbmV0d29ya0lkGAEgASgJIkgKFk5ldHdvcmtJbnNwZWN0UmVzcG9uc2USLgoHaW5zcGVjdBgBIAEoCzIdLmRvY2tlci52MS5OZXR3b3JrSW5zcGVjdEluZm8ibAoSTmV0d29ya0luc3BlY3RJbmZvEh8KA25ldBgBIAEoCzISLmRvY2tlci52MS5OZXR3b3JrEjUKCWNvbnRhaW5lchgCIAMoCzIiLmRvY2tlci52MS5OZXR3b3JrQ29udGFpbmVySW5zcGVjdCJiChdOZXR3b3JrQ29udGFpbmVySW5zcGVjdBIMCgROYW1lGAEgASgJEhAKCEVuZHBvaW50GAIgASgJEgwKBElQdjQYAyABKAkSDAoESVB2NhgEIAEoCRILCgNNYWMYBSABKAkiJgoTSW1hZ2VJbnNwZWN0UmVxdWVzdBIPCgdpbWFnZUlkGAEgASgJIkAKFEltYWdlSW5zcGVjdFJlc3BvbnNlEigKB2luc3BlY3QYASABKAsyFy5kb2NrZXIudjEuSW1hZ2VJbnNwZWN0In8KDEltYWdlSW5zcGVjdBIMCgRuYW1lGAEgASgJEgoKAmlkGAYgASgJEgwKBHNpemUYAyABKAkSDAoEYXJjaBgFIAEoCRISCgpjcmVhdGVkSXNvGAQgASgJEiUKBmxheWVycxgCIAMoCzIVLmRvY2tlci52MS5JbWFnZUxheWVyIlIKCkltYWdlTGF5ZXISDwoHTGF5ZXJJZBgDIAEoCRILCgNjbWQYASABKAkSDAoEc2l6ZRgCIAEoCRIYChB0b3RhbFNpemVBdExheWVyGAQgASgJIicKF0NvbXBvc2VWYWxpZGF0ZVJlc3BvbnNlEgwKBGVycnMYASADKAkiPQoVQ29udGFpbmVyRXhlY0NtZElucHV0Eg8KB3VzZXJDbWQYASABKAkSEwoLY29udGFpbmVySUQYAiABKAkiPAoUQ29udGFpbmVyRXhlY1JlcXVlc3QSEwoLY29udGFpbmVySUQYASABKAkSDwoHZXhlY0NtZBgCIAMoCSK2AgoFSW1hZ2USEgoKY29udGFpbmVycxgBIAEoAxIPCgdjcmVhdGVkGAIgASgDEgoKAmlkGAMgASgJEiwKBmxhYmVscxgEIAMoCzIcLmRvY2tlci52MS5JbWFnZS5MYWJlbHNFbnRyeRIRCglwYXJlbnRfaWQYBSABKAkSLQoJbWFuaWZlc3RzGAcgAygLMhouZG9ja2VyLnYxLk1hbmlmZXN0U3VtbWFyeRIUCgxyZXBvX2RpZ2VzdHMYCCADKAkSEQoJcmVwb190YWdzGAkgAygJEhMKC3NoYXJlZF9zaXplGAogASgDEgwKBHNpemUYCyABKAMSEQoJdXBkYXRlUmVmGAwgASgJGi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiQwoPTWFuaWZlc3RTdW1tYXJ5Eg4KBmRpZ2VzdBgBIAEoCRISCgptZWRpYV90eXBlGAIgASgJEgwKBHNpemUYAyABKAMiEwoRTGlzdEltYWdlc1JlcXVlc3QihAEKEkxpc3RJbWFnZXNSZXNwb25zZRIWCg50b3RhbERpc2tVc2FnZRgBIAEoAxIYChB1bnVzZWRJbWFnZUNvdW50GAIgASgDEhoKEnVudGFnZ2VkSW1hZ2VDb3VudBgDIAEoAxIgCgZpbWFnZXMYBCADKAsyEC5kb2NrZXIudjEuSW1hZ2UiNAoSUmVtb3ZlSW1hZ2VSZXF1ZXN0EgwKBGhvc3QYAiABKAkSEAoIaW1hZ2VJZHMYASADKAkiFQoTUmVtb3ZlSW1hZ2VSZXNwb25zZSJXChJJbWFnZVBydW5lUmVzcG9uc2USFgoOU3BhY2VSZWNsYWltZWQYASABKAQSKQoHZGVsZXRlZBgCIAMoCzIYLmRvY2tlci52MS5JbWFnZXNEZWxldGVkIjMKEUltYWdlUHJ1bmVSZXF1ZXN0EgwKBGhvc3QYAiABKAkSEAoIcHJ1bmVBbGwYASABKAgiMgoNSW1hZ2VzRGVsZXRlZBIPCgdEZWxldGVkGAEgASgJEhAKCFVudGFnZ2VkGAIgASgJIqEBCgZWb2x1bWUSDAoEbmFtZRgBIAEoCRITCgtjb250YWluZXJJRBgCIAEoCRIRCgljcmVhdGVkQXQYAyABKAkSEgoKbW91bnRQb2ludBgEIAEoCRIMCgRzaXplGAUgASgDEg4KBmxhYmVscxgGIAEoCRITCgtjb21wb3NlUGF0aBgHIAEoCRIaChJjb21wb3NlUHJvamVjdE5hbWUYCCABKAkiFAoSTGlzdFZvbHVtZXNSZXF1ZXN0IjkKE0xpc3RWb2x1bWVzUmVzcG9uc2USIgoHdm9sdW1lcxgBIAMoCzIRLmRvY2tlci52MS5Wb2x1bWUiFQoTQ3JlYXRlVm9sdW1lUmVxdWVzdCIWChRDcmVhdGVWb2x1bWVSZXNwb25zZSJUChNEZWxldGVWb2x1bWVSZXF1ZXN0EgwKBGhvc3QYBCABKAkSEQoJdm9sdW1lSWRzGAEgAygJEgwKBGFub24YAiABKAgSDgoGdW51c2VkGAMgASgIIhYKFERlbGV0ZVZvbHVtZVJlc3BvbnNlIuMBCgdOZXR3b3JrEgwKBG5hbWUYASABKAkSCgoCaWQYAiABKAkSDgoGc3VibmV0GAMgASgJEg0KBXNjb3BlGAQgASgJEg4KBmRyaXZlchgFIAEoCRITCgtlbmFibGVfaXB2NBgGIAEoCBITCgtlbmFibGVfaXB2NhgHIAEoCBIQCghpbnRlcm5hbBgJIAEoCBISCgphdHRhY2hhYmxlGAogASgIEhEKCWNyZWF0ZWRBdBgLIAEoCRIWCg5jb21wb3NlUHJvamVjdBgMIAEoCRIUCgxjb250YWluZXJJZHMYDSADKAkiFQoTTGlzdE5ldHdvcmtzUmVxdWVzdCI8ChRMaXN0TmV0d29ya3NSZXNwb25zZRIkCghuZXR3b3JrcxgBIAMoCzISLmRvY2tlci52MS5OZXR3b3JrIhYKFENyZWF0ZU5ldHdvcmtSZXF1ZXN0IhcKFUNyZWF0ZU5ldHdvcmtSZXNwb25zZSI5ChREZWxldGVOZXR3b3JrUmVxdWVzdBISCgpuZXR3b3JrSWRzGAMgAygJEg0KBXBydW5lGAIgASgIIhcKFURlbGV0ZU5ldHdvcmtSZXNwb25zZSIrChRDb250YWluZXJMb2dzUmVxdWVzdBITCgtjb250YWluZXJJRBgBIAEoCSIeCgtMb2dzTWVzc2FnZRIPCgdtZXNzYWdlGAEgASgJImUKDVN0YXRzUmVzcG9uc2USJQoGc3lzdGVtGAEgASgLMhUuZG9ja2VyLnYxLlN5c3RlbUluZm8SLQoKY29udGFpbmVycxgCIAMoCzIZLmRvY2tlci52MS5Db250YWluZXJTdGF0cyKKAQoMU3RhdHNSZXF1ZXN0EgwKBGhvc3QYBCABKAkSJAoEZmlsZRgBIAEoCzIWLmRvY2tlci52MS5Db21wb3NlRmlsZRIlCgZzb3J0QnkYAiABKA4yFS5kb2NrZXIudjEuU09SVF9GSUVMRBIfCgVvcmRlchgDIAEoDjIQLmRvY2tlci52MS5PUkRFUiItCgpTeXN0ZW1JbmZvEgsKA0NQVRgBIAEoARISCgptZW1JbkJ5dGVzGAIgASgEIqkBCgxMaXN0UmVzcG9uc2USPQoLc3RhdHVzQ291bnQYASADKAsyKC5kb2NrZXIudjEuTGlzdFJlc3BvbnNlLlN0YXR1c0NvdW50RW50cnkSJgoEbGlzdBgCIAMoCzIYLmRvY2tlci52MS5Db250YWluZXJMaXN0GjIKEFN0YXR1c0NvdW50RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ASKGAgoNQ29udGFpbmVyTGlzdBIKCgJpZBgBIAEoCRIPCgdpbWFnZUlEGAIgASgJEhEKCWltYWdlTmFtZRgDIAEoCRINCgVzdGF0ZRgEIAEoCRIOCgZoZWFsdGgYDSABKAkSDAoEbmFtZRgFIAEoCRIPCgdjcmVhdGVkGAYgASgJEh4KBXBvcnRzGAcgAygLMg8uZG9ja2VyLnYxLlBvcnQSEwoLc2VydmljZU5hbWUYCCABKAkSEwoLc2VydmljZVBhdGgYCSABKAkSEQoJc3RhY2tOYW1lGAogASgJEhcKD3VwZGF0ZUF2YWlsYWJsZRgLIAEoCRIRCglJUEFkZHJlc3MYDCADKAkiugEKDkNvbnRhaW5lclN0YXRzEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEQoJY3B1X3VzYWdlGAMgASgBEhQKDG1lbW9yeV91c2FnZRgEIAEoBBIUCgxtZW1vcnlfbGltaXQYBSABKAQSEgoKbmV0d29ya19yeBgGIAEoBBISCgpuZXR3b3JrX3R4GAcgASgEEhIKCmJsb2NrX3JlYWQYCCABKAQSEwoLYmxvY2tfd3JpdGUYCSABKAQiQwoEUG9ydBIOCgZwdWJsaWMYASABKAUSDwoHcHJpdmF0ZRgCIAEoBRIMCgRob3N0GAMgASgJEgwKBHR5cGUYBCABKAkiBwoFRW1wdHkiKAoQQ29udGFpbmVyUmVxdWVzdBIUCgxjb250YWluZXJJZHMYASADKAkiOQoLQ29tcG9zZUZpbGUSEAoIZmlsZW5hbWUYASABKAkSGAoQc2VsZWN0ZWRTZXJ2aWNlcxgDIAMoCSpgCgpTT1JUX0ZJRUxEEggKBE5BTUUQABIHCgNDUFUQARIHCgNNRU0QAhIOCgpORVRXT1JLX1JYEAMSDgoKTkVUV09SS19UWBAEEgoKBkRJU0tfUhAFEgoKBkRJU0tfVxAGKhkKBU9SREVSEgcKA0RTQxAAEgcKA0FTQxABMqISCg1Eb2NrZXJTZXJ2aWNlEkcKDkNvbnRhaW5lclN0YXJ0EhsuZG9ja2VyLnYxLkNvbnRhaW5lclJlcXVlc3QaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiABJGCg1Db250YWluZXJTdG9wEhsuZG9ja2VyLnYxLkNvbnRhaW5lclJlcXVlc3QaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiABJICg9Db250YWluZXJSZW1vdmUSGy5kb2NrZXIudjEuQ29udGFpbmVyUmVxdWVzdBoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAEkkKEENvbnRhaW5lclJlc3RhcnQSGy5kb2NrZXIudjEuQ29udGFpbmVyUmVxdWVzdBoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAEkIKD0NvbnRhaW5lclVwZGF0ZRIbLmRvY2tlci52MS5Db250YWluZXJSZXF1ZXN0GhAuZG9ja2VyLnYxLkVtcHR5IgASUQoMQ29udGFpbmVyVG9wEh4uZG9ja2VyLnYxLkNvbnRhaW5lclRvcFJlcXVlc3QaHy5kb2NrZXIudjEuQ29udGFpbmVyVG9wUmVzcG9uc2UiABJLCg1Db250YWluZXJMaXN0Eh8uZG9ja2VyLnYxLkNvbnRhaW5lckxpc3RSZXF1ZXN0GhcuZG9ja2VyLnYxLkxpc3RSZXNwb25zZSIAEkUKDkNvbnRhaW5lclN0YXRzEhcuZG9ja2VyLnYxLlN0YXRzUmVxdWVzdBoYLmRvY2tlci52MS5TdGF0c1Jlc3BvbnNlIgASTAoNQ29udGFpbmVyTG9ncxIfLmRvY2tlci52MS5Db250YWluZXJMb2dzUmVxdWVzdBoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESWQoQQ29udGFpbmVySW5zcGVjdBIfLmRvY2tlci52MS5Db250YWluZXJMb2dzUmVxdWVzdBoiLmRvY2tlci52MS5Db250YWluZXJJbnNwZWN0TWVzc2FnZSIAEj8KCUNvbXBvc2VVcBIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESQQoLQ29tcG9zZURvd24SFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiADABEkIKDENvbXBvc2VTdGFydBIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESQQoLQ29tcG9zZVN0b3ASFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiADABEkQKDkNvbXBvc2VSZXN0YXJ0EhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlGhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgAwARJDCg1Db21wb3NlVXBkYXRlEhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlGhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgAwARJACgtDb21wb3NlTGlzdBIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoXLmRvY2tlci52MS5MaXN0UmVzcG9uc2UiABJPCg9Db21wb3NlVmFsaWRhdGUSFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaIi5kb2NrZXIudjEuQ29tcG9zZVZhbGlkYXRlUmVzcG9uc2UiABJgChFDb21wb3NlRmlsZVN0YXR1cxIjLmRvY2tlci52MS5Db21wb3NlRmlsZVN0YXR1c1JlcXVlc3QaJC5kb2NrZXIudjEuQ29tcG9zZUZpbGVTdGF0dXNSZXNwb25zZSIAEkoKCUltYWdlTGlzdBIcLmRvY2tlci52MS5MaXN0SW1hZ2VzUmVxdWVzdBodLmRvY2tlci52MS5MaXN0SW1hZ2VzUmVzcG9uc2UiABJOCgtJbWFnZVJlbW92ZRIdLmRvY2tlci52MS5SZW1vdmVJbWFnZVJlcXVlc3QaHi5kb2NrZXIudjEuUmVtb3ZlSW1hZ2VSZXNwb25zZSIAElEKEEltYWdlUHJ1bmVVbnVzZWQSHC5kb2NrZXIudjEuSW1hZ2VQcnVuZVJlcXVlc3QaHS5kb2NrZXIudjEuSW1hZ2VQcnVuZVJlc3BvbnNlIgASUQoMSW1hZ2VJbnNwZWN0Eh4uZG9ja2VyLnYxLkltYWdlSW5zcGVjdFJlcXVlc3QaHy5kb2NrZXIudjEuSW1hZ2VJbnNwZWN0UmVzcG9uc2UiABJNCgpWb2x1bWVMaXN0Eh0uZG9ja2VyLnYxLkxpc3RWb2x1bWVzUmVxdWVzdBoeLmRvY2tlci52MS5MaXN0Vm9sdW1lc1Jlc3BvbnNlIgASUQoMVm9sdW1lQ3JlYXRlEh4uZG9ja2VyLnYxLkNyZWF0ZVZvbHVtZVJlcXVlc3QaHy5kb2NrZXIudjEuQ3JlYXRlVm9sdW1lUmVzcG9uc2UiABJRCgxWb2x1bWVEZWxldGUSHi5kb2NrZXIudjEuRGVsZXRlVm9sdW1lUmVxdWVzdBofLmRvY2tlci52MS5EZWxldGVWb2x1bWVSZXNwb25zZSIAElAKC05ldHdvcmtMaXN0Eh4uZG9ja2VyLnYxLkxpc3ROZXR3b3Jrc1JlcXVlc3QaHy5kb2NrZXIudjEuTGlzdE5ldHdvcmtzUmVzcG9uc2UiABJUCg1OZXR3b3JrQ3JlYXRlEh8uZG9ja2VyLnYxLkNyZWF0ZU5ldHdvcmtSZXF1ZXN0GiAuZG9ja2VyLnYxLkNyZWF0ZU5ldHdvcmtSZXNwb25zZSIAElQKDU5ldHdvcmtEZWxldGUSHy5kb2NrZXIudjEuRGVsZXRlTmV0d29ya1JlcXVlc3QaIC5kb2NrZXIudjEuRGVsZXRlTmV0d29ya1Jlc3BvbnNlIgASVwoOTmV0d29ya0luc3BlY3QSIC5kb2NrZXIudjEuTmV0d29ya0luc3BlY3RSZXF1ZXN0GiEuZG9ja2VyLnYxLk5ldHdvcmtJbnNwZWN0UmVzcG9uc2UiAEKPAQoNY29tLmRvY2tlci52MUILRG9ja2VyUHJvdG9QAVosZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9kb2NrZXIvdjGiAgNEWFiqAglEb2NrZXIuVjHKAglEb2NrZXJcVjHiAhVEb2NrZXJcVjFcR1BCTWV0YWRhdGHqAgpEb2NrZXI6OlYxYgZwcm90bzM");
+  fileDesc("ChZkb2NrZXIvdjEvZG9ja2VyLnByb3RvEglkb2NrZXIudjEiKQoYQ29tcG9zZUZpbGVTdGF0dXNSZXF1ZXN0Eg0KBWZpbGVzGAEgAygJImYKBlN0YXR1cxISCgpzZXJ2aWNlc1VwGAEgASgFEhQKDHNlcnZpY2VzRG93bhgCIAEoBRIXCg9zZXJ2aWNlc0hlYWx0aHkYAyABKAUSGQoRc2VydmljZXNVbkhlYWx0aHkYBCABKAUinwEKGUNvbXBvc2VGaWxlU3RhdHVzUmVzcG9uc2USQAoGc3RhdHVzGAEgAygLMjAuZG9ja2VyLnYxLkNvbXBvc2VGaWxlU3RhdHVzUmVzcG9uc2UuU3RhdHVzRW50cnkaQAoLU3RhdHVzRW50cnkSCwoDa2V5GAEgASgJEiAKBXZhbHVlGAIgASgLMhEuZG9ja2VyLnYxLlN0YXR1czoCOAEiKgoTQ29udGFpbmVyVG9wUmVxdWVzdBITCgtjb250YWluZXJJZBgBIAEoCSIzChRDb250YWluZXJUb3BSZXNwb25zZRIbCgN0b3AYASABKAsyDi5kb2NrZXIudjEuVG9wIhwKB1Byb2Nlc3MSEQoJUHJvY2Vzc2VzGAEgAygJIjcKA1RvcBIgCgRwcm9jGAEgAygLMhIuZG9ja2VyLnYxLlByb2Nlc3MSDgoGVGl0bGVzGAIgAygJIssBChdDb250YWluZXJJbnNwZWN0TWVzc2FnZRIMCgROYW1lGAEgASgJEgoKAklEGAIgASgJEgwKBFBhdGgYAyABKAkSDwoHQ3JlYXRlZBgHIAEoCRINCgVJbWFnZRgEIAEoCRIRCglIb3N0c1BhdGgYBSABKAkSKQoGbW91bnRzGAYgAygLMhkuZG9ja2VyLnYxLkNvbnRhaW5lck1vdW50EioKBmNvbmZpZxgIIAEoCzIaLmRvY2tlci52MS5Db250YWluZXJDb25maWcirQMKD0NvbnRhaW5lckNvbmZpZxIQCghIb3N0bmFtZRgBIAEoCRISCgpEb21haW5uYW1lGAIgASgJEgwKBFVzZXIYAyABKAkSEwoLQXR0YWNoU3RkaW4YBCABKAgSFAoMQXR0YWNoU3Rkb3V0GAUgASgIEhQKDEF0dGFjaFN0ZGVychgGIAEoCBILCgNUdHkYByABKAgSEQoJT3BlblN0ZGluGAggASgIEhEKCVN0ZGluT25jZRgJIAEoCBITCgtBcmdzRXNjYXBlZBgKIAEoCBINCgVJbWFnZRgLIAEoCRILCgNFbnYYDCADKAkSCwoDQ21kGA0gAygJEg8KB1ZvbHVtZXMYDiADKAkSEgoKV29ya2luZ0RpchgPIAEoCRISCgpFbnRyeXBvaW50GBAgAygJEjYKBkxhYmVscxgRIAMoCzImLmRvY2tlci52MS5Db250YWluZXJDb25maWcuTGFiZWxzRW50cnkSFAoMRXhwb3NlZFBvcnRzGBIgAygJGi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiewoOQ29udGFpbmVyTW91bnQSDAoEVHlwZRgBIAEoCRIMCgROYW1lGAIgASgJEg4KBlNvdXJjZRgDIAEoCRITCgtEZXN0aW5hdGlvbhgEIAEoCRIOCgZEcml2ZXIYBSABKAkSDAoETW9kZRgGIAEoCRIKCgJSVxgHIAEoCCIWChRDb250YWluZXJMaXN0UmVxdWVzdCIqChVOZXR3b3JrSW5zcGVjdFJlcXVlc3QSEQoJbmV0d29ya0lkGAEgASgJIkgKFk5ldHdvcmtJbnNwZWN0UmVzcG9uc2USLgoHaW5zcGVjdBgBIAEoCzIdLmRvY2tlci52MS5OZXR3b3JrSW5zcGVjdEluZm8ibAoSTmV0d29ya0luc3BlY3RJbmZvEh8KA25ldBgBIAEoCzISLmRvY2tlci52MS5OZXR3b3JrEjUKCWNvbnRhaW5lchgCIAMoCzIiLmRvY2tlci52MS5OZXR3b3JrQ29udGFpbmVySW5zcGVjdCJiChdOZXR3b3JrQ29udGFpbmVySW5zcGVjdBIMCgROYW1lGAEgASgJEhAKCEVuZHBvaW50GAIgASgJEgwKBElQdjQYAyABKAkSDAoESVB2NhgEIAEoCRILCgNNYWMYBSABKAkiJgoTSW1hZ2VJbnNwZWN0UmVxdWVzdBIPCgdpbWFnZUlkGAEgASgJIkAKFEltYWdlSW5zcGVjdFJlc3BvbnNlEigKB2luc3BlY3QYASABKAsyFy5kb2NrZXIudjEuSW1hZ2VJbnNwZWN0In8KDEltYWdlSW5zcGVjdBIMCgRuYW1lGAEgASgJEgoKAmlkGAYgASgJEgwKBHNpemUYAyABKAkSDAoEYXJjaBgFIAEoCRISCgpjcmVhdGVkSXNvGAQgASgJEiUKBmxheWVycxgCIAMoCzIVLmRvY2tlci52MS5JbWFnZUxheWVyIlIKCkltYWdlTGF5ZXISDwoHTGF5ZXJJZBgDIAEoCRILCgNjbWQYASABKAkSDAoEc2l6ZRgCIAEoCRIYChB0b3RhbFNpemVBdExheWVyGAQgASgJIloKF0NvbXBvc2VWYWxpZGF0ZVJlc3BvbnNlEgwKBGVycnMYASADKAkSMQoLZGlhZ25vc3RpY3MYAiADKAsyHC5kb2NrZXIudjEuQ29tcG9zZURpYWdub3N0aWMicAoRQ29tcG9zZURpYWdub3N0aWMSDAoEZmlsZRgBIAEoCRIMCgRsaW5lGAIgASgFEg4KBmNvbHVtbhgDIAEoBRIQCghzZXZlcml0eRgEIAEoCRIMCgRydWxlGAUgASgJEg8KB21lc3NhZ2UYBiABKAkiPQoVQ29udGFpbmVyRXhlY0NtZElucHV0Eg8KB3VzZXJDbWQYASABKAkSEwoLY29udGFpbmVySUQYAiABKAkiPAoUQ29udGFpbmVyRXhlY1JlcXVlc3QSEwoLY29udGFpbmVySUQYASABKAkSDwoHZXhlY0NtZBgCIAMoCSK2AgoFSW1hZ2USEgoKY29udGFpbmVycxgBIAEoAxIPCgdjcmVhdGVkGAIgASgDEgoKAmlkGAMgASgJEiwKBmxhYmVscxgEIAMoCzIcLmRvY2tlci52MS5JbWFnZS5MYWJlbHNFbnRyeRIRCglwYXJlbnRfaWQYBSABKAkSLQoJbWFuaWZlc3RzGAcgAygLMhouZG9ja2VyLnYxLk1hbmlmZXN0U3VtbWFyeRIUCgxyZXBvX2RpZ2VzdHMYCCADKAkSEQoJcmVwb190YWdzGAkgAygJEhMKC3NoYXJlZF9zaXplGAogASgDEgwKBHNpemUYCyABKAMSEQoJdXBkYXRlUmVmGAwgASgJGi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiQwoPTWFuaWZlc3RTdW1tYXJ5Eg4KBmRpZ2VzdBgBIAEoCRISCgptZWRpYV90eXBlGAIgASgJEgwKBHNpemUYAyABKAMiEwoRTGlzdEltYWdlc1JlcXVlc3QihAEKEkxpc3RJbWFnZXNSZXNwb25zZRIWCg50b3RhbERpc2tVc2FnZRgBIAEoAxIYChB1bnVzZWRJbWFnZUNvdW50GAIgASgDEhoKEnVudGFnZ2VkSW1hZ2VDb3VudBgDIAEoAxIgCgZpbWFnZXMYBCADKAsyEC5kb2NrZXIudjEuSW1hZ2UiNAoSUmVtb3ZlSW1hZ2VSZXF1ZXN0EgwKBGhvc3QYAiABKAkSEAoIaW1hZ2VJZHMYASADKAkiFQoTUmVtb3ZlSW1hZ2VSZXNwb25zZSJXChJJbWFnZVBydW5lUmVzcG9uc2USFgoOU3BhY2VSZWNsYWltZWQYASABKAQSKQoHZGVsZXRlZBgCIAMoCzIYLmRvY2tlci52MS5JbWFnZXNEZWxldGVkIjMKEUltYWdlUHJ1bmVSZXF1ZXN0EgwKBGhvc3QYAiABKAkSEAoIcHJ1bmVBbGwYASABKAgiMgoNSW1hZ2VzRGVsZXRlZBIPCgdEZWxldGVkGAEgASgJEhAKCFVudGFnZ2VkGAIgASgJIqEBCgZWb2x1bWUSDAoEbmFtZRgBIAEoCRITCgtjb250YWluZXJJRBgCIAEoCRIRCgljcmVhdGVkQXQYAyABKAkSEgoKbW91bnRQb2ludBgEIAEoCRIMCgRzaXplGAUgASgDEg4KBmxhYmVscxgGIAEoCRITCgtjb21wb3NlUGF0aBgHIAEoCRIaChJjb21wb3NlUHJvamVjdE5hbWUYCCABKAkiFAoSTGlzdFZvbHVtZXNSZXF1ZXN0IjkKE0xpc3RWb2x1bWVzUmVzcG9uc2USIgoHdm9sdW1lcxgBIAMoCzIRLmRvY2tlci52MS5Wb2x1bWUilQIKE0NyZWF0ZVZvbHVtZVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIOCgZkcml2ZXIYAiABKAkSQgoKZHJpdmVyT3B0cxgDIAMoCzIuLmRvY2tlci52MS5DcmVhdGVWb2x1bWVSZXF1ZXN0LkRyaXZlck9wdHNFbnRyeRI6CgZsYWJlbHMYBCADKAsyKi5kb2NrZXIudjEuQ3JlYXRlVm9sdW1lUmVxdWVzdC5MYWJlbHNFbnRyeRoxCg9Ecml2ZXJPcHRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARotCgtMYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjkKFENyZWF0ZVZvbHVtZVJlc3BvbnNlEiEKBnZvbHVtZRgBIAEoCzIRLmRvY2tlci52MS5Wb2x1bWUiVAoTRGVsZXRlVm9sdW1lUmVxdWVzdBIMCgRob3N0GAQgASgJEhEKCXZvbHVtZUlkcxgBIAMoCRIMCgRhbm9uGAIgASgIEg4KBnVudXNlZBgDIAEoCCIWChREZWxldGVWb2x1bWVSZXNwb25zZSI0ChJWb2x1bWVDbG9uZVJlcXVlc3QSDgoGc291cmNlGAEgASgJEg4KBnRhcmdldBgCIAEoCSIVChNWb2x1bWVDbG9uZVJlc3BvbnNlIl4KFFZvbHVtZU1pZ3JhdGVSZXF1ZXN0Eg4KBnNvdXJjZRgBIAEoCRIQCghkZXN0SG9zdBgCIAEoCRIOCgZ0YXJnZXQYAyABKAkSFAoMcmVtb3ZlU291cmNlGAQgASgIIhcKFVZvbHVtZU1pZ3JhdGVSZXNwb25zZSLjAQoHTmV0d29yaxIMCgRuYW1lGAEgASgJEgoKAmlkGAIgASgJEg4KBnN1Ym5ldBgDIAEoCRINCgVzY29wZRgEIAEoCRIOCgZkcml2ZXIYBSABKAkSEwoLZW5hYmxlX2lwdjQYBiABKAgSEwoLZW5hYmxlX2lwdjYYByABKAgSEAoIaW50ZXJuYWwYCSABKAgSEgoKYXR0YWNoYWJsZRgKIAEoCBIRCgljcmVhdGVkQXQYCyABKAkSFgoOY29tcG9zZVByb2plY3QYDCABKAkSFAoMY29udGFpbmVySWRzGA0gAygJIhUKE0xpc3ROZXR3b3Jrc1JlcXVlc3QiPAoUTGlzdE5ldHdvcmtzUmVzcG9uc2USJAoIbmV0d29ya3MYASADKAsyEi5kb2NrZXIudjEuTmV0d29yayL0AgoUQ3JlYXRlTmV0d29ya1JlcXVlc3QSDAoEbmFtZRgBIAEoCRIOCgZkcml2ZXIYAiABKAkSPQoHb3B0aW9ucxgDIAMoCzIsLmRvY2tlci52MS5DcmVhdGVOZXR3b3JrUmVxdWVzdC5PcHRpb25zRW50cnkSOwoGbGFiZWxzGAQgAygLMisuZG9ja2VyLnYxLkNyZWF0ZU5ldHdvcmtSZXF1ZXN0LkxhYmVsc0VudHJ5EikKB3N1Ym5ldHMYBSADKAsyGC5kb2NrZXIudjEuTmV0d29ya1N1Ym5ldBISCgplbmFibGVJcHY2GAYgASgIEhAKCGludGVybmFsGAcgASgIEhIKCmF0dGFjaGFibGUYCCABKAgaLgoMT3B0aW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaLQoLTGFiZWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJBCg1OZXR3b3JrU3VibmV0Eg4KBnN1Ym5ldBgBIAEoCRIPCgdnYXRld2F5GAIgASgJEg8KB2lwUmFuZ2UYAyABKAkiNQoVQ3JlYXRlTmV0d29ya1Jlc3BvbnNlEgoKAmlkGAEgASgJEhAKCHdhcm5pbmdzGAIgAygJImwKFU5ldHdvcmtDb25uZWN0UmVxdWVzdBIRCgluZXR3b3JrSWQYASABKAkSEwoLY29udGFpbmVySWQYAiABKAkSDwoHYWxpYXNlcxgDIAMoCRIMCgRpcHY0GAQgASgJEgwKBGlwdjYYBSABKAkiGAoWTmV0d29ya0Nvbm5lY3RSZXNwb25zZSJRChhOZXR3b3JrRGlzY29ubmVjdFJlcXVlc3QSEQoJbmV0d29ya0lkGAEgASgJEhMKC2NvbnRhaW5lcklkGAIgASgJEg0KBWZvcmNlGAMgASgIIhsKGU5ldHdvcmtEaXNjb25uZWN0UmVzcG9uc2UiOQoURGVsZXRlTmV0d29ya1JlcXVlc3QSEgoKbmV0d29ya0lkcxgDIAMoCRINCgVwcnVuZRgCIAEoCCIXChVEZWxldGVOZXR3b3JrUmVzcG9uc2UiKwoUQ29udGFpbmVyTG9nc1JlcXVlc3QSEwoLY29udGFpbmVySUQYASABKAkiLQoLTG9nc01lc3NhZ2USDwoHbWVzc2FnZRgBIAEoCRINCgVqb2JJZBgCIAEoCSJlCg1TdGF0c1Jlc3BvbnNlEiUKBnN5c3RlbRgBIAEoCzIVLmRvY2tlci52MS5TeXN0ZW1JbmZvEi0KCmNvbnRhaW5lcnMYAiADKAsyGS5kb2NrZXIudjEuQ29udGFpbmVyU3RhdHMiigEKDFN0YXRzUmVxdWVzdBIMCgRob3N0GAQgASgJEiQKBGZpbGUYASABKAsyFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUSJQoGc29ydEJ5GAIgASgOMhUuZG9ja2VyLnYxLlNPUlRfRklFTEQSHwoFb3JkZXIYAyABKA4yEC5kb2NrZXIudjEuT1JERVIiLQoKU3lzdGVtSW5mbxILCgNDUFUYASABKAESEgoKbWVtSW5CeXRlcxgCIAEoBCKpAQoMTGlzdFJlc3BvbnNlEj0KC3N0YXR1c0NvdW50GAEgAygLMiguZG9ja2VyLnYxLkxpc3RSZXNwb25zZS5TdGF0dXNDb3VudEVudHJ5EiYKBGxpc3QYAiADKAsyGC5kb2NrZXIudjEuQ29udGFpbmVyTGlzdBoyChBTdGF0dXNDb3VudEVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEihgIKDUNvbnRhaW5lckxpc3QSCgoCaWQYASABKAkSDwoHaW1hZ2VJRBgCIAEoCRIRCglpbWFnZU5hbWUYAyABKAkSDQoFc3RhdGUYBCABKAkSDgoGaGVhbHRoGA0gASgJEgwKBG5hbWUYBSABKAkSDwoHY3JlYXRlZBgGIAEoCRIeCgVwb3J0cxgHIAMoCzIPLmRvY2tlci52MS5Qb3J0EhMKC3NlcnZpY2VOYW1lGAggASgJEhMKC3NlcnZpY2VQYXRoGAkgASgJEhEKCXN0YWNrTmFtZRgKIAEoCRIXCg91cGRhdGVBdmFpbGFibGUYCyABKAkSEQoJSVBBZGRyZXNzGAwgAygJIroBCg5Db250YWluZXJTdGF0cxIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhEKCWNwdV91c2FnZRgDIAEoARIUCgxtZW1vcnlfdXNhZ2UYBCABKAQSFAoMbWVtb3J5X2xpbWl0GAUgASgEEhIKCm5ldHdvcmtfcngYBiABKAQSEgoKbmV0d29ya190eBgHIAEoBBISCgpibG9ja19yZWFkGAggASgEEhMKC2Jsb2NrX3dyaXRlGAkgASgEIkMKBFBvcnQSDgoGcHVibGljGAEgASgFEg8KB3ByaXZhdGUYAiABKAUSDAoEaG9zdBgDIAEoCRIMCgR0eXBlGAQgASgJIgcKBUVtcHR5IigKEENvbnRhaW5lclJlcXVlc3QSFAoMY29udGFpbmVySWRzGAEgAygJIjkKC0NvbXBvc2VGaWxlEhAKCGZpbGVuYW1lGAEgASgJEhgKEHNlbGVjdGVkU2VydmljZXMYAyADKAkieAoWQ29tcG9zZVJlc29sdmVSZXNwb25zZRIOCgZjb25maWcYASABKAkSLQoJdmFyaWFibGVzGAIgAygLMhouZG9ja2VyLnYxLkNvbXBvc2VWYXJpYWJsZRIQCgh3YXJuaW5ncxgDIAMoCRINCgVlcnJvchgEIAEoCSKHAQoPQ29tcG9zZVZhcmlhYmxlEgwKBG5hbWUYASABKAkSDQoFdmFsdWUYAiABKAkSDgoGc291cmNlGAMgASgJEhQKDGRlZmF1bHRWYWx1ZRgEIAEoCRIPCgdkZWZpbmVkGAUgASgIEhAKCHJlcXVpcmVkGAYgASgIEg4KBnNlY3JldBgHIAEoCCJdChJDb21wb3NlTWFueVJlcXVlc3QSDgoGZm9sZGVyGAEgASgJEgsKA3RhZxgCIAEoCRITCgtwYXJhbGxlbGlzbRgDIAEoBRIVCg1zdG9wT25GYWlsdXJlGAQgASgIIlQKE0NvbXBvc2VNYW55UHJvZ3Jlc3MSEAoIZmlsZW5hbWUYASABKAkSDQoFc3RhdGUYAiABKAkSDQoFam9iSWQYAyABKAkSDQoFZXJyb3IYBCABKAkiEAoOSm9iTGlzdFJlcXVlc3QiLwoPSm9iTGlzdFJlc3BvbnNlEhwKBGpvYnMYASADKAsyDi5kb2NrZXIudjEuSm9iInYKA0pvYhIKCgJpZBgBIAEoCRIQCghmaWxlbmFtZRgCIAEoCRIOCgZhY3Rpb24YAyABKAkSDgoGc3RhdHVzGAQgASgJEg0KBWVycm9yGAUgASgJEhEKCXN0YXJ0ZWRBdBgGIAEoAxIPCgdlbmRlZEF0GAcgASgDIjQKEEpvYkF0dGFjaFJlcXVlc3QSDQoFam9iSWQYASABKAkSEQoJZnJvbVN0YXJ0GAIgASgIIiEKEEpvYkNhbmNlbFJlcXVlc3QSDQoFam9iSWQYASABKAkiEwoRSm9iQ2FuY2VsUmVzcG9uc2UqYAoKU09SVF9GSUVMRBIICgROQU1FEAASBwoDQ1BVEAESBwoDTUVNEAISDgoKTkVUV09SS19SWBADEg4KCk5FVFdPUktfVFgQBBIKCgZESVNLX1IQBRIKCgZESVNLX1cQBioZCgVPUkRFUhIHCgNEU0MQABIHCgNBU0MQATL5GQoNRG9ja2VyU2VydmljZRJHCg5Db250YWluZXJTdGFydBIbLmRvY2tlci52MS5Db250YWluZXJSZXF1ZXN0GhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgASRgoNQ29udGFpbmVyU3RvcBIbLmRvY2tlci52MS5Db250YWluZXJSZXF1ZXN0GhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgASSAoPQ29udGFpbmVyUmVtb3ZlEhsuZG9ja2VyLnYxLkNvbnRhaW5lclJlcXVlc3QaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiABJJChBDb250YWluZXJSZXN0YXJ0EhsuZG9ja2VyLnYxLkNvbnRhaW5lclJlcXVlc3QaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiABJCCg9Db250YWluZXJVcGRhdGUSGy5kb2NrZXIudjEuQ29udGFpbmVyUmVxdWVzdBoQLmRvY2tlci52MS5FbXB0eSIAElEKDENvbnRhaW5lclRvcBIeLmRvY2tlci52MS5Db250YWluZXJUb3BSZXF1ZXN0Gh8uZG9ja2VyLnYxLkNvbnRhaW5lclRvcFJlc3BvbnNlIgASSwoNQ29udGFpbmVyTGlzdBIfLmRvY2tlci52MS5Db250YWluZXJMaXN0UmVxdWVzdBoXLmRvY2tlci52MS5MaXN0UmVzcG9uc2UiABJFCg5Db250YWluZXJTdGF0cxIXLmRvY2tlci52MS5TdGF0c1JlcXVlc3QaGC5kb2NrZXIudjEuU3RhdHNSZXNwb25zZSIAEkwKDUNvbnRhaW5lckxvZ3MSHy5kb2NrZXIudjEuQ29udGFpbmVyTG9nc1JlcXVlc3QaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiADABElkKEENvbnRhaW5lckluc3BlY3QSHy5kb2NrZXIudjEuQ29udGFpbmVyTG9nc1JlcXVlc3QaIi5kb2NrZXIudjEuQ29udGFpbmVySW5zcGVjdE1lc3NhZ2UiABI/CglDb21wb3NlVXASFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiADABEkEKC0NvbXBvc2VEb3duEhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlGhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgAwARJCCgxDb21wb3NlU3RhcnQSFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiADABEkEKC0NvbXBvc2VTdG9wEhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlGhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgAwARJECg5Db21wb3NlUmVzdGFydBIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESQwoNQ29tcG9zZVVwZGF0ZRIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESQAoLQ29tcG9zZUxpc3QSFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaFy5kb2NrZXIudjEuTGlzdFJlc3BvbnNlIgASTwoPQ29tcG9zZVZhbGlkYXRlEhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlGiIuZG9ja2VyLnYxLkNvbXBvc2VWYWxpZGF0ZVJlc3BvbnNlIgASTQoOQ29tcG9zZVJlc29sdmUSFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaIS5kb2NrZXIudjEuQ29tcG9zZVJlc29sdmVSZXNwb25zZSIAEmAKEUNvbXBvc2VGaWxlU3RhdHVzEiMuZG9ja2VyLnYxLkNvbXBvc2VGaWxlU3RhdHVzUmVxdWVzdBokLmRvY2tlci52MS5Db21wb3NlRmlsZVN0YXR1c1Jlc3BvbnNlIgASUgoNQ29tcG9zZVVwTWFueRIdLmRvY2tlci52MS5Db21wb3NlTWFueVJlcXVlc3QaHi5kb2NrZXIudjEuQ29tcG9zZU1hbnlQcm9ncmVzcyIAMAESVgoRQ29tcG9zZVVwZGF0ZU1hbnkSHS5kb2NrZXIudjEuQ29tcG9zZU1hbnlSZXF1ZXN0Gh4uZG9ja2VyLnYxLkNvbXBvc2VNYW55UHJvZ3Jlc3MiADABElQKD0NvbXBvc2VEb3duTWFueRIdLmRvY2tlci52MS5Db21wb3NlTWFueVJlcXVlc3QaHi5kb2NrZXIudjEuQ29tcG9zZU1hbnlQcm9ncmVzcyIAMAESQgoHSm9iTGlzdBIZLmRvY2tlci52MS5Kb2JMaXN0UmVxdWVzdBoaLmRvY2tlci52MS5Kb2JMaXN0UmVzcG9uc2UiABJECglKb2JBdHRhY2gSGy5kb2NrZXIudjEuSm9iQXR0YWNoUmVxdWVzdBoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESSAoJSm9iQ2FuY2VsEhsuZG9ja2VyLnYxLkpvYkNhbmNlbFJlcXVlc3QaHC5kb2NrZXIudjEuSm9iQ2FuY2VsUmVzcG9uc2UiABJKCglJbWFnZUxpc3QSHC5kb2NrZXIudjEuTGlzdEltYWdlc1JlcXVlc3QaHS5kb2NrZXIudjEuTGlzdEltYWdlc1Jlc3BvbnNlIgASTgoLSW1hZ2VSZW1vdmUSHS5kb2NrZXIudjEuUmVtb3ZlSW1hZ2VSZXF1ZXN0Gh4uZG9ja2VyLnYxLlJlbW92ZUltYWdlUmVzcG9uc2UiABJRChBJbWFnZVBydW5lVW51c2VkEhwuZG9ja2VyLnYxLkltYWdlUHJ1bmVSZXF1ZXN0Gh0uZG9ja2VyLnYxLkltYWdlUHJ1bmVSZXNwb25zZSIAElEKDEltYWdlSW5zcGVjdBIeLmRvY2tlci52MS5JbWFnZUluc3BlY3RSZXF1ZXN0Gh8uZG9ja2VyLnYxLkltYWdlSW5zcGVjdFJlc3BvbnNlIgASTQoKVm9sdW1lTGlzdBIdLmRvY2tlci52MS5MaXN0Vm9sdW1lc1JlcXVlc3QaHi5kb2NrZXIudjEuTGlzdFZvbHVtZXNSZXNwb25zZSIAElEKDFZvbHVtZUNyZWF0ZRIeLmRvY2tlci52MS5DcmVhdGVWb2x1bWVSZXF1ZXN0Gh8uZG9ja2VyLnYxLkNyZWF0ZVZvbHVtZVJlc3BvbnNlIgASUQoMVm9sdW1lRGVsZXRlEh4uZG9ja2VyLnYxLkRlbGV0ZVZvbHVtZVJlcXVlc3QaHy5kb2NrZXIudjEuRGVsZXRlVm9sdW1lUmVzcG9uc2UiABJOCgtWb2x1bWVDbG9uZRIdLmRvY2tlci52MS5Wb2x1bWVDbG9uZVJlcXVlc3QaHi5kb2NrZXIudjEuVm9sdW1lQ2xvbmVSZXNwb25zZSIAEk8KDFZvbHVtZVJlbmFtZRIdLmRvY2tlci52MS5Wb2x1bWVDbG9uZVJlcXVlc3QaHi5kb2NrZXIudjEuVm9sdW1lQ2xvbmVSZXNwb25zZSIAElQKDVZvbHVtZU1pZ3JhdGUSHy5kb2NrZXIudjEuVm9sdW1lTWlncmF0ZVJlcXVlc3QaIC5kb2NrZXIudjEuVm9sdW1lTWlncmF0ZVJlc3BvbnNlIgASUAoLTmV0d29ya0xpc3QSHi5kb2NrZXIudjEuTGlzdE5ldHdvcmtzUmVxdWVzdBofLmRvY2tlci52MS5MaXN0TmV0d29ya3NSZXNwb25zZSIAElQKDU5ldHdvcmtDcmVhdGUSHy5kb2NrZXIudjEuQ3JlYXRlTmV0d29ya1JlcXVlc3QaIC5kb2NrZXIudjEuQ3JlYXRlTmV0d29ya1Jlc3BvbnNlIgASVAoNTmV0d29ya0RlbGV0ZRIfLmRvY2tlci52MS5EZWxldGVOZXR3b3JrUmVxdWVzdBogLmRvY2tlci52MS5EZWxldGVOZXR3b3JrUmVzcG9uc2UiABJXCg5OZXR3b3JrSW5zcGVjdBIgLmRvY2tlci52MS5OZXR3b3JrSW5zcGVjdFJlcXVlc3QaIS5kb2NrZXIudjEuTmV0d29ya0luc3BlY3RSZXNwb25zZSIAElcKDk5ldHdvcmtDb25uZWN0EiAuZG9ja2VyLnYxLk5ldHdvcmtDb25uZWN0UmVxdWVzdBohLmRvY2tlci52MS5OZXR3b3JrQ29ubmVjdFJlc3BvbnNlIgASYAoRTmV0d29ya0Rpc2Nvbm5lY3QSIy5kb2NrZXIudjEuTmV0d29ya0Rpc2Nvbm5lY3RSZXF1ZXN0GiQuZG9ja2VyLnYxLk5ldHdvcmtEaXNjb25uZWN0UmVzcG9uc2UiAEKPAQoNY29tLmRvY2tlci52MUILRG9ja2VyUHJvdG9QAVosZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9kb2NrZXIvdjGiAgNEWFiqAglEb2NrZXIuVjHKAglEb2NrZXJcVjHiAhVEb2NrZXJcVjFcR1BCTWV0YWRhdGHqAgpEb2NrZXI6OlYxYgZwcm90bzM");
 
 /**
  * @generated from message docker.v1.ComposeFileStatusRequest
@@ -571,9 +571,16 @@ export const ImageLayerSchema: GenMessage<ImageLayer> = /*@__PURE__*/
  */
 export type ComposeValidateResponse = Message<"docker.v1.ComposeValidateResponse"> & {
   /**
+   * messages of all diagnostics, kept for older clients
+   *
    * @generated from field: repeated string errs = 1;
    */
   errs: string[];
+
+  /**
+   * @generated from field: repeated docker.v1.ComposeDiagnostic diagnostics = 2;
+   */
+  diagnostics: ComposeDiagnostic[];
 };
 
 /**
@@ -583,6 +590,52 @@ export type ComposeValidateResponse = Message<"docker.v1.ComposeValidateResponse
 export const ComposeValidateResponseSchema: GenMessage<ComposeValidateResponse> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 19);
 
+/**
+ * @generated from message docker.v1.ComposeDiagnostic
+ */
+export type ComposeDiagnostic = Message<"docker.v1.ComposeDiagnostic"> & {
+  /**
+   * @generated from field: string file = 1;
+   */
+  file: string;
+
+  /**
+   * 1-based, 0 if unknown
+   *
+   * @generated from field: int32 line = 2;
+   */
+  line: number;
+
+  /**
+   * @generated from field: int32 column = 3;
+   */
+  column: number;
+
+  /**
+   * error or warning
+   *
+   * @generated from field: string severity = 4;
+   */
+  severity: string;
+
+  /**
+   * @generated from field: string rule = 5;
+   */
+  rule: string;
+
+  /**
+   * @generated from field: string message = 6;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message docker.v1.ComposeDiagnostic.
+ * Use `create(ComposeDiagnosticSchema)` to create a new message.
+ */
+export const ComposeDiagnosticSchema: GenMessage<ComposeDiagnostic> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 20);
+
 /**
  * forwards commands from user to a running session
  *
@@ -605,7 +658,7 @@ export type ContainerExecCmdInput = Message<"docker.v1.ContainerExecCmdInput"> &
  * Use `create(ContainerExecCmdInputSchema)` to create a new message.
  */
 export const ContainerExecCmdInputSchema: GenMessage<ContainerExecCmdInput> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 20);
+  messageDesc(file_docker_v1_docker, 21);
 
 /**
  * @generated from message docker.v1.ContainerExecRequest
@@ -629,7 +682,7 @@ export type ContainerExecRequest = Message<"docker.v1.ContainerExecRequest"> & {
  * Use `create(ContainerExecRequestSchema)` to create a new message.
  */
 export const ContainerExecRequestSchema: GenMessage<ContainerExecRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 21);
+  messageDesc(file_docker_v1_docker, 22);
 
 /**
  * Image-related messages
@@ -698,7 +751,7 @@ export type Image = Message<"docker.v1.Image"> & {
  * Use `create(ImageSchema)` to create a new message.
  */
 export const ImageSchema: GenMessage<Image> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 22);
+  messageDesc(file_docker_v1_docker, 23);
 
 /**
  * @generated from message docker.v1.ManifestSummary
@@ -725,7 +778,7 @@ export type ManifestSummary = Message<"docker.v1.ManifestSummary"> & {
  * Use `create(ManifestSummarySchema)` to create a new message.
  */
 export const ManifestSummarySchema: GenMessage<ManifestSummary> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 23);
+  messageDesc(file_docker_v1_docker, 24);
 
 /**
  * @generated from message docker.v1.ListImagesRequest
@@ -738,7 +791,7 @@ export type ListImagesRequest = Message<"docker.v1.ListImagesRequest"> & {
  * Use `create(ListImagesRequestSchema)` to create a new message.
  */
 export const ListImagesRequestSchema: GenMessage<ListImagesRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 24);
+  messageDesc(file_docker_v1_docker, 25);
 
 /**
  * @generated from message docker.v1.ListImagesResponse
@@ -770,7 +823,7 @@ export type ListImagesResponse = Message<"docker.v1.ListImagesResponse"> & {
  * Use `create(ListImagesResponseSchema)` to create a new message.
  */
 export const ListImagesResponseSchema: GenMessage<ListImagesResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 25);
+  messageDesc(file_docker_v1_docker, 26);
 
 /**
  * @generated from message docker.v1.RemoveImageRequest
@@ -792,7 +845,7 @@ export type RemoveImageRequest = Message<"docker.v1.RemoveImageRequest"> & {
  * Use `create(RemoveImageRequestSchema)` to create a new message.
  */
 export const RemoveImageRequestSchema: GenMessage<RemoveImageRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 26);
+  messageDesc(file_docker_v1_docker, 27);
 
 /**
  * @generated from message docker.v1.RemoveImageResponse
@@ -805,7 +858,7 @@ export type RemoveImageResponse = Message<"docker.v1.RemoveImageResponse"> & {
  * Use `create(RemoveImageResponseSchema)` to create a new message.
  */
 export const RemoveImageResponseSchema: GenMessage<RemoveImageResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 27);
+  messageDesc(file_docker_v1_docker, 28);
 
 /**
  * @generated from message docker.v1.ImagePruneResponse
@@ -827,7 +880,7 @@ export type ImagePruneResponse = Message<"docker.v1.ImagePruneResponse"> & {
  * Use `create(ImagePruneResponseSchema)` to create a new message.
  */
 export const ImagePruneResponseSchema: GenMessage<ImagePruneResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 28);
+  messageDesc(file_docker_v1_docker, 29);
 
 /**
  * @generated from message docker.v1.ImagePruneRequest
@@ -849,7 +902,7 @@ export type ImagePruneRequest = Message<"docker.v1.ImagePruneRequest"> & {
  * Use `create(ImagePruneRequestSchema)` to create a new message.
  */
 export const ImagePruneRequestSchema: GenMessage<ImagePruneRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 29);
+  messageDesc(file_docker_v1_docker, 30);
 
 /**
  * @generated from message docker.v1.ImagesDeleted
@@ -871,7 +924,7 @@ export type ImagesDeleted = Message<"docker.v1.ImagesDeleted"> & {
  * Use `create(ImagesDeletedSchema)` to create a new message.
  */
 export const ImagesDeletedSchema: GenMessage<ImagesDeleted> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 30);
+  messageDesc(file_docker_v1_docker, 31);
 
 /**
  * Volume-related messages
@@ -925,7 +978,7 @@ export type Volume = Message<"docker.v1.Volume"> & {
  * Use `create(VolumeSchema)` to create a new message.
  */
 export const VolumeSchema: GenMessage<Volume> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 31);
+  messageDesc(file_docker_v1_docker, 32);
 
 /**
  * @generated from message docker.v1.ListVolumesRequest
@@ -938,7 +991,7 @@ export type ListVolumesRequest = Message<"docker.v1.ListVolumesRequest"> & {
  * Use `create(ListVolumesRequestSchema)` to create a new message.
  */
 export const ListVolumesRequestSchema: GenMessage<ListVolumesRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 32);
+  messageDesc(file_docker_v1_docker, 33);
 
 /**
  * @generated from message docker.v1.ListVolumesResponse
@@ -955,12 +1008,38 @@ export type ListVolumesResponse = Message<"docker.v1.ListVolumesResponse"> & {
  * Use `create(ListVolumesResponseSchema)` to create a new message.
  */
 export const ListVolumesResponseSchema: GenMessage<ListVolumesResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 33);
+  messageDesc(file_docker_v1_docker, 34);
 
 /**
  * @generated from message docker.v1.CreateVolumeRequest
  */
 export type CreateVolumeRequest = Message<"docker.v1.CreateVolumeRequest"> & {
+  /**
+   * generated by docker if empty
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * local if empty
+   *
+   * @generated from field: string driver = 2;
+   */
+  driver: string;
+
+  /**
+   * e.g. type=nfs, o=addr=10.0.0.2,rw and device=:/export
+   * for an nfs share with the local driver
+   *
+   * @generated from field: map<string, string> driverOpts = 3;
+   */
+  driverOpts: { [key: string]: string };
+
+  /**
+   * @generated from field: map<string, string> labels = 4;
+   */
+  labels: { [key: string]: string };
 };
 
 /**
@@ -968,12 +1047,16 @@ export type CreateVolumeRequest = Message<"docker.v1.CreateVolumeRequest"> & {
  * Use `create(CreateVolumeRequestSchema)` to create a new message.
  */
 export const CreateVolumeRequestSchema: GenMessage<CreateVolumeRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 34);
+  messageDesc(file_docker_v1_docker, 35);
 
 /**
  * @generated from message docker.v1.CreateVolumeResponse
  */
 export type CreateVolumeResponse = Message<"docker.v1.CreateVolumeResponse"> & {
+  /**
+   * @generated from field: docker.v1.Volume volume = 1;
+   */
+  volume?: Volume;
 };
 
 /**
@@ -981,7 +1064,7 @@ export type CreateVolumeResponse = Message<"docker.v1.CreateVolumeResponse"> & {
  * Use `create(CreateVolumeResponseSchema)` to create a new message.
  */
 export const CreateVolumeResponseSchema: GenMessage<CreateVolumeResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 35);
+  messageDesc(file_docker_v1_docker, 36);
 
 /**
  * @generated from message docker.v1.DeleteVolumeRequest
@@ -1013,7 +1096,7 @@ export type DeleteVolumeRequest = Message<"docker.v1.DeleteVolumeRequest"> & {
  * Use `create(DeleteVolumeRequestSchema)` to create a new message.
  */
 export const DeleteVolumeRequestSchema: GenMessage<DeleteVolumeRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 36);
+  messageDesc(file_docker_v1_docker, 37);
 
 /**
  * @generated from message docker.v1.DeleteVolumeResponse
@@ -1026,7 +1109,93 @@ export type DeleteVolumeResponse = Message<"docker.v1.DeleteVolumeResponse"> & {
  * Use `create(DeleteVolumeResponseSchema)` to create a new message.
  */
 export const DeleteVolumeResponseSchema: GenMessage<DeleteVolumeResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 37);
+  messageDesc(file_docker_v1_docker, 38);
+
+/**
+ * @generated from message docker.v1.VolumeCloneRequest
+ */
+export type VolumeCloneRequest = Message<"docker.v1.VolumeCloneRequest"> & {
+  /**
+   * @generated from field: string source = 1;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string target = 2;
+   */
+  target: string;
+};
+
+/**
+ * Describes the message docker.v1.VolumeCloneRequest.
+ * Use `create(VolumeCloneRequestSchema)` to create a new message.
+ */
+export const VolumeCloneRequestSchema: GenMessage<VolumeCloneRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 39);
+
+/**
+ * @generated from message docker.v1.VolumeCloneResponse
+ */
+export type VolumeCloneResponse = Message<"docker.v1.VolumeCloneResponse"> & {
+};
+
+/**
+ * Describes the message docker.v1.VolumeCloneResponse.
+ * Use `create(VolumeCloneResponseSchema)` to create a new message.
+ */
+export const VolumeCloneResponseSchema: GenMessage<VolumeCloneResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 40);
+
+/**
+ * @generated from message docker.v1.VolumeMigrateRequest
+ */
+export type VolumeMigrateRequest = Message<"docker.v1.VolumeMigrateRequest"> & {
+  /**
+   * @generated from field: string source = 1;
+   */
+  source: string;
+
+  /**
+   * connected host the volume is copied to
+   *
+   * @generated from field: string destHost = 2;
+   */
+  destHost: string;
+
+  /**
+   * name on the destination, the source name if empty
+   *
+   * @generated from field: string target = 3;
+   */
+  target: string;
+
+  /**
+   * remove the source volume once copied
+   *
+   * @generated from field: bool removeSource = 4;
+   */
+  removeSource: boolean;
+};
+
+/**
+ * Describes the message docker.v1.VolumeMigrateRequest.
+ * Use `create(VolumeMigrateRequestSchema)` to create a new message.
+ */
+export const VolumeMigrateRequestSchema: GenMessage<VolumeMigrateRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 41);
+
+/**
+ * @generated from message docker.v1.VolumeMigrateResponse
+ */
+export type VolumeMigrateResponse = Message<"docker.v1.VolumeMigrateResponse"> & {
+};
+
+/**
+ * Describes the message docker.v1.VolumeMigrateResponse.
+ * Use `create(VolumeMigrateResponseSchema)` to create a new message.
+ */
+export const VolumeMigrateResponseSchema: GenMessage<VolumeMigrateResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 42);
 
 /**
  * Network-related messages
@@ -1100,7 +1269,7 @@ export type Network = Message<"docker.v1.Network"> & {
  * Use `create(NetworkSchema)` to create a new message.
  */
 export const NetworkSchema: GenMessage<Network> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 38);
+  messageDesc(file_docker_v1_docker, 43);
 
 /**
  * @generated from message docker.v1.ListNetworksRequest
@@ -1113,7 +1282,7 @@ export type ListNetworksRequest = Message<"docker.v1.ListNetworksRequest"> & {
  * Use `create(ListNetworksRequestSchema)` to create a new message.
  */
 export const ListNetworksRequestSchema: GenMessage<ListNetworksRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 39);
+  messageDesc(file_docker_v1_docker, 44);
 
 /**
  * @generated from message docker.v1.ListNetworksResponse
@@ -1130,12 +1299,59 @@ export type ListNetworksResponse = Message<"docker.v1.ListNetworksResponse"> & {
  * Use `create(ListNetworksResponseSchema)` to create a new message.
  */
 export const ListNetworksResponseSchema: GenMessage<ListNetworksResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 40);
+  messageDesc(file_docker_v1_docker, 45);
 
 /**
  * @generated from message docker.v1.CreateNetworkRequest
  */
 export type CreateNetworkRequest = Message<"docker.v1.CreateNetworkRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * bridge, macvlan, ipvlan, overlay or a plugin, bridge if empty
+   *
+   * @generated from field: string driver = 2;
+   */
+  driver: string;
+
+  /**
+   * driver options, e.g. parent=eth0 for macvlan and ipvlan
+   *
+   * @generated from field: map<string, string> options = 3;
+   */
+  options: { [key: string]: string };
+
+  /**
+   * @generated from field: map<string, string> labels = 4;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * docker picks a subnet if empty
+   *
+   * @generated from field: repeated docker.v1.NetworkSubnet subnets = 5;
+   */
+  subnets: NetworkSubnet[];
+
+  /**
+   * implied by an ipv6 subnet
+   *
+   * @generated from field: bool enableIpv6 = 6;
+   */
+  enableIpv6: boolean;
+
+  /**
+   * @generated from field: bool internal = 7;
+   */
+  internal: boolean;
+
+  /**
+   * @generated from field: bool attachable = 8;
+   */
+  attachable: boolean;
 };
 
 /**
@@ -1143,20 +1359,154 @@ export type CreateNetworkRequest = Message<"docker.v1.CreateNetworkRequest"> & {
  * Use `create(CreateNetworkRequestSchema)` to create a new message.
  */
 export const CreateNetworkRequestSchema: GenMessage<CreateNetworkRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 41);
+  messageDesc(file_docker_v1_docker, 46);
+
+/**
+ * @generated from message docker.v1.NetworkSubnet
+ */
+export type NetworkSubnet = Message<"docker.v1.NetworkSubnet"> & {
+  /**
+   * CIDR, e.g. 172.30.0.0/16
+   *
+   * @generated from field: string subnet = 1;
+   */
+  subnet: string;
+
+  /**
+   * @generated from field: string gateway = 2;
+   */
+  gateway: string;
+
+  /**
+   * CIDR within subnet containers are assigned addresses from
+   *
+   * @generated from field: string ipRange = 3;
+   */
+  ipRange: string;
+};
+
+/**
+ * Describes the message docker.v1.NetworkSubnet.
+ * Use `create(NetworkSubnetSchema)` to create a new message.
+ */
+export const NetworkSubnetSchema: GenMessage<NetworkSubnet> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 47);
+
+/**
+ * @generated from message docker.v1.CreateNetworkResponse
+ */
+export type CreateNetworkResponse = Message<"docker.v1.CreateNetworkResponse"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[];
+};
+
+/**
+ * Describes the message docker.v1.CreateNetworkResponse.
+ * Use `create(CreateNetworkResponseSchema)` to create a new message.
+ */
+export const CreateNetworkResponseSchema: GenMessage<CreateNetworkResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 48);
+
+/**
+ * @generated from message docker.v1.NetworkConnectRequest
+ */
+export type NetworkConnectRequest = Message<"docker.v1.NetworkConnectRequest"> & {
+  /**
+   * @generated from field: string networkId = 1;
+   */
+  networkId: string;
+
+  /**
+   * @generated from field: string containerId = 2;
+   */
+  containerId: string;
+
+  /**
+   * extra dns names of the container on the network
+   *
+   * @generated from field: repeated string aliases = 3;
+   */
+  aliases: string[];
+
+  /**
+   * static addresses, only on networks with a user defined subnet
+   *
+   * @generated from field: string ipv4 = 4;
+   */
+  ipv4: string;
+
+  /**
+   * @generated from field: string ipv6 = 5;
+   */
+  ipv6: string;
+};
+
+/**
+ * Describes the message docker.v1.NetworkConnectRequest.
+ * Use `create(NetworkConnectRequestSchema)` to create a new message.
+ */
+export const NetworkConnectRequestSchema: GenMessage<NetworkConnectRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 49);
+
+/**
+ * @generated from message docker.v1.NetworkConnectResponse
+ */
+export type NetworkConnectResponse = Message<"docker.v1.NetworkConnectResponse"> & {
+};
+
+/**
+ * Describes the message docker.v1.NetworkConnectResponse.
+ * Use `create(NetworkConnectResponseSchema)` to create a new message.
+ */
+export const NetworkConnectResponseSchema: GenMessage<NetworkConnectResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 50);
+
+/**
+ * @generated from message docker.v1.NetworkDisconnectRequest
+ */
+export type NetworkDisconnectRequest = Message<"docker.v1.NetworkDisconnectRequest"> & {
+  /**
+   * @generated from field: string networkId = 1;
+   */
+  networkId: string;
+
+  /**
+   * @generated from field: string containerId = 2;
+   */
+  containerId: string;
+
+  /**
+   * @generated from field: bool force = 3;
+   */
+  force: boolean;
+};
+
+/**
+ * Describes the message docker.v1.NetworkDisconnectRequest.
+ * Use `create(NetworkDisconnectRequestSchema)` to create a new message.
+ */
+export const NetworkDisconnectRequestSchema: GenMessage<NetworkDisconnectRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 51);
 
 /**
- * @generated from message docker.v1.CreateNetworkResponse
+ * @generated from message docker.v1.NetworkDisconnectResponse
  */
-export type CreateNetworkResponse = Message<"docker.v1.CreateNetworkResponse"> & {
+export type NetworkDisconnectResponse = Message<"docker.v1.NetworkDisconnectResponse"> & {
 };
 
 /**
- * Describes the message docker.v1.CreateNetworkResponse.
- * Use `create(CreateNetworkResponseSchema)` to create a new message.
+ * Describes the message docker.v1.NetworkDisconnectResponse.
+ * Use `create(NetworkDisconnectResponseSchema)` to create a new message.
  */
-export const CreateNetworkResponseSchema: GenMessage<CreateNetworkResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 42);
+export const NetworkDisconnectResponseSchema: GenMessage<NetworkDisconnectResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 52);
 
 /**
  * @generated from message docker.v1.DeleteNetworkRequest
@@ -1178,7 +1528,7 @@ export type DeleteNetworkRequest = Message<"docker.v1.DeleteNetworkRequest"> & {
  * Use `create(DeleteNetworkRequestSchema)` to create a new message.
  */
 export const DeleteNetworkRequestSchema: GenMessage<DeleteNetworkRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 43);
+  messageDesc(file_docker_v1_docker, 53);
 
 /**
  * @generated from message docker.v1.DeleteNetworkResponse
@@ -1191,7 +1541,7 @@ export type DeleteNetworkResponse = Message<"docker.v1.DeleteNetworkResponse"> &
  * Use `create(DeleteNetworkResponseSchema)` to create a new message.
  */
 export const DeleteNetworkResponseSchema: GenMessage<DeleteNetworkResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 44);
+  messageDesc(file_docker_v1_docker, 54);
 
 /**
  * @generated from message docker.v1.ContainerLogsRequest
@@ -1208,7 +1558,7 @@ export type ContainerLogsRequest = Message<"docker.v1.ContainerLogsRequest"> & {
  * Use `create(ContainerLogsRequestSchema)` to create a new message.
  */
 export const ContainerLogsRequestSchema: GenMessage<ContainerLogsRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 45);
+  messageDesc(file_docker_v1_docker, 55);
 
 /**
  * @generated from message docker.v1.LogsMessage
@@ -1218,6 +1568,14 @@ export type LogsMessage = Message<"docker.v1.LogsMessage"> & {
    * @generated from field: string message = 1;
    */
   message: string;
+
+  /**
+   * set on the first message of a compose operation,
+   * used to reattach to the job with JobAttach
+   *
+   * @generated from field: string jobId = 2;
+   */
+  jobId: string;
 };
 
 /**
@@ -1225,7 +1583,7 @@ export type LogsMessage = Message<"docker.v1.LogsMessage"> & {
  * Use `create(LogsMessageSchema)` to create a new message.
  */
 export const LogsMessageSchema: GenMessage<LogsMessage> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 46);
+  messageDesc(file_docker_v1_docker, 56);
 
 /**
  * @generated from message docker.v1.StatsResponse
@@ -1247,7 +1605,7 @@ export type StatsResponse = Message<"docker.v1.StatsResponse"> & {
  * Use `create(StatsResponseSchema)` to create a new message.
  */
 export const StatsResponseSchema: GenMessage<StatsResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 47);
+  messageDesc(file_docker_v1_docker, 57);
 
 /**
  * @generated from message docker.v1.StatsRequest
@@ -1279,7 +1637,7 @@ export type StatsRequest = Message<"docker.v1.StatsRequest"> & {
  * Use `create(StatsRequestSchema)` to create a new message.
  */
 export const StatsRequestSchema: GenMessage<StatsRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 48);
+  messageDesc(file_docker_v1_docker, 58);
 
 /**
  * @generated from message docker.v1.SystemInfo
@@ -1303,7 +1661,7 @@ export type SystemInfo = Message<"docker.v1.SystemInfo"> & {
  * Use `create(SystemInfoSchema)` to create a new message.
  */
 export const SystemInfoSchema: GenMessage<SystemInfo> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 49);
+  messageDesc(file_docker_v1_docker, 59);
 
 /**
  * @generated from message docker.v1.ListResponse
@@ -1325,7 +1683,7 @@ export type ListResponse = Message<"docker.v1.ListResponse"> & {
  * Use `create(ListResponseSchema)` to create a new message.
  */
 export const ListResponseSchema: GenMessage<ListResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 50);
+  messageDesc(file_docker_v1_docker, 60);
 
 /**
  * @generated from message docker.v1.ContainerList
@@ -1404,7 +1762,7 @@ export type ContainerList = Message<"docker.v1.ContainerList"> & {
  * Use `create(ContainerListSchema)` to create a new message.
  */
 export const ContainerListSchema: GenMessage<ContainerList> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 51);
+  messageDesc(file_docker_v1_docker, 61);
 
 /**
  * ContainerInfo holds metrics for a single Docker container.
@@ -1481,7 +1839,7 @@ export type ContainerStats = Message<"docker.v1.ContainerStats"> & {
  * Use `create(ContainerStatsSchema)` to create a new message.
  */
 export const ContainerStatsSchema: GenMessage<ContainerStats> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 52);
+  messageDesc(file_docker_v1_docker, 62);
 
 /**
  * @generated from message docker.v1.Port
@@ -1513,7 +1871,7 @@ export type Port = Message<"docker.v1.Port"> & {
  * Use `create(PortSchema)` to create a new message.
  */
 export const PortSchema: GenMessage<Port> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 53);
+  messageDesc(file_docker_v1_docker, 63);
 
 /**
  * @generated from message docker.v1.Empty
@@ -1526,7 +1884,7 @@ export type Empty = Message<"docker.v1.Empty"> & {
  * Use `create(EmptySchema)` to create a new message.
  */
 export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 54);
+  messageDesc(file_docker_v1_docker, 64);
 
 /**
  * @generated from message docker.v1.ContainerRequest
@@ -1543,7 +1901,7 @@ export type ContainerRequest = Message<"docker.v1.ContainerRequest"> & {
  * Use `create(ContainerRequestSchema)` to create a new message.
  */
 export const ContainerRequestSchema: GenMessage<ContainerRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 55);
+  messageDesc(file_docker_v1_docker, 65);
 
 /**
  * @generated from message docker.v1.ComposeFile
@@ -1565,7 +1923,305 @@ export type ComposeFile = Message<"docker.v1.ComposeFile"> & {
  * Use `create(ComposeFileSchema)` to create a new message.
  */
 export const ComposeFileSchema: GenMessage<ComposeFile> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 56);
+  messageDesc(file_docker_v1_docker, 66);
+
+/**
+ * @generated from message docker.v1.ComposeResolveResponse
+ */
+export type ComposeResolveResponse = Message<"docker.v1.ComposeResolveResponse"> & {
+  /**
+   * fully interpolated config, secret values are masked
+   *
+   * @generated from field: string config = 1;
+   */
+  config: string;
+
+  /**
+   * @generated from field: repeated docker.v1.ComposeVariable variables = 2;
+   */
+  variables: ComposeVariable[];
+
+  /**
+   * @generated from field: repeated string warnings = 3;
+   */
+  warnings: string[];
+
+  /**
+   * set if the config could not be resolved
+   *
+   * @generated from field: string error = 4;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message docker.v1.ComposeResolveResponse.
+ * Use `create(ComposeResolveResponseSchema)` to create a new message.
+ */
+export const ComposeResolveResponseSchema: GenMessage<ComposeResolveResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 67);
+
+/**
+ * @generated from message docker.v1.ComposeVariable
+ */
+export type ComposeVariable = Message<"docker.v1.ComposeVariable"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * masked if secret is set
+   *
+   * @generated from field: string value = 2;
+   */
+  value: string;
+
+  /**
+   * env file the value was loaded from
+   *
+   * @generated from field: string source = 3;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string defaultValue = 4;
+   */
+  defaultValue: string;
+
+  /**
+   * @generated from field: bool defined = 5;
+   */
+  defined: boolean;
+
+  /**
+   * @generated from field: bool required = 6;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: bool secret = 7;
+   */
+  secret: boolean;
+};
+
+/**
+ * Describes the message docker.v1.ComposeVariable.
+ * Use `create(ComposeVariableSchema)` to create a new message.
+ */
+export const ComposeVariableSchema: GenMessage<ComposeVariable> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 68);
+
+/**
+ * @generated from message docker.v1.ComposeManyRequest
+ */
+export type ComposeManyRequest = Message<"docker.v1.ComposeManyRequest"> & {
+  /**
+   * select all compose files under a folder e.g. compose/media
+   *
+   * @generated from field: string folder = 1;
+   */
+  folder: string;
+
+  /**
+   * select all stacks with this tag in dockman.yml
+   *
+   * @generated from field: string tag = 2;
+   */
+  tag: string;
+
+  /**
+   * max stacks running at the same time, defaults to 1
+   *
+   * @generated from field: int32 parallelism = 3;
+   */
+  parallelism: number;
+
+  /**
+   * @generated from field: bool stopOnFailure = 4;
+   */
+  stopOnFailure: boolean;
+};
+
+/**
+ * Describes the message docker.v1.ComposeManyRequest.
+ * Use `create(ComposeManyRequestSchema)` to create a new message.
+ */
+export const ComposeManyRequestSchema: GenMessage<ComposeManyRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 69);
+
+/**
+ * @generated from message docker.v1.ComposeManyProgress
+ */
+export type ComposeManyProgress = Message<"docker.v1.ComposeManyProgress"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * pending, running, succeeded, failed, skipped
+   *
+   * @generated from field: string state = 2;
+   */
+  state: string;
+
+  /**
+   * set once the stack is running
+   *
+   * @generated from field: string jobId = 3;
+   */
+  jobId: string;
+
+  /**
+   * @generated from field: string error = 4;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message docker.v1.ComposeManyProgress.
+ * Use `create(ComposeManyProgressSchema)` to create a new message.
+ */
+export const ComposeManyProgressSchema: GenMessage<ComposeManyProgress> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 70);
+
+/**
+ * @generated from message docker.v1.JobListRequest
+ */
+export type JobListRequest = Message<"docker.v1.JobListRequest"> & {
+};
+
+/**
+ * Describes the message docker.v1.JobListRequest.
+ * Use `create(JobListRequestSchema)` to create a new message.
+ */
+export const JobListRequestSchema: GenMessage<JobListRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 71);
+
+/**
+ * @generated from message docker.v1.JobListResponse
+ */
+export type JobListResponse = Message<"docker.v1.JobListResponse"> & {
+  /**
+   * @generated from field: repeated docker.v1.Job jobs = 1;
+   */
+  jobs: Job[];
+};
+
+/**
+ * Describes the message docker.v1.JobListResponse.
+ * Use `create(JobListResponseSchema)` to create a new message.
+ */
+export const JobListResponseSchema: GenMessage<JobListResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 72);
+
+/**
+ * @generated from message docker.v1.Job
+ */
+export type Job = Message<"docker.v1.Job"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string filename = 2;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: string action = 3;
+   */
+  action: string;
+
+  /**
+   * running, succeeded, failed, cancelled
+   *
+   * @generated from field: string status = 4;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string error = 5;
+   */
+  error: string;
+
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 startedAt = 6;
+   */
+  startedAt: bigint;
+
+  /**
+   * unix seconds, 0 while the job is running
+   *
+   * @generated from field: int64 endedAt = 7;
+   */
+  endedAt: bigint;
+};
+
+/**
+ * Describes the message docker.v1.Job.
+ * Use `create(JobSchema)` to create a new message.
+ */
+export const JobSchema: GenMessage<Job> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 73);
+
+/**
+ * @generated from message docker.v1.JobAttachRequest
+ */
+export type JobAttachRequest = Message<"docker.v1.JobAttachRequest"> & {
+  /**
+   * @generated from field: string jobId = 1;
+   */
+  jobId: string;
+
+  /**
+   * replay the full output instead of only the most recent
+   *
+   * @generated from field: bool fromStart = 2;
+   */
+  fromStart: boolean;
+};
+
+/**
+ * Describes the message docker.v1.JobAttachRequest.
+ * Use `create(JobAttachRequestSchema)` to create a new message.
+ */
+export const JobAttachRequestSchema: GenMessage<JobAttachRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 74);
+
+/**
+ * @generated from message docker.v1.JobCancelRequest
+ */
+export type JobCancelRequest = Message<"docker.v1.JobCancelRequest"> & {
+  /**
+   * @generated from field: string jobId = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message docker.v1.JobCancelRequest.
+ * Use `create(JobCancelRequestSchema)` to create a new message.
+ */
+export const JobCancelRequestSchema: GenMessage<JobCancelRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 75);
+
+/**
+ * @generated from message docker.v1.JobCancelResponse
+ */
+export type JobCancelResponse = Message<"docker.v1.JobCancelResponse"> & {
+};
+
+/**
+ * Describes the message docker.v1.JobCancelResponse.
+ * Use `create(JobCancelResponseSchema)` to create a new message.
+ */
+export const JobCancelResponseSchema: GenMessage<JobCancelResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 76);
 
 /**
  * @generated from enum docker.v1.SORT_FIELD
@@ -1788,6 +2444,14 @@ export const DockerService: GenService<{
     input: typeof ComposeFileSchema;
     output: typeof ComposeValidateResponseSchema;
   },
+  /**
+   * @generated from rpc docker.v1.DockerService.ComposeResolve
+   */
+  composeResolve: {
+    methodKind: "unary";
+    input: typeof ComposeFileSchema;
+    output: typeof ComposeResolveResponseSchema;
+  },
   /**
    * @generated from rpc docker.v1.DockerService.ComposeFileStatus
    */
@@ -1796,6 +2460,56 @@ export const DockerService: GenService<{
     input: typeof ComposeFileStatusRequestSchema;
     output: typeof ComposeFileStatusResponseSchema;
   },
+  /**
+   * @generated from rpc docker.v1.DockerService.ComposeUpMany
+   */
+  composeUpMany: {
+    methodKind: "server_streaming";
+    input: typeof ComposeManyRequestSchema;
+    output: typeof ComposeManyProgressSchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.ComposeUpdateMany
+   */
+  composeUpdateMany: {
+    methodKind: "server_streaming";
+    input: typeof ComposeManyRequestSchema;
+    output: typeof ComposeManyProgressSchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.ComposeDownMany
+   */
+  composeDownMany: {
+    methodKind: "server_streaming";
+    input: typeof ComposeManyRequestSchema;
+    output: typeof ComposeManyProgressSchema;
+  },
+  /**
+   * compose jobs
+   *
+   * @generated from rpc docker.v1.DockerService.JobList
+   */
+  jobList: {
+    methodKind: "unary";
+    input: typeof JobListRequestSchema;
+    output: typeof JobListResponseSchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.JobAttach
+   */
+  jobAttach: {
+    methodKind: "server_streaming";
+    input: typeof JobAttachRequestSchema;
+    output: typeof LogsMessageSchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.JobCancel
+   */
+  jobCancel: {
+    methodKind: "unary";
+    input: typeof JobCancelRequestSchema;
+    output: typeof JobCancelResponseSchema;
+  },
   /**
    * images
    *
@@ -1856,6 +2570,34 @@ export const DockerService: GenService<{
     input: typeof DeleteVolumeRequestSchema;
     output: typeof DeleteVolumeResponseSchema;
   },
+  /**
+   * @generated from rpc docker.v1.DockerService.VolumeClone
+   */
+  volumeClone: {
+    methodKind: "unary";
+    input: typeof VolumeCloneRequestSchema;
+    output: typeof VolumeCloneResponseSchema;
+  },
+  /**
+   * VolumeRename copies the volume to a new name and removes the old one
+   *
+   * @generated from rpc docker.v1.DockerService.VolumeRename
+   */
+  volumeRename: {
+    methodKind: "unary";
+    input: typeof VolumeCloneRequestSchema;
+    output: typeof VolumeCloneResponseSchema;
+  },
+  /**
+   * VolumeMigrate copies a volume to another connected host
+   *
+   * @generated from rpc docker.v1.DockerService.VolumeMigrate
+   */
+  volumeMigrate: {
+    methodKind: "unary";
+    input: typeof VolumeMigrateRequestSchema;
+    output: typeof VolumeMigrateResponseSchema;
+  },
   /**
    * networks
    *
@@ -1890,6 +2632,22 @@ export const DockerService: GenService<{
     input: typeof NetworkInspectRequestSchema;
     output: typeof NetworkInspectResponseSchema;
   },
+  /**
+   * @generated from rpc docker.v1.DockerService.NetworkConnect
+   */
+  networkConnect: {
+    methodKind: "unary";
+    input: typeof NetworkConnectRequestSchema;
+    output: typeof NetworkConnectResponseSchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.NetworkDisconnect
+   */
+  networkDisconnect: {
+    methodKind: "unary";
+    input: typeof NetworkDisconnectRequestSchema;
+    output: typeof NetworkDisconnectResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_docker_v1_docker, 0);
 
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file dockyaml/v1/dockyaml.proto.
  */
 export const file_dockyaml_v1_dockyaml: GenFile = /*@__PURE__*/
-  fileDesc("Chpkb2NreWFtbC92MS9kb2NreWFtbC5wcm90bxILZG9ja3lhbWwudjEiHwoLU2F2ZVJlcXVlc3QSEAoIY29udGVudHMYAiABKAwiDgoMU2F2ZVJlc3BvbnNlIgwKCkdldFJlcXVlc3QiHwoLR2V0UmVzcG9uc2USEAoIY29udGVudHMYASABKAwiEAoOR2V0WWFtbFJlcXVlc3QiOQoPR2V0WWFtbFJlc3BvbnNlEiYKBGRvY2sYASABKAsyGC5kb2NreWFtbC52MS5Eb2NrbWFuWWFtbCKrAwoLRG9ja21hbllhbWwSPgoLY3VzdG9tVG9vbHMYCSADKAsyKS5kb2NreWFtbC52MS5Eb2NrbWFuWWFtbC5DdXN0b21Ub29sc0VudHJ5EhkKEXVzZUNvbXBvc2VGb2xkZXJzGAEgASgIEiIKGmRpc2FibGVDb21wb3NlUXVpY2tBY3Rpb25zGAcgASgIEhMKC3NlYXJjaExpbWl0GAggASgFEhAKCHRhYkxpbWl0GAYgASgFEi8KC3ZvbHVtZXNQYWdlGAIgASgLMhouZG9ja3lhbWwudjEuVm9sdW1lc0NvbmZpZxIvCgtuZXR3b3JrUGFnZRgDIAEoCzIaLmRvY2t5YW1sLnYxLk5ldHdvcmtDb25maWcSKwoJaW1hZ2VQYWdlGAQgASgLMhguZG9ja3lhbWwudjEuSW1hZ2VDb25maWcSMwoNY29udGFpbmVyUGFnZRgFIAEoCzIcLmRvY2t5YW1sLnYxLkNvbnRhaW5lckNvbmZpZxoyChBDdXN0b21Ub29sc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiMAoNVm9sdW1lc0NvbmZpZxIfCgRzb3J0GAEgASgLMhEuZG9ja3lhbWwudjEuU29ydCIwCg1OZXR3b3JrQ29uZmlnEh8KBHNvcnQYASABKAsyES5kb2NreWFtbC52MS5Tb3J0Ii4KC0ltYWdlQ29uZmlnEh8KBHNvcnQYASABKAsyES5kb2NreWFtbC52MS5Tb3J0IjIKD0NvbnRhaW5lckNvbmZpZxIfCgRzb3J0GAEgASgLMhEuZG9ja3lhbWwudjEuU29ydCIsCgRTb3J0EhEKCXNvcnRPcmRlchgBIAEoCRIRCglzb3J0RmllbGQYAiABKAky1AEKD0RvY2t5YW1sU2VydmljZRI6CgNHZXQSFy5kb2NreWFtbC52MS5HZXRSZXF1ZXN0GhguZG9ja3lhbWwudjEuR2V0UmVzcG9uc2UiABI9CgRTYXZlEhguZG9ja3lhbWwudjEuU2F2ZVJlcXVlc3QaGS5kb2NreWFtbC52MS5TYXZlUmVzcG9uc2UiABJGCgdHZXRZYW1sEhsuZG9ja3lhbWwudjEuR2V0WWFtbFJlcXVlc3QaHC5kb2NreWFtbC52MS5HZXRZYW1sUmVzcG9uc2UiAEKdAQoPY29tLmRvY2t5YW1sLnYxQg1Eb2NreWFtbFByb3RvUAFaLmdpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvZG9ja3lhbWwvdjGiAgNEWFiqAgtEb2NreWFtbC5WMcoCC0RvY2t5YW1sXFYx4gIXRG9ja3lhbWxcVjFcR1BCTWV0YWRhdGHqAgxEb2NreWFtbDo6VjFiBnByb3RvMw");
+  fileDesc("Chpkb2NreWFtbC92MS9kb2NreWFtbC5wcm90bxILZG9ja3lhbWwudjEiHwoLU2F2ZVJlcXVlc3QSEAoIY29udGVudHMYAiABKAwiDgoMU2F2ZVJlc3BvbnNlIgwKCkdldFJlcXVlc3QiHwoLR2V0UmVzcG9uc2USEAoIY29udGVudHMYASABKAwiEAoOR2V0WWFtbFJlcXVlc3QiOQoPR2V0WWFtbFJlc3BvbnNlEiYKBGRvY2sYASABKAsyGC5kb2NreWFtbC52MS5Eb2NrbWFuWWFtbCKqBAoLRG9ja21hbllhbWwSPgoLY3VzdG9tVG9vbHMYCSADKAsyKS5kb2NreWFtbC52MS5Eb2NrbWFuWWFtbC5DdXN0b21Ub29sc0VudHJ5EhkKEXVzZUNvbXBvc2VGb2xkZXJzGAEgASgIEiIKGmRpc2FibGVDb21wb3NlUXVpY2tBY3Rpb25zGAcgASgIEhMKC3NlYXJjaExpbWl0GAggASgFEhAKCHRhYkxpbWl0GAYgASgFEi8KC3ZvbHVtZXNQYWdlGAIgASgLMhouZG9ja3lhbWwudjEuVm9sdW1lc0NvbmZpZxIvCgtuZXR3b3JrUGFnZRgDIAEoCzIaLmRvY2t5YW1sLnYxLk5ldHdvcmtDb25maWcSKwoJaW1hZ2VQYWdlGAQgASgLMhguZG9ja3lhbWwudjEuSW1hZ2VDb25maWcSMwoNY29udGFpbmVyUGFnZRgFIAEoCzIcLmRvY2t5YW1sLnYxLkNvbnRhaW5lckNvbmZpZxI0CgZzdGFja3MYCiADKAsyJC5kb2NreWFtbC52MS5Eb2NrbWFuWWFtbC5TdGFja3NFbnRyeRoyChBDdXN0b21Ub29sc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaRwoLU3RhY2tzRW50cnkSCwoDa2V5GAEgASgJEicKBXZhbHVlGAIgASgLMhguZG9ja3lhbWwudjEuU3RhY2tDb25maWc6AjgBImQKC1N0YWNrQ29uZmlnEg0KBWZpbGVzGAEgAygJEhAKCHByb2ZpbGVzGAIgAygJEhMKC3Byb2plY3ROYW1lGAMgASgJEhEKCWRlcGVuZHNPbhgEIAMoCRIMCgR0YWdzGAUgAygJIjAKDVZvbHVtZXNDb25maWcSHwoEc29ydBgBIAEoCzIRLmRvY2t5YW1sLnYxLlNvcnQiMAoNTmV0d29ya0NvbmZpZxIfCgRzb3J0GAEgASgLMhEuZG9ja3lhbWwudjEuU29ydCIuCgtJbWFnZUNvbmZpZxIfCgRzb3J0GAEgASgLMhEuZG9ja3lhbWwudjEuU29ydCIyCg9Db250YWluZXJDb25maWcSHwoEc29ydBgBIAEoCzIRLmRvY2t5YW1sLnYxLlNvcnQiLAoEU29ydBIRCglzb3J0T3JkZXIYASABKAkSEQoJc29ydEZpZWxkGAIgASgJMtQBCg9Eb2NreWFtbFNlcnZpY2USOgoDR2V0EhcuZG9ja3lhbWwudjEuR2V0UmVxdWVzdBoYLmRvY2t5YW1sLnYxLkdldFJlc3BvbnNlIgASPQoEU2F2ZRIYLmRvY2t5YW1sLnYxLlNhdmVSZXF1ZXN0GhkuZG9ja3lhbWwudjEuU2F2ZVJlc3BvbnNlIgASRgoHR2V0WWFtbBIbLmRvY2t5YW1sLnYxLkdldFlhbWxSZXF1ZXN0GhwuZG9ja3lhbWwudjEuR2V0WWFtbFJlc3BvbnNlIgBCnQEKD2NvbS5kb2NreWFtbC52MUINRG9ja3lhbWxQcm90b1ABWi5naXRodWIuY29tL1JBMzQxL2RvY2ttYW4vZ2VuZXJhdGVkL2RvY2t5YW1sL3YxogIDRFhYqgILRG9ja3lhbWwuVjHKAgtEb2NreWFtbFxWMeICF0RvY2t5YW1sXFYxXEdQQk1ldGFkYXRh6gIMRG9ja3lhbWw6OlYxYgZwcm90bzM");
 
 /**
  * @generated from message dockyaml.v1.SaveRequest
@@ -150,6 +150,11 @@ export type DockmanYaml = Message<"dockyaml.v1.DockmanYaml"> & {
    * @generated from field: dockyaml.v1.ContainerConfig containerPage = 5;
    */
   containerPage?: ContainerConfig;
+
+  /**
+   * @generated from field: map<string, dockyaml.v1.StackConfig> stacks = 10;
+   */
+  stacks: { [key: string]: StackConfig };
 };
 
 /**
@@ -159,6 +164,43 @@ export type DockmanYaml = Message<"dockyaml.v1.DockmanYaml"> & {
 export const DockmanYamlSchema: GenMessage<DockmanYaml> = /*@__PURE__*/
   messageDesc(file_dockyaml_v1_dockyaml, 6);
 
+/**
+ * @generated from message dockyaml.v1.StackConfig
+ */
+export type StackConfig = Message<"dockyaml.v1.StackConfig"> & {
+  /**
+   * @generated from field: repeated string files = 1;
+   */
+  files: string[];
+
+  /**
+   * @generated from field: repeated string profiles = 2;
+   */
+  profiles: string[];
+
+  /**
+   * @generated from field: string projectName = 3;
+   */
+  projectName: string;
+
+  /**
+   * @generated from field: repeated string dependsOn = 4;
+   */
+  dependsOn: string[];
+
+  /**
+   * @generated from field: repeated string tags = 5;
+   */
+  tags: string[];
+};
+
+/**
+ * Describes the message dockyaml.v1.StackConfig.
+ * Use `create(StackConfigSchema)` to create a new message.
+ */
+export const StackConfigSchema: GenMessage<StackConfig> = /*@__PURE__*/
+  messageDesc(file_dockyaml_v1_dockyaml, 7);
+
 /**
  * @generated from message dockyaml.v1.VolumesConfig
  */
@@ -174,7 +216,7 @@ export type VolumesConfig = Message<"dockyaml.v1.VolumesConfig"> & {
  * Use `create(VolumesConfigSchema)` to create a new message.
  */
 export const VolumesConfigSchema: GenMessage<VolumesConfig> = /*@__PURE__*/
-  messageDesc(file_dockyaml_v1_dockyaml, 7);
+  messageDesc(file_dockyaml_v1_dockyaml, 8);
 
 /**
  * @generated from message dockyaml.v1.NetworkConfig
@@ -191,7 +233,7 @@ export type NetworkConfig = Message<"dockyaml.v1.NetworkConfig"> & {
  * Use `create(NetworkConfigSchema)` to create a new message.
  */
 export const NetworkConfigSchema: GenMessage<NetworkConfig> = /*@__PURE__*/
-  messageDesc(file_dockyaml_v1_dockyaml, 8);
+  messageDesc(file_dockyaml_v1_dockyaml, 9);
 
 /**
  * @generated from message dockyaml.v1.ImageConfig
@@ -208,7 +250,7 @@ export type ImageConfig = Message<"dockyaml.v1.ImageConfig"> & {
  * Use `create(ImageConfigSchema)` to create a new message.
  */
 export const ImageConfigSchema: GenMessage<ImageConfig> = /*@__PURE__*/
-  messageDesc(file_dockyaml_v1_dockyaml, 9);
+  messageDesc(file_dockyaml_v1_dockyaml, 10);
 
 /**
  * @generated from message dockyaml.v1.ContainerConfig
@@ -225,7 +267,7 @@ export type ContainerConfig = Message<"dockyaml.v1.ContainerConfig"> & {
  * Use `create(ContainerConfigSchema)` to create a new message.
  */
 export const ContainerConfigSchema: GenMessage<ContainerConfig> = /*@__PURE__*/
-  messageDesc(file_dockyaml_v1_dockyaml, 10);
+  messageDesc(file_dockyaml_v1_dockyaml, 11);
 
 /**
  * @generated from message dockyaml.v1.Sort
@@ -247,7 +289,7 @@ export type Sort = Message<"dockyaml.v1.Sort"> & {
  * Use `create(SortSchema)` to create a new message.
  */
 export const SortSchema: GenMessage<Sort> = /*@__PURE__*/
-  messageDesc(file_dockyaml_v1_dockyaml, 11);
+  messageDesc(file_dockyaml_v1_dockyaml, 12);
 
 /**
  * @generated from service dockyaml.v1.DockyamlService
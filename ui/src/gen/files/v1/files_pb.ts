@@ -2,15 +2,15 @@
 // @generated from file files/v1/files.proto (package files.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file files/v1/files.proto.
  */
 export const file_files_v1_files: GenFile = /*@__PURE__*/
-  fileDesc("ChRmaWxlcy92MS9maWxlcy5wcm90bxIIZmlsZXMudjEiQQoQV3JpdGVUbXBsUmVxdWVzdBILCgNkaXIYAiABKAkSIAoEdG1wbBgBIAEoCzISLmZpbGVzLnYxLlRlbXBsYXRlIhMKEVdyaXRlVG1wbFJlc3BvbnNlIiAKD0dldFRtcGxzUmVxdWVzdBINCgVhbGlhcxgBIAEoCSJxCghUZW1wbGF0ZRIMCgROYW1lGAEgASgJEioKBHZhcnMYAiADKAsyHC5maWxlcy52MS5UZW1wbGF0ZS5WYXJzRW50cnkaKwoJVmFyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiNgoQR2V0VG1wbHNSZXNwb25zZRIiCgZ0ZW1wbHMYASADKAsyEi5maWxlcy52MS5UZW1wbGF0ZSJLCgtDb3B5UmVxdWVzdBIeCgZzb3VyY2UYASABKAsyDi5maWxlcy52MS5GaWxlEhwKBGRlc3QYAiABKAsyDi5maWxlcy52MS5GaWxlIg4KDENvcHlSZXNwb25zZSIbCgtMaXN0UmVxdWVzdBIMCgRwYXRoGAEgASgJIjIKDExpc3RSZXNwb25zZRIiCgdlbnRyaWVzGAEgAygLMhEuZmlsZXMudjEuRnNFbnRyeSIhCg1Gb3JtYXRSZXF1ZXN0EhAKCGZpbGVuYW1lGAEgASgJIiIKDkZvcm1hdFJlc3BvbnNlEhAKCGNvbnRlbnRzGAEgASgJInsKB0ZzRW50cnkSEAoIZmlsZW5hbWUYAiABKAkSDQoFaXNEaXIYAyABKAgSIwoIc3ViRmlsZXMYBCADKAsyES5maWxlcy52MS5Gc0VudHJ5EhEKCWlzRmV0Y2hlZBgFIAEoCBIXCg9pc0NvbXBvc2VGb2xkZXIYBiABKAkiNgoKUmVuYW1lRmlsZRITCgtvbGRGaWxlUGF0aBgBIAEoCRITCgtuZXdGaWxlUGF0aBgCIAEoCSInCgRGaWxlEhAKCGZpbGVuYW1lGAEgASgJEg0KBWlzRGlyGAIgASgIIgcKBUVtcHR5MoUECgtGaWxlU2VydmljZRI3CgRMaXN0EhUuZmlsZXMudjEuTGlzdFJlcXVlc3QaFi5maWxlcy52MS5MaXN0UmVzcG9uc2UiABIrCgZDcmVhdGUSDi5maWxlcy52MS5GaWxlGg8uZmlsZXMudjEuRW1wdHkiABI3CgRDb3B5EhUuZmlsZXMudjEuQ29weVJlcXVlc3QaFi5maWxlcy52MS5Db3B5UmVzcG9uc2UiABIrCgZEZWxldGUSDi5maWxlcy52MS5GaWxlGg8uZmlsZXMudjEuRW1wdHkiABIrCgZFeGlzdHMSDi5maWxlcy52MS5GaWxlGg8uZmlsZXMudjEuRW1wdHkiABIxCgZSZW5hbWUSFC5maWxlcy52MS5SZW5hbWVGaWxlGg8uZmlsZXMudjEuRW1wdHkiABJDCghHZXRUbXBscxIZLmZpbGVzLnYxLkdldFRtcGxzUmVxdWVzdBoaLmZpbGVzLnYxLkdldFRtcGxzUmVzcG9uc2UiABJGCglXcml0ZVRtcGwSGi5maWxlcy52MS5Xcml0ZVRtcGxSZXF1ZXN0GhsuZmlsZXMudjEuV3JpdGVUbXBsUmVzcG9uc2UiABI9CgZGb3JtYXQSFy5maWxlcy52MS5Gb3JtYXRSZXF1ZXN0GhguZmlsZXMudjEuRm9ybWF0UmVzcG9uc2UiAEKIAQoMY29tLmZpbGVzLnYxQgpGaWxlc1Byb3RvUAFaK2dpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvZmlsZXMvdjGiAgNGWFiqAghGaWxlcy5WMcoCCEZpbGVzXFYx4gIURmlsZXNcVjFcR1BCTWV0YWRhdGHqAglGaWxlczo6VjFiBnByb3RvMw");
+  fileDesc("ChRmaWxlcy92MS9maWxlcy5wcm90bxIIZmlsZXMudjEiVAoQV3JpdGVUbXBsUmVxdWVzdBILCgNkaXIYAiABKAkSIAoEdG1wbBgBIAEoCzISLmZpbGVzLnYxLlRlbXBsYXRlEhEKCW92ZXJ3cml0ZRgDIAEoCCIiChFXcml0ZVRtcGxSZXNwb25zZRINCgVmaWxlcxgBIAMoCSJKChNQcmV2aWV3VG1wbFJlc3BvbnNlEiUKBWZpbGVzGAEgAygLMhYuZmlsZXMudjEuVGVtcGxhdGVGaWxlEgwKBGRpcnMYAiADKAkiUgoMVGVtcGxhdGVGaWxlEhAKCGZpbGVuYW1lGAEgASgJEhAKCGNvbnRlbnRzGAIgASgJEg4KBmV4aXN0cxgDIAEoCBIOCgZiaW5hcnkYBCABKAgiMQoPR2V0VG1wbHNSZXF1ZXN0Eg0KBWFsaWFzGAEgASgJEg8KB3JlZnJlc2gYAiABKAgi3AEKCFRlbXBsYXRlEgwKBE5hbWUYASABKAkSKgoEdmFycxgCIAMoCzIcLmZpbGVzLnYxLlRlbXBsYXRlLlZhcnNFbnRyeRIOCgZzb3VyY2UYAyABKAkSDQoFdGl0bGUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSJgoHdmFyRGVmcxgGIAMoCzIVLmZpbGVzLnYxLlRlbXBsYXRlVmFyEg0KBWZpbGVzGAcgAygJGisKCVZhcnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIqoBCgtUZW1wbGF0ZVZhchIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEgwKBHR5cGUYAyABKAkSFAoMZGVmYXVsdFZhbHVlGAQgASgJEhAKCHJlcXVpcmVkGAUgASgIEg8KB3BhdHRlcm4YBiABKAkSDwoHb3B0aW9ucxgHIAMoCRIOCgZzZWNyZXQYCCABKAgSEAoIZ2VuZXJhdGUYCSABKAgiNgoQR2V0VG1wbHNSZXNwb25zZRIiCgZ0ZW1wbHMYASADKAsyEi5maWxlcy52MS5UZW1wbGF0ZSJLCgtDb3B5UmVxdWVzdBIeCgZzb3VyY2UYASABKAsyDi5maWxlcy52MS5GaWxlEhwKBGRlc3QYAiABKAsyDi5maWxlcy52MS5GaWxlIg4KDENvcHlSZXNwb25zZSIbCgtMaXN0UmVxdWVzdBIMCgRwYXRoGAEgASgJIjIKDExpc3RSZXNwb25zZRIiCgdlbnRyaWVzGAEgAygLMhEuZmlsZXMudjEuRnNFbnRyeSIhCg1Gb3JtYXRSZXF1ZXN0EhAKCGZpbGVuYW1lGAEgASgJIiIKDkZvcm1hdFJlc3BvbnNlEhAKCGNvbnRlbnRzGAEgASgJInsKB0ZzRW50cnkSEAoIZmlsZW5hbWUYAiABKAkSDQoFaXNEaXIYAyABKAgSIwoIc3ViRmlsZXMYBCADKAsyES5maWxlcy52MS5Gc0VudHJ5EhEKCWlzRmV0Y2hlZBgFIAEoCBIXCg9pc0NvbXBvc2VGb2xkZXIYBiABKAkikQEKD1RyYW5zZmVyUmVxdWVzdBIOCgZzb3VyY2UYASABKAkSEgoKc291cmNlSG9zdBgCIAEoCRIMCgRkZXN0GAMgASgJEhAKCGRlc3RIb3N0GAQgASgJEgwKBG1vdmUYBSABKAgSLAoKb25Db25mbGljdBgGIAEoDjIYLmZpbGVzLnYxLkNvbmZsaWN0UG9saWN5IosBChBUcmFuc2ZlclByb2dyZXNzEgwKBGZpbGUYASABKAkSDAoEZGVzdBgCIAEoCRINCgVzdGF0ZRgDIAEoCRIRCglieXRlc0RvbmUYBCABKAMSEgoKYnl0ZXNUb3RhbBgFIAEoAxIRCglmaWxlc0RvbmUYBiABKAUSEgoKZmlsZXNUb3RhbBgHIAEoBSIwChFXYXRjaEZpbGVzUmVxdWVzdBIMCgRkaXJzGAEgAygJEg0KBWZpbGVzGAIgAygJIjkKEldhdGNoRmlsZXNSZXNwb25zZRIjCgZldmVudHMYASADKAsyEy5maWxlcy52MS5GaWxlRXZlbnQiegoJRmlsZUV2ZW50EgoKAm9wGAEgASgJEhAKCGZpbGVuYW1lGAIgASgJEhMKC29sZEZpbGVuYW1lGAMgASgJEg0KBWlzRGlyGAQgASgIEgwKBHNpemUYBSABKAMSDwoHbW9kVGltZRgGIAEoAxIMCgRoYXNoGAcgASgJIrkBChVTZWFyY2hDb250ZW50c1JlcXVlc3QSDAoEcm9vdBgBIAEoCRINCgVxdWVyeRgCIAEoCRINCgVyZWdleBgDIAEoCBIVCg1jYXNlU2Vuc2l0aXZlGAQgASgIEhEKCXdob2xlV29yZBgFIAEoCBIPCgdpbmNsdWRlGAYgAygJEg8KB2V4Y2x1ZGUYByADKAkSFAoMY29udGV4dExpbmVzGAggASgFEhIKCm1heE1hdGNoZXMYCSABKAUiYwoWU2VhcmNoQ29udGVudHNSZXNwb25zZRIQCghmaWxlbmFtZRgBIAEoCRIkCgdtYXRjaGVzGAIgAygLMhMuZmlsZXMudjEuTGluZU1hdGNoEhEKCXRydW5jYXRlZBgDIAEoCCJmCglMaW5lTWF0Y2gSDAoEbGluZRgBIAEoBRIOCgZjb2x1bW4YAiABKAUSDgoGbGVuZ3RoGAMgASgFEgwKBHRleHQYBCABKAkSDgoGYmVmb3JlGAUgAygJEg0KBWFmdGVyGAYgAygJIjYKClJlbmFtZUZpbGUSEwoLb2xkRmlsZVBhdGgYASABKAkSEwoLbmV3RmlsZVBhdGgYAiABKAkiJwoERmlsZRIQCghmaWxlbmFtZRgBIAEoCRINCgVpc0RpchgCIAEoCCIHCgVFbXB0eSo1Cg5Db25mbGljdFBvbGljeRIICgRTS0lQEAASDQoJT1ZFUldSSVRFEAESCgoGUkVOQU1FEAIyvgYKC0ZpbGVTZXJ2aWNlEjcKBExpc3QSFS5maWxlcy52MS5MaXN0UmVxdWVzdBoWLmZpbGVzLnYxLkxpc3RSZXNwb25zZSIAEisKBkNyZWF0ZRIOLmZpbGVzLnYxLkZpbGUaDy5maWxlcy52MS5FbXB0eSIAEjcKBENvcHkSFS5maWxlcy52MS5Db3B5UmVxdWVzdBoWLmZpbGVzLnYxLkNvcHlSZXNwb25zZSIAEisKBkRlbGV0ZRIOLmZpbGVzLnYxLkZpbGUaDy5maWxlcy52MS5FbXB0eSIAEisKBkV4aXN0cxIOLmZpbGVzLnYxLkZpbGUaDy5maWxlcy52MS5FbXB0eSIAEjEKBlJlbmFtZRIULmZpbGVzLnYxLlJlbmFtZUZpbGUaDy5maWxlcy52MS5FbXB0eSIAEkUKCFRyYW5zZmVyEhkuZmlsZXMudjEuVHJhbnNmZXJSZXF1ZXN0GhouZmlsZXMudjEuVHJhbnNmZXJQcm9ncmVzcyIAMAESSwoKV2F0Y2hGaWxlcxIbLmZpbGVzLnYxLldhdGNoRmlsZXNSZXF1ZXN0GhwuZmlsZXMudjEuV2F0Y2hGaWxlc1Jlc3BvbnNlIgAwARJXCg5TZWFyY2hDb250ZW50cxIfLmZpbGVzLnYxLlNlYXJjaENvbnRlbnRzUmVxdWVzdBogLmZpbGVzLnYxLlNlYXJjaENvbnRlbnRzUmVzcG9uc2UiADABEkMKCEdldFRtcGxzEhkuZmlsZXMudjEuR2V0VG1wbHNSZXF1ZXN0GhouZmlsZXMudjEuR2V0VG1wbHNSZXNwb25zZSIAEkYKCVdyaXRlVG1wbBIaLmZpbGVzLnYxLldyaXRlVG1wbFJlcXVlc3QaGy5maWxlcy52MS5Xcml0ZVRtcGxSZXNwb25zZSIAEkoKC1ByZXZpZXdUbXBsEhouZmlsZXMudjEuV3JpdGVUbXBsUmVxdWVzdBodLmZpbGVzLnYxLlByZXZpZXdUbXBsUmVzcG9uc2UiABI9CgZGb3JtYXQSFy5maWxlcy52MS5Gb3JtYXRSZXF1ZXN0GhguZmlsZXMudjEuRm9ybWF0UmVzcG9uc2UiAEKIAQoMY29tLmZpbGVzLnYxQgpGaWxlc1Byb3RvUAFaK2dpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvZmlsZXMvdjGiAgNGWFiqAghGaWxlcy5WMcoCCEZpbGVzXFYx4gIURmlsZXNcVjFcR1BCTWV0YWRhdGHqAglGaWxlczo6VjFiBnByb3RvMw");
 
 /**
  * @generated from message files.v1.WriteTmplRequest
@@ -22,9 +22,18 @@ export type WriteTmplRequest = Message<"files.v1.WriteTmplRequest"> & {
   dir: string;
 
   /**
+   * name, source and the values in vars are used
+   *
    * @generated from field: files.v1.Template tmpl = 1;
    */
   tmpl?: Template;
+
+  /**
+   * replace files that already exist in dir
+   *
+   * @generated from field: bool overwrite = 3;
+   */
+  overwrite: boolean;
 };
 
 /**
@@ -38,6 +47,12 @@ export const WriteTmplRequestSchema: GenMessage<WriteTmplRequest> = /*@__PURE__*
  * @generated from message files.v1.WriteTmplResponse
  */
 export type WriteTmplResponse = Message<"files.v1.WriteTmplResponse"> & {
+  /**
+   * written files, <alias>/<relpath>
+   *
+   * @generated from field: repeated string files = 1;
+   */
+  files: string[];
 };
 
 /**
@@ -47,6 +62,68 @@ export type WriteTmplResponse = Message<"files.v1.WriteTmplResponse"> & {
 export const WriteTmplResponseSchema: GenMessage<WriteTmplResponse> = /*@__PURE__*/
   messageDesc(file_files_v1_files, 1);
 
+/**
+ * @generated from message files.v1.PreviewTmplResponse
+ */
+export type PreviewTmplResponse = Message<"files.v1.PreviewTmplResponse"> & {
+  /**
+   * @generated from field: repeated files.v1.TemplateFile files = 1;
+   */
+  files: TemplateFile[];
+
+  /**
+   * folders created by the template hooks
+   *
+   * @generated from field: repeated string dirs = 2;
+   */
+  dirs: string[];
+};
+
+/**
+ * Describes the message files.v1.PreviewTmplResponse.
+ * Use `create(PreviewTmplResponseSchema)` to create a new message.
+ */
+export const PreviewTmplResponseSchema: GenMessage<PreviewTmplResponse> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 2);
+
+/**
+ * @generated from message files.v1.TemplateFile
+ */
+export type TemplateFile = Message<"files.v1.TemplateFile"> & {
+  /**
+   * <alias>/<relpath>
+   *
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: string contents = 2;
+   */
+  contents: string;
+
+  /**
+   * writing fails unless overwrite is set
+   *
+   * @generated from field: bool exists = 3;
+   */
+  exists: boolean;
+
+  /**
+   * contents are not sent for binary files
+   *
+   * @generated from field: bool binary = 4;
+   */
+  binary: boolean;
+};
+
+/**
+ * Describes the message files.v1.TemplateFile.
+ * Use `create(TemplateFileSchema)` to create a new message.
+ */
+export const TemplateFileSchema: GenMessage<TemplateFile> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 3);
+
 /**
  * @generated from message files.v1.GetTmplsRequest
  */
@@ -55,6 +132,13 @@ export type GetTmplsRequest = Message<"files.v1.GetTmplsRequest"> & {
    * @generated from field: string alias = 1;
    */
   alias: string;
+
+  /**
+   * fetch git sources even if the cached clone is recent
+   *
+   * @generated from field: bool refresh = 2;
+   */
+  refresh: boolean;
 };
 
 /**
@@ -62,7 +146,7 @@ export type GetTmplsRequest = Message<"files.v1.GetTmplsRequest"> & {
  * Use `create(GetTmplsRequestSchema)` to create a new message.
  */
 export const GetTmplsRequestSchema: GenMessage<GetTmplsRequest> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 2);
+  messageDesc(file_files_v1_files, 4);
 
 /**
  * @generated from message files.v1.Template
@@ -74,9 +158,40 @@ export type Template = Message<"files.v1.Template"> & {
   Name: string;
 
   /**
+   * var name to value, the defaults when listing
+   *
    * @generated from field: map<string, string> vars = 2;
    */
   vars: { [key: string]: string };
+
+  /**
+   * configured source, empty for the templates folder of the alias
+   *
+   * @generated from field: string source = 3;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string title = 4;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated files.v1.TemplateVar varDefs = 6;
+   */
+  varDefs: TemplateVar[];
+
+  /**
+   * files written by the template, before vars are filled in
+   *
+   * @generated from field: repeated string files = 7;
+   */
+  files: string[];
 };
 
 /**
@@ -84,7 +199,74 @@ export type Template = Message<"files.v1.Template"> & {
  * Use `create(TemplateSchema)` to create a new message.
  */
 export const TemplateSchema: GenMessage<Template> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 3);
+  messageDesc(file_files_v1_files, 5);
+
+/**
+ * @generated from message files.v1.TemplateVar
+ */
+export type TemplateVar = Message<"files.v1.TemplateVar"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * string, int, bool, enum
+   *
+   * @generated from field: string type = 3;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string defaultValue = 4;
+   */
+  defaultValue: string;
+
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required: boolean;
+
+  /**
+   * regex the value must match
+   *
+   * @generated from field: string pattern = 6;
+   */
+  pattern: string;
+
+  /**
+   * allowed values of an enum
+   *
+   * @generated from field: repeated string options = 7;
+   */
+  options: string[];
+
+  /**
+   * mask the value, it is written to env files with 0600
+   *
+   * @generated from field: bool secret = 8;
+   */
+  secret: boolean;
+
+  /**
+   * a random value is generated if left empty
+   *
+   * @generated from field: bool generate = 9;
+   */
+  generate: boolean;
+};
+
+/**
+ * Describes the message files.v1.TemplateVar.
+ * Use `create(TemplateVarSchema)` to create a new message.
+ */
+export const TemplateVarSchema: GenMessage<TemplateVar> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 6);
 
 /**
  * @generated from message files.v1.GetTmplsResponse
@@ -101,7 +283,7 @@ export type GetTmplsResponse = Message<"files.v1.GetTmplsResponse"> & {
  * Use `create(GetTmplsResponseSchema)` to create a new message.
  */
 export const GetTmplsResponseSchema: GenMessage<GetTmplsResponse> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 4);
+  messageDesc(file_files_v1_files, 7);
 
 /**
  * @generated from message files.v1.CopyRequest
@@ -123,7 +305,7 @@ export type CopyRequest = Message<"files.v1.CopyRequest"> & {
  * Use `create(CopyRequestSchema)` to create a new message.
  */
 export const CopyRequestSchema: GenMessage<CopyRequest> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 5);
+  messageDesc(file_files_v1_files, 8);
 
 /**
  * @generated from message files.v1.CopyResponse
@@ -136,7 +318,7 @@ export type CopyResponse = Message<"files.v1.CopyResponse"> & {
  * Use `create(CopyResponseSchema)` to create a new message.
  */
 export const CopyResponseSchema: GenMessage<CopyResponse> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 6);
+  messageDesc(file_files_v1_files, 9);
 
 /**
  * @generated from message files.v1.ListRequest
@@ -153,7 +335,7 @@ export type ListRequest = Message<"files.v1.ListRequest"> & {
  * Use `create(ListRequestSchema)` to create a new message.
  */
 export const ListRequestSchema: GenMessage<ListRequest> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 7);
+  messageDesc(file_files_v1_files, 10);
 
 /**
  * @generated from message files.v1.ListResponse
@@ -170,7 +352,7 @@ export type ListResponse = Message<"files.v1.ListResponse"> & {
  * Use `create(ListResponseSchema)` to create a new message.
  */
 export const ListResponseSchema: GenMessage<ListResponse> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 8);
+  messageDesc(file_files_v1_files, 11);
 
 /**
  * @generated from message files.v1.FormatRequest
@@ -187,7 +369,7 @@ export type FormatRequest = Message<"files.v1.FormatRequest"> & {
  * Use `create(FormatRequestSchema)` to create a new message.
  */
 export const FormatRequestSchema: GenMessage<FormatRequest> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 9);
+  messageDesc(file_files_v1_files, 12);
 
 /**
  * @generated from message files.v1.FormatResponse
@@ -204,7 +386,7 @@ export type FormatResponse = Message<"files.v1.FormatResponse"> & {
  * Use `create(FormatResponseSchema)` to create a new message.
  */
 export const FormatResponseSchema: GenMessage<FormatResponse> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 10);
+  messageDesc(file_files_v1_files, 13);
 
 /**
  * @generated from message files.v1.FsEntry
@@ -243,7 +425,348 @@ export type FsEntry = Message<"files.v1.FsEntry"> & {
  * Use `create(FsEntrySchema)` to create a new message.
  */
 export const FsEntrySchema: GenMessage<FsEntry> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 11);
+  messageDesc(file_files_v1_files, 14);
+
+/**
+ * @generated from message files.v1.TransferRequest
+ */
+export type TransferRequest = Message<"files.v1.TransferRequest"> & {
+  /**
+   * <alias>/<relpath>
+   *
+   * @generated from field: string source = 1;
+   */
+  source: string;
+
+  /**
+   * host of the source, defaults to the current host
+   *
+   * @generated from field: string sourceHost = 2;
+   */
+  sourceHost: string;
+
+  /**
+   * @generated from field: string dest = 3;
+   */
+  dest: string;
+
+  /**
+   * host of the dest, defaults to the current host
+   *
+   * @generated from field: string destHost = 4;
+   */
+  destHost: string;
+
+  /**
+   * remove the source once copied
+   *
+   * @generated from field: bool move = 5;
+   */
+  move: boolean;
+
+  /**
+   * @generated from field: files.v1.ConflictPolicy onConflict = 6;
+   */
+  onConflict: ConflictPolicy;
+};
+
+/**
+ * Describes the message files.v1.TransferRequest.
+ * Use `create(TransferRequestSchema)` to create a new message.
+ */
+export const TransferRequestSchema: GenMessage<TransferRequest> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 15);
+
+/**
+ * @generated from message files.v1.TransferProgress
+ */
+export type TransferProgress = Message<"files.v1.TransferProgress"> & {
+  /**
+   * file being transferred, relative to the source
+   *
+   * @generated from field: string file = 1;
+   */
+  file: string;
+
+  /**
+   * where the file is written to
+   *
+   * @generated from field: string dest = 2;
+   */
+  dest: string;
+
+  /**
+   * copying, copied, skipped, overwritten, renamed
+   *
+   * @generated from field: string state = 3;
+   */
+  state: string;
+
+  /**
+   * @generated from field: int64 bytesDone = 4;
+   */
+  bytesDone: bigint;
+
+  /**
+   * @generated from field: int64 bytesTotal = 5;
+   */
+  bytesTotal: bigint;
+
+  /**
+   * @generated from field: int32 filesDone = 6;
+   */
+  filesDone: number;
+
+  /**
+   * @generated from field: int32 filesTotal = 7;
+   */
+  filesTotal: number;
+};
+
+/**
+ * Describes the message files.v1.TransferProgress.
+ * Use `create(TransferProgressSchema)` to create a new message.
+ */
+export const TransferProgressSchema: GenMessage<TransferProgress> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 16);
+
+/**
+ * @generated from message files.v1.WatchFilesRequest
+ */
+export type WatchFilesRequest = Message<"files.v1.WatchFilesRequest"> & {
+  /**
+   * folders watched recursively, <alias>/<relpath>
+   *
+   * @generated from field: repeated string dirs = 1;
+   */
+  dirs: string[];
+
+  /**
+   * files open in the editor, <alias>/<relpath>
+   *
+   * @generated from field: repeated string files = 2;
+   */
+  files: string[];
+};
+
+/**
+ * Describes the message files.v1.WatchFilesRequest.
+ * Use `create(WatchFilesRequestSchema)` to create a new message.
+ */
+export const WatchFilesRequestSchema: GenMessage<WatchFilesRequest> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 17);
+
+/**
+ * @generated from message files.v1.WatchFilesResponse
+ */
+export type WatchFilesResponse = Message<"files.v1.WatchFilesResponse"> & {
+  /**
+   * @generated from field: repeated files.v1.FileEvent events = 1;
+   */
+  events: FileEvent[];
+};
+
+/**
+ * Describes the message files.v1.WatchFilesResponse.
+ * Use `create(WatchFilesResponseSchema)` to create a new message.
+ */
+export const WatchFilesResponseSchema: GenMessage<WatchFilesResponse> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 18);
+
+/**
+ * @generated from message files.v1.FileEvent
+ */
+export type FileEvent = Message<"files.v1.FileEvent"> & {
+  /**
+   * create, modify, delete, rename
+   *
+   * @generated from field: string op = 1;
+   */
+  op: string;
+
+  /**
+   * @generated from field: string filename = 2;
+   */
+  filename: string;
+
+  /**
+   * previous name of a renamed file
+   *
+   * @generated from field: string oldFilename = 3;
+   */
+  oldFilename: string;
+
+  /**
+   * @generated from field: bool isDir = 4;
+   */
+  isDir: boolean;
+
+  /**
+   * @generated from field: int64 size = 5;
+   */
+  size: bigint;
+
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 modTime = 6;
+   */
+  modTime: bigint;
+
+  /**
+   * contents hash of a watched file, same as the ETag it is loaded with
+   *
+   * @generated from field: string hash = 7;
+   */
+  hash: string;
+};
+
+/**
+ * Describes the message files.v1.FileEvent.
+ * Use `create(FileEventSchema)` to create a new message.
+ */
+export const FileEventSchema: GenMessage<FileEvent> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 19);
+
+/**
+ * @generated from message files.v1.SearchContentsRequest
+ */
+export type SearchContentsRequest = Message<"files.v1.SearchContentsRequest"> & {
+  /**
+   * folder to search, <alias>/<relpath>
+   *
+   * @generated from field: string root = 1;
+   */
+  root: string;
+
+  /**
+   * @generated from field: string query = 2;
+   */
+  query: string;
+
+  /**
+   * @generated from field: bool regex = 3;
+   */
+  regex: boolean;
+
+  /**
+   * @generated from field: bool caseSensitive = 4;
+   */
+  caseSensitive: boolean;
+
+  /**
+   * @generated from field: bool wholeWord = 5;
+   */
+  wholeWord: boolean;
+
+  /**
+   * globs, a glob without a / matches the file name, ** matches any folders
+   *
+   * @generated from field: repeated string include = 6;
+   */
+  include: string[];
+
+  /**
+   * @generated from field: repeated string exclude = 7;
+   */
+  exclude: string[];
+
+  /**
+   * lines shown before and after every match, max 10
+   *
+   * @generated from field: int32 contextLines = 8;
+   */
+  contextLines: number;
+
+  /**
+   * stop after this many matches, 0 or over 1000 uses 1000
+   *
+   * @generated from field: int32 maxMatches = 9;
+   */
+  maxMatches: number;
+};
+
+/**
+ * Describes the message files.v1.SearchContentsRequest.
+ * Use `create(SearchContentsRequestSchema)` to create a new message.
+ */
+export const SearchContentsRequestSchema: GenMessage<SearchContentsRequest> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 20);
+
+/**
+ * @generated from message files.v1.SearchContentsResponse
+ */
+export type SearchContentsResponse = Message<"files.v1.SearchContentsResponse"> & {
+  /**
+   * <alias>/<relpath>
+   *
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: repeated files.v1.LineMatch matches = 2;
+   */
+  matches: LineMatch[];
+
+  /**
+   * set on the last message if the search stopped at maxMatches
+   *
+   * @generated from field: bool truncated = 3;
+   */
+  truncated: boolean;
+};
+
+/**
+ * Describes the message files.v1.SearchContentsResponse.
+ * Use `create(SearchContentsResponseSchema)` to create a new message.
+ */
+export const SearchContentsResponseSchema: GenMessage<SearchContentsResponse> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 21);
+
+/**
+ * @generated from message files.v1.LineMatch
+ */
+export type LineMatch = Message<"files.v1.LineMatch"> & {
+  /**
+   * line and column start at 1, column and length count characters
+   *
+   * @generated from field: int32 line = 1;
+   */
+  line: number;
+
+  /**
+   * @generated from field: int32 column = 2;
+   */
+  column: number;
+
+  /**
+   * @generated from field: int32 length = 3;
+   */
+  length: number;
+
+  /**
+   * @generated from field: string text = 4;
+   */
+  text: string;
+
+  /**
+   * @generated from field: repeated string before = 5;
+   */
+  before: string[];
+
+  /**
+   * @generated from field: repeated string after = 6;
+   */
+  after: string[];
+};
+
+/**
+ * Describes the message files.v1.LineMatch.
+ * Use `create(LineMatchSchema)` to create a new message.
+ */
+export const LineMatchSchema: GenMessage<LineMatch> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 22);
 
 /**
  * @generated from message files.v1.RenameFile
@@ -265,7 +788,7 @@ export type RenameFile = Message<"files.v1.RenameFile"> & {
  * Use `create(RenameFileSchema)` to create a new message.
  */
 export const RenameFileSchema: GenMessage<RenameFile> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 12);
+  messageDesc(file_files_v1_files, 23);
 
 /**
  * @generated from message files.v1.File
@@ -287,7 +810,7 @@ export type File = Message<"files.v1.File"> & {
  * Use `create(FileSchema)` to create a new message.
  */
 export const FileSchema: GenMessage<File> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 13);
+  messageDesc(file_files_v1_files, 24);
 
 /**
  * @generated from message files.v1.Empty
@@ -300,7 +823,37 @@ export type Empty = Message<"files.v1.Empty"> & {
  * Use `create(EmptySchema)` to create a new message.
  */
 export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 14);
+  messageDesc(file_files_v1_files, 25);
+
+/**
+ * @generated from enum files.v1.ConflictPolicy
+ */
+export enum ConflictPolicy {
+  /**
+   * default val
+   *
+   * @generated from enum value: SKIP = 0;
+   */
+  SKIP = 0,
+
+  /**
+   * @generated from enum value: OVERWRITE = 1;
+   */
+  OVERWRITE = 1,
+
+  /**
+   * keep both, the new file is written as "name (1).ext"
+   *
+   * @generated from enum value: RENAME = 2;
+   */
+  RENAME = 2,
+}
+
+/**
+ * Describes the enum files.v1.ConflictPolicy.
+ */
+export const ConflictPolicySchema: GenEnum<ConflictPolicy> = /*@__PURE__*/
+  enumDesc(file_files_v1_files, 0);
 
 /**
  * @generated from service files.v1.FileService
@@ -354,6 +907,37 @@ export const FileService: GenService<{
     input: typeof RenameFileSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * Transfer recursively copies or moves files across aliases and hosts
+   *
+   * @generated from rpc files.v1.FileService.Transfer
+   */
+  transfer: {
+    methodKind: "server_streaming";
+    input: typeof TransferRequestSchema;
+    output: typeof TransferProgressSchema;
+  },
+  /**
+   * WatchFiles streams changes made on disk to the tree and open files,
+   * including ones made outside dockman
+   *
+   * @generated from rpc files.v1.FileService.WatchFiles
+   */
+  watchFiles: {
+    methodKind: "server_streaming";
+    input: typeof WatchFilesRequestSchema;
+    output: typeof WatchFilesResponseSchema;
+  },
+  /**
+   * SearchContents streams the lines matching a query, one file at a time
+   *
+   * @generated from rpc files.v1.FileService.SearchContents
+   */
+  searchContents: {
+    methodKind: "server_streaming";
+    input: typeof SearchContentsRequestSchema;
+    output: typeof SearchContentsResponseSchema;
+  },
   /**
    * @generated from rpc files.v1.FileService.GetTmpls
    */
@@ -370,6 +954,16 @@ export const FileService: GenService<{
     input: typeof WriteTmplRequestSchema;
     output: typeof WriteTmplResponseSchema;
   },
+  /**
+   * PreviewTmpl renders a template without writing it, secrets are masked
+   *
+   * @generated from rpc files.v1.FileService.PreviewTmpl
+   */
+  previewTmpl: {
+    methodKind: "unary";
+    input: typeof WriteTmplRequestSchema;
+    output: typeof PreviewTmplResponseSchema;
+  },
   /**
    * @generated from rpc files.v1.FileService.Format
    */
@@ -10,12 +10,559 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file git/v1/git.proto.
  */
 export const file_git_v1_git: GenFile = /*@__PURE__*/
-  fileDesc("ChBnaXQvdjEvZ2l0LnByb3RvEgZnaXQudjEiFQoTTGlzdEJyYW5jaGVzUmVxdWVzdCIoChRMaXN0QnJhbmNoZXNSZXNwb25zZRIQCghicmFuY2hlcxgBIAMoCSInChVCcmFuY2hMaXN0RmlsZVJlcXVlc3QSDgoGYnJhbmNoGAEgASgJIicKFkJyYW5jaExpc3RGaWxlUmVzcG9uc2USDQoFZmlsZXMYASADKAkiLwoLRmlsZVJlcXVlc3QSDgoGYnJhbmNoGAEgASgJEhAKCGZpbGVwYXRoGAIgAygJIjoKC0NvbW1pdFF1ZXJ5EhoKBGZpbGUYASABKAsyDC5naXQudjEuRmlsZRIPCgdtZXNzYWdlGAIgASgJIi0KCkNvbW1pdExpc3QSHwoHY29tbWl0cxgBIAMoCzIOLmdpdC52MS5Db21taXQiVAoGQ29tbWl0EgwKBGhhc2gYASABKAkSDgoGYXV0aG9yGAIgASgJEg0KBWVtYWlsGAQgASgJEgwKBHdoZW4YBSABKAkSDwoHbWVzc2FnZRgGIAEoCSIUCgRGaWxlEgwKBG5hbWUYASABKAkiBwoFRW1wdHkyxQIKCkdpdFNlcnZpY2USMQoLTGlzdENvbW1pdHMSDC5naXQudjEuRmlsZRoSLmdpdC52MS5Db21taXRMaXN0IgASLgoGQ29tbWl0EhMuZ2l0LnYxLkNvbW1pdFF1ZXJ5Gg0uZ2l0LnYxLkVtcHR5IgASMAoIU3luY0ZpbGUSEy5naXQudjEuRmlsZVJlcXVlc3QaDS5naXQudjEuRW1wdHkiABJVChJMaXN0RmlsZUZyb21CcmFuY2gSHS5naXQudjEuQnJhbmNoTGlzdEZpbGVSZXF1ZXN0Gh4uZ2l0LnYxLkJyYW5jaExpc3RGaWxlUmVzcG9uc2UiABJLCgxMaXN0QnJhbmNoZXMSGy5naXQudjEuTGlzdEJyYW5jaGVzUmVxdWVzdBocLmdpdC52MS5MaXN0QnJhbmNoZXNSZXNwb25zZSIAQnoKCmNvbS5naXQudjFCCEdpdFByb3RvUAFaKWdpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvZ2l0L3YxogIDR1hYqgIGR2l0LlYxygIGR2l0XFYx4gISR2l0XFYxXEdQQk1ldGFkYXRh6gIHR2l0OjpWMWIGcHJvdG8z");
+  fileDesc("ChBnaXQvdjEvZ2l0LnByb3RvEgZnaXQudjEiHwoOR2V0U3luY1JlcXVlc3QSDQoFYWxpYXMYASABKAkiywEKClN5bmNDb25maWcSDQoFYWxpYXMYASABKAkSDwoHZW5hYmxlZBgCIAEoCBIOCgZyZW1vdGUYAyABKAkSDgoGYnJhbmNoGAQgASgJEhkKEWludGVydmFsSW5NaW51dGVzGAUgASgNEg4KBmRlcGxveRgGIAEoCBIQCgh1c2VybmFtZRgHIAEoCRINCgV0b2tlbhgIIAEoCRIQCghoYXNUb2tlbhgJIAEoCBIOCgZzc2hLZXkYCiABKAkSDwoHaG9zdEtleRgLIAEoCSIfCg5SdW5TeW5jUmVxdWVzdBINCgVhbGlhcxgBIAEoCSInChZMaXN0U3luY0hpc3RvcnlSZXF1ZXN0Eg0KBWFsaWFzGAEgASgJIjgKF0xpc3RTeW5jSGlzdG9yeVJlc3BvbnNlEh0KBHJ1bnMYASADKAsyDy5naXQudjEuU3luY1J1biKFAQoHU3luY1J1bhIMCgR0aW1lGAEgASgDEg0KBXN0YXRlGAIgASgJEg0KBWVycm9yGAMgASgJEgwKBGZyb20YBCABKAkSCgoCdG8YBSABKAkSDwoHY2hhbmdlZBgGIAMoCRIjCgdkZXBsb3lzGAcgAygLMhIuZ2l0LnYxLlN5bmNEZXBsb3kiSwoKU3luY0RlcGxveRIQCghmaWxlbmFtZRgBIAEoCRINCgVzdGF0ZRgCIAEoCRINCgVlcnJvchgDIAEoCRINCgVqb2JJZBgEIAEoCSI5CgtEaWZmUmVxdWVzdBIQCghmaWxlbmFtZRgBIAEoCRIMCgRmcm9tGAIgASgJEgoKAnRvGAMgASgJIhwKDERpZmZSZXNwb25zZRIMCgRkaWZmGAEgASgJIh4KDVN0YXR1c1JlcXVlc3QSDQoFYWxpYXMYASABKAkiMwoOU3RhdHVzUmVzcG9uc2USIQoFZmlsZXMYASADKAsyEi5naXQudjEuRmlsZVN0YXR1cyJBCgpGaWxlU3RhdHVzEhAKCGZpbGVuYW1lGAEgASgJEg8KB3N0YWdpbmcYAiABKAkSEAoId29ya3RyZWUYAyABKAkiLAoMU3RhZ2VSZXF1ZXN0Eg0KBWFsaWFzGAEgASgJEg0KBWZpbGVzGAIgAygJIhwKC1B1c2hSZXF1ZXN0Eg0KBWFsaWFzGAEgASgJIhwKC1B1bGxSZXF1ZXN0Eg0KBWFsaWFzGAEgASgJIj4KC0dpdFByb2dyZXNzEg4KBm91dHB1dBgBIAEoCRIfCgZyZXN1bHQYAiABKAsyDy5naXQudjEuU3luY1J1biJDCg9Sb2xsYmFja1JlcXVlc3QSEAoIZmlsZW5hbWUYASABKAkSDgoGY29tbWl0GAIgASgJEg4KBmRlcGxveRgDIAEoCCJQChBSb2xsYmFja1Byb2dyZXNzEg4KBmNvbW1pdBgBIAEoCRINCgVmaWxlcxgCIAMoCRINCgVqb2JJZBgDIAEoCRIOCgZvdXRwdXQYBCABKAkiIQoQR2V0SWdub3JlUmVxdWVzdBINCgVhbGlhcxgBIAEoCSItCgpJZ25vcmVGaWxlEg0KBWFsaWFzGAEgASgJEhAKCGNvbnRlbnRzGAIgASgJIiQKE0xpc3RCcmFuY2hlc1JlcXVlc3QSDQoFYWxpYXMYASABKAkiKAoUTGlzdEJyYW5jaGVzUmVzcG9uc2USEAoIYnJhbmNoZXMYASADKAkiNgoVQnJhbmNoTGlzdEZpbGVSZXF1ZXN0Eg4KBmJyYW5jaBgBIAEoCRINCgVhbGlhcxgCIAEoCSInChZCcmFuY2hMaXN0RmlsZVJlc3BvbnNlEg0KBWZpbGVzGAEgAygJIj4KC0ZpbGVSZXF1ZXN0Eg4KBmJyYW5jaBgBIAEoCRIQCghmaWxlcGF0aBgCIAMoCRINCgVhbGlhcxgDIAEoCSJJCgtDb21taXRRdWVyeRIaCgRmaWxlGAEgASgLMgwuZ2l0LnYxLkZpbGUSDwoHbWVzc2FnZRgCIAEoCRINCgVhbGlhcxgDIAEoCSItCgpDb21taXRMaXN0Eh8KB2NvbW1pdHMYASADKAsyDi5naXQudjEuQ29tbWl0IlQKBkNvbW1pdBIMCgRoYXNoGAEgASgJEg4KBmF1dGhvchgCIAEoCRINCgVlbWFpbBgEIAEoCRIMCgR3aGVuGAUgASgJEg8KB21lc3NhZ2UYBiABKAkiFAoERmlsZRIMCgRuYW1lGAEgASgJIgcKBUVtcHR5MqwICgpHaXRTZXJ2aWNlEjEKC0xpc3RDb21taXRzEgwuZ2l0LnYxLkZpbGUaEi5naXQudjEuQ29tbWl0TGlzdCIAEi4KBkNvbW1pdBITLmdpdC52MS5Db21taXRRdWVyeRoNLmdpdC52MS5FbXB0eSIAEjAKCFN5bmNGaWxlEhMuZ2l0LnYxLkZpbGVSZXF1ZXN0Gg0uZ2l0LnYxLkVtcHR5IgASVQoSTGlzdEZpbGVGcm9tQnJhbmNoEh0uZ2l0LnYxLkJyYW5jaExpc3RGaWxlUmVxdWVzdBoeLmdpdC52MS5CcmFuY2hMaXN0RmlsZVJlc3BvbnNlIgASSwoMTGlzdEJyYW5jaGVzEhsuZ2l0LnYxLkxpc3RCcmFuY2hlc1JlcXVlc3QaHC5naXQudjEuTGlzdEJyYW5jaGVzUmVzcG9uc2UiABIzCgREaWZmEhMuZ2l0LnYxLkRpZmZSZXF1ZXN0GhQuZ2l0LnYxLkRpZmZSZXNwb25zZSIAEjkKBlN0YXR1cxIVLmdpdC52MS5TdGF0dXNSZXF1ZXN0GhYuZ2l0LnYxLlN0YXR1c1Jlc3BvbnNlIgASLgoFU3RhZ2USFC5naXQudjEuU3RhZ2VSZXF1ZXN0Gg0uZ2l0LnYxLkVtcHR5IgASMAoHVW5zdGFnZRIULmdpdC52MS5TdGFnZVJlcXVlc3QaDS5naXQudjEuRW1wdHkiABI0CgRQdXNoEhMuZ2l0LnYxLlB1c2hSZXF1ZXN0GhMuZ2l0LnYxLkdpdFByb2dyZXNzIgAwARI0CgRQdWxsEhMuZ2l0LnYxLlB1bGxSZXF1ZXN0GhMuZ2l0LnYxLkdpdFByb2dyZXNzIgAwARI7CglHZXRJZ25vcmUSGC5naXQudjEuR2V0SWdub3JlUmVxdWVzdBoSLmdpdC52MS5JZ25vcmVGaWxlIgASMQoKU2F2ZUlnbm9yZRISLmdpdC52MS5JZ25vcmVGaWxlGg0uZ2l0LnYxLkVtcHR5IgASQQoIUm9sbGJhY2sSFy5naXQudjEuUm9sbGJhY2tSZXF1ZXN0GhguZ2l0LnYxLlJvbGxiYWNrUHJvZ3Jlc3MiADABEjcKB0dldFN5bmMSFi5naXQudjEuR2V0U3luY1JlcXVlc3QaEi5naXQudjEuU3luY0NvbmZpZyIAEi8KCFNhdmVTeW5jEhIuZ2l0LnYxLlN5bmNDb25maWcaDS5naXQudjEuRW1wdHkiABI0CgdSdW5TeW5jEhYuZ2l0LnYxLlJ1blN5bmNSZXF1ZXN0Gg8uZ2l0LnYxLlN5bmNSdW4iABJUCg9MaXN0U3luY0hpc3RvcnkSHi5naXQudjEuTGlzdFN5bmNIaXN0b3J5UmVxdWVzdBofLmdpdC52MS5MaXN0U3luY0hpc3RvcnlSZXNwb25zZSIAQnoKCmNvbS5naXQudjFCCEdpdFByb3RvUAFaKWdpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvZ2l0L3YxogIDR1hYqgIGR2l0LlYxygIGR2l0XFYx4gISR2l0XFYxXEdQQk1ldGFkYXRh6gIHR2l0OjpWMWIGcHJvdG8z");
+
+/**
+ * @generated from message git.v1.GetSyncRequest
+ */
+export type GetSyncRequest = Message<"git.v1.GetSyncRequest"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
+};
+
+/**
+ * Describes the message git.v1.GetSyncRequest.
+ * Use `create(GetSyncRequestSchema)` to create a new message.
+ */
+export const GetSyncRequestSchema: GenMessage<GetSyncRequest> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 0);
+
+/**
+ * @generated from message git.v1.SyncConfig
+ */
+export type SyncConfig = Message<"git.v1.SyncConfig"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: string remote = 3;
+   */
+  remote: string;
+
+  /**
+   * @generated from field: string branch = 4;
+   */
+  branch: string;
+
+  /**
+   * @generated from field: uint32 intervalInMinutes = 5;
+   */
+  intervalInMinutes: number;
+
+  /**
+   * run compose up on every stack changed by a sync
+   *
+   * @generated from field: bool deploy = 6;
+   */
+  deploy: boolean;
+
+  /**
+   * @generated from field: string username = 7;
+   */
+  username: string;
+
+  /**
+   * write only, empty keeps the saved token
+   *
+   * @generated from field: string token = 8;
+   */
+  token: string;
+
+  /**
+   * @generated from field: bool hasToken = 9;
+   */
+  hasToken: boolean;
+
+  /**
+   * name of the ssh key used for ssh remotes, empty is the dockman key
+   *
+   * @generated from field: string sshKey = 10;
+   */
+  sshKey: string;
+
+  /**
+   * read only, saved on the first connect to an ssh remote
+   *
+   * @generated from field: string hostKey = 11;
+   */
+  hostKey: string;
+};
+
+/**
+ * Describes the message git.v1.SyncConfig.
+ * Use `create(SyncConfigSchema)` to create a new message.
+ */
+export const SyncConfigSchema: GenMessage<SyncConfig> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 1);
+
+/**
+ * @generated from message git.v1.RunSyncRequest
+ */
+export type RunSyncRequest = Message<"git.v1.RunSyncRequest"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
+};
+
+/**
+ * Describes the message git.v1.RunSyncRequest.
+ * Use `create(RunSyncRequestSchema)` to create a new message.
+ */
+export const RunSyncRequestSchema: GenMessage<RunSyncRequest> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 2);
+
+/**
+ * @generated from message git.v1.ListSyncHistoryRequest
+ */
+export type ListSyncHistoryRequest = Message<"git.v1.ListSyncHistoryRequest"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
+};
+
+/**
+ * Describes the message git.v1.ListSyncHistoryRequest.
+ * Use `create(ListSyncHistoryRequestSchema)` to create a new message.
+ */
+export const ListSyncHistoryRequestSchema: GenMessage<ListSyncHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 3);
+
+/**
+ * @generated from message git.v1.ListSyncHistoryResponse
+ */
+export type ListSyncHistoryResponse = Message<"git.v1.ListSyncHistoryResponse"> & {
+  /**
+   * @generated from field: repeated git.v1.SyncRun runs = 1;
+   */
+  runs: SyncRun[];
+};
+
+/**
+ * Describes the message git.v1.ListSyncHistoryResponse.
+ * Use `create(ListSyncHistoryResponseSchema)` to create a new message.
+ */
+export const ListSyncHistoryResponseSchema: GenMessage<ListSyncHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 4);
+
+/**
+ * @generated from message git.v1.SyncRun
+ */
+export type SyncRun = Message<"git.v1.SyncRun"> & {
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 time = 1;
+   */
+  time: bigint;
+
+  /**
+   * up-to-date, updated, conflict, failed
+   *
+   * @generated from field: string state = 2;
+   */
+  state: string;
+
+  /**
+   * @generated from field: string error = 3;
+   */
+  error: string;
+
+  /**
+   * @generated from field: string from = 4;
+   */
+  from: string;
+
+  /**
+   * @generated from field: string to = 5;
+   */
+  to: string;
+
+  /**
+   * files changed relative to the alias
+   *
+   * @generated from field: repeated string changed = 6;
+   */
+  changed: string[];
+
+  /**
+   * @generated from field: repeated git.v1.SyncDeploy deploys = 7;
+   */
+  deploys: SyncDeploy[];
+};
+
+/**
+ * Describes the message git.v1.SyncRun.
+ * Use `create(SyncRunSchema)` to create a new message.
+ */
+export const SyncRunSchema: GenMessage<SyncRun> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 5);
+
+/**
+ * @generated from message git.v1.SyncDeploy
+ */
+export type SyncDeploy = Message<"git.v1.SyncDeploy"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * pending, running, succeeded, failed, skipped
+   *
+   * @generated from field: string state = 2;
+   */
+  state: string;
+
+  /**
+   * @generated from field: string error = 3;
+   */
+  error: string;
+
+  /**
+   * attach to the output with the docker JobAttach rpc
+   *
+   * @generated from field: string jobId = 4;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message git.v1.SyncDeploy.
+ * Use `create(SyncDeploySchema)` to create a new message.
+ */
+export const SyncDeploySchema: GenMessage<SyncDeploy> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 6);
+
+/**
+ * @generated from message git.v1.DiffRequest
+ */
+export type DiffRequest = Message<"git.v1.DiffRequest"> & {
+  /**
+   * <alias>/<relpath>
+   *
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * commit hash, empty is the commit before to
+   *
+   * @generated from field: string from = 2;
+   */
+  from: string;
+
+  /**
+   * commit hash, empty is the file as it is now
+   *
+   * @generated from field: string to = 3;
+   */
+  to: string;
+};
+
+/**
+ * Describes the message git.v1.DiffRequest.
+ * Use `create(DiffRequestSchema)` to create a new message.
+ */
+export const DiffRequestSchema: GenMessage<DiffRequest> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 7);
+
+/**
+ * @generated from message git.v1.DiffResponse
+ */
+export type DiffResponse = Message<"git.v1.DiffResponse"> & {
+  /**
+   * unified diff
+   *
+   * @generated from field: string diff = 1;
+   */
+  diff: string;
+};
+
+/**
+ * Describes the message git.v1.DiffResponse.
+ * Use `create(DiffResponseSchema)` to create a new message.
+ */
+export const DiffResponseSchema: GenMessage<DiffResponse> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 8);
+
+/**
+ * @generated from message git.v1.StatusRequest
+ */
+export type StatusRequest = Message<"git.v1.StatusRequest"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
+};
+
+/**
+ * Describes the message git.v1.StatusRequest.
+ * Use `create(StatusRequestSchema)` to create a new message.
+ */
+export const StatusRequestSchema: GenMessage<StatusRequest> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 9);
+
+/**
+ * @generated from message git.v1.StatusResponse
+ */
+export type StatusResponse = Message<"git.v1.StatusResponse"> & {
+  /**
+   * @generated from field: repeated git.v1.FileStatus files = 1;
+   */
+  files: FileStatus[];
+};
+
+/**
+ * Describes the message git.v1.StatusResponse.
+ * Use `create(StatusResponseSchema)` to create a new message.
+ */
+export const StatusResponseSchema: GenMessage<StatusResponse> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 10);
+
+/**
+ * @generated from message git.v1.FileStatus
+ */
+export type FileStatus = Message<"git.v1.FileStatus"> & {
+  /**
+   * <alias>/<relpath>
+   *
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * unmodified, untracked, modified, added, deleted, renamed, copied, unmerged
+   *
+   * @generated from field: string staging = 2;
+   */
+  staging: string;
+
+  /**
+   * @generated from field: string worktree = 3;
+   */
+  worktree: string;
+};
+
+/**
+ * Describes the message git.v1.FileStatus.
+ * Use `create(FileStatusSchema)` to create a new message.
+ */
+export const FileStatusSchema: GenMessage<FileStatus> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 11);
+
+/**
+ * @generated from message git.v1.StageRequest
+ */
+export type StageRequest = Message<"git.v1.StageRequest"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
+
+  /**
+   * relative to the alias
+   *
+   * @generated from field: repeated string files = 2;
+   */
+  files: string[];
+};
+
+/**
+ * Describes the message git.v1.StageRequest.
+ * Use `create(StageRequestSchema)` to create a new message.
+ */
+export const StageRequestSchema: GenMessage<StageRequest> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 12);
+
+/**
+ * @generated from message git.v1.PushRequest
+ */
+export type PushRequest = Message<"git.v1.PushRequest"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
+};
+
+/**
+ * Describes the message git.v1.PushRequest.
+ * Use `create(PushRequestSchema)` to create a new message.
+ */
+export const PushRequestSchema: GenMessage<PushRequest> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 13);
+
+/**
+ * @generated from message git.v1.PullRequest
+ */
+export type PullRequest = Message<"git.v1.PullRequest"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
+};
+
+/**
+ * Describes the message git.v1.PullRequest.
+ * Use `create(PullRequestSchema)` to create a new message.
+ */
+export const PullRequestSchema: GenMessage<PullRequest> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 14);
+
+/**
+ * @generated from message git.v1.GitProgress
+ */
+export type GitProgress = Message<"git.v1.GitProgress"> & {
+  /**
+   * git transfer progress output
+   *
+   * @generated from field: string output = 1;
+   */
+  output: string;
+
+  /**
+   * set on the last message of a pull
+   *
+   * @generated from field: git.v1.SyncRun result = 2;
+   */
+  result?: SyncRun;
+};
+
+/**
+ * Describes the message git.v1.GitProgress.
+ * Use `create(GitProgressSchema)` to create a new message.
+ */
+export const GitProgressSchema: GenMessage<GitProgress> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 15);
+
+/**
+ * @generated from message git.v1.RollbackRequest
+ */
+export type RollbackRequest = Message<"git.v1.RollbackRequest"> & {
+  /**
+   * compose file of the stack as <alias>/<relpath>
+   *
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: string commit = 2;
+   */
+  commit: string;
+
+  /**
+   * run compose up once the files are restored
+   *
+   * @generated from field: bool deploy = 3;
+   */
+  deploy: boolean;
+};
+
+/**
+ * Describes the message git.v1.RollbackRequest.
+ * Use `create(RollbackRequestSchema)` to create a new message.
+ */
+export const RollbackRequestSchema: GenMessage<RollbackRequest> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 16);
+
+/**
+ * @generated from message git.v1.RollbackProgress
+ */
+export type RollbackProgress = Message<"git.v1.RollbackProgress"> & {
+  /**
+   * set on the first message, empty if the stack already matched the commit
+   *
+   * @generated from field: string commit = 1;
+   */
+  commit: string;
+
+  /**
+   * restored or removed files, <alias>/<relpath>
+   *
+   * @generated from field: repeated string files = 2;
+   */
+  files: string[];
+
+  /**
+   * set once the deploy job started
+   *
+   * @generated from field: string jobId = 3;
+   */
+  jobId: string;
+
+  /**
+   * compose up output
+   *
+   * @generated from field: string output = 4;
+   */
+  output: string;
+};
+
+/**
+ * Describes the message git.v1.RollbackProgress.
+ * Use `create(RollbackProgressSchema)` to create a new message.
+ */
+export const RollbackProgressSchema: GenMessage<RollbackProgress> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 17);
+
+/**
+ * @generated from message git.v1.GetIgnoreRequest
+ */
+export type GetIgnoreRequest = Message<"git.v1.GetIgnoreRequest"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
+};
+
+/**
+ * Describes the message git.v1.GetIgnoreRequest.
+ * Use `create(GetIgnoreRequestSchema)` to create a new message.
+ */
+export const GetIgnoreRequestSchema: GenMessage<GetIgnoreRequest> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 18);
+
+/**
+ * @generated from message git.v1.IgnoreFile
+ */
+export type IgnoreFile = Message<"git.v1.IgnoreFile"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
+
+  /**
+   * @generated from field: string contents = 2;
+   */
+  contents: string;
+};
+
+/**
+ * Describes the message git.v1.IgnoreFile.
+ * Use `create(IgnoreFileSchema)` to create a new message.
+ */
+export const IgnoreFileSchema: GenMessage<IgnoreFile> = /*@__PURE__*/
+  messageDesc(file_git_v1_git, 19);
 
 /**
  * @generated from message git.v1.ListBranchesRequest
  */
 export type ListBranchesRequest = Message<"git.v1.ListBranchesRequest"> & {
+  /**
+   * @generated from field: string alias = 1;
+   */
+  alias: string;
 };
 
 /**
@@ -23,7 +570,7 @@ export type ListBranchesRequest = Message<"git.v1.ListBranchesRequest"> & {
  * Use `create(ListBranchesRequestSchema)` to create a new message.
  */
 export const ListBranchesRequestSchema: GenMessage<ListBranchesRequest> = /*@__PURE__*/
-  messageDesc(file_git_v1_git, 0);
+  messageDesc(file_git_v1_git, 20);
 
 /**
  * @generated from message git.v1.ListBranchesResponse
@@ -40,7 +587,7 @@ export type ListBranchesResponse = Message<"git.v1.ListBranchesResponse"> & {
  * Use `create(ListBranchesResponseSchema)` to create a new message.
  */
 export const ListBranchesResponseSchema: GenMessage<ListBranchesResponse> = /*@__PURE__*/
-  messageDesc(file_git_v1_git, 1);
+  messageDesc(file_git_v1_git, 21);
 
 /**
  * @generated from message git.v1.BranchListFileRequest
@@ -50,6 +597,11 @@ export type BranchListFileRequest = Message<"git.v1.BranchListFileRequest"> & {
    * @generated from field: string branch = 1;
    */
   branch: string;
+
+  /**
+   * @generated from field: string alias = 2;
+   */
+  alias: string;
 };
 
 /**
@@ -57,7 +609,7 @@ export type BranchListFileRequest = Message<"git.v1.BranchListFileRequest"> & {
  * Use `create(BranchListFileRequestSchema)` to create a new message.
  */
 export const BranchListFileRequestSchema: GenMessage<BranchListFileRequest> = /*@__PURE__*/
-  messageDesc(file_git_v1_git, 2);
+  messageDesc(file_git_v1_git, 22);
 
 /**
  * @generated from message git.v1.BranchListFileResponse
@@ -74,7 +626,7 @@ export type BranchListFileResponse = Message<"git.v1.BranchListFileResponse"> &
  * Use `create(BranchListFileResponseSchema)` to create a new message.
  */
 export const BranchListFileResponseSchema: GenMessage<BranchListFileResponse> = /*@__PURE__*/
-  messageDesc(file_git_v1_git, 3);
+  messageDesc(file_git_v1_git, 23);
 
 /**
  * @generated from message git.v1.FileRequest
@@ -89,6 +641,11 @@ export type FileRequest = Message<"git.v1.FileRequest"> & {
    * @generated from field: repeated string filepath = 2;
    */
   filepath: string[];
+
+  /**
+   * @generated from field: string alias = 3;
+   */
+  alias: string;
 };
 
 /**
@@ -96,13 +653,15 @@ export type FileRequest = Message<"git.v1.FileRequest"> & {
  * Use `create(FileRequestSchema)` to create a new message.
  */
 export const FileRequestSchema: GenMessage<FileRequest> = /*@__PURE__*/
-  messageDesc(file_git_v1_git, 4);
+  messageDesc(file_git_v1_git, 24);
 
 /**
  * @generated from message git.v1.CommitQuery
  */
 export type CommitQuery = Message<"git.v1.CommitQuery"> & {
   /**
+   * optional, a file to stage and commit with everything already staged
+   *
    * @generated from field: git.v1.File file = 1;
    */
   file?: File;
@@ -111,6 +670,13 @@ export type CommitQuery = Message<"git.v1.CommitQuery"> & {
    * @generated from field: string message = 2;
    */
   message: string;
+
+  /**
+   * the alias to commit the staged files of when file is not set
+   *
+   * @generated from field: string alias = 3;
+   */
+  alias: string;
 };
 
 /**
@@ -118,7 +684,7 @@ export type CommitQuery = Message<"git.v1.CommitQuery"> & {
  * Use `create(CommitQuerySchema)` to create a new message.
  */
 export const CommitQuerySchema: GenMessage<CommitQuery> = /*@__PURE__*/
-  messageDesc(file_git_v1_git, 5);
+  messageDesc(file_git_v1_git, 25);
 
 /**
  * @generated from message git.v1.CommitList
@@ -135,7 +701,7 @@ export type CommitList = Message<"git.v1.CommitList"> & {
  * Use `create(CommitListSchema)` to create a new message.
  */
 export const CommitListSchema: GenMessage<CommitList> = /*@__PURE__*/
-  messageDesc(file_git_v1_git, 6);
+  messageDesc(file_git_v1_git, 26);
 
 /**
  * @generated from message git.v1.Commit
@@ -172,13 +738,15 @@ export type Commit = Message<"git.v1.Commit"> & {
  * Use `create(CommitSchema)` to create a new message.
  */
 export const CommitSchema: GenMessage<Commit> = /*@__PURE__*/
-  messageDesc(file_git_v1_git, 7);
+  messageDesc(file_git_v1_git, 27);
 
 /**
  * @generated from message git.v1.File
  */
 export type File = Message<"git.v1.File"> & {
   /**
+   * <alias>/<relpath>
+   *
    * @generated from field: string name = 1;
    */
   name: string;
@@ -189,7 +757,7 @@ export type File = Message<"git.v1.File"> & {
  * Use `create(FileSchema)` to create a new message.
  */
 export const FileSchema: GenMessage<File> = /*@__PURE__*/
-  messageDesc(file_git_v1_git, 8);
+  messageDesc(file_git_v1_git, 28);
 
 /**
  * @generated from message git.v1.Empty
@@ -202,7 +770,7 @@ export type Empty = Message<"git.v1.Empty"> & {
  * Use `create(EmptySchema)` to create a new message.
  */
 export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
-  messageDesc(file_git_v1_git, 9);
+  messageDesc(file_git_v1_git, 29);
 
 /**
  * @generated from service git.v1.GitService
@@ -248,6 +816,110 @@ export const GitService: GenService<{
     input: typeof ListBranchesRequestSchema;
     output: typeof ListBranchesResponseSchema;
   },
+  /**
+   * @generated from rpc git.v1.GitService.Diff
+   */
+  diff: {
+    methodKind: "unary";
+    input: typeof DiffRequestSchema;
+    output: typeof DiffResponseSchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.Status
+   */
+  status: {
+    methodKind: "unary";
+    input: typeof StatusRequestSchema;
+    output: typeof StatusResponseSchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.Stage
+   */
+  stage: {
+    methodKind: "unary";
+    input: typeof StageRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.Unstage
+   */
+  unstage: {
+    methodKind: "unary";
+    input: typeof StageRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.Push
+   */
+  push: {
+    methodKind: "server_streaming";
+    input: typeof PushRequestSchema;
+    output: typeof GitProgressSchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.Pull
+   */
+  pull: {
+    methodKind: "server_streaming";
+    input: typeof PullRequestSchema;
+    output: typeof GitProgressSchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.GetIgnore
+   */
+  getIgnore: {
+    methodKind: "unary";
+    input: typeof GetIgnoreRequestSchema;
+    output: typeof IgnoreFileSchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.SaveIgnore
+   */
+  saveIgnore: {
+    methodKind: "unary";
+    input: typeof IgnoreFileSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.Rollback
+   */
+  rollback: {
+    methodKind: "server_streaming";
+    input: typeof RollbackRequestSchema;
+    output: typeof RollbackProgressSchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.GetSync
+   */
+  getSync: {
+    methodKind: "unary";
+    input: typeof GetSyncRequestSchema;
+    output: typeof SyncConfigSchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.SaveSync
+   */
+  saveSync: {
+    methodKind: "unary";
+    input: typeof SyncConfigSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.RunSync
+   */
+  runSync: {
+    methodKind: "unary";
+    input: typeof RunSyncRequestSchema;
+    output: typeof SyncRunSchema;
+  },
+  /**
+   * @generated from rpc git.v1.GitService.ListSyncHistory
+   */
+  listSyncHistory: {
+    methodKind: "unary";
+    input: typeof ListSyncHistoryRequestSchema;
+    output: typeof ListSyncHistoryResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_git_v1_git, 0);
 
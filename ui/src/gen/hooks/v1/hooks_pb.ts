@@ -0,0 +1,259 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file hooks/v1/hooks.proto (package hooks.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file hooks/v1/hooks.proto.
+ */
+export const file_hooks_v1_hooks: GenFile = /*@__PURE__*/
+  fileDesc("ChRob29rcy92MS9ob29rcy5wcm90bxIIaG9va3MudjEigQEKBEhvb2sSCgoCaWQYASABKA0SCwoDa2V5GAIgASgJEgwKBG5hbWUYAyABKAkSDwoHZW5hYmxlZBgEIAEoCBIOCgZhY3Rpb24YBSABKAkSDgoGdGFyZ2V0GAYgASgJEg4KBnNlY3JldBgHIAEoCRIRCgloYXNTZWNyZXQYCCABKAgiEgoQTGlzdEhvb2tzUmVxdWVzdCIyChFMaXN0SG9va3NSZXNwb25zZRIdCgVob29rcxgBIAMoCzIOLmhvb2tzLnYxLkhvb2siHwoRRGVsZXRlSG9va1JlcXVlc3QSCgoCaWQYASABKA0iFAoSRGVsZXRlSG9va1Jlc3BvbnNlIigKFkxpc3RJbnZvY2F0aW9uc1JlcXVlc3QSDgoGaG9va0lkGAEgASgNIkQKF0xpc3RJbnZvY2F0aW9uc1Jlc3BvbnNlEikKC2ludm9jYXRpb25zGAEgAygLMhQuaG9va3MudjEuSW52b2NhdGlvbiJoCgpJbnZvY2F0aW9uEgwKBHRpbWUYASABKAMSEAoIcHJvdmlkZXIYAiABKAkSDQoFZXZlbnQYAyABKAkSDQoFc3RhdGUYBCABKAkSDQoFZXJyb3IYBSABKAkSDQoFam9iSWQYBiABKAkyqQIKDEhvb2tzU2VydmljZRJGCglMaXN0SG9va3MSGi5ob29rcy52MS5MaXN0SG9va3NSZXF1ZXN0GhsuaG9va3MudjEuTGlzdEhvb2tzUmVzcG9uc2UiABIsCghTYXZlSG9vaxIOLmhvb2tzLnYxLkhvb2saDi5ob29rcy52MS5Ib29rIgASSQoKRGVsZXRlSG9vaxIbLmhvb2tzLnYxLkRlbGV0ZUhvb2tSZXF1ZXN0GhwuaG9va3MudjEuRGVsZXRlSG9va1Jlc3BvbnNlIgASWAoPTGlzdEludm9jYXRpb25zEiAuaG9va3MudjEuTGlzdEludm9jYXRpb25zUmVxdWVzdBohLmhvb2tzLnYxLkxpc3RJbnZvY2F0aW9uc1Jlc3BvbnNlIgBCiAEKDGNvbS5ob29rcy52MUIKSG9va3NQcm90b1ABWitnaXRodWIuY29tL1JBMzQxL2RvY2ttYW4vZ2VuZXJhdGVkL2hvb2tzL3YxogIDSFhYqgIISG9va3MuVjHKAghIb29rc1xWMeICFEhvb2tzXFYxXEdQQk1ldGFkYXRh6gIJSG9va3M6OlYxYgZwcm90bzM");
+
+/**
+ * @generated from message hooks.v1.Hook
+ */
+export type Hook = Message<"hooks.v1.Hook"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+
+  /**
+   * used in the hook url /api/hooks/{key}, generated on create
+   *
+   * @generated from field: string key = 2;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool enabled = 4;
+   */
+  enabled: boolean;
+
+  /**
+   * compose-update, sync, cleaner, image-update
+   *
+   * @generated from field: string action = 5;
+   */
+  action: string;
+
+  /**
+   * compose file for compose-update, alias for sync,
+   * image for image-update, unused for cleaner
+   *
+   * @generated from field: string target = 6;
+   */
+  target: string;
+
+  /**
+   * write only, empty keeps the saved secret.
+   * a secret generated on create is returned once by SaveHook
+   *
+   * @generated from field: string secret = 7;
+   */
+  secret: string;
+
+  /**
+   * @generated from field: bool hasSecret = 8;
+   */
+  hasSecret: boolean;
+};
+
+/**
+ * Describes the message hooks.v1.Hook.
+ * Use `create(HookSchema)` to create a new message.
+ */
+export const HookSchema: GenMessage<Hook> = /*@__PURE__*/
+  messageDesc(file_hooks_v1_hooks, 0);
+
+/**
+ * @generated from message hooks.v1.ListHooksRequest
+ */
+export type ListHooksRequest = Message<"hooks.v1.ListHooksRequest"> & {
+};
+
+/**
+ * Describes the message hooks.v1.ListHooksRequest.
+ * Use `create(ListHooksRequestSchema)` to create a new message.
+ */
+export const ListHooksRequestSchema: GenMessage<ListHooksRequest> = /*@__PURE__*/
+  messageDesc(file_hooks_v1_hooks, 1);
+
+/**
+ * @generated from message hooks.v1.ListHooksResponse
+ */
+export type ListHooksResponse = Message<"hooks.v1.ListHooksResponse"> & {
+  /**
+   * @generated from field: repeated hooks.v1.Hook hooks = 1;
+   */
+  hooks: Hook[];
+};
+
+/**
+ * Describes the message hooks.v1.ListHooksResponse.
+ * Use `create(ListHooksResponseSchema)` to create a new message.
+ */
+export const ListHooksResponseSchema: GenMessage<ListHooksResponse> = /*@__PURE__*/
+  messageDesc(file_hooks_v1_hooks, 2);
+
+/**
+ * @generated from message hooks.v1.DeleteHookRequest
+ */
+export type DeleteHookRequest = Message<"hooks.v1.DeleteHookRequest"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message hooks.v1.DeleteHookRequest.
+ * Use `create(DeleteHookRequestSchema)` to create a new message.
+ */
+export const DeleteHookRequestSchema: GenMessage<DeleteHookRequest> = /*@__PURE__*/
+  messageDesc(file_hooks_v1_hooks, 3);
+
+/**
+ * @generated from message hooks.v1.DeleteHookResponse
+ */
+export type DeleteHookResponse = Message<"hooks.v1.DeleteHookResponse"> & {
+};
+
+/**
+ * Describes the message hooks.v1.DeleteHookResponse.
+ * Use `create(DeleteHookResponseSchema)` to create a new message.
+ */
+export const DeleteHookResponseSchema: GenMessage<DeleteHookResponse> = /*@__PURE__*/
+  messageDesc(file_hooks_v1_hooks, 4);
+
+/**
+ * @generated from message hooks.v1.ListInvocationsRequest
+ */
+export type ListInvocationsRequest = Message<"hooks.v1.ListInvocationsRequest"> & {
+  /**
+   * @generated from field: uint32 hookId = 1;
+   */
+  hookId: number;
+};
+
+/**
+ * Describes the message hooks.v1.ListInvocationsRequest.
+ * Use `create(ListInvocationsRequestSchema)` to create a new message.
+ */
+export const ListInvocationsRequestSchema: GenMessage<ListInvocationsRequest> = /*@__PURE__*/
+  messageDesc(file_hooks_v1_hooks, 5);
+
+/**
+ * @generated from message hooks.v1.ListInvocationsResponse
+ */
+export type ListInvocationsResponse = Message<"hooks.v1.ListInvocationsResponse"> & {
+  /**
+   * @generated from field: repeated hooks.v1.Invocation invocations = 1;
+   */
+  invocations: Invocation[];
+};
+
+/**
+ * Describes the message hooks.v1.ListInvocationsResponse.
+ * Use `create(ListInvocationsResponseSchema)` to create a new message.
+ */
+export const ListInvocationsResponseSchema: GenMessage<ListInvocationsResponse> = /*@__PURE__*/
+  messageDesc(file_hooks_v1_hooks, 6);
+
+/**
+ * @generated from message hooks.v1.Invocation
+ */
+export type Invocation = Message<"hooks.v1.Invocation"> & {
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 time = 1;
+   */
+  time: bigint;
+
+  /**
+   * github, gitea, gitlab
+   *
+   * @generated from field: string provider = 2;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: string event = 3;
+   */
+  event: string;
+
+  /**
+   * running, success, failed, rejected
+   *
+   * @generated from field: string state = 4;
+   */
+  state: string;
+
+  /**
+   * @generated from field: string error = 5;
+   */
+  error: string;
+
+  /**
+   * set for compose-update
+   *
+   * @generated from field: string jobId = 6;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message hooks.v1.Invocation.
+ * Use `create(InvocationSchema)` to create a new message.
+ */
+export const InvocationSchema: GenMessage<Invocation> = /*@__PURE__*/
+  messageDesc(file_hooks_v1_hooks, 7);
+
+/**
+ * @generated from service hooks.v1.HooksService
+ */
+export const HooksService: GenService<{
+  /**
+   * @generated from rpc hooks.v1.HooksService.ListHooks
+   */
+  listHooks: {
+    methodKind: "unary";
+    input: typeof ListHooksRequestSchema;
+    output: typeof ListHooksResponseSchema;
+  },
+  /**
+   * @generated from rpc hooks.v1.HooksService.SaveHook
+   */
+  saveHook: {
+    methodKind: "unary";
+    input: typeof HookSchema;
+    output: typeof HookSchema;
+  },
+  /**
+   * @generated from rpc hooks.v1.HooksService.DeleteHook
+   */
+  deleteHook: {
+    methodKind: "unary";
+    input: typeof DeleteHookRequestSchema;
+    output: typeof DeleteHookResponseSchema;
+  },
+  /**
+   * @generated from rpc hooks.v1.HooksService.ListInvocations
+   */
+  listInvocations: {
+    methodKind: "unary";
+    input: typeof ListInvocationsRequestSchema;
+    output: typeof ListInvocationsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_hooks_v1_hooks, 0);
+
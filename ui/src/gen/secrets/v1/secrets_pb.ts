@@ -0,0 +1,190 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file secrets/v1/secrets.proto (package secrets.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file secrets/v1/secrets.proto.
+ */
+export const file_secrets_v1_secrets: GenFile = /*@__PURE__*/
+  fileDesc("ChhzZWNyZXRzL3YxL3NlY3JldHMucHJvdG8SCnNlY3JldHMudjEiBwoFRW1wdHkiIwoSTGlzdFNlY3JldHNSZXF1ZXN0Eg0KBXN0YWNrGAEgASgJIjoKE0xpc3RTZWNyZXRzUmVzcG9uc2USIwoHc2VjcmV0cxgBIAMoCzISLnNlY3JldHMudjEuU2VjcmV0IkYKBlNlY3JldBINCgVzdGFjaxgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBG1vZGUYAyABKAkSEQoJdXBkYXRlZEF0GAQgASgDIk0KEVNhdmVTZWNyZXRSZXF1ZXN0Eg0KBXN0YWNrGAEgASgJEgwKBG5hbWUYAiABKAkSDAoEbW9kZRgDIAEoCRINCgV2YWx1ZRgEIAEoCSIyChNEZWxldGVTZWNyZXRSZXF1ZXN0Eg0KBXN0YWNrGAEgASgJEgwKBG5hbWUYAiABKAky6gEKDlNlY3JldHNTZXJ2aWNlElAKC0xpc3RTZWNyZXRzEh4uc2VjcmV0cy52MS5MaXN0U2VjcmV0c1JlcXVlc3QaHy5zZWNyZXRzLnYxLkxpc3RTZWNyZXRzUmVzcG9uc2UiABJACgpTYXZlU2VjcmV0Eh0uc2VjcmV0cy52MS5TYXZlU2VjcmV0UmVxdWVzdBoRLnNlY3JldHMudjEuRW1wdHkiABJECgxEZWxldGVTZWNyZXQSHy5zZWNyZXRzLnYxLkRlbGV0ZVNlY3JldFJlcXVlc3QaES5zZWNyZXRzLnYxLkVtcHR5IgBClgEKDmNvbS5zZWNyZXRzLnYxQgxTZWNyZXRzUHJvdG9QAVotZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9zZWNyZXRzL3YxogIDU1hYqgIKU2VjcmV0cy5WMcoCClNlY3JldHNcVjHiAhZTZWNyZXRzXFYxXEdQQk1ldGFkYXRh6gILU2VjcmV0czo6VjFiBnByb3RvMw");
+
+/**
+ * @generated from message secrets.v1.Empty
+ */
+export type Empty = Message<"secrets.v1.Empty"> & {
+};
+
+/**
+ * Describes the message secrets.v1.Empty.
+ * Use `create(EmptySchema)` to create a new message.
+ */
+export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
+  messageDesc(file_secrets_v1_secrets, 0);
+
+/**
+ * @generated from message secrets.v1.ListSecretsRequest
+ */
+export type ListSecretsRequest = Message<"secrets.v1.ListSecretsRequest"> & {
+  /**
+   * compose file as <alias>/<relpath>, empty lists the secrets of every stack
+   *
+   * @generated from field: string stack = 1;
+   */
+  stack: string;
+};
+
+/**
+ * Describes the message secrets.v1.ListSecretsRequest.
+ * Use `create(ListSecretsRequestSchema)` to create a new message.
+ */
+export const ListSecretsRequestSchema: GenMessage<ListSecretsRequest> = /*@__PURE__*/
+  messageDesc(file_secrets_v1_secrets, 1);
+
+/**
+ * @generated from message secrets.v1.ListSecretsResponse
+ */
+export type ListSecretsResponse = Message<"secrets.v1.ListSecretsResponse"> & {
+  /**
+   * @generated from field: repeated secrets.v1.Secret secrets = 1;
+   */
+  secrets: Secret[];
+};
+
+/**
+ * Describes the message secrets.v1.ListSecretsResponse.
+ * Use `create(ListSecretsResponseSchema)` to create a new message.
+ */
+export const ListSecretsResponseSchema: GenMessage<ListSecretsResponse> = /*@__PURE__*/
+  messageDesc(file_secrets_v1_secrets, 2);
+
+/**
+ * Secret values are never returned
+ *
+ * @generated from message secrets.v1.Secret
+ */
+export type Secret = Message<"secrets.v1.Secret"> & {
+  /**
+   * compose file the secret belongs to, empty for every stack on the host
+   *
+   * @generated from field: string stack = 1;
+   */
+  stack: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * env, file
+   *
+   * @generated from field: string mode = 3;
+   */
+  mode: string;
+
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 updatedAt = 4;
+   */
+  updatedAt: bigint;
+};
+
+/**
+ * Describes the message secrets.v1.Secret.
+ * Use `create(SecretSchema)` to create a new message.
+ */
+export const SecretSchema: GenMessage<Secret> = /*@__PURE__*/
+  messageDesc(file_secrets_v1_secrets, 3);
+
+/**
+ * @generated from message secrets.v1.SaveSecretRequest
+ */
+export type SaveSecretRequest = Message<"secrets.v1.SaveSecretRequest"> & {
+  /**
+   * @generated from field: string stack = 1;
+   */
+  stack: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string mode = 3;
+   */
+  mode: string;
+
+  /**
+   * required when creating, empty keeps the saved value
+   *
+   * @generated from field: string value = 4;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message secrets.v1.SaveSecretRequest.
+ * Use `create(SaveSecretRequestSchema)` to create a new message.
+ */
+export const SaveSecretRequestSchema: GenMessage<SaveSecretRequest> = /*@__PURE__*/
+  messageDesc(file_secrets_v1_secrets, 4);
+
+/**
+ * @generated from message secrets.v1.DeleteSecretRequest
+ */
+export type DeleteSecretRequest = Message<"secrets.v1.DeleteSecretRequest"> & {
+  /**
+   * @generated from field: string stack = 1;
+   */
+  stack: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message secrets.v1.DeleteSecretRequest.
+ * Use `create(DeleteSecretRequestSchema)` to create a new message.
+ */
+export const DeleteSecretRequestSchema: GenMessage<DeleteSecretRequest> = /*@__PURE__*/
+  messageDesc(file_secrets_v1_secrets, 5);
+
+/**
+ * @generated from service secrets.v1.SecretsService
+ */
+export const SecretsService: GenService<{
+  /**
+   * @generated from rpc secrets.v1.SecretsService.ListSecrets
+   */
+  listSecrets: {
+    methodKind: "unary";
+    input: typeof ListSecretsRequestSchema;
+    output: typeof ListSecretsResponseSchema;
+  },
+  /**
+   * @generated from rpc secrets.v1.SecretsService.SaveSecret
+   */
+  saveSecret: {
+    methodKind: "unary";
+    input: typeof SaveSecretRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc secrets.v1.SecretsService.DeleteSecret
+   */
+  deleteSecret: {
+    methodKind: "unary";
+    input: typeof DeleteSecretRequestSchema;
+    output: typeof EmptySchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_secrets_v1_secrets, 0);
+
@@ -0,0 +1,98 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file volumestats/v1/volumestats.proto (package volumestats.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file volumestats/v1/volumestats.proto.
+ */
+export const file_volumestats_v1_volumestats: GenFile = /*@__PURE__*/
+  fileDesc("CiB2b2x1bWVzdGF0cy92MS92b2x1bWVzdGF0cy5wcm90bxIOdm9sdW1lc3RhdHMudjEiMwoSU2l6ZUhpc3RvcnlSZXF1ZXN0Eg4KBnZvbHVtZRgBIAEoCRINCgVzaW5jZRgCIAEoAyJCChNTaXplSGlzdG9yeVJlc3BvbnNlEisKB3NhbXBsZXMYASADKAsyGi52b2x1bWVzdGF0cy52MS5TaXplU2FtcGxlIigKClNpemVTYW1wbGUSDAoEdGltZRgBIAEoAxIMCgRzaXplGAIgASgDMm4KElZvbHVtZVN0YXRzU2VydmljZRJYCgtTaXplSGlzdG9yeRIiLnZvbHVtZXN0YXRzLnYxLlNpemVIaXN0b3J5UmVxdWVzdBojLnZvbHVtZXN0YXRzLnYxLlNpemVIaXN0b3J5UmVzcG9uc2UiAEKyAQoSY29tLnZvbHVtZXN0YXRzLnYxQhBWb2x1bWVzdGF0c1Byb3RvUAFaMWdpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvdm9sdW1lc3RhdHMvdjGiAgNWWFiqAg5Wb2x1bWVzdGF0cy5WMcoCDlZvbHVtZXN0YXRzXFYx4gIaVm9sdW1lc3RhdHNcVjFcR1BCTWV0YWRhdGHqAg9Wb2x1bWVzdGF0czo6VjFiBnByb3RvMw");
+
+/**
+ * @generated from message volumestats.v1.SizeHistoryRequest
+ */
+export type SizeHistoryRequest = Message<"volumestats.v1.SizeHistoryRequest"> & {
+  /**
+   * @generated from field: string volume = 1;
+   */
+  volume: string;
+
+  /**
+   * unix seconds, only samples after this are returned, 0 returns all of them
+   *
+   * @generated from field: int64 since = 2;
+   */
+  since: bigint;
+};
+
+/**
+ * Describes the message volumestats.v1.SizeHistoryRequest.
+ * Use `create(SizeHistoryRequestSchema)` to create a new message.
+ */
+export const SizeHistoryRequestSchema: GenMessage<SizeHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_volumestats_v1_volumestats, 0);
+
+/**
+ * @generated from message volumestats.v1.SizeHistoryResponse
+ */
+export type SizeHistoryResponse = Message<"volumestats.v1.SizeHistoryResponse"> & {
+  /**
+   * @generated from field: repeated volumestats.v1.SizeSample samples = 1;
+   */
+  samples: SizeSample[];
+};
+
+/**
+ * Describes the message volumestats.v1.SizeHistoryResponse.
+ * Use `create(SizeHistoryResponseSchema)` to create a new message.
+ */
+export const SizeHistoryResponseSchema: GenMessage<SizeHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_volumestats_v1_volumestats, 1);
+
+/**
+ * @generated from message volumestats.v1.SizeSample
+ */
+export type SizeSample = Message<"volumestats.v1.SizeSample"> & {
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 time = 1;
+   */
+  time: bigint;
+
+  /**
+   * bytes
+   *
+   * @generated from field: int64 size = 2;
+   */
+  size: bigint;
+};
+
+/**
+ * Describes the message volumestats.v1.SizeSample.
+ * Use `create(SizeSampleSchema)` to create a new message.
+ */
+export const SizeSampleSchema: GenMessage<SizeSample> = /*@__PURE__*/
+  messageDesc(file_volumestats_v1_volumestats, 2);
+
+/**
+ * @generated from service volumestats.v1.VolumeStatsService
+ */
+export const VolumeStatsService: GenService<{
+  /**
+   * SizeHistory sizes of a volume on the current host, oldest first
+   *
+   * @generated from rpc volumestats.v1.VolumeStatsService.SizeHistory
+   */
+  sizeHistory: {
+    methodKind: "unary";
+    input: typeof SizeHistoryRequestSchema;
+    output: typeof SizeHistoryResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_volumestats_v1_volumestats, 0);
+